@@ -7,6 +7,7 @@
 package v1
 
 import (
+	_ "github.com/go-kratos/kratos/v2/errors"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -21,22 +22,130 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// 业务错误 - reason和http状态码, grpc状态码由http状态码转换
+// 响应体为{"errors":{key:[message]}}, key为metadata中的field, 没有field时为reason
 type ErrorReason int32
 
 const (
-	ErrorReason_GREETER_UNSPECIFIED ErrorReason = 0
-	ErrorReason_USER_NOT_FOUND      ErrorReason = 1
+	ErrorReason_ERROR_REASON_UNSPECIFIED ErrorReason = 0
+	ErrorReason_USER_NOT_FOUND           ErrorReason = 1
+	// 服务内部错误, 不返回具体原因
+	ErrorReason_INTERNAL ErrorReason = 2
+	// 字段校验失败, metadata中的field为字段名
+	ErrorReason_VALIDATION_FAILED ErrorReason = 3
+	ErrorReason_UNAUTHORIZED      ErrorReason = 4
+	// 登录时邮箱或密码错误
+	ErrorReason_INVALID_CREDENTIALS ErrorReason = 5
+	ErrorReason_FORBIDDEN           ErrorReason = 6
+	// 被对方拉黑
+	ErrorReason_BLOCKED                  ErrorReason = 7
+	ErrorReason_ARTICLE_NOT_FOUND        ErrorReason = 8
+	ErrorReason_COMMENT_NOT_FOUND        ErrorReason = 9
+	ErrorReason_TAG_NOT_FOUND            ErrorReason = 10
+	ErrorReason_ALIAS_NOT_FOUND          ErrorReason = 11
+	ErrorReason_COLLECTION_NOT_FOUND     ErrorReason = 12
+	ErrorReason_ATTACHMENT_NOT_FOUND     ErrorReason = 13
+	ErrorReason_MEDIA_NOT_FOUND          ErrorReason = 14
+	ErrorReason_FOLLOW_REQUEST_NOT_FOUND ErrorReason = 15
+	ErrorReason_FOLLOW_SELF              ErrorReason = 16
+	ErrorReason_FOLLOW_EXISTS            ErrorReason = 17
+	ErrorReason_FOLLOW_NOT_FOUND         ErrorReason = 18
+	ErrorReason_FOLLOW_REQUEST_EXISTS    ErrorReason = 19
+	ErrorReason_BLOCK_SELF               ErrorReason = 20
+	ErrorReason_BLOCK_EXISTS             ErrorReason = 21
+	ErrorReason_BLOCK_NOT_FOUND          ErrorReason = 22
+	ErrorReason_MUTE_SELF                ErrorReason = 23
+	ErrorReason_MUTE_EXISTS              ErrorReason = 24
+	ErrorReason_MUTE_NOT_FOUND           ErrorReason = 25
+	// 占位用户(已删除用户的内容归属)不能删除
+	ErrorReason_PLACEHOLDER_USER          ErrorReason = 26
+	ErrorReason_ATTACHMENT_QUOTA_EXCEEDED ErrorReason = 27
+	ErrorReason_MEDIA_KEY_INVALID         ErrorReason = 28
+	ErrorReason_MEDIA_SIGNATURE_INVALID   ErrorReason = 29
+	ErrorReason_MEDIA_SIGNATURE_EXPIRED   ErrorReason = 30
+	ErrorReason_TIMEOUT                   ErrorReason = 31
+	// 客户端断开连接
+	ErrorReason_CANCELED               ErrorReason = 32
+	ErrorReason_NOTIFICATION_NOT_FOUND ErrorReason = 33
+	// 占位用户的用户名被普通用户占用, 无法转移已删除用户的内容
+	ErrorReason_PLACEHOLDER_TAKEN ErrorReason = 34
 )
 
 // Enum value maps for ErrorReason.
 var (
 	ErrorReason_name = map[int32]string{
-		0: "GREETER_UNSPECIFIED",
-		1: "USER_NOT_FOUND",
+		0:  "ERROR_REASON_UNSPECIFIED",
+		1:  "USER_NOT_FOUND",
+		2:  "INTERNAL",
+		3:  "VALIDATION_FAILED",
+		4:  "UNAUTHORIZED",
+		5:  "INVALID_CREDENTIALS",
+		6:  "FORBIDDEN",
+		7:  "BLOCKED",
+		8:  "ARTICLE_NOT_FOUND",
+		9:  "COMMENT_NOT_FOUND",
+		10: "TAG_NOT_FOUND",
+		11: "ALIAS_NOT_FOUND",
+		12: "COLLECTION_NOT_FOUND",
+		13: "ATTACHMENT_NOT_FOUND",
+		14: "MEDIA_NOT_FOUND",
+		15: "FOLLOW_REQUEST_NOT_FOUND",
+		16: "FOLLOW_SELF",
+		17: "FOLLOW_EXISTS",
+		18: "FOLLOW_NOT_FOUND",
+		19: "FOLLOW_REQUEST_EXISTS",
+		20: "BLOCK_SELF",
+		21: "BLOCK_EXISTS",
+		22: "BLOCK_NOT_FOUND",
+		23: "MUTE_SELF",
+		24: "MUTE_EXISTS",
+		25: "MUTE_NOT_FOUND",
+		26: "PLACEHOLDER_USER",
+		27: "ATTACHMENT_QUOTA_EXCEEDED",
+		28: "MEDIA_KEY_INVALID",
+		29: "MEDIA_SIGNATURE_INVALID",
+		30: "MEDIA_SIGNATURE_EXPIRED",
+		31: "TIMEOUT",
+		32: "CANCELED",
+		33: "NOTIFICATION_NOT_FOUND",
+		34: "PLACEHOLDER_TAKEN",
 	}
 	ErrorReason_value = map[string]int32{
-		"GREETER_UNSPECIFIED": 0,
-		"USER_NOT_FOUND":      1,
+		"ERROR_REASON_UNSPECIFIED":  0,
+		"USER_NOT_FOUND":            1,
+		"INTERNAL":                  2,
+		"VALIDATION_FAILED":         3,
+		"UNAUTHORIZED":              4,
+		"INVALID_CREDENTIALS":       5,
+		"FORBIDDEN":                 6,
+		"BLOCKED":                   7,
+		"ARTICLE_NOT_FOUND":         8,
+		"COMMENT_NOT_FOUND":         9,
+		"TAG_NOT_FOUND":             10,
+		"ALIAS_NOT_FOUND":           11,
+		"COLLECTION_NOT_FOUND":      12,
+		"ATTACHMENT_NOT_FOUND":      13,
+		"MEDIA_NOT_FOUND":           14,
+		"FOLLOW_REQUEST_NOT_FOUND":  15,
+		"FOLLOW_SELF":               16,
+		"FOLLOW_EXISTS":             17,
+		"FOLLOW_NOT_FOUND":          18,
+		"FOLLOW_REQUEST_EXISTS":     19,
+		"BLOCK_SELF":                20,
+		"BLOCK_EXISTS":              21,
+		"BLOCK_NOT_FOUND":           22,
+		"MUTE_SELF":                 23,
+		"MUTE_EXISTS":               24,
+		"MUTE_NOT_FOUND":            25,
+		"PLACEHOLDER_USER":          26,
+		"ATTACHMENT_QUOTA_EXCEEDED": 27,
+		"MEDIA_KEY_INVALID":         28,
+		"MEDIA_SIGNATURE_INVALID":   29,
+		"MEDIA_SIGNATURE_EXPIRED":   30,
+		"TIMEOUT":                   31,
+		"CANCELED":                  32,
+		"NOTIFICATION_NOT_FOUND":    33,
+		"PLACEHOLDER_TAKEN":         34,
 	}
 )
 
@@ -71,10 +180,45 @@ var File_realworld_v1_error_reason_proto protoreflect.FileDescriptor
 
 const file_realworld_v1_error_reason_proto_rawDesc = "" +
 	"\n" +
-	"\x1frealworld/v1/error_reason.proto\x12\frealworld.v1*:\n" +
-	"\vErrorReason\x12\x17\n" +
-	"\x13GREETER_UNSPECIFIED\x10\x00\x12\x12\n" +
-	"\x0eUSER_NOT_FOUND\x10\x01B&Z$kratos-realworld/api/realworld/v1;v1b\x06proto3"
+	"\x1frealworld/v1/error_reason.proto\x12\frealworld.v1\x1a\x13errors/errors.proto*\xce\a\n" +
+	"\vErrorReason\x12\x1c\n" +
+	"\x18ERROR_REASON_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x0eUSER_NOT_FOUND\x10\x01\x1a\x04\xa8E\x94\x03\x12\x12\n" +
+	"\bINTERNAL\x10\x02\x1a\x04\xa8E\xf4\x03\x12\x1b\n" +
+	"\x11VALIDATION_FAILED\x10\x03\x1a\x04\xa8E\xa6\x03\x12\x16\n" +
+	"\fUNAUTHORIZED\x10\x04\x1a\x04\xa8E\x91\x03\x12\x1d\n" +
+	"\x13INVALID_CREDENTIALS\x10\x05\x1a\x04\xa8E\x91\x03\x12\x13\n" +
+	"\tFORBIDDEN\x10\x06\x1a\x04\xa8E\x93\x03\x12\x11\n" +
+	"\aBLOCKED\x10\a\x1a\x04\xa8E\x93\x03\x12\x1b\n" +
+	"\x11ARTICLE_NOT_FOUND\x10\b\x1a\x04\xa8E\x94\x03\x12\x1b\n" +
+	"\x11COMMENT_NOT_FOUND\x10\t\x1a\x04\xa8E\x94\x03\x12\x17\n" +
+	"\rTAG_NOT_FOUND\x10\n" +
+	"\x1a\x04\xa8E\x94\x03\x12\x19\n" +
+	"\x0fALIAS_NOT_FOUND\x10\v\x1a\x04\xa8E\x94\x03\x12\x1e\n" +
+	"\x14COLLECTION_NOT_FOUND\x10\f\x1a\x04\xa8E\x94\x03\x12\x1e\n" +
+	"\x14ATTACHMENT_NOT_FOUND\x10\r\x1a\x04\xa8E\x94\x03\x12\x19\n" +
+	"\x0fMEDIA_NOT_FOUND\x10\x0e\x1a\x04\xa8E\x94\x03\x12\"\n" +
+	"\x18FOLLOW_REQUEST_NOT_FOUND\x10\x0f\x1a\x04\xa8E\x94\x03\x12\x15\n" +
+	"\vFOLLOW_SELF\x10\x10\x1a\x04\xa8E\x90\x03\x12\x17\n" +
+	"\rFOLLOW_EXISTS\x10\x11\x1a\x04\xa8E\x90\x03\x12\x1a\n" +
+	"\x10FOLLOW_NOT_FOUND\x10\x12\x1a\x04\xa8E\x90\x03\x12\x1f\n" +
+	"\x15FOLLOW_REQUEST_EXISTS\x10\x13\x1a\x04\xa8E\x90\x03\x12\x14\n" +
+	"\n" +
+	"BLOCK_SELF\x10\x14\x1a\x04\xa8E\x90\x03\x12\x16\n" +
+	"\fBLOCK_EXISTS\x10\x15\x1a\x04\xa8E\x90\x03\x12\x19\n" +
+	"\x0fBLOCK_NOT_FOUND\x10\x16\x1a\x04\xa8E\x90\x03\x12\x13\n" +
+	"\tMUTE_SELF\x10\x17\x1a\x04\xa8E\x90\x03\x12\x15\n" +
+	"\vMUTE_EXISTS\x10\x18\x1a\x04\xa8E\x90\x03\x12\x18\n" +
+	"\x0eMUTE_NOT_FOUND\x10\x19\x1a\x04\xa8E\x90\x03\x12\x1a\n" +
+	"\x10PLACEHOLDER_USER\x10\x1a\x1a\x04\xa8E\x90\x03\x12#\n" +
+	"\x19ATTACHMENT_QUOTA_EXCEEDED\x10\x1b\x1a\x04\xa8E\x93\x03\x12\x1b\n" +
+	"\x11MEDIA_KEY_INVALID\x10\x1c\x1a\x04\xa8E\x90\x03\x12!\n" +
+	"\x17MEDIA_SIGNATURE_INVALID\x10\x1d\x1a\x04\xa8E\x93\x03\x12!\n" +
+	"\x17MEDIA_SIGNATURE_EXPIRED\x10\x1e\x1a\x04\xa8E\x93\x03\x12\x11\n" +
+	"\aTIMEOUT\x10\x1f\x1a\x04\xa8E\xf8\x03\x12\x12\n" +
+	"\bCANCELED\x10 \x1a\x04\xa8E\xf3\x03\x12 \n" +
+	"\x16NOTIFICATION_NOT_FOUND\x10!\x1a\x04\xa8E\x94\x03\x12\x1b\n" +
+	"\x11PLACEHOLDER_TAKEN\x10\"\x1a\x04\xa8E\x99\x03\x1a\x04\xa0E\xf4\x03B&Z$kratos-realworld/api/realworld/v1;v1b\x06proto3"
 
 var (
 	file_realworld_v1_error_reason_proto_rawDescOnce sync.Once
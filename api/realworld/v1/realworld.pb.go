@@ -24,7 +24,11 @@ const (
 )
 
 type GetTagsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 为0时返回全部
+	Limit int64 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	// 按前缀过滤, 不区分大小写, 用于输入时的自动补全
+	Prefix        string `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -59,27 +63,41 @@ func (*GetTagsRequest) Descriptor() ([]byte, []int) {
 	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{0}
 }
 
-type FavoriteArticleRequest struct {
+func (x *GetTagsRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetTagsRequest) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+type GetTrendingTagsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	Limit         int64                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FavoriteArticleRequest) Reset() {
-	*x = FavoriteArticleRequest{}
+func (x *GetTrendingTagsRequest) Reset() {
+	*x = GetTrendingTagsRequest{}
 	mi := &file_realworld_v1_realworld_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FavoriteArticleRequest) String() string {
+func (x *GetTrendingTagsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FavoriteArticleRequest) ProtoMessage() {}
+func (*GetTrendingTagsRequest) ProtoMessage() {}
 
-func (x *FavoriteArticleRequest) ProtoReflect() protoreflect.Message {
+func (x *GetTrendingTagsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_realworld_v1_realworld_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -91,39 +109,39 @@ func (x *FavoriteArticleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FavoriteArticleRequest.ProtoReflect.Descriptor instead.
-func (*FavoriteArticleRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetTrendingTagsRequest.ProtoReflect.Descriptor instead.
+func (*GetTrendingTagsRequest) Descriptor() ([]byte, []int) {
 	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *FavoriteArticleRequest) GetSlug() string {
+func (x *GetTrendingTagsRequest) GetLimit() int64 {
 	if x != nil {
-		return x.Slug
+		return x.Limit
 	}
-	return ""
+	return 0
 }
 
-type UnfavoriteArticleRequest struct {
+type FollowTagRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	Tag           string                 `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UnfavoriteArticleRequest) Reset() {
-	*x = UnfavoriteArticleRequest{}
+func (x *FollowTagRequest) Reset() {
+	*x = FollowTagRequest{}
 	mi := &file_realworld_v1_realworld_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnfavoriteArticleRequest) String() string {
+func (x *FollowTagRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnfavoriteArticleRequest) ProtoMessage() {}
+func (*FollowTagRequest) ProtoMessage() {}
 
-func (x *UnfavoriteArticleRequest) ProtoReflect() protoreflect.Message {
+func (x *FollowTagRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_realworld_v1_realworld_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -135,40 +153,39 @@ func (x *UnfavoriteArticleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnfavoriteArticleRequest.ProtoReflect.Descriptor instead.
-func (*UnfavoriteArticleRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use FollowTagRequest.ProtoReflect.Descriptor instead.
+func (*FollowTagRequest) Descriptor() ([]byte, []int) {
 	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *UnfavoriteArticleRequest) GetSlug() string {
+func (x *FollowTagRequest) GetTag() string {
 	if x != nil {
-		return x.Slug
+		return x.Tag
 	}
 	return ""
 }
 
-type DeleteCommentRequest struct {
+type UnfollowTagRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
-	Id            uint32                 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Tag           string                 `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteCommentRequest) Reset() {
-	*x = DeleteCommentRequest{}
+func (x *UnfollowTagRequest) Reset() {
+	*x = UnfollowTagRequest{}
 	mi := &file_realworld_v1_realworld_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteCommentRequest) String() string {
+func (x *UnfollowTagRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCommentRequest) ProtoMessage() {}
+func (*UnfollowTagRequest) ProtoMessage() {}
 
-func (x *DeleteCommentRequest) ProtoReflect() protoreflect.Message {
+func (x *UnfollowTagRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_realworld_v1_realworld_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -180,46 +197,40 @@ func (x *DeleteCommentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCommentRequest.ProtoReflect.Descriptor instead.
-func (*DeleteCommentRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnfollowTagRequest.ProtoReflect.Descriptor instead.
+func (*UnfollowTagRequest) Descriptor() ([]byte, []int) {
 	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *DeleteCommentRequest) GetSlug() string {
+func (x *UnfollowTagRequest) GetTag() string {
 	if x != nil {
-		return x.Slug
+		return x.Tag
 	}
 	return ""
 }
 
-func (x *DeleteCommentRequest) GetId() uint32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-type DeleteCommentResponse struct {
+type RenameTagRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Tag           string                 `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteCommentResponse) Reset() {
-	*x = DeleteCommentResponse{}
+func (x *RenameTagRequest) Reset() {
+	*x = RenameTagRequest{}
 	mi := &file_realworld_v1_realworld_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteCommentResponse) String() string {
+func (x *RenameTagRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCommentResponse) ProtoMessage() {}
+func (*RenameTagRequest) ProtoMessage() {}
 
-func (x *DeleteCommentResponse) ProtoReflect() protoreflect.Message {
+func (x *RenameTagRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_realworld_v1_realworld_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -231,39 +242,47 @@ func (x *DeleteCommentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCommentResponse.ProtoReflect.Descriptor instead.
-func (*DeleteCommentResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RenameTagRequest.ProtoReflect.Descriptor instead.
+func (*RenameTagRequest) Descriptor() ([]byte, []int) {
 	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *DeleteCommentResponse) GetMessage() string {
+func (x *RenameTagRequest) GetTag() string {
 	if x != nil {
-		return x.Message
+		return x.Tag
 	}
 	return ""
 }
 
-type GetCommentsRequest struct {
+func (x *RenameTagRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type MergeTagRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	Tag           string                 `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Target        string                 `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCommentsRequest) Reset() {
-	*x = GetCommentsRequest{}
+func (x *MergeTagRequest) Reset() {
+	*x = MergeTagRequest{}
 	mi := &file_realworld_v1_realworld_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCommentsRequest) String() string {
+func (x *MergeTagRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCommentsRequest) ProtoMessage() {}
+func (*MergeTagRequest) ProtoMessage() {}
 
-func (x *GetCommentsRequest) ProtoReflect() protoreflect.Message {
+func (x *MergeTagRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_realworld_v1_realworld_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -275,40 +294,46 @@ func (x *GetCommentsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCommentsRequest.ProtoReflect.Descriptor instead.
-func (*GetCommentsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use MergeTagRequest.ProtoReflect.Descriptor instead.
+func (*MergeTagRequest) Descriptor() ([]byte, []int) {
 	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *GetCommentsRequest) GetSlug() string {
+func (x *MergeTagRequest) GetTag() string {
 	if x != nil {
-		return x.Slug
+		return x.Tag
 	}
 	return ""
 }
 
-type AddCommentRequest struct {
-	state         protoimpl.MessageState     `protogen:"open.v1"`
-	Comment       *AddCommentRequest_Comment `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
-	Slug          string                     `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+func (x *MergeTagRequest) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+type DeleteTagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tag           string                 `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddCommentRequest) Reset() {
-	*x = AddCommentRequest{}
+func (x *DeleteTagRequest) Reset() {
+	*x = DeleteTagRequest{}
 	mi := &file_realworld_v1_realworld_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddCommentRequest) String() string {
+func (x *DeleteTagRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddCommentRequest) ProtoMessage() {}
+func (*DeleteTagRequest) ProtoMessage() {}
 
-func (x *AddCommentRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteTagRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_realworld_v1_realworld_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -320,46 +345,38 @@ func (x *AddCommentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddCommentRequest.ProtoReflect.Descriptor instead.
-func (*AddCommentRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteTagRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTagRequest) Descriptor() ([]byte, []int) {
 	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *AddCommentRequest) GetComment() *AddCommentRequest_Comment {
-	if x != nil {
-		return x.Comment
-	}
-	return nil
-}
-
-func (x *AddCommentRequest) GetSlug() string {
+func (x *DeleteTagRequest) GetTag() string {
 	if x != nil {
-		return x.Slug
+		return x.Tag
 	}
 	return ""
 }
 
-type DeleteArticleRequest struct {
+type DeleteTagResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteArticleRequest) Reset() {
-	*x = DeleteArticleRequest{}
+func (x *DeleteTagResponse) Reset() {
+	*x = DeleteTagResponse{}
 	mi := &file_realworld_v1_realworld_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteArticleRequest) String() string {
+func (x *DeleteTagResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteArticleRequest) ProtoMessage() {}
+func (*DeleteTagResponse) ProtoMessage() {}
 
-func (x *DeleteArticleRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteTagResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_realworld_v1_realworld_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -371,39 +388,33 @@ func (x *DeleteArticleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteArticleRequest.ProtoReflect.Descriptor instead.
-func (*DeleteArticleRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteTagResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTagResponse) Descriptor() ([]byte, []int) {
 	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *DeleteArticleRequest) GetSlug() string {
-	if x != nil {
-		return x.Slug
-	}
-	return ""
-}
-
-type DeleteArticleResponse struct {
+type AddTagAliasRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Tag           string                 `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Alias         string                 `protobuf:"bytes,2,opt,name=alias,proto3" json:"alias,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteArticleResponse) Reset() {
-	*x = DeleteArticleResponse{}
+func (x *AddTagAliasRequest) Reset() {
+	*x = AddTagAliasRequest{}
 	mi := &file_realworld_v1_realworld_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteArticleResponse) String() string {
+func (x *AddTagAliasRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteArticleResponse) ProtoMessage() {}
+func (*AddTagAliasRequest) ProtoMessage() {}
 
-func (x *DeleteArticleResponse) ProtoReflect() protoreflect.Message {
+func (x *AddTagAliasRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_realworld_v1_realworld_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -415,40 +426,47 @@ func (x *DeleteArticleResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteArticleResponse.ProtoReflect.Descriptor instead.
-func (*DeleteArticleResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddTagAliasRequest.ProtoReflect.Descriptor instead.
+func (*AddTagAliasRequest) Descriptor() ([]byte, []int) {
 	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *DeleteArticleResponse) GetMessage() string {
+func (x *AddTagAliasRequest) GetTag() string {
 	if x != nil {
-		return x.Message
+		return x.Tag
 	}
 	return ""
 }
 
-type UpdateArticleRequest struct {
-	state         protoimpl.MessageState        `protogen:"open.v1"`
-	Article       *UpdateArticleRequest_Article `protobuf:"bytes,1,opt,name=article,proto3" json:"article,omitempty"`
-	Slug          string                        `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+func (x *AddTagAliasRequest) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+type RemoveTagAliasRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tag           string                 `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Alias         string                 `protobuf:"bytes,2,opt,name=alias,proto3" json:"alias,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateArticleRequest) Reset() {
-	*x = UpdateArticleRequest{}
+func (x *RemoveTagAliasRequest) Reset() {
+	*x = RemoveTagAliasRequest{}
 	mi := &file_realworld_v1_realworld_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateArticleRequest) String() string {
+func (x *RemoveTagAliasRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateArticleRequest) ProtoMessage() {}
+func (*RemoveTagAliasRequest) ProtoMessage() {}
 
-func (x *UpdateArticleRequest) ProtoReflect() protoreflect.Message {
+func (x *RemoveTagAliasRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_realworld_v1_realworld_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -460,46 +478,45 @@ func (x *UpdateArticleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateArticleRequest.ProtoReflect.Descriptor instead.
-func (*UpdateArticleRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RemoveTagAliasRequest.ProtoReflect.Descriptor instead.
+func (*RemoveTagAliasRequest) Descriptor() ([]byte, []int) {
 	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *UpdateArticleRequest) GetArticle() *UpdateArticleRequest_Article {
+func (x *RemoveTagAliasRequest) GetTag() string {
 	if x != nil {
-		return x.Article
+		return x.Tag
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateArticleRequest) GetSlug() string {
+func (x *RemoveTagAliasRequest) GetAlias() string {
 	if x != nil {
-		return x.Slug
+		return x.Alias
 	}
 	return ""
 }
 
-type CreateArticleRequest struct {
-	state         protoimpl.MessageState        `protogen:"open.v1"`
-	Article       *CreateArticleRequest_Article `protobuf:"bytes,1,opt,name=article,proto3" json:"article,omitempty"`
+type GetReactionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateArticleRequest) Reset() {
-	*x = CreateArticleRequest{}
+func (x *GetReactionsRequest) Reset() {
+	*x = GetReactionsRequest{}
 	mi := &file_realworld_v1_realworld_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateArticleRequest) String() string {
+func (x *GetReactionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateArticleRequest) ProtoMessage() {}
+func (*GetReactionsRequest) ProtoMessage() {}
 
-func (x *CreateArticleRequest) ProtoReflect() protoreflect.Message {
+func (x *GetReactionsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_realworld_v1_realworld_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -511,40 +528,33 @@ func (x *CreateArticleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateArticleRequest.ProtoReflect.Descriptor instead.
-func (*CreateArticleRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetReactionsRequest.ProtoReflect.Descriptor instead.
+func (*GetReactionsRequest) Descriptor() ([]byte, []int) {
 	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *CreateArticleRequest) GetArticle() *CreateArticleRequest_Article {
-	if x != nil {
-		return x.Article
-	}
-	return nil
-}
-
-type FeedArticlesRequest struct {
+type AddArticleReactionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Limit         int64                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int64                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	Reaction      string                 `protobuf:"bytes,2,opt,name=reaction,proto3" json:"reaction,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FeedArticlesRequest) Reset() {
-	*x = FeedArticlesRequest{}
+func (x *AddArticleReactionRequest) Reset() {
+	*x = AddArticleReactionRequest{}
 	mi := &file_realworld_v1_realworld_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FeedArticlesRequest) String() string {
+func (x *AddArticleReactionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FeedArticlesRequest) ProtoMessage() {}
+func (*AddArticleReactionRequest) ProtoMessage() {}
 
-func (x *FeedArticlesRequest) ProtoReflect() protoreflect.Message {
+func (x *AddArticleReactionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_realworld_v1_realworld_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -556,46 +566,47 @@ func (x *FeedArticlesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FeedArticlesRequest.ProtoReflect.Descriptor instead.
-func (*FeedArticlesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddArticleReactionRequest.ProtoReflect.Descriptor instead.
+func (*AddArticleReactionRequest) Descriptor() ([]byte, []int) {
 	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *FeedArticlesRequest) GetLimit() int64 {
+func (x *AddArticleReactionRequest) GetSlug() string {
 	if x != nil {
-		return x.Limit
+		return x.Slug
 	}
-	return 0
+	return ""
 }
 
-func (x *FeedArticlesRequest) GetOffset() int64 {
+func (x *AddArticleReactionRequest) GetReaction() string {
 	if x != nil {
-		return x.Offset
+		return x.Reaction
 	}
-	return 0
+	return ""
 }
 
-type GetArticleRequest struct {
+type RemoveArticleReactionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	Reaction      string                 `protobuf:"bytes,2,opt,name=reaction,proto3" json:"reaction,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetArticleRequest) Reset() {
-	*x = GetArticleRequest{}
+func (x *RemoveArticleReactionRequest) Reset() {
+	*x = RemoveArticleReactionRequest{}
 	mi := &file_realworld_v1_realworld_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetArticleRequest) String() string {
+func (x *RemoveArticleReactionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetArticleRequest) ProtoMessage() {}
+func (*RemoveArticleReactionRequest) ProtoMessage() {}
 
-func (x *GetArticleRequest) ProtoReflect() protoreflect.Message {
+func (x *RemoveArticleReactionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_realworld_v1_realworld_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -607,43 +618,48 @@ func (x *GetArticleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetArticleRequest.ProtoReflect.Descriptor instead.
-func (*GetArticleRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RemoveArticleReactionRequest.ProtoReflect.Descriptor instead.
+func (*RemoveArticleReactionRequest) Descriptor() ([]byte, []int) {
 	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *GetArticleRequest) GetSlug() string {
+func (x *RemoveArticleReactionRequest) GetSlug() string {
 	if x != nil {
 		return x.Slug
 	}
 	return ""
 }
 
-type ListArticlesRequest struct {
+func (x *RemoveArticleReactionRequest) GetReaction() string {
+	if x != nil {
+		return x.Reaction
+	}
+	return ""
+}
+
+type AddCommentReactionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Tag           string                 `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
-	Author        string                 `protobuf:"bytes,2,opt,name=author,proto3" json:"author,omitempty"`
-	Favorited     string                 `protobuf:"bytes,3,opt,name=favorited,proto3" json:"favorited,omitempty"`
-	Limit         int64                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int64                  `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
+	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	Id            uint32                 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Reaction      string                 `protobuf:"bytes,3,opt,name=reaction,proto3" json:"reaction,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListArticlesRequest) Reset() {
-	*x = ListArticlesRequest{}
+func (x *AddCommentReactionRequest) Reset() {
+	*x = AddCommentReactionRequest{}
 	mi := &file_realworld_v1_realworld_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListArticlesRequest) String() string {
+func (x *AddCommentReactionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListArticlesRequest) ProtoMessage() {}
+func (*AddCommentReactionRequest) ProtoMessage() {}
 
-func (x *ListArticlesRequest) ProtoReflect() protoreflect.Message {
+func (x *AddCommentReactionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_realworld_v1_realworld_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -655,67 +671,55 @@ func (x *ListArticlesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListArticlesRequest.ProtoReflect.Descriptor instead.
-func (*ListArticlesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddCommentReactionRequest.ProtoReflect.Descriptor instead.
+func (*AddCommentReactionRequest) Descriptor() ([]byte, []int) {
 	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *ListArticlesRequest) GetTag() string {
+func (x *AddCommentReactionRequest) GetSlug() string {
 	if x != nil {
-		return x.Tag
+		return x.Slug
 	}
 	return ""
 }
 
-func (x *ListArticlesRequest) GetAuthor() string {
+func (x *AddCommentReactionRequest) GetId() uint32 {
 	if x != nil {
-		return x.Author
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *ListArticlesRequest) GetFavorited() string {
+func (x *AddCommentReactionRequest) GetReaction() string {
 	if x != nil {
-		return x.Favorited
+		return x.Reaction
 	}
 	return ""
 }
 
-func (x *ListArticlesRequest) GetLimit() int64 {
-	if x != nil {
-		return x.Limit
-	}
-	return 0
-}
-
-func (x *ListArticlesRequest) GetOffset() int64 {
-	if x != nil {
-		return x.Offset
-	}
-	return 0
-}
-
-type UnfollowUserRequest struct {
+type RemoveCommentReactionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	Id            uint32                 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Reaction      string                 `protobuf:"bytes,3,opt,name=reaction,proto3" json:"reaction,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UnfollowUserRequest) Reset() {
-	*x = UnfollowUserRequest{}
+func (x *RemoveCommentReactionRequest) Reset() {
+	*x = RemoveCommentReactionRequest{}
 	mi := &file_realworld_v1_realworld_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnfollowUserRequest) String() string {
+func (x *RemoveCommentReactionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnfollowUserRequest) ProtoMessage() {}
+func (*RemoveCommentReactionRequest) ProtoMessage() {}
 
-func (x *UnfollowUserRequest) ProtoReflect() protoreflect.Message {
+func (x *RemoveCommentReactionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_realworld_v1_realworld_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -727,39 +731,55 @@ func (x *UnfollowUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnfollowUserRequest.ProtoReflect.Descriptor instead.
-func (*UnfollowUserRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RemoveCommentReactionRequest.ProtoReflect.Descriptor instead.
+func (*RemoveCommentReactionRequest) Descriptor() ([]byte, []int) {
 	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *UnfollowUserRequest) GetUsername() string {
+func (x *RemoveCommentReactionRequest) GetSlug() string {
 	if x != nil {
-		return x.Username
+		return x.Slug
 	}
 	return ""
 }
 
-type FollowUserRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+func (x *RemoveCommentReactionRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RemoveCommentReactionRequest) GetReaction() string {
+	if x != nil {
+		return x.Reaction
+	}
+	return ""
+}
+
+type BookmarkArticleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Slug  string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	// 放入的收藏夹, 为0表示不放入收藏夹; 已经加入书签时移动到这个收藏夹
+	CollectionId  uint32 `protobuf:"varint,2,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FollowUserRequest) Reset() {
-	*x = FollowUserRequest{}
+func (x *BookmarkArticleRequest) Reset() {
+	*x = BookmarkArticleRequest{}
 	mi := &file_realworld_v1_realworld_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FollowUserRequest) String() string {
+func (x *BookmarkArticleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FollowUserRequest) ProtoMessage() {}
+func (*BookmarkArticleRequest) ProtoMessage() {}
 
-func (x *FollowUserRequest) ProtoReflect() protoreflect.Message {
+func (x *BookmarkArticleRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_realworld_v1_realworld_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -771,39 +791,46 @@ func (x *FollowUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FollowUserRequest.ProtoReflect.Descriptor instead.
-func (*FollowUserRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BookmarkArticleRequest.ProtoReflect.Descriptor instead.
+func (*BookmarkArticleRequest) Descriptor() ([]byte, []int) {
 	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *FollowUserRequest) GetUsername() string {
+func (x *BookmarkArticleRequest) GetSlug() string {
 	if x != nil {
-		return x.Username
+		return x.Slug
 	}
 	return ""
 }
 
-type GetProfileRequest struct {
+func (x *BookmarkArticleRequest) GetCollectionId() uint32 {
+	if x != nil {
+		return x.CollectionId
+	}
+	return 0
+}
+
+type UnbookmarkArticleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetProfileRequest) Reset() {
-	*x = GetProfileRequest{}
+func (x *UnbookmarkArticleRequest) Reset() {
+	*x = UnbookmarkArticleRequest{}
 	mi := &file_realworld_v1_realworld_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetProfileRequest) String() string {
+func (x *UnbookmarkArticleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProfileRequest) ProtoMessage() {}
+func (*UnbookmarkArticleRequest) ProtoMessage() {}
 
-func (x *GetProfileRequest) ProtoReflect() protoreflect.Message {
+func (x *UnbookmarkArticleRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_realworld_v1_realworld_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -815,39 +842,42 @@ func (x *GetProfileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProfileRequest.ProtoReflect.Descriptor instead.
-func (*GetProfileRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnbookmarkArticleRequest.ProtoReflect.Descriptor instead.
+func (*UnbookmarkArticleRequest) Descriptor() ([]byte, []int) {
 	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *GetProfileRequest) GetUsername() string {
+func (x *UnbookmarkArticleRequest) GetSlug() string {
 	if x != nil {
-		return x.Username
+		return x.Slug
 	}
 	return ""
 }
 
-type UpdateUserRequest struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	User          *UpdateUserRequest_User `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+type ListBookmarksRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 只列出这个收藏夹中的书签, 为0时列出全部
+	CollectionId  uint32 `protobuf:"varint,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	Cursor        string `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit         int64  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateUserRequest) Reset() {
-	*x = UpdateUserRequest{}
+func (x *ListBookmarksRequest) Reset() {
+	*x = ListBookmarksRequest{}
 	mi := &file_realworld_v1_realworld_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateUserRequest) String() string {
+func (x *ListBookmarksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateUserRequest) ProtoMessage() {}
+func (*ListBookmarksRequest) ProtoMessage() {}
 
-func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
+func (x *ListBookmarksRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_realworld_v1_realworld_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -859,38 +889,52 @@ func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateUserRequest.ProtoReflect.Descriptor instead.
-func (*UpdateUserRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListBookmarksRequest.ProtoReflect.Descriptor instead.
+func (*ListBookmarksRequest) Descriptor() ([]byte, []int) {
 	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *UpdateUserRequest) GetUser() *UpdateUserRequest_User {
+func (x *ListBookmarksRequest) GetCollectionId() uint32 {
 	if x != nil {
-		return x.User
+		return x.CollectionId
 	}
-	return nil
+	return 0
 }
 
-type GetCurrentUserRequest struct {
+func (x *ListBookmarksRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListBookmarksRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListBookmarkCollectionsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCurrentUserRequest) Reset() {
-	*x = GetCurrentUserRequest{}
+func (x *ListBookmarkCollectionsRequest) Reset() {
+	*x = ListBookmarkCollectionsRequest{}
 	mi := &file_realworld_v1_realworld_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCurrentUserRequest) String() string {
+func (x *ListBookmarkCollectionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCurrentUserRequest) ProtoMessage() {}
+func (*ListBookmarkCollectionsRequest) ProtoMessage() {}
 
-func (x *GetCurrentUserRequest) ProtoReflect() protoreflect.Message {
+func (x *ListBookmarkCollectionsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_realworld_v1_realworld_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -902,32 +946,32 @@ func (x *GetCurrentUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCurrentUserRequest.ProtoReflect.Descriptor instead.
-func (*GetCurrentUserRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListBookmarkCollectionsRequest.ProtoReflect.Descriptor instead.
+func (*ListBookmarkCollectionsRequest) Descriptor() ([]byte, []int) {
 	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{18}
 }
 
-type LoginRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	User          *LoginRequest_User     `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+type CreateBookmarkCollectionRequest struct {
+	state         protoimpl.MessageState                      `protogen:"open.v1"`
+	Collection    *CreateBookmarkCollectionRequest_Collection `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LoginRequest) Reset() {
-	*x = LoginRequest{}
+func (x *CreateBookmarkCollectionRequest) Reset() {
+	*x = CreateBookmarkCollectionRequest{}
 	mi := &file_realworld_v1_realworld_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LoginRequest) String() string {
+func (x *CreateBookmarkCollectionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoginRequest) ProtoMessage() {}
+func (*CreateBookmarkCollectionRequest) ProtoMessage() {}
 
-func (x *LoginRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateBookmarkCollectionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_realworld_v1_realworld_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -939,39 +983,40 @@ func (x *LoginRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoginRequest.ProtoReflect.Descriptor instead.
-func (*LoginRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateBookmarkCollectionRequest.ProtoReflect.Descriptor instead.
+func (*CreateBookmarkCollectionRequest) Descriptor() ([]byte, []int) {
 	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *LoginRequest) GetUser() *LoginRequest_User {
+func (x *CreateBookmarkCollectionRequest) GetCollection() *CreateBookmarkCollectionRequest_Collection {
 	if x != nil {
-		return x.User
+		return x.Collection
 	}
 	return nil
 }
 
-type RegisterRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	User          *RegisterRequest_User  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+type UpdateBookmarkCollectionRequest struct {
+	state         protoimpl.MessageState                      `protogen:"open.v1"`
+	Collection    *UpdateBookmarkCollectionRequest_Collection `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	Id            uint32                                      `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RegisterRequest) Reset() {
-	*x = RegisterRequest{}
+func (x *UpdateBookmarkCollectionRequest) Reset() {
+	*x = UpdateBookmarkCollectionRequest{}
 	mi := &file_realworld_v1_realworld_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RegisterRequest) String() string {
+func (x *UpdateBookmarkCollectionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegisterRequest) ProtoMessage() {}
+func (*UpdateBookmarkCollectionRequest) ProtoMessage() {}
 
-func (x *RegisterRequest) ProtoReflect() protoreflect.Message {
+func (x *UpdateBookmarkCollectionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_realworld_v1_realworld_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -983,40 +1028,46 @@ func (x *RegisterRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegisterRequest.ProtoReflect.Descriptor instead.
-func (*RegisterRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateBookmarkCollectionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBookmarkCollectionRequest) Descriptor() ([]byte, []int) {
 	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *RegisterRequest) GetUser() *RegisterRequest_User {
+func (x *UpdateBookmarkCollectionRequest) GetCollection() *UpdateBookmarkCollectionRequest_Collection {
 	if x != nil {
-		return x.User
+		return x.Collection
 	}
 	return nil
 }
 
-// 通用
-type UserResponse struct {
+func (x *UpdateBookmarkCollectionRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteBookmarkCollectionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	User          *UserResponse_User     `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserResponse) Reset() {
-	*x = UserResponse{}
+func (x *DeleteBookmarkCollectionRequest) Reset() {
+	*x = DeleteBookmarkCollectionRequest{}
 	mi := &file_realworld_v1_realworld_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserResponse) String() string {
+func (x *DeleteBookmarkCollectionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserResponse) ProtoMessage() {}
+func (*DeleteBookmarkCollectionRequest) ProtoMessage() {}
 
-func (x *UserResponse) ProtoReflect() protoreflect.Message {
+func (x *DeleteBookmarkCollectionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_realworld_v1_realworld_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1028,39 +1079,38 @@ func (x *UserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserResponse.ProtoReflect.Descriptor instead.
-func (*UserResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteBookmarkCollectionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBookmarkCollectionRequest) Descriptor() ([]byte, []int) {
 	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *UserResponse) GetUser() *UserResponse_User {
+func (x *DeleteBookmarkCollectionRequest) GetId() uint32 {
 	if x != nil {
-		return x.User
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-type ProfileResponse struct {
-	state         protoimpl.MessageState   `protogen:"open.v1"`
-	Profile       *ProfileResponse_Profile `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+type DeleteBookmarkCollectionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProfileResponse) Reset() {
-	*x = ProfileResponse{}
+func (x *DeleteBookmarkCollectionResponse) Reset() {
+	*x = DeleteBookmarkCollectionResponse{}
 	mi := &file_realworld_v1_realworld_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProfileResponse) String() string {
+func (x *DeleteBookmarkCollectionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProfileResponse) ProtoMessage() {}
+func (*DeleteBookmarkCollectionResponse) ProtoMessage() {}
 
-func (x *ProfileResponse) ProtoReflect() protoreflect.Message {
+func (x *DeleteBookmarkCollectionResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_realworld_v1_realworld_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1072,48 +1122,31 @@ func (x *ProfileResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProfileResponse.ProtoReflect.Descriptor instead.
-func (*ProfileResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteBookmarkCollectionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteBookmarkCollectionResponse) Descriptor() ([]byte, []int) {
 	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *ProfileResponse) GetProfile() *ProfileResponse_Profile {
-	if x != nil {
-		return x.Profile
-	}
-	return nil
-}
-
-type Article struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Slug           string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
-	Title          string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Description    string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Body           string                 `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
-	TagList        []string               `protobuf:"bytes,5,rep,name=tagList,proto3" json:"tagList,omitempty"`
-	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
-	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
-	Favorited      bool                   `protobuf:"varint,8,opt,name=favorited,proto3" json:"favorited,omitempty"`
-	FavoritesCount uint32                 `protobuf:"varint,9,opt,name=favoritesCount,proto3" json:"favoritesCount,omitempty"`
-	Author         *Profile               `protobuf:"bytes,10,opt,name=author,proto3" json:"author,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type ListAttachmentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Article) Reset() {
-	*x = Article{}
+func (x *ListAttachmentsRequest) Reset() {
+	*x = ListAttachmentsRequest{}
 	mi := &file_realworld_v1_realworld_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Article) String() string {
+func (x *ListAttachmentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Article) ProtoMessage() {}
+func (*ListAttachmentsRequest) ProtoMessage() {}
 
-func (x *Article) ProtoReflect() protoreflect.Message {
+func (x *ListAttachmentsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_realworld_v1_realworld_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1125,103 +1158,4137 @@ func (x *Article) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Article.ProtoReflect.Descriptor instead.
-func (*Article) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListAttachmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListAttachmentsRequest) Descriptor() ([]byte, []int) {
 	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *Article) GetSlug() string {
-	if x != nil {
-		return x.Slug
-	}
-	return ""
+type ListArticleAttachmentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Article) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
+func (x *ListArticleAttachmentsRequest) Reset() {
+	*x = ListArticleAttachmentsRequest{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Article) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
+func (x *ListArticleAttachmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Article) GetBody() string {
+func (*ListArticleAttachmentsRequest) ProtoMessage() {}
+
+func (x *ListArticleAttachmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[24]
 	if x != nil {
-		return x.Body
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Article) GetTagList() []string {
-	if x != nil {
-		return x.TagList
-	}
-	return nil
+// Deprecated: Use ListArticleAttachmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListArticleAttachmentsRequest) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *Article) GetCreatedAt() *timestamppb.Timestamp {
+func (x *ListArticleAttachmentsRequest) GetSlug() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.Slug
 	}
-	return nil
+	return ""
 }
 
-func (x *Article) GetUpdatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.UpdatedAt
-	}
-	return nil
+type DeleteAttachmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Article) GetFavorited() bool {
-	if x != nil {
-		return x.Favorited
-	}
-	return false
+func (x *DeleteAttachmentRequest) Reset() {
+	*x = DeleteAttachmentRequest{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Article) GetFavoritesCount() uint32 {
-	if x != nil {
-		return x.FavoritesCount
-	}
-	return 0
+func (x *DeleteAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Article) GetAuthor() *Profile {
+func (*DeleteAttachmentRequest) ProtoMessage() {}
+
+func (x *DeleteAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *DeleteAttachmentRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteAttachmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAttachmentResponse) Reset() {
+	*x = DeleteAttachmentResponse{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAttachmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAttachmentResponse) ProtoMessage() {}
+
+func (x *DeleteAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{26}
+}
+
+type FavoriteArticleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FavoriteArticleRequest) Reset() {
+	*x = FavoriteArticleRequest{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FavoriteArticleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FavoriteArticleRequest) ProtoMessage() {}
+
+func (x *FavoriteArticleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FavoriteArticleRequest.ProtoReflect.Descriptor instead.
+func (*FavoriteArticleRequest) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *FavoriteArticleRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+type UnfavoriteArticleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnfavoriteArticleRequest) Reset() {
+	*x = UnfavoriteArticleRequest{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnfavoriteArticleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnfavoriteArticleRequest) ProtoMessage() {}
+
+func (x *UnfavoriteArticleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnfavoriteArticleRequest.ProtoReflect.Descriptor instead.
+func (*UnfavoriteArticleRequest) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *UnfavoriteArticleRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+type DeleteCommentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	Id            uint32                 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCommentRequest) Reset() {
+	*x = DeleteCommentRequest{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCommentRequest) ProtoMessage() {}
+
+func (x *DeleteCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCommentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCommentRequest) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *DeleteCommentRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *DeleteCommentRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteCommentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCommentResponse) Reset() {
+	*x = DeleteCommentResponse{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCommentResponse) ProtoMessage() {}
+
+func (x *DeleteCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCommentResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCommentResponse) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *DeleteCommentResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetCommentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCommentsRequest) Reset() {
+	*x = GetCommentsRequest{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCommentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommentsRequest) ProtoMessage() {}
+
+func (x *GetCommentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommentsRequest.ProtoReflect.Descriptor instead.
+func (*GetCommentsRequest) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetCommentsRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+type AddCommentRequest struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Comment       *AddCommentRequest_Comment `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
+	Slug          string                     `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddCommentRequest) Reset() {
+	*x = AddCommentRequest{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCommentRequest) ProtoMessage() {}
+
+func (x *AddCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCommentRequest.ProtoReflect.Descriptor instead.
+func (*AddCommentRequest) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *AddCommentRequest) GetComment() *AddCommentRequest_Comment {
+	if x != nil {
+		return x.Comment
+	}
+	return nil
+}
+
+func (x *AddCommentRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+type DeleteArticleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteArticleRequest) Reset() {
+	*x = DeleteArticleRequest{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteArticleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteArticleRequest) ProtoMessage() {}
+
+func (x *DeleteArticleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteArticleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteArticleRequest) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DeleteArticleRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+type DeleteArticleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteArticleResponse) Reset() {
+	*x = DeleteArticleResponse{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteArticleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteArticleResponse) ProtoMessage() {}
+
+func (x *DeleteArticleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteArticleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteArticleResponse) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *DeleteArticleResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type UpdateArticleRequest struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	Article       *UpdateArticleRequest_Article `protobuf:"bytes,1,opt,name=article,proto3" json:"article,omitempty"`
+	Slug          string                        `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateArticleRequest) Reset() {
+	*x = UpdateArticleRequest{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateArticleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateArticleRequest) ProtoMessage() {}
+
+func (x *UpdateArticleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateArticleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateArticleRequest) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *UpdateArticleRequest) GetArticle() *UpdateArticleRequest_Article {
+	if x != nil {
+		return x.Article
+	}
+	return nil
+}
+
+func (x *UpdateArticleRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+type CreateArticleRequest struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	Article       *CreateArticleRequest_Article `protobuf:"bytes,1,opt,name=article,proto3" json:"article,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateArticleRequest) Reset() {
+	*x = CreateArticleRequest{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateArticleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateArticleRequest) ProtoMessage() {}
+
+func (x *CreateArticleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateArticleRequest.ProtoReflect.Descriptor instead.
+func (*CreateArticleRequest) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CreateArticleRequest) GetArticle() *CreateArticleRequest_Article {
+	if x != nil {
+		return x.Article
+	}
+	return nil
+}
+
+type FeedArticlesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Limit int64                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	// 兼容旧的客户端, 传了cursor时忽略
+	Offset        int64  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Cursor        string `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FeedArticlesRequest) Reset() {
+	*x = FeedArticlesRequest{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeedArticlesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeedArticlesRequest) ProtoMessage() {}
+
+func (x *FeedArticlesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeedArticlesRequest.ProtoReflect.Descriptor instead.
+func (*FeedArticlesRequest) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *FeedArticlesRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *FeedArticlesRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *FeedArticlesRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type GetArticleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetArticleRequest) Reset() {
+	*x = GetArticleRequest{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetArticleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetArticleRequest) ProtoMessage() {}
+
+func (x *GetArticleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetArticleRequest.ProtoReflect.Descriptor instead.
+func (*GetArticleRequest) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetArticleRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+type ListArticlesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tag           string                 `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Author        string                 `protobuf:"bytes,2,opt,name=author,proto3" json:"author,omitempty"`
+	Favorited     string                 `protobuf:"bytes,3,opt,name=favorited,proto3" json:"favorited,omitempty"`
+	Limit         int64                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int64                  `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListArticlesRequest) Reset() {
+	*x = ListArticlesRequest{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListArticlesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListArticlesRequest) ProtoMessage() {}
+
+func (x *ListArticlesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListArticlesRequest.ProtoReflect.Descriptor instead.
+func (*ListArticlesRequest) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListArticlesRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *ListArticlesRequest) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *ListArticlesRequest) GetFavorited() string {
+	if x != nil {
+		return x.Favorited
+	}
+	return ""
+}
+
+func (x *ListArticlesRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListArticlesRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type UnfollowUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnfollowUserRequest) Reset() {
+	*x = UnfollowUserRequest{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnfollowUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnfollowUserRequest) ProtoMessage() {}
+
+func (x *UnfollowUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnfollowUserRequest.ProtoReflect.Descriptor instead.
+func (*UnfollowUserRequest) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *UnfollowUserRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type FollowUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FollowUserRequest) Reset() {
+	*x = FollowUserRequest{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FollowUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowUserRequest) ProtoMessage() {}
+
+func (x *FollowUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowUserRequest.ProtoReflect.Descriptor instead.
+func (*FollowUserRequest) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *FollowUserRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type GetProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProfileRequest) Reset() {
+	*x = GetProfileRequest{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProfileRequest) ProtoMessage() {}
+
+func (x *GetProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProfileRequest.ProtoReflect.Descriptor instead.
+func (*GetProfileRequest) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetProfileRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type SearchProfilesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Q             string                 `protobuf:"bytes,1,opt,name=q,proto3" json:"q,omitempty"`
+	Cursor        string                 `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit         int64                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchProfilesRequest) Reset() {
+	*x = SearchProfilesRequest{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchProfilesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchProfilesRequest) ProtoMessage() {}
+
+func (x *SearchProfilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchProfilesRequest.ProtoReflect.Descriptor instead.
+func (*SearchProfilesRequest) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *SearchProfilesRequest) GetQ() string {
+	if x != nil {
+		return x.Q
+	}
+	return ""
+}
+
+func (x *SearchProfilesRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *SearchProfilesRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SuggestProfilesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cursor        string                 `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit         int64                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestProfilesRequest) Reset() {
+	*x = SuggestProfilesRequest{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestProfilesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestProfilesRequest) ProtoMessage() {}
+
+func (x *SuggestProfilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestProfilesRequest.ProtoReflect.Descriptor instead.
+func (*SuggestProfilesRequest) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *SuggestProfilesRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *SuggestProfilesRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type BlockUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockUserRequest) Reset() {
+	*x = BlockUserRequest{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockUserRequest) ProtoMessage() {}
+
+func (x *BlockUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockUserRequest.ProtoReflect.Descriptor instead.
+func (*BlockUserRequest) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *BlockUserRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type UnblockUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnblockUserRequest) Reset() {
+	*x = UnblockUserRequest{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnblockUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnblockUserRequest) ProtoMessage() {}
+
+func (x *UnblockUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnblockUserRequest.ProtoReflect.Descriptor instead.
+func (*UnblockUserRequest) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *UnblockUserRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type MuteUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MuteUserRequest) Reset() {
+	*x = MuteUserRequest{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MuteUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MuteUserRequest) ProtoMessage() {}
+
+func (x *MuteUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MuteUserRequest.ProtoReflect.Descriptor instead.
+func (*MuteUserRequest) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *MuteUserRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type UnmuteUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnmuteUserRequest) Reset() {
+	*x = UnmuteUserRequest{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnmuteUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnmuteUserRequest) ProtoMessage() {}
+
+func (x *UnmuteUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnmuteUserRequest.ProtoReflect.Descriptor instead.
+func (*UnmuteUserRequest) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *UnmuteUserRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type ListBlockedUsersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cursor        string                 `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit         int64                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBlockedUsersRequest) Reset() {
+	*x = ListBlockedUsersRequest{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBlockedUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBlockedUsersRequest) ProtoMessage() {}
+
+func (x *ListBlockedUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBlockedUsersRequest.ProtoReflect.Descriptor instead.
+func (*ListBlockedUsersRequest) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ListBlockedUsersRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListBlockedUsersRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListMutedUsersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cursor        string                 `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit         int64                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMutedUsersRequest) Reset() {
+	*x = ListMutedUsersRequest{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMutedUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMutedUsersRequest) ProtoMessage() {}
+
+func (x *ListMutedUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMutedUsersRequest.ProtoReflect.Descriptor instead.
+func (*ListMutedUsersRequest) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ListMutedUsersRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListMutedUsersRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListFollowRequestsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cursor        string                 `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit         int64                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFollowRequestsRequest) Reset() {
+	*x = ListFollowRequestsRequest{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFollowRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFollowRequestsRequest) ProtoMessage() {}
+
+func (x *ListFollowRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFollowRequestsRequest.ProtoReflect.Descriptor instead.
+func (*ListFollowRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ListFollowRequestsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListFollowRequestsRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// username为申请人
+type ApproveFollowRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveFollowRequestRequest) Reset() {
+	*x = ApproveFollowRequestRequest{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveFollowRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveFollowRequestRequest) ProtoMessage() {}
+
+func (x *ApproveFollowRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveFollowRequestRequest.ProtoReflect.Descriptor instead.
+func (*ApproveFollowRequestRequest) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ApproveFollowRequestRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type RejectFollowRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectFollowRequestRequest) Reset() {
+	*x = RejectFollowRequestRequest{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectFollowRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectFollowRequestRequest) ProtoMessage() {}
+
+func (x *RejectFollowRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectFollowRequestRequest.ProtoReflect.Descriptor instead.
+func (*RejectFollowRequestRequest) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *RejectFollowRequestRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+// username为申请关注的私密账号
+type CancelFollowRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelFollowRequestRequest) Reset() {
+	*x = CancelFollowRequestRequest{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelFollowRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelFollowRequestRequest) ProtoMessage() {}
+
+func (x *CancelFollowRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelFollowRequestRequest.ProtoReflect.Descriptor instead.
+func (*CancelFollowRequestRequest) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *CancelFollowRequestRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+// cursor为上一页返回的next_cursor, 为空则从头开始
+type ListFollowsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Cursor        string                 `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit         int64                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFollowsRequest) Reset() {
+	*x = ListFollowsRequest{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFollowsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFollowsRequest) ProtoMessage() {}
+
+func (x *ListFollowsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFollowsRequest.ProtoReflect.Descriptor instead.
+func (*ListFollowsRequest) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ListFollowsRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ListFollowsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListFollowsRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type UpdateUserRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	User          *UpdateUserRequest_User `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserRequest) Reset() {
+	*x = UpdateUserRequest{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserRequest) ProtoMessage() {}
+
+func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserRequest.ProtoReflect.Descriptor instead.
+func (*UpdateUserRequest) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *UpdateUserRequest) GetUser() *UpdateUserRequest_User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type GetCurrentUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCurrentUserRequest) Reset() {
+	*x = GetCurrentUserRequest{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCurrentUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCurrentUserRequest) ProtoMessage() {}
+
+func (x *GetCurrentUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCurrentUserRequest.ProtoReflect.Descriptor instead.
+func (*GetCurrentUserRequest) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{57}
+}
+
+type DeleteCurrentUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCurrentUserRequest) Reset() {
+	*x = DeleteCurrentUserRequest{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCurrentUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCurrentUserRequest) ProtoMessage() {}
+
+func (x *DeleteCurrentUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCurrentUserRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCurrentUserRequest) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{58}
+}
+
+type DeleteCurrentUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCurrentUserResponse) Reset() {
+	*x = DeleteCurrentUserResponse{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCurrentUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCurrentUserResponse) ProtoMessage() {}
+
+func (x *DeleteCurrentUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCurrentUserResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCurrentUserResponse) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *DeleteCurrentUserResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ExportCurrentUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportCurrentUserRequest) Reset() {
+	*x = ExportCurrentUserRequest{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportCurrentUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportCurrentUserRequest) ProtoMessage() {}
+
+func (x *ExportCurrentUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportCurrentUserRequest.ProtoReflect.Descriptor instead.
+func (*ExportCurrentUserRequest) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{60}
+}
+
+type LoginRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *LoginRequest_User     `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginRequest) Reset() {
+	*x = LoginRequest{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginRequest) ProtoMessage() {}
+
+func (x *LoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginRequest.ProtoReflect.Descriptor instead.
+func (*LoginRequest) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *LoginRequest) GetUser() *LoginRequest_User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type RegisterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *RegisterRequest_User  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterRequest) Reset() {
+	*x = RegisterRequest{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterRequest) ProtoMessage() {}
+
+func (x *RegisterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterRequest.ProtoReflect.Descriptor instead.
+func (*RegisterRequest) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *RegisterRequest) GetUser() *RegisterRequest_User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+// 通用
+type UserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *UserResponse_User     `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserResponse) Reset() {
+	*x = UserResponse{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserResponse) ProtoMessage() {}
+
+func (x *UserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserResponse.ProtoReflect.Descriptor instead.
+func (*UserResponse) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *UserResponse) GetUser() *UserResponse_User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type ProfileResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Profile       *ProfileResponse_Profile `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProfileResponse) Reset() {
+	*x = ProfileResponse{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProfileResponse) ProtoMessage() {}
+
+func (x *ProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProfileResponse.ProtoReflect.Descriptor instead.
+func (*ProfileResponse) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ProfileResponse) GetProfile() *ProfileResponse_Profile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+type Article struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Slug           string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	Title          string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description    string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Body           string                 `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	TagList        []string               `protobuf:"bytes,5,rep,name=tagList,proto3" json:"tagList,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	Favorited      bool                   `protobuf:"varint,8,opt,name=favorited,proto3" json:"favorited,omitempty"`
+	FavoritesCount uint32                 `protobuf:"varint,9,opt,name=favoritesCount,proto3" json:"favoritesCount,omitempty"`
+	Author         *Profile               `protobuf:"bytes,10,opt,name=author,proto3" json:"author,omitempty"`
+	CoverImage     string                 `protobuf:"bytes,11,opt,name=coverImage,proto3" json:"coverImage,omitempty"`
+	Bookmarked     bool                   `protobuf:"varint,12,opt,name=bookmarked,proto3" json:"bookmarked,omitempty"`
+	Reactions      []*Reaction            `protobuf:"bytes,13,rep,name=reactions,proto3" json:"reactions,omitempty"`
+	// 当前用户添加过的表情
+	MyReactions   []string `protobuf:"bytes,14,rep,name=myReactions,proto3" json:"myReactions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Article) Reset() {
+	*x = Article{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Article) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Article) ProtoMessage() {}
+
+func (x *Article) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Article.ProtoReflect.Descriptor instead.
+func (*Article) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *Article) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *Article) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Article) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Article) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *Article) GetTagList() []string {
+	if x != nil {
+		return x.TagList
+	}
+	return nil
+}
+
+func (x *Article) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Article) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Article) GetFavorited() bool {
+	if x != nil {
+		return x.Favorited
+	}
+	return false
+}
+
+func (x *Article) GetFavoritesCount() uint32 {
+	if x != nil {
+		return x.FavoritesCount
+	}
+	return 0
+}
+
+func (x *Article) GetAuthor() *Profile {
+	if x != nil {
+		return x.Author
+	}
+	return nil
+}
+
+func (x *Article) GetCoverImage() string {
+	if x != nil {
+		return x.CoverImage
+	}
+	return ""
+}
+
+func (x *Article) GetBookmarked() bool {
+	if x != nil {
+		return x.Bookmarked
+	}
+	return false
+}
+
+func (x *Article) GetReactions() []*Reaction {
+	if x != nil {
+		return x.Reactions
+	}
+	return nil
+}
+
+func (x *Article) GetMyReactions() []string {
+	if x != nil {
+		return x.MyReactions
+	}
+	return nil
+}
+
+// 每种表情的回应数, 按配置的顺序排列, 没有回应的表情不返回
+type Reaction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reaction      string                 `protobuf:"bytes,1,opt,name=reaction,proto3" json:"reaction,omitempty"`
+	Count         uint32                 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Reaction) Reset() {
+	*x = Reaction{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Reaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reaction) ProtoMessage() {}
+
+func (x *Reaction) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reaction.ProtoReflect.Descriptor instead.
+func (*Reaction) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *Reaction) GetReaction() string {
+	if x != nil {
+		return x.Reaction
+	}
+	return ""
+}
+
+func (x *Reaction) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type SingleArticleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Article       *Article               `protobuf:"bytes,1,opt,name=article,proto3" json:"article,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SingleArticleResponse) Reset() {
+	*x = SingleArticleResponse{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SingleArticleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SingleArticleResponse) ProtoMessage() {}
+
+func (x *SingleArticleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SingleArticleResponse.ProtoReflect.Descriptor instead.
+func (*SingleArticleResponse) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *SingleArticleResponse) GetArticle() *Article {
+	if x != nil {
+		return x.Article
+	}
+	return nil
+}
+
+type MultipleArticleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Articles      []*Article             `protobuf:"bytes,1,rep,name=articles,proto3" json:"articles,omitempty"`
+	ArticlesCount uint32                 `protobuf:"varint,2,opt,name=articles_count,json=articlesCount,proto3" json:"articles_count,omitempty"`
+	// 游标分页的列表使用, 为空表示没有下一页
+	NextCursor    string `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MultipleArticleResponse) Reset() {
+	*x = MultipleArticleResponse{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MultipleArticleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultipleArticleResponse) ProtoMessage() {}
+
+func (x *MultipleArticleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultipleArticleResponse.ProtoReflect.Descriptor instead.
+func (*MultipleArticleResponse) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *MultipleArticleResponse) GetArticles() []*Article {
+	if x != nil {
+		return x.Articles
+	}
+	return nil
+}
+
+func (x *MultipleArticleResponse) GetArticlesCount() uint32 {
+	if x != nil {
+		return x.ArticlesCount
+	}
+	return 0
+}
+
+func (x *MultipleArticleResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type SingleCommentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Comment       *Comment               `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SingleCommentResponse) Reset() {
+	*x = SingleCommentResponse{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SingleCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SingleCommentResponse) ProtoMessage() {}
+
+func (x *SingleCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SingleCommentResponse.ProtoReflect.Descriptor instead.
+func (*SingleCommentResponse) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *SingleCommentResponse) GetComment() *Comment {
+	if x != nil {
+		return x.Comment
+	}
+	return nil
+}
+
+type Comment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	Body          string                 `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	Author        *Profile               `protobuf:"bytes,5,opt,name=author,proto3" json:"author,omitempty"`
+	Reactions     []*Reaction            `protobuf:"bytes,6,rep,name=reactions,proto3" json:"reactions,omitempty"`
+	MyReactions   []string               `protobuf:"bytes,7,rep,name=myReactions,proto3" json:"myReactions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Comment) Reset() {
+	*x = Comment{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Comment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Comment) ProtoMessage() {}
+
+func (x *Comment) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Comment.ProtoReflect.Descriptor instead.
+func (*Comment) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *Comment) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Comment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Comment) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Comment) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *Comment) GetAuthor() *Profile {
 	if x != nil {
 		return x.Author
 	}
-	return nil
+	return nil
+}
+
+func (x *Comment) GetReactions() []*Reaction {
+	if x != nil {
+		return x.Reactions
+	}
+	return nil
+}
+
+func (x *Comment) GetMyReactions() []string {
+	if x != nil {
+		return x.MyReactions
+	}
+	return nil
+}
+
+// 字段需要和ProfileResponse.Profile保持一致, service层直接做类型转换
+type Profile struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Username        string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Bio             string                 `protobuf:"bytes,2,opt,name=bio,proto3" json:"bio,omitempty"`
+	Image           string                 `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`
+	Following       bool                   `protobuf:"varint,4,opt,name=following,proto3" json:"following,omitempty"`
+	FollowersCount  uint32                 `protobuf:"varint,5,opt,name=followers_count,json=followersCount,proto3" json:"followers_count,omitempty"`
+	FollowingCount  uint32                 `protobuf:"varint,6,opt,name=following_count,json=followingCount,proto3" json:"following_count,omitempty"`
+	ArticlesCount   uint32                 `protobuf:"varint,7,opt,name=articles_count,json=articlesCount,proto3" json:"articles_count,omitempty"`
+	Private         bool                   `protobuf:"varint,8,opt,name=private,proto3" json:"private,omitempty"`
+	FollowRequested bool                   `protobuf:"varint,9,opt,name=follow_requested,json=followRequested,proto3" json:"follow_requested,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Profile) Reset() {
+	*x = Profile{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Profile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Profile) ProtoMessage() {}
+
+func (x *Profile) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Profile.ProtoReflect.Descriptor instead.
+func (*Profile) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *Profile) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *Profile) GetBio() string {
+	if x != nil {
+		return x.Bio
+	}
+	return ""
+}
+
+func (x *Profile) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *Profile) GetFollowing() bool {
+	if x != nil {
+		return x.Following
+	}
+	return false
+}
+
+func (x *Profile) GetFollowersCount() uint32 {
+	if x != nil {
+		return x.FollowersCount
+	}
+	return 0
+}
+
+func (x *Profile) GetFollowingCount() uint32 {
+	if x != nil {
+		return x.FollowingCount
+	}
+	return 0
+}
+
+func (x *Profile) GetArticlesCount() uint32 {
+	if x != nil {
+		return x.ArticlesCount
+	}
+	return 0
+}
+
+func (x *Profile) GetPrivate() bool {
+	if x != nil {
+		return x.Private
+	}
+	return false
+}
+
+func (x *Profile) GetFollowRequested() bool {
+	if x != nil {
+		return x.FollowRequested
+	}
+	return false
+}
+
+// 头像上传是multipart请求, 由http服务单独注册路由, 这里只定义响应
+type UploadAvatarResponse struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Image         *UploadAvatarResponse_Image `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadAvatarResponse) Reset() {
+	*x = UploadAvatarResponse{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadAvatarResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAvatarResponse) ProtoMessage() {}
+
+func (x *UploadAvatarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAvatarResponse.ProtoReflect.Descriptor instead.
+func (*UploadAvatarResponse) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *UploadAvatarResponse) GetImage() *UploadAvatarResponse_Image {
+	if x != nil {
+		return x.Image
+	}
+	return nil
+}
+
+type BookmarkCollection struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	BookmarksCount uint32                 `protobuf:"varint,3,opt,name=bookmarksCount,proto3" json:"bookmarksCount,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *BookmarkCollection) Reset() {
+	*x = BookmarkCollection{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookmarkCollection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookmarkCollection) ProtoMessage() {}
+
+func (x *BookmarkCollection) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookmarkCollection.ProtoReflect.Descriptor instead.
+func (*BookmarkCollection) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *BookmarkCollection) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BookmarkCollection) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BookmarkCollection) GetBookmarksCount() uint32 {
+	if x != nil {
+		return x.BookmarksCount
+	}
+	return 0
+}
+
+func (x *BookmarkCollection) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type SingleBookmarkCollectionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Collection    *BookmarkCollection    `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SingleBookmarkCollectionResponse) Reset() {
+	*x = SingleBookmarkCollectionResponse{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SingleBookmarkCollectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SingleBookmarkCollectionResponse) ProtoMessage() {}
+
+func (x *SingleBookmarkCollectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SingleBookmarkCollectionResponse.ProtoReflect.Descriptor instead.
+func (*SingleBookmarkCollectionResponse) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *SingleBookmarkCollectionResponse) GetCollection() *BookmarkCollection {
+	if x != nil {
+		return x.Collection
+	}
+	return nil
+}
+
+type MultipleBookmarkCollectionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Collections   []*BookmarkCollection  `protobuf:"bytes,1,rep,name=collections,proto3" json:"collections,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MultipleBookmarkCollectionResponse) Reset() {
+	*x = MultipleBookmarkCollectionResponse{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MultipleBookmarkCollectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultipleBookmarkCollectionResponse) ProtoMessage() {}
+
+func (x *MultipleBookmarkCollectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultipleBookmarkCollectionResponse.ProtoReflect.Descriptor instead.
+func (*MultipleBookmarkCollectionResponse) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *MultipleBookmarkCollectionResponse) GetCollections() []*BookmarkCollection {
+	if x != nil {
+		return x.Collections
+	}
+	return nil
+}
+
+type Attachment struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 文章中引用的地址
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// 编辑器中预览用的地址, 未发布的附件是带过期时间的签名url
+	PreviewUrl    string                 `protobuf:"bytes,3,opt,name=preview_url,json=previewUrl,proto3" json:"preview_url,omitempty"`
+	ContentType   string                 `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Size          int64                  `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
+	Width         int32                  `protobuf:"varint,6,opt,name=width,proto3" json:"width,omitempty"`
+	Height        int32                  `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Attachment) Reset() {
+	*x = Attachment{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Attachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attachment) ProtoMessage() {}
+
+func (x *Attachment) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attachment.ProtoReflect.Descriptor instead.
+func (*Attachment) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *Attachment) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Attachment) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Attachment) GetPreviewUrl() string {
+	if x != nil {
+		return x.PreviewUrl
+	}
+	return ""
+}
+
+func (x *Attachment) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *Attachment) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *Attachment) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *Attachment) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Attachment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type SingleAttachmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attachment    *Attachment            `protobuf:"bytes,1,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SingleAttachmentResponse) Reset() {
+	*x = SingleAttachmentResponse{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SingleAttachmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SingleAttachmentResponse) ProtoMessage() {}
+
+func (x *SingleAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SingleAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*SingleAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *SingleAttachmentResponse) GetAttachment() *Attachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+type MultipleAttachmentResponse struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Attachments []*Attachment          `protobuf:"bytes,1,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	// 当前用户附件占用的空间和配额
+	UsedBytes     int64 `protobuf:"varint,2,opt,name=used_bytes,json=usedBytes,proto3" json:"used_bytes,omitempty"`
+	QuotaBytes    int64 `protobuf:"varint,3,opt,name=quota_bytes,json=quotaBytes,proto3" json:"quota_bytes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MultipleAttachmentResponse) Reset() {
+	*x = MultipleAttachmentResponse{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MultipleAttachmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultipleAttachmentResponse) ProtoMessage() {}
+
+func (x *MultipleAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultipleAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*MultipleAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *MultipleAttachmentResponse) GetAttachments() []*Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+func (x *MultipleAttachmentResponse) GetUsedBytes() int64 {
+	if x != nil {
+		return x.UsedBytes
+	}
+	return 0
+}
+
+func (x *MultipleAttachmentResponse) GetQuotaBytes() int64 {
+	if x != nil {
+		return x.QuotaBytes
+	}
+	return 0
+}
+
+type MultipleProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profiles      []*Profile             `protobuf:"bytes,1,rep,name=profiles,proto3" json:"profiles,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MultipleProfileResponse) Reset() {
+	*x = MultipleProfileResponse{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MultipleProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultipleProfileResponse) ProtoMessage() {}
+
+func (x *MultipleProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultipleProfileResponse.ProtoReflect.Descriptor instead.
+func (*MultipleProfileResponse) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *MultipleProfileResponse) GetProfiles() []*Profile {
+	if x != nil {
+		return x.Profiles
+	}
+	return nil
+}
+
+func (x *MultipleProfileResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type UserExportResponse struct {
+	state                  protoimpl.MessageState             `protogen:"open.v1"`
+	User                   *UserExportResponse_User           `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Articles               []*Article                         `protobuf:"bytes,2,rep,name=articles,proto3" json:"articles,omitempty"`
+	Comments               []*UserExportResponse_Comment      `protobuf:"bytes,3,rep,name=comments,proto3" json:"comments,omitempty"`
+	Favorites              []*UserExportResponse_Favorite     `protobuf:"bytes,4,rep,name=favorites,proto3" json:"favorites,omitempty"`
+	Following              []*UserExportResponse_Follow       `protobuf:"bytes,5,rep,name=following,proto3" json:"following,omitempty"`
+	Followers              []*UserExportResponse_Follow       `protobuf:"bytes,6,rep,name=followers,proto3" json:"followers,omitempty"`
+	ExportedAt             *timestamppb.Timestamp             `protobuf:"bytes,7,opt,name=exported_at,json=exportedAt,proto3" json:"exported_at,omitempty"`
+	FollowRequestsSent     []*UserExportResponse_Follow       `protobuf:"bytes,8,rep,name=follow_requests_sent,json=followRequestsSent,proto3" json:"follow_requests_sent,omitempty"`
+	FollowRequestsReceived []*UserExportResponse_Follow       `protobuf:"bytes,9,rep,name=follow_requests_received,json=followRequestsReceived,proto3" json:"follow_requests_received,omitempty"`
+	Blocked                []*UserExportResponse_Follow       `protobuf:"bytes,10,rep,name=blocked,proto3" json:"blocked,omitempty"`
+	Muted                  []*UserExportResponse_Follow       `protobuf:"bytes,11,rep,name=muted,proto3" json:"muted,omitempty"`
+	Attachments            []*Attachment                      `protobuf:"bytes,12,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	BookmarkCollections    []*BookmarkCollection              `protobuf:"bytes,13,rep,name=bookmark_collections,json=bookmarkCollections,proto3" json:"bookmark_collections,omitempty"`
+	Bookmarks              []*UserExportResponse_Bookmark     `protobuf:"bytes,14,rep,name=bookmarks,proto3" json:"bookmarks,omitempty"`
+	Reactions              []*UserExportResponse_Reaction     `protobuf:"bytes,15,rep,name=reactions,proto3" json:"reactions,omitempty"`
+	FollowedTags           []*UserExportResponse_Tag          `protobuf:"bytes,16,rep,name=followed_tags,json=followedTags,proto3" json:"followed_tags,omitempty"`
+	Notifications          []*UserExportResponse_Notification `protobuf:"bytes,17,rep,name=notifications,proto3" json:"notifications,omitempty"`
+	// 只包含保存过的设置
+	NotificationPreferences map[string]bool `protobuf:"bytes,18,rep,name=notification_preferences,json=notificationPreferences,proto3" json:"notification_preferences,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *UserExportResponse) Reset() {
+	*x = UserExportResponse{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserExportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserExportResponse) ProtoMessage() {}
+
+func (x *UserExportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserExportResponse.ProtoReflect.Descriptor instead.
+func (*UserExportResponse) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *UserExportResponse) GetUser() *UserExportResponse_User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *UserExportResponse) GetArticles() []*Article {
+	if x != nil {
+		return x.Articles
+	}
+	return nil
+}
+
+func (x *UserExportResponse) GetComments() []*UserExportResponse_Comment {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+func (x *UserExportResponse) GetFavorites() []*UserExportResponse_Favorite {
+	if x != nil {
+		return x.Favorites
+	}
+	return nil
+}
+
+func (x *UserExportResponse) GetFollowing() []*UserExportResponse_Follow {
+	if x != nil {
+		return x.Following
+	}
+	return nil
+}
+
+func (x *UserExportResponse) GetFollowers() []*UserExportResponse_Follow {
+	if x != nil {
+		return x.Followers
+	}
+	return nil
+}
+
+func (x *UserExportResponse) GetExportedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExportedAt
+	}
+	return nil
+}
+
+func (x *UserExportResponse) GetFollowRequestsSent() []*UserExportResponse_Follow {
+	if x != nil {
+		return x.FollowRequestsSent
+	}
+	return nil
+}
+
+func (x *UserExportResponse) GetFollowRequestsReceived() []*UserExportResponse_Follow {
+	if x != nil {
+		return x.FollowRequestsReceived
+	}
+	return nil
+}
+
+func (x *UserExportResponse) GetBlocked() []*UserExportResponse_Follow {
+	if x != nil {
+		return x.Blocked
+	}
+	return nil
+}
+
+func (x *UserExportResponse) GetMuted() []*UserExportResponse_Follow {
+	if x != nil {
+		return x.Muted
+	}
+	return nil
+}
+
+func (x *UserExportResponse) GetAttachments() []*Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+func (x *UserExportResponse) GetBookmarkCollections() []*BookmarkCollection {
+	if x != nil {
+		return x.BookmarkCollections
+	}
+	return nil
+}
+
+func (x *UserExportResponse) GetBookmarks() []*UserExportResponse_Bookmark {
+	if x != nil {
+		return x.Bookmarks
+	}
+	return nil
+}
+
+func (x *UserExportResponse) GetReactions() []*UserExportResponse_Reaction {
+	if x != nil {
+		return x.Reactions
+	}
+	return nil
+}
+
+func (x *UserExportResponse) GetFollowedTags() []*UserExportResponse_Tag {
+	if x != nil {
+		return x.FollowedTags
+	}
+	return nil
+}
+
+func (x *UserExportResponse) GetNotifications() []*UserExportResponse_Notification {
+	if x != nil {
+		return x.Notifications
+	}
+	return nil
+}
+
+func (x *UserExportResponse) GetNotificationPreferences() map[string]bool {
+	if x != nil {
+		return x.NotificationPreferences
+	}
+	return nil
+}
+
+type MultipleCommentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Comments      []*Comment             `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MultipleCommentResponse) Reset() {
+	*x = MultipleCommentResponse{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MultipleCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultipleCommentResponse) ProtoMessage() {}
+
+func (x *MultipleCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultipleCommentResponse.ProtoReflect.Descriptor instead.
+func (*MultipleCommentResponse) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *MultipleCommentResponse) GetComments() []*Comment {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+type TagsListResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Tags  []string               `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	// 和tags一一对应, 带当前用户的关注状态
+	Details       []*Tag `protobuf:"bytes,2,rep,name=details,proto3" json:"details,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagsListResponse) Reset() {
+	*x = TagsListResponse{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagsListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagsListResponse) ProtoMessage() {}
+
+func (x *TagsListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagsListResponse.ProtoReflect.Descriptor instead.
+func (*TagsListResponse) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *TagsListResponse) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *TagsListResponse) GetDetails() []*Tag {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+type Tag struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Following     bool                   `protobuf:"varint,2,opt,name=following,proto3" json:"following,omitempty"`
+	ArticlesCount uint32                 `protobuf:"varint,3,opt,name=articlesCount,proto3" json:"articlesCount,omitempty"`
+	// 只在标签管理的接口中返回
+	Aliases       []string `protobuf:"bytes,4,rep,name=aliases,proto3" json:"aliases,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Tag) Reset() {
+	*x = Tag{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tag) ProtoMessage() {}
+
+func (x *Tag) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tag.ProtoReflect.Descriptor instead.
+func (*Tag) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *Tag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Tag) GetFollowing() bool {
+	if x != nil {
+		return x.Following
+	}
+	return false
+}
+
+func (x *Tag) GetArticlesCount() uint32 {
+	if x != nil {
+		return x.ArticlesCount
+	}
+	return 0
+}
+
+func (x *Tag) GetAliases() []string {
+	if x != nil {
+		return x.Aliases
+	}
+	return nil
+}
+
+type TrendingTag struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// 热度分数, 只用于排序
+	Score float64 `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	// 时间窗口内使用这个标签的文章数
+	RecentArticlesCount uint32 `protobuf:"varint,3,opt,name=recentArticlesCount,proto3" json:"recentArticlesCount,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *TrendingTag) Reset() {
+	*x = TrendingTag{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrendingTag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrendingTag) ProtoMessage() {}
+
+func (x *TrendingTag) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrendingTag.ProtoReflect.Descriptor instead.
+func (*TrendingTag) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *TrendingTag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TrendingTag) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *TrendingTag) GetRecentArticlesCount() uint32 {
+	if x != nil {
+		return x.RecentArticlesCount
+	}
+	return 0
+}
+
+type TrendingTagsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          []*TrendingTag         `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrendingTagsResponse) Reset() {
+	*x = TrendingTagsResponse{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrendingTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrendingTagsResponse) ProtoMessage() {}
+
+func (x *TrendingTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrendingTagsResponse.ProtoReflect.Descriptor instead.
+func (*TrendingTagsResponse) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *TrendingTagsResponse) GetTags() []*TrendingTag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type TagResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tag           *Tag                   `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagResponse) Reset() {
+	*x = TagResponse{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagResponse) ProtoMessage() {}
+
+func (x *TagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagResponse.ProtoReflect.Descriptor instead.
+func (*TagResponse) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *TagResponse) GetTag() *Tag {
+	if x != nil {
+		return x.Tag
+	}
+	return nil
+}
+
+type ReactionsListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reactions     []string               `protobuf:"bytes,1,rep,name=reactions,proto3" json:"reactions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReactionsListResponse) Reset() {
+	*x = ReactionsListResponse{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReactionsListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactionsListResponse) ProtoMessage() {}
+
+func (x *ReactionsListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactionsListResponse.ProtoReflect.Descriptor instead.
+func (*ReactionsListResponse) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *ReactionsListResponse) GetReactions() []string {
+	if x != nil {
+		return x.Reactions
+	}
+	return nil
+}
+
+type ListNotificationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cursor        string                 `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit         int64                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotificationsRequest) Reset() {
+	*x = ListNotificationsRequest{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotificationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotificationsRequest) ProtoMessage() {}
+
+func (x *ListNotificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotificationsRequest.ProtoReflect.Descriptor instead.
+func (*ListNotificationsRequest) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *ListNotificationsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListNotificationsRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type MarkNotificationReadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkNotificationReadRequest) Reset() {
+	*x = MarkNotificationReadRequest{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkNotificationReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkNotificationReadRequest) ProtoMessage() {}
+
+func (x *MarkNotificationReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkNotificationReadRequest.ProtoReflect.Descriptor instead.
+func (*MarkNotificationReadRequest) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *MarkNotificationReadRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type MarkAllNotificationsReadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkAllNotificationsReadRequest) Reset() {
+	*x = MarkAllNotificationsReadRequest{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkAllNotificationsReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkAllNotificationsReadRequest) ProtoMessage() {}
+
+func (x *MarkAllNotificationsReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkAllNotificationsReadRequest.ProtoReflect.Descriptor instead.
+func (*MarkAllNotificationsReadRequest) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{90}
+}
+
+type MarkNotificationsReadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UnreadCount   uint32                 `protobuf:"varint,1,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkNotificationsReadResponse) Reset() {
+	*x = MarkNotificationsReadResponse{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkNotificationsReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkNotificationsReadResponse) ProtoMessage() {}
+
+func (x *MarkNotificationsReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkNotificationsReadResponse.ProtoReflect.Descriptor instead.
+func (*MarkNotificationsReadResponse) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *MarkNotificationsReadResponse) GetUnreadCount() uint32 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+type Notification struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// follow, favorite, comment, mention
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// 例如"alice and 3 others favorited your article"
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	// 最近的操作者, 已删除的用户为空
+	Actor       *Profile `protobuf:"bytes,4,opt,name=actor,proto3" json:"actor,omitempty"`
+	ActorsCount uint32   `protobuf:"varint,5,opt,name=actors_count,json=actorsCount,proto3" json:"actors_count,omitempty"`
+	// follow类型没有文章
+	ArticleSlug  string `protobuf:"bytes,6,opt,name=article_slug,json=articleSlug,proto3" json:"article_slug,omitempty"`
+	ArticleTitle string `protobuf:"bytes,7,opt,name=article_title,json=articleTitle,proto3" json:"article_title,omitempty"`
+	// comment和mention类型为最近的一条评论
+	CommentId     uint32                 `protobuf:"varint,8,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	Read          bool                   `protobuf:"varint,9,opt,name=read,proto3" json:"read,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Notification) Reset() {
+	*x = Notification{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Notification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Notification) ProtoMessage() {}
+
+func (x *Notification) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Notification.ProtoReflect.Descriptor instead.
+func (*Notification) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *Notification) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Notification) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Notification) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Notification) GetActor() *Profile {
+	if x != nil {
+		return x.Actor
+	}
+	return nil
+}
+
+func (x *Notification) GetActorsCount() uint32 {
+	if x != nil {
+		return x.ActorsCount
+	}
+	return 0
+}
+
+func (x *Notification) GetArticleSlug() string {
+	if x != nil {
+		return x.ArticleSlug
+	}
+	return ""
+}
+
+func (x *Notification) GetArticleTitle() string {
+	if x != nil {
+		return x.ArticleTitle
+	}
+	return ""
+}
+
+func (x *Notification) GetCommentId() uint32 {
+	if x != nil {
+		return x.CommentId
+	}
+	return 0
+}
+
+func (x *Notification) GetRead() bool {
+	if x != nil {
+		return x.Read
+	}
+	return false
+}
+
+func (x *Notification) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type MultipleNotificationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Notifications []*Notification        `protobuf:"bytes,1,rep,name=notifications,proto3" json:"notifications,omitempty"`
+	UnreadCount   uint32                 `protobuf:"varint,2,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MultipleNotificationResponse) Reset() {
+	*x = MultipleNotificationResponse{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MultipleNotificationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultipleNotificationResponse) ProtoMessage() {}
+
+func (x *MultipleNotificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultipleNotificationResponse.ProtoReflect.Descriptor instead.
+func (*MultipleNotificationResponse) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *MultipleNotificationResponse) GetNotifications() []*Notification {
+	if x != nil {
+		return x.Notifications
+	}
+	return nil
+}
+
+func (x *MultipleNotificationResponse) GetUnreadCount() uint32 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+func (x *MultipleNotificationResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+// 每种通知的开关, 默认开启
+type NotificationPreferences struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Follow        *bool                  `protobuf:"varint,1,opt,name=follow,proto3,oneof" json:"follow,omitempty"`
+	Favorite      *bool                  `protobuf:"varint,2,opt,name=favorite,proto3,oneof" json:"favorite,omitempty"`
+	Comment       *bool                  `protobuf:"varint,3,opt,name=comment,proto3,oneof" json:"comment,omitempty"`
+	Mention       *bool                  `protobuf:"varint,4,opt,name=mention,proto3,oneof" json:"mention,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationPreferences) Reset() {
+	*x = NotificationPreferences{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationPreferences) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationPreferences) ProtoMessage() {}
+
+func (x *NotificationPreferences) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationPreferences.ProtoReflect.Descriptor instead.
+func (*NotificationPreferences) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *NotificationPreferences) GetFollow() bool {
+	if x != nil && x.Follow != nil {
+		return *x.Follow
+	}
+	return false
+}
+
+func (x *NotificationPreferences) GetFavorite() bool {
+	if x != nil && x.Favorite != nil {
+		return *x.Favorite
+	}
+	return false
+}
+
+func (x *NotificationPreferences) GetComment() bool {
+	if x != nil && x.Comment != nil {
+		return *x.Comment
+	}
+	return false
+}
+
+func (x *NotificationPreferences) GetMention() bool {
+	if x != nil && x.Mention != nil {
+		return *x.Mention
+	}
+	return false
+}
+
+type GetNotificationPreferencesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNotificationPreferencesRequest) Reset() {
+	*x = GetNotificationPreferencesRequest{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNotificationPreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationPreferencesRequest) ProtoMessage() {}
+
+func (x *GetNotificationPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*GetNotificationPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{95}
+}
+
+type UpdateNotificationPreferencesRequest struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Preferences   *NotificationPreferences `protobuf:"bytes,1,opt,name=preferences,proto3" json:"preferences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateNotificationPreferencesRequest) Reset() {
+	*x = UpdateNotificationPreferencesRequest{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNotificationPreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNotificationPreferencesRequest) ProtoMessage() {}
+
+func (x *UpdateNotificationPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNotificationPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*UpdateNotificationPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *UpdateNotificationPreferencesRequest) GetPreferences() *NotificationPreferences {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+type NotificationPreferencesResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Preferences   *NotificationPreferences `protobuf:"bytes,1,opt,name=preferences,proto3" json:"preferences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationPreferencesResponse) Reset() {
+	*x = NotificationPreferencesResponse{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationPreferencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationPreferencesResponse) ProtoMessage() {}
+
+func (x *NotificationPreferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationPreferencesResponse.ProtoReflect.Descriptor instead.
+func (*NotificationPreferencesResponse) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *NotificationPreferencesResponse) GetPreferences() *NotificationPreferences {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+type CreateBookmarkCollectionRequest_Collection struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBookmarkCollectionRequest_Collection) Reset() {
+	*x = CreateBookmarkCollectionRequest_Collection{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBookmarkCollectionRequest_Collection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBookmarkCollectionRequest_Collection) ProtoMessage() {}
+
+func (x *CreateBookmarkCollectionRequest_Collection) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBookmarkCollectionRequest_Collection.ProtoReflect.Descriptor instead.
+func (*CreateBookmarkCollectionRequest_Collection) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{19, 0}
+}
+
+func (x *CreateBookmarkCollectionRequest_Collection) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type UpdateBookmarkCollectionRequest_Collection struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateBookmarkCollectionRequest_Collection) Reset() {
+	*x = UpdateBookmarkCollectionRequest_Collection{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBookmarkCollectionRequest_Collection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBookmarkCollectionRequest_Collection) ProtoMessage() {}
+
+func (x *UpdateBookmarkCollectionRequest_Collection) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBookmarkCollectionRequest_Collection.ProtoReflect.Descriptor instead.
+func (*UpdateBookmarkCollectionRequest_Collection) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{20, 0}
+}
+
+func (x *UpdateBookmarkCollectionRequest_Collection) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-type SingleArticleResponse struct {
+type AddCommentRequest_Comment struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Article       *Article               `protobuf:"bytes,1,opt,name=article,proto3" json:"article,omitempty"`
+	Body          string                 `protobuf:"bytes,1,opt,name=body,proto3" json:"body,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SingleArticleResponse) Reset() {
-	*x = SingleArticleResponse{}
-	mi := &file_realworld_v1_realworld_proto_msgTypes[24]
+func (x *AddCommentRequest_Comment) Reset() {
+	*x = AddCommentRequest_Comment{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SingleArticleResponse) String() string {
+func (x *AddCommentRequest_Comment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SingleArticleResponse) ProtoMessage() {}
+func (*AddCommentRequest_Comment) ProtoMessage() {}
 
-func (x *SingleArticleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_realworld_v1_realworld_proto_msgTypes[24]
+func (x *AddCommentRequest_Comment) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1232,41 +5299,280 @@ func (x *SingleArticleResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SingleArticleResponse.ProtoReflect.Descriptor instead.
-func (*SingleArticleResponse) Descriptor() ([]byte, []int) {
-	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use AddCommentRequest_Comment.ProtoReflect.Descriptor instead.
+func (*AddCommentRequest_Comment) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{32, 0}
 }
 
-func (x *SingleArticleResponse) GetArticle() *Article {
+func (x *AddCommentRequest_Comment) GetBody() string {
 	if x != nil {
-		return x.Article
+		return x.Body
+	}
+	return ""
+}
+
+type UpdateArticleRequest_Article struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Title       string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Body        string                 `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	TagList     []string               `protobuf:"bytes,4,rep,name=tag_list,json=tagList,proto3" json:"tag_list,omitempty"`
+	// 不传表示不修改, 空字符串表示去掉封面
+	CoverImage    *string `protobuf:"bytes,5,opt,name=cover_image,json=coverImage,proto3,oneof" json:"cover_image,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateArticleRequest_Article) Reset() {
+	*x = UpdateArticleRequest_Article{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateArticleRequest_Article) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateArticleRequest_Article) ProtoMessage() {}
+
+func (x *UpdateArticleRequest_Article) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateArticleRequest_Article.ProtoReflect.Descriptor instead.
+func (*UpdateArticleRequest_Article) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{35, 0}
+}
+
+func (x *UpdateArticleRequest_Article) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UpdateArticleRequest_Article) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateArticleRequest_Article) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *UpdateArticleRequest_Article) GetTagList() []string {
+	if x != nil {
+		return x.TagList
 	}
 	return nil
 }
 
-type MultipleArticleResponse struct {
+func (x *UpdateArticleRequest_Article) GetCoverImage() string {
+	if x != nil && x.CoverImage != nil {
+		return *x.CoverImage
+	}
+	return ""
+}
+
+type CreateArticleRequest_Article struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Title       string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Body        string                 `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	TagList     []string               `protobuf:"bytes,4,rep,name=tag_list,json=tagList,proto3" json:"tag_list,omitempty"`
+	// 必须是当前用户上传的附件的url
+	CoverImage    string `protobuf:"bytes,5,opt,name=cover_image,json=coverImage,proto3" json:"cover_image,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateArticleRequest_Article) Reset() {
+	*x = CreateArticleRequest_Article{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateArticleRequest_Article) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateArticleRequest_Article) ProtoMessage() {}
+
+func (x *CreateArticleRequest_Article) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateArticleRequest_Article.ProtoReflect.Descriptor instead.
+func (*CreateArticleRequest_Article) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{36, 0}
+}
+
+func (x *CreateArticleRequest_Article) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateArticleRequest_Article) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateArticleRequest_Article) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *CreateArticleRequest_Article) GetTagList() []string {
+	if x != nil {
+		return x.TagList
+	}
+	return nil
+}
+
+func (x *CreateArticleRequest_Article) GetCoverImage() string {
+	if x != nil {
+		return x.CoverImage
+	}
+	return ""
+}
+
+type UpdateUserRequest_User struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Email    string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Password string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Username string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	Bio      string                 `protobuf:"bytes,4,opt,name=bio,proto3" json:"bio,omitempty"`
+	Image    string                 `protobuf:"bytes,5,opt,name=image,proto3" json:"image,omitempty"`
+	// 不传则不修改
+	Private       *bool `protobuf:"varint,6,opt,name=private,proto3,oneof" json:"private,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserRequest_User) Reset() {
+	*x = UpdateUserRequest_User{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserRequest_User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserRequest_User) ProtoMessage() {}
+
+func (x *UpdateUserRequest_User) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserRequest_User.ProtoReflect.Descriptor instead.
+func (*UpdateUserRequest_User) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{56, 0}
+}
+
+func (x *UpdateUserRequest_User) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest_User) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest_User) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest_User) GetBio() string {
+	if x != nil {
+		return x.Bio
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest_User) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest_User) GetPrivate() bool {
+	if x != nil && x.Private != nil {
+		return *x.Private
+	}
+	return false
+}
+
+type LoginRequest_User struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Articles      []*Article             `protobuf:"bytes,1,rep,name=articles,proto3" json:"articles,omitempty"`
-	ArticlesCount uint32                 `protobuf:"varint,2,opt,name=articles_count,json=articlesCount,proto3" json:"articles_count,omitempty"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MultipleArticleResponse) Reset() {
-	*x = MultipleArticleResponse{}
-	mi := &file_realworld_v1_realworld_proto_msgTypes[25]
+func (x *LoginRequest_User) Reset() {
+	*x = LoginRequest_User{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MultipleArticleResponse) String() string {
+func (x *LoginRequest_User) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MultipleArticleResponse) ProtoMessage() {}
+func (*LoginRequest_User) ProtoMessage() {}
 
-func (x *MultipleArticleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_realworld_v1_realworld_proto_msgTypes[25]
+func (x *LoginRequest_User) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1277,47 +5583,49 @@ func (x *MultipleArticleResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MultipleArticleResponse.ProtoReflect.Descriptor instead.
-func (*MultipleArticleResponse) Descriptor() ([]byte, []int) {
-	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use LoginRequest_User.ProtoReflect.Descriptor instead.
+func (*LoginRequest_User) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{61, 0}
 }
 
-func (x *MultipleArticleResponse) GetArticles() []*Article {
+func (x *LoginRequest_User) GetEmail() string {
 	if x != nil {
-		return x.Articles
+		return x.Email
 	}
-	return nil
+	return ""
 }
 
-func (x *MultipleArticleResponse) GetArticlesCount() uint32 {
+func (x *LoginRequest_User) GetPassword() string {
 	if x != nil {
-		return x.ArticlesCount
+		return x.Password
 	}
-	return 0
+	return ""
 }
 
-type SingleCommentResponse struct {
+type RegisterRequest_User struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Comment       *Comment               `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Password      string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SingleCommentResponse) Reset() {
-	*x = SingleCommentResponse{}
-	mi := &file_realworld_v1_realworld_proto_msgTypes[26]
+func (x *RegisterRequest_User) Reset() {
+	*x = RegisterRequest_User{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SingleCommentResponse) String() string {
+func (x *RegisterRequest_User) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SingleCommentResponse) ProtoMessage() {}
+func (*RegisterRequest_User) ProtoMessage() {}
 
-func (x *SingleCommentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_realworld_v1_realworld_proto_msgTypes[26]
+func (x *RegisterRequest_User) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1328,44 +5636,59 @@ func (x *SingleCommentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SingleCommentResponse.ProtoReflect.Descriptor instead.
-func (*SingleCommentResponse) Descriptor() ([]byte, []int) {
-	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use RegisterRequest_User.ProtoReflect.Descriptor instead.
+func (*RegisterRequest_User) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{62, 0}
 }
 
-func (x *SingleCommentResponse) GetComment() *Comment {
+func (x *RegisterRequest_User) GetUsername() string {
 	if x != nil {
-		return x.Comment
+		return x.Username
 	}
-	return nil
+	return ""
 }
 
-type Comment struct {
+func (x *RegisterRequest_User) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *RegisterRequest_User) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type UserResponse_User struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
-	Body          string                 `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
-	Author        *Profile               `protobuf:"bytes,5,opt,name=author,proto3" json:"author,omitempty"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	Bio           string                 `protobuf:"bytes,4,opt,name=bio,proto3" json:"bio,omitempty"`
+	Image         string                 `protobuf:"bytes,5,opt,name=image,proto3" json:"image,omitempty"`
+	Private       bool                   `protobuf:"varint,6,opt,name=private,proto3" json:"private,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Comment) Reset() {
-	*x = Comment{}
-	mi := &file_realworld_v1_realworld_proto_msgTypes[27]
+func (x *UserResponse_User) Reset() {
+	*x = UserResponse_User{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Comment) String() string {
+func (x *UserResponse_User) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Comment) ProtoMessage() {}
+func (*UserResponse_User) ProtoMessage() {}
 
-func (x *Comment) ProtoReflect() protoreflect.Message {
-	mi := &file_realworld_v1_realworld_proto_msgTypes[27]
+func (x *UserResponse_User) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1376,71 +5699,84 @@ func (x *Comment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Comment.ProtoReflect.Descriptor instead.
-func (*Comment) Descriptor() ([]byte, []int) {
-	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use UserResponse_User.ProtoReflect.Descriptor instead.
+func (*UserResponse_User) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{63, 0}
 }
 
-func (x *Comment) GetId() uint32 {
+func (x *UserResponse_User) GetEmail() string {
 	if x != nil {
-		return x.Id
+		return x.Email
 	}
-	return 0
+	return ""
 }
 
-func (x *Comment) GetCreatedAt() *timestamppb.Timestamp {
+func (x *UserResponse_User) GetToken() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.Token
 	}
-	return nil
+	return ""
 }
 
-func (x *Comment) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *UserResponse_User) GetUsername() string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Username
 	}
-	return nil
+	return ""
 }
 
-func (x *Comment) GetBody() string {
+func (x *UserResponse_User) GetBio() string {
 	if x != nil {
-		return x.Body
+		return x.Bio
 	}
 	return ""
 }
 
-func (x *Comment) GetAuthor() *Profile {
+func (x *UserResponse_User) GetImage() string {
 	if x != nil {
-		return x.Author
+		return x.Image
 	}
-	return nil
+	return ""
 }
 
-type Profile struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
-	Bio           string                 `protobuf:"bytes,2,opt,name=bio,proto3" json:"bio,omitempty"`
-	Image         string                 `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`
-	Following     bool                   `protobuf:"varint,4,opt,name=following,proto3" json:"following,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *UserResponse_User) GetPrivate() bool {
+	if x != nil {
+		return x.Private
+	}
+	return false
 }
 
-func (x *Profile) Reset() {
-	*x = Profile{}
-	mi := &file_realworld_v1_realworld_proto_msgTypes[28]
+type ProfileResponse_Profile struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Username       string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Bio            string                 `protobuf:"bytes,2,opt,name=bio,proto3" json:"bio,omitempty"`
+	Image          string                 `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`
+	Following      bool                   `protobuf:"varint,4,opt,name=following,proto3" json:"following,omitempty"`
+	FollowersCount uint32                 `protobuf:"varint,5,opt,name=followers_count,json=followersCount,proto3" json:"followers_count,omitempty"`
+	FollowingCount uint32                 `protobuf:"varint,6,opt,name=following_count,json=followingCount,proto3" json:"following_count,omitempty"`
+	ArticlesCount  uint32                 `protobuf:"varint,7,opt,name=articles_count,json=articlesCount,proto3" json:"articles_count,omitempty"`
+	Private        bool                   `protobuf:"varint,8,opt,name=private,proto3" json:"private,omitempty"`
+	// 已发出关注申请, 等待对方同意
+	FollowRequested bool `protobuf:"varint,9,opt,name=follow_requested,json=followRequested,proto3" json:"follow_requested,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ProfileResponse_Profile) Reset() {
+	*x = ProfileResponse_Profile{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Profile) String() string {
+func (x *ProfileResponse_Profile) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Profile) ProtoMessage() {}
+func (*ProfileResponse_Profile) ProtoMessage() {}
 
-func (x *Profile) ProtoReflect() protoreflect.Message {
-	mi := &file_realworld_v1_realworld_proto_msgTypes[28]
+func (x *ProfileResponse_Profile) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1451,105 +5787,97 @@ func (x *Profile) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Profile.ProtoReflect.Descriptor instead.
-func (*Profile) Descriptor() ([]byte, []int) {
-	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use ProfileResponse_Profile.ProtoReflect.Descriptor instead.
+func (*ProfileResponse_Profile) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{64, 0}
 }
 
-func (x *Profile) GetUsername() string {
+func (x *ProfileResponse_Profile) GetUsername() string {
 	if x != nil {
 		return x.Username
 	}
 	return ""
 }
 
-func (x *Profile) GetBio() string {
+func (x *ProfileResponse_Profile) GetBio() string {
 	if x != nil {
 		return x.Bio
 	}
 	return ""
 }
 
-func (x *Profile) GetImage() string {
+func (x *ProfileResponse_Profile) GetImage() string {
 	if x != nil {
 		return x.Image
 	}
 	return ""
 }
 
-func (x *Profile) GetFollowing() bool {
+func (x *ProfileResponse_Profile) GetFollowing() bool {
 	if x != nil {
 		return x.Following
 	}
 	return false
 }
 
-type MultipleCommentResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Comments      []*Comment             `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *MultipleCommentResponse) Reset() {
-	*x = MultipleCommentResponse{}
-	mi := &file_realworld_v1_realworld_proto_msgTypes[29]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ProfileResponse_Profile) GetFollowersCount() uint32 {
+	if x != nil {
+		return x.FollowersCount
+	}
+	return 0
 }
 
-func (x *MultipleCommentResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ProfileResponse_Profile) GetFollowingCount() uint32 {
+	if x != nil {
+		return x.FollowingCount
+	}
+	return 0
 }
 
-func (*MultipleCommentResponse) ProtoMessage() {}
-
-func (x *MultipleCommentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_realworld_v1_realworld_proto_msgTypes[29]
+func (x *ProfileResponse_Profile) GetArticlesCount() uint32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ArticlesCount
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use MultipleCommentResponse.ProtoReflect.Descriptor instead.
-func (*MultipleCommentResponse) Descriptor() ([]byte, []int) {
-	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{29}
+func (x *ProfileResponse_Profile) GetPrivate() bool {
+	if x != nil {
+		return x.Private
+	}
+	return false
 }
 
-func (x *MultipleCommentResponse) GetComments() []*Comment {
+func (x *ProfileResponse_Profile) GetFollowRequested() bool {
 	if x != nil {
-		return x.Comments
+		return x.FollowRequested
 	}
-	return nil
+	return false
 }
 
-type TagsListResponse struct {
+type UploadAvatarResponse_Thumbnail struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Tags          []string               `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	Size          int32                  `protobuf:"varint,1,opt,name=size,proto3" json:"size,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TagsListResponse) Reset() {
-	*x = TagsListResponse{}
-	mi := &file_realworld_v1_realworld_proto_msgTypes[30]
+func (x *UploadAvatarResponse_Thumbnail) Reset() {
+	*x = UploadAvatarResponse_Thumbnail{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TagsListResponse) String() string {
+func (x *UploadAvatarResponse_Thumbnail) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TagsListResponse) ProtoMessage() {}
+func (*UploadAvatarResponse_Thumbnail) ProtoMessage() {}
 
-func (x *TagsListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_realworld_v1_realworld_proto_msgTypes[30]
+func (x *UploadAvatarResponse_Thumbnail) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1560,40 +5888,49 @@ func (x *TagsListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TagsListResponse.ProtoReflect.Descriptor instead.
-func (*TagsListResponse) Descriptor() ([]byte, []int) {
-	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use UploadAvatarResponse_Thumbnail.ProtoReflect.Descriptor instead.
+func (*UploadAvatarResponse_Thumbnail) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{72, 0}
 }
 
-func (x *TagsListResponse) GetTags() []string {
+func (x *UploadAvatarResponse_Thumbnail) GetSize() int32 {
 	if x != nil {
-		return x.Tags
+		return x.Size
 	}
-	return nil
+	return 0
 }
 
-type AddCommentRequest_Comment struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Body          string                 `protobuf:"bytes,1,opt,name=body,proto3" json:"body,omitempty"`
+func (x *UploadAvatarResponse_Thumbnail) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type UploadAvatarResponse_Image struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 最大的缩略图, 作为UpdateUser的image
+	Url           string                            `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Thumbnails    []*UploadAvatarResponse_Thumbnail `protobuf:"bytes,2,rep,name=thumbnails,proto3" json:"thumbnails,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddCommentRequest_Comment) Reset() {
-	*x = AddCommentRequest_Comment{}
-	mi := &file_realworld_v1_realworld_proto_msgTypes[31]
+func (x *UploadAvatarResponse_Image) Reset() {
+	*x = UploadAvatarResponse_Image{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddCommentRequest_Comment) String() string {
+func (x *UploadAvatarResponse_Image) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddCommentRequest_Comment) ProtoMessage() {}
+func (*UploadAvatarResponse_Image) ProtoMessage() {}
 
-func (x *AddCommentRequest_Comment) ProtoReflect() protoreflect.Message {
-	mi := &file_realworld_v1_realworld_proto_msgTypes[31]
+func (x *UploadAvatarResponse_Image) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1604,43 +5941,51 @@ func (x *AddCommentRequest_Comment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddCommentRequest_Comment.ProtoReflect.Descriptor instead.
-func (*AddCommentRequest_Comment) Descriptor() ([]byte, []int) {
-	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{6, 0}
+// Deprecated: Use UploadAvatarResponse_Image.ProtoReflect.Descriptor instead.
+func (*UploadAvatarResponse_Image) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{72, 1}
 }
 
-func (x *AddCommentRequest_Comment) GetBody() string {
+func (x *UploadAvatarResponse_Image) GetUrl() string {
 	if x != nil {
-		return x.Body
+		return x.Url
 	}
 	return ""
 }
 
-type UpdateArticleRequest_Article struct {
+func (x *UploadAvatarResponse_Image) GetThumbnails() []*UploadAvatarResponse_Thumbnail {
+	if x != nil {
+		return x.Thumbnails
+	}
+	return nil
+}
+
+type UserExportResponse_User struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Body          string                 `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
-	TagList       []string               `protobuf:"bytes,4,rep,name=tag_list,json=tagList,proto3" json:"tag_list,omitempty"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Bio           string                 `protobuf:"bytes,3,opt,name=bio,proto3" json:"bio,omitempty"`
+	Image         string                 `protobuf:"bytes,4,opt,name=image,proto3" json:"image,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateArticleRequest_Article) Reset() {
-	*x = UpdateArticleRequest_Article{}
-	mi := &file_realworld_v1_realworld_proto_msgTypes[32]
+func (x *UserExportResponse_User) Reset() {
+	*x = UserExportResponse_User{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[110]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateArticleRequest_Article) String() string {
+func (x *UserExportResponse_User) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateArticleRequest_Article) ProtoMessage() {}
+func (*UserExportResponse_User) ProtoMessage() {}
 
-func (x *UpdateArticleRequest_Article) ProtoReflect() protoreflect.Message {
-	mi := &file_realworld_v1_realworld_proto_msgTypes[32]
+func (x *UserExportResponse_User) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[110]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1651,64 +5996,72 @@ func (x *UpdateArticleRequest_Article) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateArticleRequest_Article.ProtoReflect.Descriptor instead.
-func (*UpdateArticleRequest_Article) Descriptor() ([]byte, []int) {
-	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{9, 0}
+// Deprecated: Use UserExportResponse_User.ProtoReflect.Descriptor instead.
+func (*UserExportResponse_User) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{80, 0}
 }
 
-func (x *UpdateArticleRequest_Article) GetTitle() string {
+func (x *UserExportResponse_User) GetEmail() string {
 	if x != nil {
-		return x.Title
+		return x.Email
 	}
 	return ""
 }
 
-func (x *UpdateArticleRequest_Article) GetDescription() string {
+func (x *UserExportResponse_User) GetUsername() string {
 	if x != nil {
-		return x.Description
+		return x.Username
 	}
 	return ""
 }
 
-func (x *UpdateArticleRequest_Article) GetBody() string {
+func (x *UserExportResponse_User) GetBio() string {
+	if x != nil {
+		return x.Bio
+	}
+	return ""
+}
+
+func (x *UserExportResponse_User) GetImage() string {
 	if x != nil {
-		return x.Body
+		return x.Image
 	}
 	return ""
 }
 
-func (x *UpdateArticleRequest_Article) GetTagList() []string {
+func (x *UserExportResponse_User) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.TagList
+		return x.CreatedAt
 	}
 	return nil
 }
 
-type CreateArticleRequest_Article struct {
+type UserExportResponse_Comment struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ArticleSlug   string                 `protobuf:"bytes,2,opt,name=article_slug,json=articleSlug,proto3" json:"article_slug,omitempty"`
 	Body          string                 `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
-	TagList       []string               `protobuf:"bytes,4,rep,name=tag_list,json=tagList,proto3" json:"tag_list,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateArticleRequest_Article) Reset() {
-	*x = CreateArticleRequest_Article{}
-	mi := &file_realworld_v1_realworld_proto_msgTypes[33]
+func (x *UserExportResponse_Comment) Reset() {
+	*x = UserExportResponse_Comment{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[111]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateArticleRequest_Article) String() string {
+func (x *UserExportResponse_Comment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateArticleRequest_Article) ProtoMessage() {}
+func (*UserExportResponse_Comment) ProtoMessage() {}
 
-func (x *CreateArticleRequest_Article) ProtoReflect() protoreflect.Message {
-	mi := &file_realworld_v1_realworld_proto_msgTypes[33]
+func (x *UserExportResponse_Comment) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[111]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1719,65 +6072,69 @@ func (x *CreateArticleRequest_Article) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateArticleRequest_Article.ProtoReflect.Descriptor instead.
-func (*CreateArticleRequest_Article) Descriptor() ([]byte, []int) {
-	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{10, 0}
+// Deprecated: Use UserExportResponse_Comment.ProtoReflect.Descriptor instead.
+func (*UserExportResponse_Comment) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{80, 1}
 }
 
-func (x *CreateArticleRequest_Article) GetTitle() string {
+func (x *UserExportResponse_Comment) GetId() uint32 {
 	if x != nil {
-		return x.Title
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateArticleRequest_Article) GetDescription() string {
+func (x *UserExportResponse_Comment) GetArticleSlug() string {
 	if x != nil {
-		return x.Description
+		return x.ArticleSlug
 	}
 	return ""
 }
 
-func (x *CreateArticleRequest_Article) GetBody() string {
+func (x *UserExportResponse_Comment) GetBody() string {
 	if x != nil {
 		return x.Body
 	}
 	return ""
 }
 
-func (x *CreateArticleRequest_Article) GetTagList() []string {
+func (x *UserExportResponse_Comment) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.TagList
+		return x.CreatedAt
 	}
 	return nil
 }
 
-type UpdateUserRequest_User struct {
+func (x *UserExportResponse_Comment) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type UserExportResponse_Favorite struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
-	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
-	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
-	Bio           string                 `protobuf:"bytes,4,opt,name=bio,proto3" json:"bio,omitempty"`
-	Image         string                 `protobuf:"bytes,5,opt,name=image,proto3" json:"image,omitempty"`
+	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateUserRequest_User) Reset() {
-	*x = UpdateUserRequest_User{}
-	mi := &file_realworld_v1_realworld_proto_msgTypes[34]
+func (x *UserExportResponse_Favorite) Reset() {
+	*x = UserExportResponse_Favorite{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateUserRequest_User) String() string {
+func (x *UserExportResponse_Favorite) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateUserRequest_User) ProtoMessage() {}
+func (*UserExportResponse_Favorite) ProtoMessage() {}
 
-func (x *UpdateUserRequest_User) ProtoReflect() protoreflect.Message {
-	mi := &file_realworld_v1_realworld_proto_msgTypes[34]
+func (x *UserExportResponse_Favorite) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1788,69 +6145,49 @@ func (x *UpdateUserRequest_User) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateUserRequest_User.ProtoReflect.Descriptor instead.
-func (*UpdateUserRequest_User) Descriptor() ([]byte, []int) {
-	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{17, 0}
-}
-
-func (x *UpdateUserRequest_User) GetEmail() string {
-	if x != nil {
-		return x.Email
-	}
-	return ""
-}
-
-func (x *UpdateUserRequest_User) GetPassword() string {
-	if x != nil {
-		return x.Password
-	}
-	return ""
-}
-
-func (x *UpdateUserRequest_User) GetUsername() string {
-	if x != nil {
-		return x.Username
-	}
-	return ""
+// Deprecated: Use UserExportResponse_Favorite.ProtoReflect.Descriptor instead.
+func (*UserExportResponse_Favorite) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{80, 2}
 }
 
-func (x *UpdateUserRequest_User) GetBio() string {
+func (x *UserExportResponse_Favorite) GetSlug() string {
 	if x != nil {
-		return x.Bio
+		return x.Slug
 	}
 	return ""
 }
 
-func (x *UpdateUserRequest_User) GetImage() string {
+func (x *UserExportResponse_Favorite) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Image
+		return x.CreatedAt
 	}
-	return ""
+	return nil
 }
 
-type LoginRequest_User struct {
+// 关注, 关注申请, 拉黑和静音的对方用户
+type UserExportResponse_Follow struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
-	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LoginRequest_User) Reset() {
-	*x = LoginRequest_User{}
-	mi := &file_realworld_v1_realworld_proto_msgTypes[35]
+func (x *UserExportResponse_Follow) Reset() {
+	*x = UserExportResponse_Follow{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[113]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LoginRequest_User) String() string {
+func (x *UserExportResponse_Follow) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoginRequest_User) ProtoMessage() {}
+func (*UserExportResponse_Follow) ProtoMessage() {}
 
-func (x *LoginRequest_User) ProtoReflect() protoreflect.Message {
-	mi := &file_realworld_v1_realworld_proto_msgTypes[35]
+func (x *UserExportResponse_Follow) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[113]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1861,49 +6198,50 @@ func (x *LoginRequest_User) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoginRequest_User.ProtoReflect.Descriptor instead.
-func (*LoginRequest_User) Descriptor() ([]byte, []int) {
-	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{19, 0}
+// Deprecated: Use UserExportResponse_Follow.ProtoReflect.Descriptor instead.
+func (*UserExportResponse_Follow) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{80, 3}
 }
 
-func (x *LoginRequest_User) GetEmail() string {
+func (x *UserExportResponse_Follow) GetUsername() string {
 	if x != nil {
-		return x.Email
+		return x.Username
 	}
 	return ""
 }
 
-func (x *LoginRequest_User) GetPassword() string {
+func (x *UserExportResponse_Follow) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Password
+		return x.CreatedAt
 	}
-	return ""
+	return nil
 }
 
-type RegisterRequest_User struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
-	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
-	Password      string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+type UserExportResponse_Bookmark struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Slug  string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	// 不在收藏夹中时为空
+	Collection    string                 `protobuf:"bytes,2,opt,name=collection,proto3" json:"collection,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RegisterRequest_User) Reset() {
-	*x = RegisterRequest_User{}
-	mi := &file_realworld_v1_realworld_proto_msgTypes[36]
+func (x *UserExportResponse_Bookmark) Reset() {
+	*x = UserExportResponse_Bookmark{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[114]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RegisterRequest_User) String() string {
+func (x *UserExportResponse_Bookmark) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegisterRequest_User) ProtoMessage() {}
+func (*UserExportResponse_Bookmark) ProtoMessage() {}
 
-func (x *RegisterRequest_User) ProtoReflect() protoreflect.Message {
-	mi := &file_realworld_v1_realworld_proto_msgTypes[36]
+func (x *UserExportResponse_Bookmark) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[114]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1914,58 +6252,58 @@ func (x *RegisterRequest_User) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegisterRequest_User.ProtoReflect.Descriptor instead.
-func (*RegisterRequest_User) Descriptor() ([]byte, []int) {
-	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{20, 0}
+// Deprecated: Use UserExportResponse_Bookmark.ProtoReflect.Descriptor instead.
+func (*UserExportResponse_Bookmark) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{80, 4}
 }
 
-func (x *RegisterRequest_User) GetUsername() string {
+func (x *UserExportResponse_Bookmark) GetSlug() string {
 	if x != nil {
-		return x.Username
+		return x.Slug
 	}
 	return ""
 }
 
-func (x *RegisterRequest_User) GetEmail() string {
+func (x *UserExportResponse_Bookmark) GetCollection() string {
 	if x != nil {
-		return x.Email
+		return x.Collection
 	}
 	return ""
 }
 
-func (x *RegisterRequest_User) GetPassword() string {
+func (x *UserExportResponse_Bookmark) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Password
+		return x.CreatedAt
 	}
-	return ""
+	return nil
 }
 
-type UserResponse_User struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
-	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
-	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
-	Bio           string                 `protobuf:"bytes,4,opt,name=bio,proto3" json:"bio,omitempty"`
-	Image         string                 `protobuf:"bytes,5,opt,name=image,proto3" json:"image,omitempty"`
+type UserExportResponse_Reaction struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// article / comment
+	TargetType    string                 `protobuf:"bytes,1,opt,name=target_type,json=targetType,proto3" json:"target_type,omitempty"`
+	TargetId      uint32                 `protobuf:"varint,2,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	Reaction      string                 `protobuf:"bytes,3,opt,name=reaction,proto3" json:"reaction,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserResponse_User) Reset() {
-	*x = UserResponse_User{}
-	mi := &file_realworld_v1_realworld_proto_msgTypes[37]
+func (x *UserExportResponse_Reaction) Reset() {
+	*x = UserExportResponse_Reaction{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[115]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserResponse_User) String() string {
+func (x *UserExportResponse_Reaction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserResponse_User) ProtoMessage() {}
+func (*UserExportResponse_Reaction) ProtoMessage() {}
 
-func (x *UserResponse_User) ProtoReflect() protoreflect.Message {
-	mi := &file_realworld_v1_realworld_proto_msgTypes[37]
+func (x *UserExportResponse_Reaction) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[115]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1976,71 +6314,118 @@ func (x *UserResponse_User) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserResponse_User.ProtoReflect.Descriptor instead.
-func (*UserResponse_User) Descriptor() ([]byte, []int) {
-	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{21, 0}
+// Deprecated: Use UserExportResponse_Reaction.ProtoReflect.Descriptor instead.
+func (*UserExportResponse_Reaction) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{80, 5}
 }
 
-func (x *UserResponse_User) GetEmail() string {
+func (x *UserExportResponse_Reaction) GetTargetType() string {
 	if x != nil {
-		return x.Email
+		return x.TargetType
 	}
 	return ""
 }
 
-func (x *UserResponse_User) GetToken() string {
+func (x *UserExportResponse_Reaction) GetTargetId() uint32 {
 	if x != nil {
-		return x.Token
+		return x.TargetId
 	}
-	return ""
+	return 0
 }
 
-func (x *UserResponse_User) GetUsername() string {
+func (x *UserExportResponse_Reaction) GetReaction() string {
 	if x != nil {
-		return x.Username
+		return x.Reaction
 	}
 	return ""
 }
 
-func (x *UserResponse_User) GetBio() string {
+func (x *UserExportResponse_Reaction) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Bio
+		return x.CreatedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *UserResponse_User) GetImage() string {
+type UserExportResponse_Tag struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserExportResponse_Tag) Reset() {
+	*x = UserExportResponse_Tag{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserExportResponse_Tag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserExportResponse_Tag) ProtoMessage() {}
+
+func (x *UserExportResponse_Tag) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[116]
 	if x != nil {
-		return x.Image
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserExportResponse_Tag.ProtoReflect.Descriptor instead.
+func (*UserExportResponse_Tag) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{80, 6}
+}
+
+func (x *UserExportResponse_Tag) GetName() string {
+	if x != nil {
+		return x.Name
 	}
 	return ""
 }
 
-type ProfileResponse_Profile struct {
+func (x *UserExportResponse_Tag) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type UserExportResponse_Notification struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
-	Bio           string                 `protobuf:"bytes,2,opt,name=bio,proto3" json:"bio,omitempty"`
-	Image         string                 `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`
-	Following     bool                   `protobuf:"varint,4,opt,name=following,proto3" json:"following,omitempty"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	ArticleSlug   string                 `protobuf:"bytes,2,opt,name=article_slug,json=articleSlug,proto3" json:"article_slug,omitempty"`
+	CommentId     uint32                 `protobuf:"varint,3,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	Actors        []string               `protobuf:"bytes,4,rep,name=actors,proto3" json:"actors,omitempty"`
+	Read          bool                   `protobuf:"varint,5,opt,name=read,proto3" json:"read,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProfileResponse_Profile) Reset() {
-	*x = ProfileResponse_Profile{}
-	mi := &file_realworld_v1_realworld_proto_msgTypes[38]
+func (x *UserExportResponse_Notification) Reset() {
+	*x = UserExportResponse_Notification{}
+	mi := &file_realworld_v1_realworld_proto_msgTypes[117]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProfileResponse_Profile) String() string {
+func (x *UserExportResponse_Notification) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProfileResponse_Profile) ProtoMessage() {}
+func (*UserExportResponse_Notification) ProtoMessage() {}
 
-func (x *ProfileResponse_Profile) ProtoReflect() protoreflect.Message {
-	mi := &file_realworld_v1_realworld_proto_msgTypes[38]
+func (x *UserExportResponse_Notification) ProtoReflect() protoreflect.Message {
+	mi := &file_realworld_v1_realworld_proto_msgTypes[117]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2051,45 +6436,131 @@ func (x *ProfileResponse_Profile) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProfileResponse_Profile.ProtoReflect.Descriptor instead.
-func (*ProfileResponse_Profile) Descriptor() ([]byte, []int) {
-	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{22, 0}
+// Deprecated: Use UserExportResponse_Notification.ProtoReflect.Descriptor instead.
+func (*UserExportResponse_Notification) Descriptor() ([]byte, []int) {
+	return file_realworld_v1_realworld_proto_rawDescGZIP(), []int{80, 7}
 }
 
-func (x *ProfileResponse_Profile) GetUsername() string {
+func (x *UserExportResponse_Notification) GetType() string {
 	if x != nil {
-		return x.Username
+		return x.Type
 	}
 	return ""
 }
 
-func (x *ProfileResponse_Profile) GetBio() string {
+func (x *UserExportResponse_Notification) GetArticleSlug() string {
 	if x != nil {
-		return x.Bio
+		return x.ArticleSlug
 	}
 	return ""
 }
 
-func (x *ProfileResponse_Profile) GetImage() string {
+func (x *UserExportResponse_Notification) GetCommentId() uint32 {
 	if x != nil {
-		return x.Image
+		return x.CommentId
 	}
-	return ""
+	return 0
 }
 
-func (x *ProfileResponse_Profile) GetFollowing() bool {
+func (x *UserExportResponse_Notification) GetActors() []string {
 	if x != nil {
-		return x.Following
+		return x.Actors
+	}
+	return nil
+}
+
+func (x *UserExportResponse_Notification) GetRead() bool {
+	if x != nil {
+		return x.Read
 	}
 	return false
 }
 
+func (x *UserExportResponse_Notification) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
 var File_realworld_v1_realworld_proto protoreflect.FileDescriptor
 
 const file_realworld_v1_realworld_proto_rawDesc = "" +
 	"\n" +
-	"\x1crealworld/v1/realworld.proto\x12\frealworld.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x10\n" +
-	"\x0eGetTagsRequest\",\n" +
+	"\x1crealworld/v1/realworld.proto\x12\frealworld.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\">\n" +
+	"\x0eGetTagsRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x03R\x05limit\x12\x16\n" +
+	"\x06prefix\x18\x02 \x01(\tR\x06prefix\".\n" +
+	"\x16GetTrendingTagsRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x03R\x05limit\"$\n" +
+	"\x10FollowTagRequest\x12\x10\n" +
+	"\x03tag\x18\x01 \x01(\tR\x03tag\"&\n" +
+	"\x12UnfollowTagRequest\x12\x10\n" +
+	"\x03tag\x18\x01 \x01(\tR\x03tag\"8\n" +
+	"\x10RenameTagRequest\x12\x10\n" +
+	"\x03tag\x18\x01 \x01(\tR\x03tag\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\";\n" +
+	"\x0fMergeTagRequest\x12\x10\n" +
+	"\x03tag\x18\x01 \x01(\tR\x03tag\x12\x16\n" +
+	"\x06target\x18\x02 \x01(\tR\x06target\"$\n" +
+	"\x10DeleteTagRequest\x12\x10\n" +
+	"\x03tag\x18\x01 \x01(\tR\x03tag\"\x13\n" +
+	"\x11DeleteTagResponse\"<\n" +
+	"\x12AddTagAliasRequest\x12\x10\n" +
+	"\x03tag\x18\x01 \x01(\tR\x03tag\x12\x14\n" +
+	"\x05alias\x18\x02 \x01(\tR\x05alias\"?\n" +
+	"\x15RemoveTagAliasRequest\x12\x10\n" +
+	"\x03tag\x18\x01 \x01(\tR\x03tag\x12\x14\n" +
+	"\x05alias\x18\x02 \x01(\tR\x05alias\"\x15\n" +
+	"\x13GetReactionsRequest\"K\n" +
+	"\x19AddArticleReactionRequest\x12\x12\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\x12\x1a\n" +
+	"\breaction\x18\x02 \x01(\tR\breaction\"N\n" +
+	"\x1cRemoveArticleReactionRequest\x12\x12\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\x12\x1a\n" +
+	"\breaction\x18\x02 \x01(\tR\breaction\"[\n" +
+	"\x19AddCommentReactionRequest\x12\x12\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\rR\x02id\x12\x1a\n" +
+	"\breaction\x18\x03 \x01(\tR\breaction\"^\n" +
+	"\x1cRemoveCommentReactionRequest\x12\x12\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\rR\x02id\x12\x1a\n" +
+	"\breaction\x18\x03 \x01(\tR\breaction\"Q\n" +
+	"\x16BookmarkArticleRequest\x12\x12\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\x12#\n" +
+	"\rcollection_id\x18\x02 \x01(\rR\fcollectionId\".\n" +
+	"\x18UnbookmarkArticleRequest\x12\x12\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\"i\n" +
+	"\x14ListBookmarksRequest\x12#\n" +
+	"\rcollection_id\x18\x01 \x01(\rR\fcollectionId\x12\x16\n" +
+	"\x06cursor\x18\x02 \x01(\tR\x06cursor\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x03R\x05limit\" \n" +
+	"\x1eListBookmarkCollectionsRequest\"\x9d\x01\n" +
+	"\x1fCreateBookmarkCollectionRequest\x12X\n" +
+	"\n" +
+	"collection\x18\x01 \x01(\v28.realworld.v1.CreateBookmarkCollectionRequest.CollectionR\n" +
+	"collection\x1a \n" +
+	"\n" +
+	"Collection\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"\xad\x01\n" +
+	"\x1fUpdateBookmarkCollectionRequest\x12X\n" +
+	"\n" +
+	"collection\x18\x01 \x01(\v28.realworld.v1.UpdateBookmarkCollectionRequest.CollectionR\n" +
+	"collection\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\rR\x02id\x1a \n" +
+	"\n" +
+	"Collection\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"1\n" +
+	"\x1fDeleteBookmarkCollectionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\"\"\n" +
+	" DeleteBookmarkCollectionResponse\"\x18\n" +
+	"\x16ListAttachmentsRequest\"3\n" +
+	"\x1dListArticleAttachmentsRequest\x12\x12\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\")\n" +
+	"\x17DeleteAttachmentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\"\x1a\n" +
+	"\x18DeleteAttachmentResponse\",\n" +
 	"\x16FavoriteArticleRequest\x12\x12\n" +
 	"\x04slug\x18\x01 \x01(\tR\x04slug\".\n" +
 	"\x18UnfavoriteArticleRequest\x12\x12\n" +
@@ -2109,25 +6580,31 @@ const file_realworld_v1_realworld_proto_rawDesc = "" +
 	"\x14DeleteArticleRequest\x12\x12\n" +
 	"\x04slug\x18\x01 \x01(\tR\x04slug\"1\n" +
 	"\x15DeleteArticleResponse\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage\"\xe2\x01\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"\x99\x02\n" +
 	"\x14UpdateArticleRequest\x12D\n" +
 	"\aarticle\x18\x01 \x01(\v2*.realworld.v1.UpdateArticleRequest.ArticleR\aarticle\x12\x12\n" +
-	"\x04slug\x18\x02 \x01(\tR\x04slug\x1ap\n" +
+	"\x04slug\x18\x02 \x01(\tR\x04slug\x1a\xa6\x01\n" +
 	"\aArticle\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x12\n" +
 	"\x04body\x18\x03 \x01(\tR\x04body\x12\x19\n" +
-	"\btag_list\x18\x04 \x03(\tR\atagList\"\xce\x01\n" +
+	"\btag_list\x18\x04 \x03(\tR\atagList\x12$\n" +
+	"\vcover_image\x18\x05 \x01(\tH\x00R\n" +
+	"coverImage\x88\x01\x01B\x0e\n" +
+	"\f_cover_image\"\xf0\x01\n" +
 	"\x14CreateArticleRequest\x12D\n" +
-	"\aarticle\x18\x01 \x01(\v2*.realworld.v1.CreateArticleRequest.ArticleR\aarticle\x1ap\n" +
+	"\aarticle\x18\x01 \x01(\v2*.realworld.v1.CreateArticleRequest.ArticleR\aarticle\x1a\x91\x01\n" +
 	"\aArticle\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x12\n" +
 	"\x04body\x18\x03 \x01(\tR\x04body\x12\x19\n" +
-	"\btag_list\x18\x04 \x03(\tR\atagList\"C\n" +
+	"\btag_list\x18\x04 \x03(\tR\atagList\x12\x1f\n" +
+	"\vcover_image\x18\x05 \x01(\tR\n" +
+	"coverImage\"[\n" +
 	"\x13FeedArticlesRequest\x12\x14\n" +
 	"\x05limit\x18\x01 \x01(\x03R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x02 \x01(\x03R\x06offset\"'\n" +
+	"\x06offset\x18\x02 \x01(\x03R\x06offset\x12\x16\n" +
+	"\x06cursor\x18\x03 \x01(\tR\x06cursor\"'\n" +
 	"\x11GetArticleRequest\x12\x12\n" +
 	"\x04slug\x18\x01 \x01(\tR\x04slug\"\x8b\x01\n" +
 	"\x13ListArticlesRequest\x12\x10\n" +
@@ -2141,16 +6618,57 @@ const file_realworld_v1_realworld_proto_rawDesc = "" +
 	"\x11FollowUserRequest\x12\x1a\n" +
 	"\busername\x18\x01 \x01(\tR\busername\"/\n" +
 	"\x11GetProfileRequest\x12\x1a\n" +
-	"\busername\x18\x01 \x01(\tR\busername\"\xcb\x01\n" +
+	"\busername\x18\x01 \x01(\tR\busername\"S\n" +
+	"\x15SearchProfilesRequest\x12\f\n" +
+	"\x01q\x18\x01 \x01(\tR\x01q\x12\x16\n" +
+	"\x06cursor\x18\x02 \x01(\tR\x06cursor\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x03R\x05limit\"F\n" +
+	"\x16SuggestProfilesRequest\x12\x16\n" +
+	"\x06cursor\x18\x01 \x01(\tR\x06cursor\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x03R\x05limit\".\n" +
+	"\x10BlockUserRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\"0\n" +
+	"\x12UnblockUserRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\"-\n" +
+	"\x0fMuteUserRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\"/\n" +
+	"\x11UnmuteUserRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\"G\n" +
+	"\x17ListBlockedUsersRequest\x12\x16\n" +
+	"\x06cursor\x18\x01 \x01(\tR\x06cursor\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x03R\x05limit\"E\n" +
+	"\x15ListMutedUsersRequest\x12\x16\n" +
+	"\x06cursor\x18\x01 \x01(\tR\x06cursor\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x03R\x05limit\"I\n" +
+	"\x19ListFollowRequestsRequest\x12\x16\n" +
+	"\x06cursor\x18\x01 \x01(\tR\x06cursor\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x03R\x05limit\"9\n" +
+	"\x1bApproveFollowRequestRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\"8\n" +
+	"\x1aRejectFollowRequestRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\"8\n" +
+	"\x1aCancelFollowRequestRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\"^\n" +
+	"\x12ListFollowsRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x16\n" +
+	"\x06cursor\x18\x02 \x01(\tR\x06cursor\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x03R\x05limit\"\xf7\x01\n" +
 	"\x11UpdateUserRequest\x128\n" +
-	"\x04user\x18\x01 \x01(\v2$.realworld.v1.UpdateUserRequest.UserR\x04user\x1a|\n" +
+	"\x04user\x18\x01 \x01(\v2$.realworld.v1.UpdateUserRequest.UserR\x04user\x1a\xa7\x01\n" +
 	"\x04User\x12\x14\n" +
 	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
 	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x1a\n" +
 	"\busername\x18\x03 \x01(\tR\busername\x12\x10\n" +
 	"\x03bio\x18\x04 \x01(\tR\x03bio\x12\x14\n" +
-	"\x05image\x18\x05 \x01(\tR\x05image\"\x17\n" +
-	"\x15GetCurrentUserRequest\"}\n" +
+	"\x05image\x18\x05 \x01(\tR\x05image\x12\x1d\n" +
+	"\aprivate\x18\x06 \x01(\bH\x00R\aprivate\x88\x01\x01B\n" +
+	"\n" +
+	"\b_private\"\x17\n" +
+	"\x15GetCurrentUserRequest\"\x1a\n" +
+	"\x18DeleteCurrentUserRequest\"5\n" +
+	"\x19DeleteCurrentUserResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"\x1a\n" +
+	"\x18ExportCurrentUserRequest\"}\n" +
 	"\fLoginRequest\x123\n" +
 	"\x04user\x18\x01 \x01(\v2\x1f.realworld.v1.LoginRequest.UserR\x04user\x1a8\n" +
 	"\x04User\x12\x14\n" +
@@ -2161,22 +6679,28 @@ const file_realworld_v1_realworld_proto_rawDesc = "" +
 	"\x04User\x12\x1a\n" +
 	"\busername\x18\x01 \x01(\tR\busername\x12\x14\n" +
 	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1a\n" +
-	"\bpassword\x18\x03 \x01(\tR\bpassword\"\xbb\x01\n" +
+	"\bpassword\x18\x03 \x01(\tR\bpassword\"\xd6\x01\n" +
 	"\fUserResponse\x123\n" +
-	"\x04user\x18\x01 \x01(\v2\x1f.realworld.v1.UserResponse.UserR\x04user\x1av\n" +
+	"\x04user\x18\x01 \x01(\v2\x1f.realworld.v1.UserResponse.UserR\x04user\x1a\x90\x01\n" +
 	"\x04User\x12\x14\n" +
 	"\x05email\x18\x01 \x01(\tR\x05email\x12\x14\n" +
 	"\x05token\x18\x02 \x01(\tR\x05token\x12\x1a\n" +
 	"\busername\x18\x03 \x01(\tR\busername\x12\x10\n" +
 	"\x03bio\x18\x04 \x01(\tR\x03bio\x12\x14\n" +
-	"\x05image\x18\x05 \x01(\tR\x05image\"\xbf\x01\n" +
+	"\x05image\x18\x05 \x01(\tR\x05image\x12\x18\n" +
+	"\aprivate\x18\x06 \x01(\bR\aprivate\"\xfe\x02\n" +
 	"\x0fProfileResponse\x12?\n" +
-	"\aprofile\x18\x01 \x01(\v2%.realworld.v1.ProfileResponse.ProfileR\aprofile\x1ak\n" +
+	"\aprofile\x18\x01 \x01(\v2%.realworld.v1.ProfileResponse.ProfileR\aprofile\x1a\xa9\x02\n" +
 	"\aProfile\x12\x1a\n" +
 	"\busername\x18\x01 \x01(\tR\busername\x12\x10\n" +
 	"\x03bio\x18\x02 \x01(\tR\x03bio\x12\x14\n" +
 	"\x05image\x18\x03 \x01(\tR\x05image\x12\x1c\n" +
-	"\tfollowing\x18\x04 \x01(\bR\tfollowing\"\xec\x02\n" +
+	"\tfollowing\x18\x04 \x01(\bR\tfollowing\x12'\n" +
+	"\x0ffollowers_count\x18\x05 \x01(\rR\x0efollowersCount\x12'\n" +
+	"\x0ffollowing_count\x18\x06 \x01(\rR\x0efollowingCount\x12%\n" +
+	"\x0earticles_count\x18\a \x01(\rR\rarticlesCount\x12\x18\n" +
+	"\aprivate\x18\b \x01(\bR\aprivate\x12)\n" +
+	"\x10follow_requested\x18\t \x01(\bR\x0ffollowRequested\"\x84\x04\n" +
 	"\aArticle\x12\x12\n" +
 	"\x04slug\x18\x01 \x01(\tR\x04slug\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
@@ -2188,41 +6712,259 @@ const file_realworld_v1_realworld_proto_rawDesc = "" +
 	"\tfavorited\x18\b \x01(\bR\tfavorited\x12&\n" +
 	"\x0efavoritesCount\x18\t \x01(\rR\x0efavoritesCount\x12-\n" +
 	"\x06author\x18\n" +
-	" \x01(\v2\x15.realworld.v1.ProfileR\x06author\"H\n" +
+	" \x01(\v2\x15.realworld.v1.ProfileR\x06author\x12\x1e\n" +
+	"\n" +
+	"coverImage\x18\v \x01(\tR\n" +
+	"coverImage\x12\x1e\n" +
+	"\n" +
+	"bookmarked\x18\f \x01(\bR\n" +
+	"bookmarked\x124\n" +
+	"\treactions\x18\r \x03(\v2\x16.realworld.v1.ReactionR\treactions\x12 \n" +
+	"\vmyReactions\x18\x0e \x03(\tR\vmyReactions\"<\n" +
+	"\bReaction\x12\x1a\n" +
+	"\breaction\x18\x01 \x01(\tR\breaction\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\rR\x05count\"H\n" +
 	"\x15SingleArticleResponse\x12/\n" +
-	"\aarticle\x18\x01 \x01(\v2\x15.realworld.v1.ArticleR\aarticle\"s\n" +
+	"\aarticle\x18\x01 \x01(\v2\x15.realworld.v1.ArticleR\aarticle\"\x94\x01\n" +
 	"\x17MultipleArticleResponse\x121\n" +
 	"\barticles\x18\x01 \x03(\v2\x15.realworld.v1.ArticleR\barticles\x12%\n" +
-	"\x0earticles_count\x18\x02 \x01(\rR\rarticlesCount\"H\n" +
+	"\x0earticles_count\x18\x02 \x01(\rR\rarticlesCount\x12\x1f\n" +
+	"\vnext_cursor\x18\x03 \x01(\tR\n" +
+	"nextCursor\"H\n" +
 	"\x15SingleCommentResponse\x12/\n" +
-	"\acomment\x18\x01 \x01(\v2\x15.realworld.v1.CommentR\acomment\"\xd0\x01\n" +
+	"\acomment\x18\x01 \x01(\v2\x15.realworld.v1.CommentR\acomment\"\xa8\x02\n" +
 	"\aComment\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\rR\x02id\x128\n" +
 	"\tcreatedAt\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x128\n" +
 	"\tupdatedAt\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x12\n" +
 	"\x04body\x18\x04 \x01(\tR\x04body\x12-\n" +
-	"\x06author\x18\x05 \x01(\v2\x15.realworld.v1.ProfileR\x06author\"k\n" +
+	"\x06author\x18\x05 \x01(\v2\x15.realworld.v1.ProfileR\x06author\x124\n" +
+	"\treactions\x18\x06 \x03(\v2\x16.realworld.v1.ReactionR\treactions\x12 \n" +
+	"\vmyReactions\x18\a \x03(\tR\vmyReactions\"\xa9\x02\n" +
 	"\aProfile\x12\x1a\n" +
 	"\busername\x18\x01 \x01(\tR\busername\x12\x10\n" +
 	"\x03bio\x18\x02 \x01(\tR\x03bio\x12\x14\n" +
 	"\x05image\x18\x03 \x01(\tR\x05image\x12\x1c\n" +
-	"\tfollowing\x18\x04 \x01(\bR\tfollowing\"L\n" +
+	"\tfollowing\x18\x04 \x01(\bR\tfollowing\x12'\n" +
+	"\x0ffollowers_count\x18\x05 \x01(\rR\x0efollowersCount\x12'\n" +
+	"\x0ffollowing_count\x18\x06 \x01(\rR\x0efollowingCount\x12%\n" +
+	"\x0earticles_count\x18\a \x01(\rR\rarticlesCount\x12\x18\n" +
+	"\aprivate\x18\b \x01(\bR\aprivate\x12)\n" +
+	"\x10follow_requested\x18\t \x01(\bR\x0ffollowRequested\"\xf2\x01\n" +
+	"\x14UploadAvatarResponse\x12>\n" +
+	"\x05image\x18\x01 \x01(\v2(.realworld.v1.UploadAvatarResponse.ImageR\x05image\x1a1\n" +
+	"\tThumbnail\x12\x12\n" +
+	"\x04size\x18\x01 \x01(\x05R\x04size\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x1ag\n" +
+	"\x05Image\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12L\n" +
+	"\n" +
+	"thumbnails\x18\x02 \x03(\v2,.realworld.v1.UploadAvatarResponse.ThumbnailR\n" +
+	"thumbnails\"\x9a\x01\n" +
+	"\x12BookmarkCollection\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12&\n" +
+	"\x0ebookmarksCount\x18\x03 \x01(\rR\x0ebookmarksCount\x128\n" +
+	"\tcreatedAt\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"d\n" +
+	" SingleBookmarkCollectionResponse\x12@\n" +
+	"\n" +
+	"collection\x18\x01 \x01(\v2 .realworld.v1.BookmarkCollectionR\n" +
+	"collection\"h\n" +
+	"\"MultipleBookmarkCollectionResponse\x12B\n" +
+	"\vcollections\x18\x01 \x03(\v2 .realworld.v1.BookmarkCollectionR\vcollections\"\xef\x01\n" +
+	"\n" +
+	"Attachment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x1f\n" +
+	"\vpreview_url\x18\x03 \x01(\tR\n" +
+	"previewUrl\x12!\n" +
+	"\fcontent_type\x18\x04 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04size\x18\x05 \x01(\x03R\x04size\x12\x14\n" +
+	"\x05width\x18\x06 \x01(\x05R\x05width\x12\x16\n" +
+	"\x06height\x18\a \x01(\x05R\x06height\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"T\n" +
+	"\x18SingleAttachmentResponse\x128\n" +
+	"\n" +
+	"attachment\x18\x01 \x01(\v2\x18.realworld.v1.AttachmentR\n" +
+	"attachment\"\x98\x01\n" +
+	"\x1aMultipleAttachmentResponse\x12:\n" +
+	"\vattachments\x18\x01 \x03(\v2\x18.realworld.v1.AttachmentR\vattachments\x12\x1d\n" +
+	"\n" +
+	"used_bytes\x18\x02 \x01(\x03R\tusedBytes\x12\x1f\n" +
+	"\vquota_bytes\x18\x03 \x01(\x03R\n" +
+	"quotaBytes\"m\n" +
+	"\x17MultipleProfileResponse\x121\n" +
+	"\bprofiles\x18\x01 \x03(\v2\x15.realworld.v1.ProfileR\bprofiles\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"\x89\x14\n" +
+	"\x12UserExportResponse\x129\n" +
+	"\x04user\x18\x01 \x01(\v2%.realworld.v1.UserExportResponse.UserR\x04user\x121\n" +
+	"\barticles\x18\x02 \x03(\v2\x15.realworld.v1.ArticleR\barticles\x12D\n" +
+	"\bcomments\x18\x03 \x03(\v2(.realworld.v1.UserExportResponse.CommentR\bcomments\x12G\n" +
+	"\tfavorites\x18\x04 \x03(\v2).realworld.v1.UserExportResponse.FavoriteR\tfavorites\x12E\n" +
+	"\tfollowing\x18\x05 \x03(\v2'.realworld.v1.UserExportResponse.FollowR\tfollowing\x12E\n" +
+	"\tfollowers\x18\x06 \x03(\v2'.realworld.v1.UserExportResponse.FollowR\tfollowers\x12;\n" +
+	"\vexported_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"exportedAt\x12Y\n" +
+	"\x14follow_requests_sent\x18\b \x03(\v2'.realworld.v1.UserExportResponse.FollowR\x12followRequestsSent\x12a\n" +
+	"\x18follow_requests_received\x18\t \x03(\v2'.realworld.v1.UserExportResponse.FollowR\x16followRequestsReceived\x12A\n" +
+	"\ablocked\x18\n" +
+	" \x03(\v2'.realworld.v1.UserExportResponse.FollowR\ablocked\x12=\n" +
+	"\x05muted\x18\v \x03(\v2'.realworld.v1.UserExportResponse.FollowR\x05muted\x12:\n" +
+	"\vattachments\x18\f \x03(\v2\x18.realworld.v1.AttachmentR\vattachments\x12S\n" +
+	"\x14bookmark_collections\x18\r \x03(\v2 .realworld.v1.BookmarkCollectionR\x13bookmarkCollections\x12G\n" +
+	"\tbookmarks\x18\x0e \x03(\v2).realworld.v1.UserExportResponse.BookmarkR\tbookmarks\x12G\n" +
+	"\treactions\x18\x0f \x03(\v2).realworld.v1.UserExportResponse.ReactionR\treactions\x12I\n" +
+	"\rfollowed_tags\x18\x10 \x03(\v2$.realworld.v1.UserExportResponse.TagR\ffollowedTags\x12S\n" +
+	"\rnotifications\x18\x11 \x03(\v2-.realworld.v1.UserExportResponse.NotificationR\rnotifications\x12x\n" +
+	"\x18notification_preferences\x18\x12 \x03(\v2=.realworld.v1.UserExportResponse.NotificationPreferencesEntryR\x17notificationPreferences\x1a\x9b\x01\n" +
+	"\x04User\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x10\n" +
+	"\x03bio\x18\x03 \x01(\tR\x03bio\x12\x14\n" +
+	"\x05image\x18\x04 \x01(\tR\x05image\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x1a\xc6\x01\n" +
+	"\aComment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12!\n" +
+	"\farticle_slug\x18\x02 \x01(\tR\varticleSlug\x12\x12\n" +
+	"\x04body\x18\x03 \x01(\tR\x04body\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x1aY\n" +
+	"\bFavorite\x12\x12\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\x129\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x1a_\n" +
+	"\x06Follow\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x129\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x1ay\n" +
+	"\bBookmark\x12\x12\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\x12\x1e\n" +
+	"\n" +
+	"collection\x18\x02 \x01(\tR\n" +
+	"collection\x129\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x1a\x9f\x01\n" +
+	"\bReaction\x12\x1f\n" +
+	"\vtarget_type\x18\x01 \x01(\tR\n" +
+	"targetType\x12\x1b\n" +
+	"\ttarget_id\x18\x02 \x01(\rR\btargetId\x12\x1a\n" +
+	"\breaction\x18\x03 \x01(\tR\breaction\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x1aT\n" +
+	"\x03Tag\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x129\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x1a\xcb\x01\n" +
+	"\fNotification\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12!\n" +
+	"\farticle_slug\x18\x02 \x01(\tR\varticleSlug\x12\x1d\n" +
+	"\n" +
+	"comment_id\x18\x03 \x01(\rR\tcommentId\x12\x16\n" +
+	"\x06actors\x18\x04 \x03(\tR\x06actors\x12\x12\n" +
+	"\x04read\x18\x05 \x01(\bR\x04read\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x1aJ\n" +
+	"\x1cNotificationPreferencesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\bR\x05value:\x028\x01\"L\n" +
 	"\x17MultipleCommentResponse\x121\n" +
-	"\bcomments\x18\x01 \x03(\v2\x15.realworld.v1.CommentR\bcomments\"&\n" +
+	"\bcomments\x18\x01 \x03(\v2\x15.realworld.v1.CommentR\bcomments\"S\n" +
 	"\x10TagsListResponse\x12\x12\n" +
-	"\x04tags\x18\x01 \x03(\tR\x04tags2\xa6\x11\n" +
+	"\x04tags\x18\x01 \x03(\tR\x04tags\x12+\n" +
+	"\adetails\x18\x02 \x03(\v2\x11.realworld.v1.TagR\adetails\"w\n" +
+	"\x03Tag\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1c\n" +
+	"\tfollowing\x18\x02 \x01(\bR\tfollowing\x12$\n" +
+	"\rarticlesCount\x18\x03 \x01(\rR\rarticlesCount\x12\x18\n" +
+	"\aaliases\x18\x04 \x03(\tR\aaliases\"i\n" +
+	"\vTrendingTag\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x01R\x05score\x120\n" +
+	"\x13recentArticlesCount\x18\x03 \x01(\rR\x13recentArticlesCount\"E\n" +
+	"\x14TrendingTagsResponse\x12-\n" +
+	"\x04tags\x18\x01 \x03(\v2\x19.realworld.v1.TrendingTagR\x04tags\"2\n" +
+	"\vTagResponse\x12#\n" +
+	"\x03tag\x18\x01 \x01(\v2\x11.realworld.v1.TagR\x03tag\"5\n" +
+	"\x15ReactionsListResponse\x12\x1c\n" +
+	"\treactions\x18\x01 \x03(\tR\treactions\"H\n" +
+	"\x18ListNotificationsRequest\x12\x16\n" +
+	"\x06cursor\x18\x01 \x01(\tR\x06cursor\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x03R\x05limit\"-\n" +
+	"\x1bMarkNotificationReadRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\"!\n" +
+	"\x1fMarkAllNotificationsReadRequest\"B\n" +
+	"\x1dMarkNotificationsReadResponse\x12!\n" +
+	"\funread_count\x18\x01 \x01(\rR\vunreadCount\"\xd2\x02\n" +
+	"\fNotification\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12+\n" +
+	"\x05actor\x18\x04 \x01(\v2\x15.realworld.v1.ProfileR\x05actor\x12!\n" +
+	"\factors_count\x18\x05 \x01(\rR\vactorsCount\x12!\n" +
+	"\farticle_slug\x18\x06 \x01(\tR\varticleSlug\x12#\n" +
+	"\rarticle_title\x18\a \x01(\tR\farticleTitle\x12\x1d\n" +
+	"\n" +
+	"comment_id\x18\b \x01(\rR\tcommentId\x12\x12\n" +
+	"\x04read\x18\t \x01(\bR\x04read\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xa4\x01\n" +
+	"\x1cMultipleNotificationResponse\x12@\n" +
+	"\rnotifications\x18\x01 \x03(\v2\x1a.realworld.v1.NotificationR\rnotifications\x12!\n" +
+	"\funread_count\x18\x02 \x01(\rR\vunreadCount\x12\x1f\n" +
+	"\vnext_cursor\x18\x03 \x01(\tR\n" +
+	"nextCursor\"\xc5\x01\n" +
+	"\x17NotificationPreferences\x12\x1b\n" +
+	"\x06follow\x18\x01 \x01(\bH\x00R\x06follow\x88\x01\x01\x12\x1f\n" +
+	"\bfavorite\x18\x02 \x01(\bH\x01R\bfavorite\x88\x01\x01\x12\x1d\n" +
+	"\acomment\x18\x03 \x01(\bH\x02R\acomment\x88\x01\x01\x12\x1d\n" +
+	"\amention\x18\x04 \x01(\bH\x03R\amention\x88\x01\x01B\t\n" +
+	"\a_followB\v\n" +
+	"\t_favoriteB\n" +
+	"\n" +
+	"\b_commentB\n" +
+	"\n" +
+	"\b_mention\"#\n" +
+	"!GetNotificationPreferencesRequest\"o\n" +
+	"$UpdateNotificationPreferencesRequest\x12G\n" +
+	"\vpreferences\x18\x01 \x01(\v2%.realworld.v1.NotificationPreferencesR\vpreferences\"j\n" +
+	"\x1fNotificationPreferencesResponse\x12G\n" +
+	"\vpreferences\x18\x01 \x01(\v2%.realworld.v1.NotificationPreferencesR\vpreferences2\xaaA\n" +
 	"\tRealWorld\x12\\\n" +
 	"\x05Login\x12\x1a.realworld.v1.LoginRequest\x1a\x1a.realworld.v1.UserResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/api/users/login\x12\\\n" +
 	"\bRegister\x12\x1d.realworld.v1.RegisterRequest\x1a\x1a.realworld.v1.UserResponse\"\x15\x82\xd3\xe4\x93\x02\x0f:\x01*\"\n" +
 	"/api/users\x12d\n" +
 	"\x0eGetCurrentUser\x12#.realworld.v1.GetCurrentUserRequest\x1a\x1a.realworld.v1.UserResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/api/user\x12_\n" +
 	"\n" +
-	"UpdateUser\x12\x1f.realworld.v1.UpdateUserRequest\x1a\x1a.realworld.v1.UserResponse\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\x1a\t/api/user\x12n\n" +
+	"UpdateUser\x12\x1f.realworld.v1.UpdateUserRequest\x1a\x1a.realworld.v1.UserResponse\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\x1a\t/api/user\x12w\n" +
+	"\x11DeleteCurrentUser\x12&.realworld.v1.DeleteCurrentUserRequest\x1a'.realworld.v1.DeleteCurrentUserResponse\"\x11\x82\xd3\xe4\x93\x02\v*\t/api/user\x12w\n" +
+	"\x11ExportCurrentUser\x12&.realworld.v1.ExportCurrentUserRequest\x1a .realworld.v1.UserExportResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/api/user/export\x12s\n" +
+	"\x0eSearchProfiles\x12#.realworld.v1.SearchProfilesRequest\x1a%.realworld.v1.MultipleProfileResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/api/profiles\x12\x81\x01\n" +
+	"\x0fSuggestProfiles\x12$.realworld.v1.SuggestProfilesRequest\x1a%.realworld.v1.MultipleProfileResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/profiles/suggestions\x12n\n" +
 	"\n" +
 	"GetProfile\x12\x1f.realworld.v1.GetProfileRequest\x1a\x1d.realworld.v1.ProfileResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/api/profiles/{username}\x12x\n" +
 	"\n" +
 	"FollowUser\x12\x1f.realworld.v1.FollowUserRequest\x1a\x1d.realworld.v1.ProfileResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/api/profiles/{username}/follow\x12y\n" +
-	"\fUnfollowUser\x12!.realworld.v1.UnfollowUserRequest\x1a\x1d.realworld.v1.ProfileResponse\"'\x82\xd3\xe4\x93\x02!*\x1f/api/profiles/{username}/follow\x12o\n" +
+	"\fUnfollowUser\x12!.realworld.v1.UnfollowUserRequest\x1a\x1d.realworld.v1.ProfileResponse\"'\x82\xd3\xe4\x93\x02!*\x1f/api/profiles/{username}/follow\x12\x84\x01\n" +
+	"\rListFollowers\x12 .realworld.v1.ListFollowsRequest\x1a%.realworld.v1.MultipleProfileResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/api/profiles/{username}/followers\x12\x84\x01\n" +
+	"\rListFollowing\x12 .realworld.v1.ListFollowsRequest\x1a%.realworld.v1.MultipleProfileResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/api/profiles/{username}/following\x12u\n" +
+	"\tBlockUser\x12\x1e.realworld.v1.BlockUserRequest\x1a\x1d.realworld.v1.ProfileResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/api/profiles/{username}/block\x12v\n" +
+	"\vUnblockUser\x12 .realworld.v1.UnblockUserRequest\x1a\x1d.realworld.v1.ProfileResponse\"&\x82\xd3\xe4\x93\x02 *\x1e/api/profiles/{username}/block\x12r\n" +
+	"\bMuteUser\x12\x1d.realworld.v1.MuteUserRequest\x1a\x1d.realworld.v1.ProfileResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/api/profiles/{username}/mute\x12s\n" +
+	"\n" +
+	"UnmuteUser\x12\x1f.realworld.v1.UnmuteUserRequest\x1a\x1d.realworld.v1.ProfileResponse\"%\x82\xd3\xe4\x93\x02\x1f*\x1d/api/profiles/{username}/mute\x12z\n" +
+	"\x10ListBlockedUsers\x12%.realworld.v1.ListBlockedUsersRequest\x1a%.realworld.v1.MultipleProfileResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/api/user/blocks\x12u\n" +
+	"\x0eListMutedUsers\x12#.realworld.v1.ListMutedUsersRequest\x1a%.realworld.v1.MultipleProfileResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/api/user/mutes\x12\x87\x01\n" +
+	"\x12ListFollowRequests\x12'.realworld.v1.ListFollowRequestsRequest\x1a%.realworld.v1.MultipleProfileResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/user/follow-requests\x12\x98\x01\n" +
+	"\x1aListOutgoingFollowRequests\x12'.realworld.v1.ListFollowRequestsRequest\x1a%.realworld.v1.MultipleProfileResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/api/user/follow-requests/outgoing\x12\x99\x01\n" +
+	"\x14ApproveFollowRequest\x12).realworld.v1.ApproveFollowRequestRequest\x1a\x1d.realworld.v1.ProfileResponse\"7\x82\xd3\xe4\x93\x021:\x01*\",/api/user/follow-requests/{username}/approve\x12\x96\x01\n" +
+	"\x13RejectFollowRequest\x12(.realworld.v1.RejectFollowRequestRequest\x1a\x1d.realworld.v1.ProfileResponse\"6\x82\xd3\xe4\x93\x020:\x01*\"+/api/user/follow-requests/{username}/reject\x12\x8f\x01\n" +
+	"\x13CancelFollowRequest\x12(.realworld.v1.CancelFollowRequestRequest\x1a\x1d.realworld.v1.ProfileResponse\"/\x82\xd3\xe4\x93\x02)*'/api/profiles/{username}/follow-request\x12o\n" +
 	"\fListArticles\x12!.realworld.v1.ListArticlesRequest\x1a%.realworld.v1.MultipleArticleResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/api/articles\x12t\n" +
 	"\fFeedArticles\x12!.realworld.v1.FeedArticlesRequest\x1a%.realworld.v1.MultipleArticleResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/api/articles/feed\x12p\n" +
 	"\n" +
@@ -2235,8 +6977,36 @@ const file_realworld_v1_realworld_proto_rawDesc = "" +
 	"\vGetComments\x12 .realworld.v1.GetCommentsRequest\x1a%.realworld.v1.MultipleCommentResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/api/articles/{slug}/comments\x12\x84\x01\n" +
 	"\rDeleteComment\x12\".realworld.v1.DeleteCommentRequest\x1a#.realworld.v1.DeleteCommentResponse\"*\x82\xd3\xe4\x93\x02$*\"/api/articles/{slug}/comments/{id}\x12\x86\x01\n" +
 	"\x0fFavoriteArticle\x12$.realworld.v1.FavoriteArticleRequest\x1a#.realworld.v1.SingleArticleResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/api/articles/{slug}/favorite\x12\x87\x01\n" +
-	"\x11UnfavoriteArticle\x12&.realworld.v1.UnfavoriteArticleRequest\x1a#.realworld.v1.SingleArticleResponse\"%\x82\xd3\xe4\x93\x02\x1f*\x1d/api/articles/{slug}/favorite\x12Z\n" +
-	"\aGetTags\x12\x1c.realworld.v1.GetTagsRequest\x1a\x1e.realworld.v1.TagsListResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/api/tagsB&Z$kratos-realworld/api/realworld/v1;v1b\x06proto3"
+	"\x11UnfavoriteArticle\x12&.realworld.v1.UnfavoriteArticleRequest\x1a#.realworld.v1.SingleArticleResponse\"%\x82\xd3\xe4\x93\x02\x1f*\x1d/api/articles/{slug}/favorite\x12\x8d\x01\n" +
+	"\x12AddArticleReaction\x12'.realworld.v1.AddArticleReactionRequest\x1a#.realworld.v1.SingleArticleResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/api/articles/{slug}/reactions\x12\x9b\x01\n" +
+	"\x15RemoveArticleReaction\x12*.realworld.v1.RemoveArticleReactionRequest\x1a#.realworld.v1.SingleArticleResponse\"1\x82\xd3\xe4\x93\x02+*)/api/articles/{slug}/reactions/{reaction}\x12\x9b\x01\n" +
+	"\x12AddCommentReaction\x12'.realworld.v1.AddCommentReactionRequest\x1a#.realworld.v1.SingleCommentResponse\"7\x82\xd3\xe4\x93\x021:\x01*\",/api/articles/{slug}/comments/{id}/reactions\x12\xa9\x01\n" +
+	"\x15RemoveCommentReaction\x12*.realworld.v1.RemoveCommentReactionRequest\x1a#.realworld.v1.SingleCommentResponse\"?\x82\xd3\xe4\x93\x029*7/api/articles/{slug}/comments/{id}/reactions/{reaction}\x12n\n" +
+	"\fGetReactions\x12!.realworld.v1.GetReactionsRequest\x1a#.realworld.v1.ReactionsListResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/api/reactions\x12\x86\x01\n" +
+	"\x0fBookmarkArticle\x12$.realworld.v1.BookmarkArticleRequest\x1a#.realworld.v1.SingleArticleResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/api/articles/{slug}/bookmark\x12\x87\x01\n" +
+	"\x11UnbookmarkArticle\x12&.realworld.v1.UnbookmarkArticleRequest\x1a#.realworld.v1.SingleArticleResponse\"%\x82\xd3\xe4\x93\x02\x1f*\x1d/api/articles/{slug}/bookmark\x12w\n" +
+	"\rListBookmarks\x12\".realworld.v1.ListBookmarksRequest\x1a%.realworld.v1.MultipleArticleResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/api/user/bookmarks\x12\xa2\x01\n" +
+	"\x17ListBookmarkCollections\x12,.realworld.v1.ListBookmarkCollectionsRequest\x1a0.realworld.v1.MultipleBookmarkCollectionResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/api/user/bookmarks/collections\x12\xa5\x01\n" +
+	"\x18CreateBookmarkCollection\x12-.realworld.v1.CreateBookmarkCollectionRequest\x1a..realworld.v1.SingleBookmarkCollectionResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/api/user/bookmarks/collections\x12\xaa\x01\n" +
+	"\x18UpdateBookmarkCollection\x12-.realworld.v1.UpdateBookmarkCollectionRequest\x1a..realworld.v1.SingleBookmarkCollectionResponse\"/\x82\xd3\xe4\x93\x02):\x01*\x1a$/api/user/bookmarks/collections/{id}\x12\xa7\x01\n" +
+	"\x18DeleteBookmarkCollection\x12-.realworld.v1.DeleteBookmarkCollectionRequest\x1a..realworld.v1.DeleteBookmarkCollectionResponse\",\x82\xd3\xe4\x93\x02&*$/api/user/bookmarks/collections/{id}\x12{\n" +
+	"\x0fListAttachments\x12$.realworld.v1.ListAttachmentsRequest\x1a(.realworld.v1.MultipleAttachmentResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/api/attachments\x12\x99\x01\n" +
+	"\x16ListArticleAttachments\x12+.realworld.v1.ListArticleAttachmentsRequest\x1a(.realworld.v1.MultipleAttachmentResponse\"(\x82\xd3\xe4\x93\x02\"\x12 /api/articles/{slug}/attachments\x12\x80\x01\n" +
+	"\x10DeleteAttachment\x12%.realworld.v1.DeleteAttachmentRequest\x1a&.realworld.v1.DeleteAttachmentResponse\"\x1d\x82\xd3\xe4\x93\x02\x17*\x15/api/attachments/{id}\x12Z\n" +
+	"\aGetTags\x12\x1c.realworld.v1.GetTagsRequest\x1a\x1e.realworld.v1.TagsListResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/api/tags\x12w\n" +
+	"\x0fGetTrendingTags\x12$.realworld.v1.GetTrendingTagsRequest\x1a\".realworld.v1.TrendingTagsResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/api/tags/trending\x12i\n" +
+	"\tFollowTag\x12\x1e.realworld.v1.FollowTagRequest\x1a\x19.realworld.v1.TagResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/api/tags/{tag}/follow\x12j\n" +
+	"\vUnfollowTag\x12 .realworld.v1.UnfollowTagRequest\x1a\x19.realworld.v1.TagResponse\"\x1e\x82\xd3\xe4\x93\x02\x18*\x16/api/tags/{tag}/follow\x12h\n" +
+	"\tRenameTag\x12\x1e.realworld.v1.RenameTagRequest\x1a\x19.realworld.v1.TagResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\x1a\x15/api/admin/tags/{tag}\x12l\n" +
+	"\bMergeTag\x12\x1d.realworld.v1.MergeTagRequest\x1a\x19.realworld.v1.TagResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/api/admin/tags/{tag}/merge\x12k\n" +
+	"\tDeleteTag\x12\x1e.realworld.v1.DeleteTagRequest\x1a\x1f.realworld.v1.DeleteTagResponse\"\x1d\x82\xd3\xe4\x93\x02\x17*\x15/api/admin/tags/{tag}\x12t\n" +
+	"\vAddTagAlias\x12 .realworld.v1.AddTagAliasRequest\x1a\x19.realworld.v1.TagResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/api/admin/tags/{tag}/aliases\x12\x7f\n" +
+	"\x0eRemoveTagAlias\x12#.realworld.v1.RemoveTagAliasRequest\x1a\x19.realworld.v1.TagResponse\"-\x82\xd3\xe4\x93\x02'*%/api/admin/tags/{tag}/aliases/{alias}\x12\x83\x01\n" +
+	"\x11ListNotifications\x12&.realworld.v1.ListNotificationsRequest\x1a*.realworld.v1.MultipleNotificationResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/api/notifications\x12\x97\x01\n" +
+	"\x14MarkNotificationRead\x12).realworld.v1.MarkNotificationReadRequest\x1a+.realworld.v1.MarkNotificationsReadResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/api/notifications/{id}/read\x12\x9a\x01\n" +
+	"\x18MarkAllNotificationsRead\x12-.realworld.v1.MarkAllNotificationsReadRequest\x1a+.realworld.v1.MarkNotificationsReadResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/api/notifications/read\x12\xa4\x01\n" +
+	"\x1aGetNotificationPreferences\x12/.realworld.v1.GetNotificationPreferencesRequest\x1a-.realworld.v1.NotificationPreferencesResponse\"&\x82\xd3\xe4\x93\x02 \x12\x1e/api/notifications/preferences\x12\xad\x01\n" +
+	"\x1dUpdateNotificationPreferences\x122.realworld.v1.UpdateNotificationPreferencesRequest\x1a-.realworld.v1.NotificationPreferencesResponse\")\x82\xd3\xe4\x93\x02#:\x01*\x1a\x1e/api/notifications/preferencesB&Z$kratos-realworld/api/realworld/v1;v1b\x06proto3"
 
 var (
 	file_realworld_v1_realworld_proto_rawDescOnce sync.Once
@@ -2250,111 +7020,329 @@ func file_realworld_v1_realworld_proto_rawDescGZIP() []byte {
 	return file_realworld_v1_realworld_proto_rawDescData
 }
 
-var file_realworld_v1_realworld_proto_msgTypes = make([]protoimpl.MessageInfo, 39)
+var file_realworld_v1_realworld_proto_msgTypes = make([]protoimpl.MessageInfo, 119)
 var file_realworld_v1_realworld_proto_goTypes = []any{
-	(*GetTagsRequest)(nil),               // 0: realworld.v1.GetTagsRequest
-	(*FavoriteArticleRequest)(nil),       // 1: realworld.v1.FavoriteArticleRequest
-	(*UnfavoriteArticleRequest)(nil),     // 2: realworld.v1.UnfavoriteArticleRequest
-	(*DeleteCommentRequest)(nil),         // 3: realworld.v1.DeleteCommentRequest
-	(*DeleteCommentResponse)(nil),        // 4: realworld.v1.DeleteCommentResponse
-	(*GetCommentsRequest)(nil),           // 5: realworld.v1.GetCommentsRequest
-	(*AddCommentRequest)(nil),            // 6: realworld.v1.AddCommentRequest
-	(*DeleteArticleRequest)(nil),         // 7: realworld.v1.DeleteArticleRequest
-	(*DeleteArticleResponse)(nil),        // 8: realworld.v1.DeleteArticleResponse
-	(*UpdateArticleRequest)(nil),         // 9: realworld.v1.UpdateArticleRequest
-	(*CreateArticleRequest)(nil),         // 10: realworld.v1.CreateArticleRequest
-	(*FeedArticlesRequest)(nil),          // 11: realworld.v1.FeedArticlesRequest
-	(*GetArticleRequest)(nil),            // 12: realworld.v1.GetArticleRequest
-	(*ListArticlesRequest)(nil),          // 13: realworld.v1.ListArticlesRequest
-	(*UnfollowUserRequest)(nil),          // 14: realworld.v1.UnfollowUserRequest
-	(*FollowUserRequest)(nil),            // 15: realworld.v1.FollowUserRequest
-	(*GetProfileRequest)(nil),            // 16: realworld.v1.GetProfileRequest
-	(*UpdateUserRequest)(nil),            // 17: realworld.v1.UpdateUserRequest
-	(*GetCurrentUserRequest)(nil),        // 18: realworld.v1.GetCurrentUserRequest
-	(*LoginRequest)(nil),                 // 19: realworld.v1.LoginRequest
-	(*RegisterRequest)(nil),              // 20: realworld.v1.RegisterRequest
-	(*UserResponse)(nil),                 // 21: realworld.v1.UserResponse
-	(*ProfileResponse)(nil),              // 22: realworld.v1.ProfileResponse
-	(*Article)(nil),                      // 23: realworld.v1.Article
-	(*SingleArticleResponse)(nil),        // 24: realworld.v1.SingleArticleResponse
-	(*MultipleArticleResponse)(nil),      // 25: realworld.v1.MultipleArticleResponse
-	(*SingleCommentResponse)(nil),        // 26: realworld.v1.SingleCommentResponse
-	(*Comment)(nil),                      // 27: realworld.v1.Comment
-	(*Profile)(nil),                      // 28: realworld.v1.Profile
-	(*MultipleCommentResponse)(nil),      // 29: realworld.v1.MultipleCommentResponse
-	(*TagsListResponse)(nil),             // 30: realworld.v1.TagsListResponse
-	(*AddCommentRequest_Comment)(nil),    // 31: realworld.v1.AddCommentRequest.Comment
-	(*UpdateArticleRequest_Article)(nil), // 32: realworld.v1.UpdateArticleRequest.Article
-	(*CreateArticleRequest_Article)(nil), // 33: realworld.v1.CreateArticleRequest.Article
-	(*UpdateUserRequest_User)(nil),       // 34: realworld.v1.UpdateUserRequest.User
-	(*LoginRequest_User)(nil),            // 35: realworld.v1.LoginRequest.User
-	(*RegisterRequest_User)(nil),         // 36: realworld.v1.RegisterRequest.User
-	(*UserResponse_User)(nil),            // 37: realworld.v1.UserResponse.User
-	(*ProfileResponse_Profile)(nil),      // 38: realworld.v1.ProfileResponse.Profile
-	(*timestamppb.Timestamp)(nil),        // 39: google.protobuf.Timestamp
+	(*GetTagsRequest)(nil),                             // 0: realworld.v1.GetTagsRequest
+	(*GetTrendingTagsRequest)(nil),                     // 1: realworld.v1.GetTrendingTagsRequest
+	(*FollowTagRequest)(nil),                           // 2: realworld.v1.FollowTagRequest
+	(*UnfollowTagRequest)(nil),                         // 3: realworld.v1.UnfollowTagRequest
+	(*RenameTagRequest)(nil),                           // 4: realworld.v1.RenameTagRequest
+	(*MergeTagRequest)(nil),                            // 5: realworld.v1.MergeTagRequest
+	(*DeleteTagRequest)(nil),                           // 6: realworld.v1.DeleteTagRequest
+	(*DeleteTagResponse)(nil),                          // 7: realworld.v1.DeleteTagResponse
+	(*AddTagAliasRequest)(nil),                         // 8: realworld.v1.AddTagAliasRequest
+	(*RemoveTagAliasRequest)(nil),                      // 9: realworld.v1.RemoveTagAliasRequest
+	(*GetReactionsRequest)(nil),                        // 10: realworld.v1.GetReactionsRequest
+	(*AddArticleReactionRequest)(nil),                  // 11: realworld.v1.AddArticleReactionRequest
+	(*RemoveArticleReactionRequest)(nil),               // 12: realworld.v1.RemoveArticleReactionRequest
+	(*AddCommentReactionRequest)(nil),                  // 13: realworld.v1.AddCommentReactionRequest
+	(*RemoveCommentReactionRequest)(nil),               // 14: realworld.v1.RemoveCommentReactionRequest
+	(*BookmarkArticleRequest)(nil),                     // 15: realworld.v1.BookmarkArticleRequest
+	(*UnbookmarkArticleRequest)(nil),                   // 16: realworld.v1.UnbookmarkArticleRequest
+	(*ListBookmarksRequest)(nil),                       // 17: realworld.v1.ListBookmarksRequest
+	(*ListBookmarkCollectionsRequest)(nil),             // 18: realworld.v1.ListBookmarkCollectionsRequest
+	(*CreateBookmarkCollectionRequest)(nil),            // 19: realworld.v1.CreateBookmarkCollectionRequest
+	(*UpdateBookmarkCollectionRequest)(nil),            // 20: realworld.v1.UpdateBookmarkCollectionRequest
+	(*DeleteBookmarkCollectionRequest)(nil),            // 21: realworld.v1.DeleteBookmarkCollectionRequest
+	(*DeleteBookmarkCollectionResponse)(nil),           // 22: realworld.v1.DeleteBookmarkCollectionResponse
+	(*ListAttachmentsRequest)(nil),                     // 23: realworld.v1.ListAttachmentsRequest
+	(*ListArticleAttachmentsRequest)(nil),              // 24: realworld.v1.ListArticleAttachmentsRequest
+	(*DeleteAttachmentRequest)(nil),                    // 25: realworld.v1.DeleteAttachmentRequest
+	(*DeleteAttachmentResponse)(nil),                   // 26: realworld.v1.DeleteAttachmentResponse
+	(*FavoriteArticleRequest)(nil),                     // 27: realworld.v1.FavoriteArticleRequest
+	(*UnfavoriteArticleRequest)(nil),                   // 28: realworld.v1.UnfavoriteArticleRequest
+	(*DeleteCommentRequest)(nil),                       // 29: realworld.v1.DeleteCommentRequest
+	(*DeleteCommentResponse)(nil),                      // 30: realworld.v1.DeleteCommentResponse
+	(*GetCommentsRequest)(nil),                         // 31: realworld.v1.GetCommentsRequest
+	(*AddCommentRequest)(nil),                          // 32: realworld.v1.AddCommentRequest
+	(*DeleteArticleRequest)(nil),                       // 33: realworld.v1.DeleteArticleRequest
+	(*DeleteArticleResponse)(nil),                      // 34: realworld.v1.DeleteArticleResponse
+	(*UpdateArticleRequest)(nil),                       // 35: realworld.v1.UpdateArticleRequest
+	(*CreateArticleRequest)(nil),                       // 36: realworld.v1.CreateArticleRequest
+	(*FeedArticlesRequest)(nil),                        // 37: realworld.v1.FeedArticlesRequest
+	(*GetArticleRequest)(nil),                          // 38: realworld.v1.GetArticleRequest
+	(*ListArticlesRequest)(nil),                        // 39: realworld.v1.ListArticlesRequest
+	(*UnfollowUserRequest)(nil),                        // 40: realworld.v1.UnfollowUserRequest
+	(*FollowUserRequest)(nil),                          // 41: realworld.v1.FollowUserRequest
+	(*GetProfileRequest)(nil),                          // 42: realworld.v1.GetProfileRequest
+	(*SearchProfilesRequest)(nil),                      // 43: realworld.v1.SearchProfilesRequest
+	(*SuggestProfilesRequest)(nil),                     // 44: realworld.v1.SuggestProfilesRequest
+	(*BlockUserRequest)(nil),                           // 45: realworld.v1.BlockUserRequest
+	(*UnblockUserRequest)(nil),                         // 46: realworld.v1.UnblockUserRequest
+	(*MuteUserRequest)(nil),                            // 47: realworld.v1.MuteUserRequest
+	(*UnmuteUserRequest)(nil),                          // 48: realworld.v1.UnmuteUserRequest
+	(*ListBlockedUsersRequest)(nil),                    // 49: realworld.v1.ListBlockedUsersRequest
+	(*ListMutedUsersRequest)(nil),                      // 50: realworld.v1.ListMutedUsersRequest
+	(*ListFollowRequestsRequest)(nil),                  // 51: realworld.v1.ListFollowRequestsRequest
+	(*ApproveFollowRequestRequest)(nil),                // 52: realworld.v1.ApproveFollowRequestRequest
+	(*RejectFollowRequestRequest)(nil),                 // 53: realworld.v1.RejectFollowRequestRequest
+	(*CancelFollowRequestRequest)(nil),                 // 54: realworld.v1.CancelFollowRequestRequest
+	(*ListFollowsRequest)(nil),                         // 55: realworld.v1.ListFollowsRequest
+	(*UpdateUserRequest)(nil),                          // 56: realworld.v1.UpdateUserRequest
+	(*GetCurrentUserRequest)(nil),                      // 57: realworld.v1.GetCurrentUserRequest
+	(*DeleteCurrentUserRequest)(nil),                   // 58: realworld.v1.DeleteCurrentUserRequest
+	(*DeleteCurrentUserResponse)(nil),                  // 59: realworld.v1.DeleteCurrentUserResponse
+	(*ExportCurrentUserRequest)(nil),                   // 60: realworld.v1.ExportCurrentUserRequest
+	(*LoginRequest)(nil),                               // 61: realworld.v1.LoginRequest
+	(*RegisterRequest)(nil),                            // 62: realworld.v1.RegisterRequest
+	(*UserResponse)(nil),                               // 63: realworld.v1.UserResponse
+	(*ProfileResponse)(nil),                            // 64: realworld.v1.ProfileResponse
+	(*Article)(nil),                                    // 65: realworld.v1.Article
+	(*Reaction)(nil),                                   // 66: realworld.v1.Reaction
+	(*SingleArticleResponse)(nil),                      // 67: realworld.v1.SingleArticleResponse
+	(*MultipleArticleResponse)(nil),                    // 68: realworld.v1.MultipleArticleResponse
+	(*SingleCommentResponse)(nil),                      // 69: realworld.v1.SingleCommentResponse
+	(*Comment)(nil),                                    // 70: realworld.v1.Comment
+	(*Profile)(nil),                                    // 71: realworld.v1.Profile
+	(*UploadAvatarResponse)(nil),                       // 72: realworld.v1.UploadAvatarResponse
+	(*BookmarkCollection)(nil),                         // 73: realworld.v1.BookmarkCollection
+	(*SingleBookmarkCollectionResponse)(nil),           // 74: realworld.v1.SingleBookmarkCollectionResponse
+	(*MultipleBookmarkCollectionResponse)(nil),         // 75: realworld.v1.MultipleBookmarkCollectionResponse
+	(*Attachment)(nil),                                 // 76: realworld.v1.Attachment
+	(*SingleAttachmentResponse)(nil),                   // 77: realworld.v1.SingleAttachmentResponse
+	(*MultipleAttachmentResponse)(nil),                 // 78: realworld.v1.MultipleAttachmentResponse
+	(*MultipleProfileResponse)(nil),                    // 79: realworld.v1.MultipleProfileResponse
+	(*UserExportResponse)(nil),                         // 80: realworld.v1.UserExportResponse
+	(*MultipleCommentResponse)(nil),                    // 81: realworld.v1.MultipleCommentResponse
+	(*TagsListResponse)(nil),                           // 82: realworld.v1.TagsListResponse
+	(*Tag)(nil),                                        // 83: realworld.v1.Tag
+	(*TrendingTag)(nil),                                // 84: realworld.v1.TrendingTag
+	(*TrendingTagsResponse)(nil),                       // 85: realworld.v1.TrendingTagsResponse
+	(*TagResponse)(nil),                                // 86: realworld.v1.TagResponse
+	(*ReactionsListResponse)(nil),                      // 87: realworld.v1.ReactionsListResponse
+	(*ListNotificationsRequest)(nil),                   // 88: realworld.v1.ListNotificationsRequest
+	(*MarkNotificationReadRequest)(nil),                // 89: realworld.v1.MarkNotificationReadRequest
+	(*MarkAllNotificationsReadRequest)(nil),            // 90: realworld.v1.MarkAllNotificationsReadRequest
+	(*MarkNotificationsReadResponse)(nil),              // 91: realworld.v1.MarkNotificationsReadResponse
+	(*Notification)(nil),                               // 92: realworld.v1.Notification
+	(*MultipleNotificationResponse)(nil),               // 93: realworld.v1.MultipleNotificationResponse
+	(*NotificationPreferences)(nil),                    // 94: realworld.v1.NotificationPreferences
+	(*GetNotificationPreferencesRequest)(nil),          // 95: realworld.v1.GetNotificationPreferencesRequest
+	(*UpdateNotificationPreferencesRequest)(nil),       // 96: realworld.v1.UpdateNotificationPreferencesRequest
+	(*NotificationPreferencesResponse)(nil),            // 97: realworld.v1.NotificationPreferencesResponse
+	(*CreateBookmarkCollectionRequest_Collection)(nil), // 98: realworld.v1.CreateBookmarkCollectionRequest.Collection
+	(*UpdateBookmarkCollectionRequest_Collection)(nil), // 99: realworld.v1.UpdateBookmarkCollectionRequest.Collection
+	(*AddCommentRequest_Comment)(nil),                  // 100: realworld.v1.AddCommentRequest.Comment
+	(*UpdateArticleRequest_Article)(nil),               // 101: realworld.v1.UpdateArticleRequest.Article
+	(*CreateArticleRequest_Article)(nil),               // 102: realworld.v1.CreateArticleRequest.Article
+	(*UpdateUserRequest_User)(nil),                     // 103: realworld.v1.UpdateUserRequest.User
+	(*LoginRequest_User)(nil),                          // 104: realworld.v1.LoginRequest.User
+	(*RegisterRequest_User)(nil),                       // 105: realworld.v1.RegisterRequest.User
+	(*UserResponse_User)(nil),                          // 106: realworld.v1.UserResponse.User
+	(*ProfileResponse_Profile)(nil),                    // 107: realworld.v1.ProfileResponse.Profile
+	(*UploadAvatarResponse_Thumbnail)(nil),             // 108: realworld.v1.UploadAvatarResponse.Thumbnail
+	(*UploadAvatarResponse_Image)(nil),                 // 109: realworld.v1.UploadAvatarResponse.Image
+	(*UserExportResponse_User)(nil),                    // 110: realworld.v1.UserExportResponse.User
+	(*UserExportResponse_Comment)(nil),                 // 111: realworld.v1.UserExportResponse.Comment
+	(*UserExportResponse_Favorite)(nil),                // 112: realworld.v1.UserExportResponse.Favorite
+	(*UserExportResponse_Follow)(nil),                  // 113: realworld.v1.UserExportResponse.Follow
+	(*UserExportResponse_Bookmark)(nil),                // 114: realworld.v1.UserExportResponse.Bookmark
+	(*UserExportResponse_Reaction)(nil),                // 115: realworld.v1.UserExportResponse.Reaction
+	(*UserExportResponse_Tag)(nil),                     // 116: realworld.v1.UserExportResponse.Tag
+	(*UserExportResponse_Notification)(nil),            // 117: realworld.v1.UserExportResponse.Notification
+	nil,                                                // 118: realworld.v1.UserExportResponse.NotificationPreferencesEntry
+	(*timestamppb.Timestamp)(nil),                      // 119: google.protobuf.Timestamp
 }
 var file_realworld_v1_realworld_proto_depIdxs = []int32{
-	31, // 0: realworld.v1.AddCommentRequest.comment:type_name -> realworld.v1.AddCommentRequest.Comment
-	32, // 1: realworld.v1.UpdateArticleRequest.article:type_name -> realworld.v1.UpdateArticleRequest.Article
-	33, // 2: realworld.v1.CreateArticleRequest.article:type_name -> realworld.v1.CreateArticleRequest.Article
-	34, // 3: realworld.v1.UpdateUserRequest.user:type_name -> realworld.v1.UpdateUserRequest.User
-	35, // 4: realworld.v1.LoginRequest.user:type_name -> realworld.v1.LoginRequest.User
-	36, // 5: realworld.v1.RegisterRequest.user:type_name -> realworld.v1.RegisterRequest.User
-	37, // 6: realworld.v1.UserResponse.user:type_name -> realworld.v1.UserResponse.User
-	38, // 7: realworld.v1.ProfileResponse.profile:type_name -> realworld.v1.ProfileResponse.Profile
-	39, // 8: realworld.v1.Article.createdAt:type_name -> google.protobuf.Timestamp
-	39, // 9: realworld.v1.Article.updatedAt:type_name -> google.protobuf.Timestamp
-	28, // 10: realworld.v1.Article.author:type_name -> realworld.v1.Profile
-	23, // 11: realworld.v1.SingleArticleResponse.article:type_name -> realworld.v1.Article
-	23, // 12: realworld.v1.MultipleArticleResponse.articles:type_name -> realworld.v1.Article
-	27, // 13: realworld.v1.SingleCommentResponse.comment:type_name -> realworld.v1.Comment
-	39, // 14: realworld.v1.Comment.createdAt:type_name -> google.protobuf.Timestamp
-	39, // 15: realworld.v1.Comment.updatedAt:type_name -> google.protobuf.Timestamp
-	28, // 16: realworld.v1.Comment.author:type_name -> realworld.v1.Profile
-	27, // 17: realworld.v1.MultipleCommentResponse.comments:type_name -> realworld.v1.Comment
-	19, // 18: realworld.v1.RealWorld.Login:input_type -> realworld.v1.LoginRequest
-	20, // 19: realworld.v1.RealWorld.Register:input_type -> realworld.v1.RegisterRequest
-	18, // 20: realworld.v1.RealWorld.GetCurrentUser:input_type -> realworld.v1.GetCurrentUserRequest
-	17, // 21: realworld.v1.RealWorld.UpdateUser:input_type -> realworld.v1.UpdateUserRequest
-	16, // 22: realworld.v1.RealWorld.GetProfile:input_type -> realworld.v1.GetProfileRequest
-	15, // 23: realworld.v1.RealWorld.FollowUser:input_type -> realworld.v1.FollowUserRequest
-	14, // 24: realworld.v1.RealWorld.UnfollowUser:input_type -> realworld.v1.UnfollowUserRequest
-	13, // 25: realworld.v1.RealWorld.ListArticles:input_type -> realworld.v1.ListArticlesRequest
-	11, // 26: realworld.v1.RealWorld.FeedArticles:input_type -> realworld.v1.FeedArticlesRequest
-	12, // 27: realworld.v1.RealWorld.GetArticle:input_type -> realworld.v1.GetArticleRequest
-	10, // 28: realworld.v1.RealWorld.CreateArticle:input_type -> realworld.v1.CreateArticleRequest
-	9,  // 29: realworld.v1.RealWorld.UpdateArticle:input_type -> realworld.v1.UpdateArticleRequest
-	7,  // 30: realworld.v1.RealWorld.DeleteArticle:input_type -> realworld.v1.DeleteArticleRequest
-	6,  // 31: realworld.v1.RealWorld.AddComment:input_type -> realworld.v1.AddCommentRequest
-	5,  // 32: realworld.v1.RealWorld.GetComments:input_type -> realworld.v1.GetCommentsRequest
-	3,  // 33: realworld.v1.RealWorld.DeleteComment:input_type -> realworld.v1.DeleteCommentRequest
-	1,  // 34: realworld.v1.RealWorld.FavoriteArticle:input_type -> realworld.v1.FavoriteArticleRequest
-	2,  // 35: realworld.v1.RealWorld.UnfavoriteArticle:input_type -> realworld.v1.UnfavoriteArticleRequest
-	0,  // 36: realworld.v1.RealWorld.GetTags:input_type -> realworld.v1.GetTagsRequest
-	21, // 37: realworld.v1.RealWorld.Login:output_type -> realworld.v1.UserResponse
-	21, // 38: realworld.v1.RealWorld.Register:output_type -> realworld.v1.UserResponse
-	21, // 39: realworld.v1.RealWorld.GetCurrentUser:output_type -> realworld.v1.UserResponse
-	21, // 40: realworld.v1.RealWorld.UpdateUser:output_type -> realworld.v1.UserResponse
-	22, // 41: realworld.v1.RealWorld.GetProfile:output_type -> realworld.v1.ProfileResponse
-	22, // 42: realworld.v1.RealWorld.FollowUser:output_type -> realworld.v1.ProfileResponse
-	22, // 43: realworld.v1.RealWorld.UnfollowUser:output_type -> realworld.v1.ProfileResponse
-	25, // 44: realworld.v1.RealWorld.ListArticles:output_type -> realworld.v1.MultipleArticleResponse
-	25, // 45: realworld.v1.RealWorld.FeedArticles:output_type -> realworld.v1.MultipleArticleResponse
-	24, // 46: realworld.v1.RealWorld.GetArticle:output_type -> realworld.v1.SingleArticleResponse
-	24, // 47: realworld.v1.RealWorld.CreateArticle:output_type -> realworld.v1.SingleArticleResponse
-	24, // 48: realworld.v1.RealWorld.UpdateArticle:output_type -> realworld.v1.SingleArticleResponse
-	8,  // 49: realworld.v1.RealWorld.DeleteArticle:output_type -> realworld.v1.DeleteArticleResponse
-	26, // 50: realworld.v1.RealWorld.AddComment:output_type -> realworld.v1.SingleCommentResponse
-	29, // 51: realworld.v1.RealWorld.GetComments:output_type -> realworld.v1.MultipleCommentResponse
-	4,  // 52: realworld.v1.RealWorld.DeleteComment:output_type -> realworld.v1.DeleteCommentResponse
-	24, // 53: realworld.v1.RealWorld.FavoriteArticle:output_type -> realworld.v1.SingleArticleResponse
-	24, // 54: realworld.v1.RealWorld.UnfavoriteArticle:output_type -> realworld.v1.SingleArticleResponse
-	30, // 55: realworld.v1.RealWorld.GetTags:output_type -> realworld.v1.TagsListResponse
-	37, // [37:56] is the sub-list for method output_type
-	18, // [18:37] is the sub-list for method input_type
-	18, // [18:18] is the sub-list for extension type_name
-	18, // [18:18] is the sub-list for extension extendee
-	0,  // [0:18] is the sub-list for field type_name
+	98,  // 0: realworld.v1.CreateBookmarkCollectionRequest.collection:type_name -> realworld.v1.CreateBookmarkCollectionRequest.Collection
+	99,  // 1: realworld.v1.UpdateBookmarkCollectionRequest.collection:type_name -> realworld.v1.UpdateBookmarkCollectionRequest.Collection
+	100, // 2: realworld.v1.AddCommentRequest.comment:type_name -> realworld.v1.AddCommentRequest.Comment
+	101, // 3: realworld.v1.UpdateArticleRequest.article:type_name -> realworld.v1.UpdateArticleRequest.Article
+	102, // 4: realworld.v1.CreateArticleRequest.article:type_name -> realworld.v1.CreateArticleRequest.Article
+	103, // 5: realworld.v1.UpdateUserRequest.user:type_name -> realworld.v1.UpdateUserRequest.User
+	104, // 6: realworld.v1.LoginRequest.user:type_name -> realworld.v1.LoginRequest.User
+	105, // 7: realworld.v1.RegisterRequest.user:type_name -> realworld.v1.RegisterRequest.User
+	106, // 8: realworld.v1.UserResponse.user:type_name -> realworld.v1.UserResponse.User
+	107, // 9: realworld.v1.ProfileResponse.profile:type_name -> realworld.v1.ProfileResponse.Profile
+	119, // 10: realworld.v1.Article.createdAt:type_name -> google.protobuf.Timestamp
+	119, // 11: realworld.v1.Article.updatedAt:type_name -> google.protobuf.Timestamp
+	71,  // 12: realworld.v1.Article.author:type_name -> realworld.v1.Profile
+	66,  // 13: realworld.v1.Article.reactions:type_name -> realworld.v1.Reaction
+	65,  // 14: realworld.v1.SingleArticleResponse.article:type_name -> realworld.v1.Article
+	65,  // 15: realworld.v1.MultipleArticleResponse.articles:type_name -> realworld.v1.Article
+	70,  // 16: realworld.v1.SingleCommentResponse.comment:type_name -> realworld.v1.Comment
+	119, // 17: realworld.v1.Comment.createdAt:type_name -> google.protobuf.Timestamp
+	119, // 18: realworld.v1.Comment.updatedAt:type_name -> google.protobuf.Timestamp
+	71,  // 19: realworld.v1.Comment.author:type_name -> realworld.v1.Profile
+	66,  // 20: realworld.v1.Comment.reactions:type_name -> realworld.v1.Reaction
+	109, // 21: realworld.v1.UploadAvatarResponse.image:type_name -> realworld.v1.UploadAvatarResponse.Image
+	119, // 22: realworld.v1.BookmarkCollection.createdAt:type_name -> google.protobuf.Timestamp
+	73,  // 23: realworld.v1.SingleBookmarkCollectionResponse.collection:type_name -> realworld.v1.BookmarkCollection
+	73,  // 24: realworld.v1.MultipleBookmarkCollectionResponse.collections:type_name -> realworld.v1.BookmarkCollection
+	119, // 25: realworld.v1.Attachment.created_at:type_name -> google.protobuf.Timestamp
+	76,  // 26: realworld.v1.SingleAttachmentResponse.attachment:type_name -> realworld.v1.Attachment
+	76,  // 27: realworld.v1.MultipleAttachmentResponse.attachments:type_name -> realworld.v1.Attachment
+	71,  // 28: realworld.v1.MultipleProfileResponse.profiles:type_name -> realworld.v1.Profile
+	110, // 29: realworld.v1.UserExportResponse.user:type_name -> realworld.v1.UserExportResponse.User
+	65,  // 30: realworld.v1.UserExportResponse.articles:type_name -> realworld.v1.Article
+	111, // 31: realworld.v1.UserExportResponse.comments:type_name -> realworld.v1.UserExportResponse.Comment
+	112, // 32: realworld.v1.UserExportResponse.favorites:type_name -> realworld.v1.UserExportResponse.Favorite
+	113, // 33: realworld.v1.UserExportResponse.following:type_name -> realworld.v1.UserExportResponse.Follow
+	113, // 34: realworld.v1.UserExportResponse.followers:type_name -> realworld.v1.UserExportResponse.Follow
+	119, // 35: realworld.v1.UserExportResponse.exported_at:type_name -> google.protobuf.Timestamp
+	113, // 36: realworld.v1.UserExportResponse.follow_requests_sent:type_name -> realworld.v1.UserExportResponse.Follow
+	113, // 37: realworld.v1.UserExportResponse.follow_requests_received:type_name -> realworld.v1.UserExportResponse.Follow
+	113, // 38: realworld.v1.UserExportResponse.blocked:type_name -> realworld.v1.UserExportResponse.Follow
+	113, // 39: realworld.v1.UserExportResponse.muted:type_name -> realworld.v1.UserExportResponse.Follow
+	76,  // 40: realworld.v1.UserExportResponse.attachments:type_name -> realworld.v1.Attachment
+	73,  // 41: realworld.v1.UserExportResponse.bookmark_collections:type_name -> realworld.v1.BookmarkCollection
+	114, // 42: realworld.v1.UserExportResponse.bookmarks:type_name -> realworld.v1.UserExportResponse.Bookmark
+	115, // 43: realworld.v1.UserExportResponse.reactions:type_name -> realworld.v1.UserExportResponse.Reaction
+	116, // 44: realworld.v1.UserExportResponse.followed_tags:type_name -> realworld.v1.UserExportResponse.Tag
+	117, // 45: realworld.v1.UserExportResponse.notifications:type_name -> realworld.v1.UserExportResponse.Notification
+	118, // 46: realworld.v1.UserExportResponse.notification_preferences:type_name -> realworld.v1.UserExportResponse.NotificationPreferencesEntry
+	70,  // 47: realworld.v1.MultipleCommentResponse.comments:type_name -> realworld.v1.Comment
+	83,  // 48: realworld.v1.TagsListResponse.details:type_name -> realworld.v1.Tag
+	84,  // 49: realworld.v1.TrendingTagsResponse.tags:type_name -> realworld.v1.TrendingTag
+	83,  // 50: realworld.v1.TagResponse.tag:type_name -> realworld.v1.Tag
+	71,  // 51: realworld.v1.Notification.actor:type_name -> realworld.v1.Profile
+	119, // 52: realworld.v1.Notification.created_at:type_name -> google.protobuf.Timestamp
+	92,  // 53: realworld.v1.MultipleNotificationResponse.notifications:type_name -> realworld.v1.Notification
+	94,  // 54: realworld.v1.UpdateNotificationPreferencesRequest.preferences:type_name -> realworld.v1.NotificationPreferences
+	94,  // 55: realworld.v1.NotificationPreferencesResponse.preferences:type_name -> realworld.v1.NotificationPreferences
+	108, // 56: realworld.v1.UploadAvatarResponse.Image.thumbnails:type_name -> realworld.v1.UploadAvatarResponse.Thumbnail
+	119, // 57: realworld.v1.UserExportResponse.User.created_at:type_name -> google.protobuf.Timestamp
+	119, // 58: realworld.v1.UserExportResponse.Comment.created_at:type_name -> google.protobuf.Timestamp
+	119, // 59: realworld.v1.UserExportResponse.Comment.updated_at:type_name -> google.protobuf.Timestamp
+	119, // 60: realworld.v1.UserExportResponse.Favorite.created_at:type_name -> google.protobuf.Timestamp
+	119, // 61: realworld.v1.UserExportResponse.Follow.created_at:type_name -> google.protobuf.Timestamp
+	119, // 62: realworld.v1.UserExportResponse.Bookmark.created_at:type_name -> google.protobuf.Timestamp
+	119, // 63: realworld.v1.UserExportResponse.Reaction.created_at:type_name -> google.protobuf.Timestamp
+	119, // 64: realworld.v1.UserExportResponse.Tag.created_at:type_name -> google.protobuf.Timestamp
+	119, // 65: realworld.v1.UserExportResponse.Notification.created_at:type_name -> google.protobuf.Timestamp
+	61,  // 66: realworld.v1.RealWorld.Login:input_type -> realworld.v1.LoginRequest
+	62,  // 67: realworld.v1.RealWorld.Register:input_type -> realworld.v1.RegisterRequest
+	57,  // 68: realworld.v1.RealWorld.GetCurrentUser:input_type -> realworld.v1.GetCurrentUserRequest
+	56,  // 69: realworld.v1.RealWorld.UpdateUser:input_type -> realworld.v1.UpdateUserRequest
+	58,  // 70: realworld.v1.RealWorld.DeleteCurrentUser:input_type -> realworld.v1.DeleteCurrentUserRequest
+	60,  // 71: realworld.v1.RealWorld.ExportCurrentUser:input_type -> realworld.v1.ExportCurrentUserRequest
+	43,  // 72: realworld.v1.RealWorld.SearchProfiles:input_type -> realworld.v1.SearchProfilesRequest
+	44,  // 73: realworld.v1.RealWorld.SuggestProfiles:input_type -> realworld.v1.SuggestProfilesRequest
+	42,  // 74: realworld.v1.RealWorld.GetProfile:input_type -> realworld.v1.GetProfileRequest
+	41,  // 75: realworld.v1.RealWorld.FollowUser:input_type -> realworld.v1.FollowUserRequest
+	40,  // 76: realworld.v1.RealWorld.UnfollowUser:input_type -> realworld.v1.UnfollowUserRequest
+	55,  // 77: realworld.v1.RealWorld.ListFollowers:input_type -> realworld.v1.ListFollowsRequest
+	55,  // 78: realworld.v1.RealWorld.ListFollowing:input_type -> realworld.v1.ListFollowsRequest
+	45,  // 79: realworld.v1.RealWorld.BlockUser:input_type -> realworld.v1.BlockUserRequest
+	46,  // 80: realworld.v1.RealWorld.UnblockUser:input_type -> realworld.v1.UnblockUserRequest
+	47,  // 81: realworld.v1.RealWorld.MuteUser:input_type -> realworld.v1.MuteUserRequest
+	48,  // 82: realworld.v1.RealWorld.UnmuteUser:input_type -> realworld.v1.UnmuteUserRequest
+	49,  // 83: realworld.v1.RealWorld.ListBlockedUsers:input_type -> realworld.v1.ListBlockedUsersRequest
+	50,  // 84: realworld.v1.RealWorld.ListMutedUsers:input_type -> realworld.v1.ListMutedUsersRequest
+	51,  // 85: realworld.v1.RealWorld.ListFollowRequests:input_type -> realworld.v1.ListFollowRequestsRequest
+	51,  // 86: realworld.v1.RealWorld.ListOutgoingFollowRequests:input_type -> realworld.v1.ListFollowRequestsRequest
+	52,  // 87: realworld.v1.RealWorld.ApproveFollowRequest:input_type -> realworld.v1.ApproveFollowRequestRequest
+	53,  // 88: realworld.v1.RealWorld.RejectFollowRequest:input_type -> realworld.v1.RejectFollowRequestRequest
+	54,  // 89: realworld.v1.RealWorld.CancelFollowRequest:input_type -> realworld.v1.CancelFollowRequestRequest
+	39,  // 90: realworld.v1.RealWorld.ListArticles:input_type -> realworld.v1.ListArticlesRequest
+	37,  // 91: realworld.v1.RealWorld.FeedArticles:input_type -> realworld.v1.FeedArticlesRequest
+	38,  // 92: realworld.v1.RealWorld.GetArticle:input_type -> realworld.v1.GetArticleRequest
+	36,  // 93: realworld.v1.RealWorld.CreateArticle:input_type -> realworld.v1.CreateArticleRequest
+	35,  // 94: realworld.v1.RealWorld.UpdateArticle:input_type -> realworld.v1.UpdateArticleRequest
+	33,  // 95: realworld.v1.RealWorld.DeleteArticle:input_type -> realworld.v1.DeleteArticleRequest
+	32,  // 96: realworld.v1.RealWorld.AddComment:input_type -> realworld.v1.AddCommentRequest
+	31,  // 97: realworld.v1.RealWorld.GetComments:input_type -> realworld.v1.GetCommentsRequest
+	29,  // 98: realworld.v1.RealWorld.DeleteComment:input_type -> realworld.v1.DeleteCommentRequest
+	27,  // 99: realworld.v1.RealWorld.FavoriteArticle:input_type -> realworld.v1.FavoriteArticleRequest
+	28,  // 100: realworld.v1.RealWorld.UnfavoriteArticle:input_type -> realworld.v1.UnfavoriteArticleRequest
+	11,  // 101: realworld.v1.RealWorld.AddArticleReaction:input_type -> realworld.v1.AddArticleReactionRequest
+	12,  // 102: realworld.v1.RealWorld.RemoveArticleReaction:input_type -> realworld.v1.RemoveArticleReactionRequest
+	13,  // 103: realworld.v1.RealWorld.AddCommentReaction:input_type -> realworld.v1.AddCommentReactionRequest
+	14,  // 104: realworld.v1.RealWorld.RemoveCommentReaction:input_type -> realworld.v1.RemoveCommentReactionRequest
+	10,  // 105: realworld.v1.RealWorld.GetReactions:input_type -> realworld.v1.GetReactionsRequest
+	15,  // 106: realworld.v1.RealWorld.BookmarkArticle:input_type -> realworld.v1.BookmarkArticleRequest
+	16,  // 107: realworld.v1.RealWorld.UnbookmarkArticle:input_type -> realworld.v1.UnbookmarkArticleRequest
+	17,  // 108: realworld.v1.RealWorld.ListBookmarks:input_type -> realworld.v1.ListBookmarksRequest
+	18,  // 109: realworld.v1.RealWorld.ListBookmarkCollections:input_type -> realworld.v1.ListBookmarkCollectionsRequest
+	19,  // 110: realworld.v1.RealWorld.CreateBookmarkCollection:input_type -> realworld.v1.CreateBookmarkCollectionRequest
+	20,  // 111: realworld.v1.RealWorld.UpdateBookmarkCollection:input_type -> realworld.v1.UpdateBookmarkCollectionRequest
+	21,  // 112: realworld.v1.RealWorld.DeleteBookmarkCollection:input_type -> realworld.v1.DeleteBookmarkCollectionRequest
+	23,  // 113: realworld.v1.RealWorld.ListAttachments:input_type -> realworld.v1.ListAttachmentsRequest
+	24,  // 114: realworld.v1.RealWorld.ListArticleAttachments:input_type -> realworld.v1.ListArticleAttachmentsRequest
+	25,  // 115: realworld.v1.RealWorld.DeleteAttachment:input_type -> realworld.v1.DeleteAttachmentRequest
+	0,   // 116: realworld.v1.RealWorld.GetTags:input_type -> realworld.v1.GetTagsRequest
+	1,   // 117: realworld.v1.RealWorld.GetTrendingTags:input_type -> realworld.v1.GetTrendingTagsRequest
+	2,   // 118: realworld.v1.RealWorld.FollowTag:input_type -> realworld.v1.FollowTagRequest
+	3,   // 119: realworld.v1.RealWorld.UnfollowTag:input_type -> realworld.v1.UnfollowTagRequest
+	4,   // 120: realworld.v1.RealWorld.RenameTag:input_type -> realworld.v1.RenameTagRequest
+	5,   // 121: realworld.v1.RealWorld.MergeTag:input_type -> realworld.v1.MergeTagRequest
+	6,   // 122: realworld.v1.RealWorld.DeleteTag:input_type -> realworld.v1.DeleteTagRequest
+	8,   // 123: realworld.v1.RealWorld.AddTagAlias:input_type -> realworld.v1.AddTagAliasRequest
+	9,   // 124: realworld.v1.RealWorld.RemoveTagAlias:input_type -> realworld.v1.RemoveTagAliasRequest
+	88,  // 125: realworld.v1.RealWorld.ListNotifications:input_type -> realworld.v1.ListNotificationsRequest
+	89,  // 126: realworld.v1.RealWorld.MarkNotificationRead:input_type -> realworld.v1.MarkNotificationReadRequest
+	90,  // 127: realworld.v1.RealWorld.MarkAllNotificationsRead:input_type -> realworld.v1.MarkAllNotificationsReadRequest
+	95,  // 128: realworld.v1.RealWorld.GetNotificationPreferences:input_type -> realworld.v1.GetNotificationPreferencesRequest
+	96,  // 129: realworld.v1.RealWorld.UpdateNotificationPreferences:input_type -> realworld.v1.UpdateNotificationPreferencesRequest
+	63,  // 130: realworld.v1.RealWorld.Login:output_type -> realworld.v1.UserResponse
+	63,  // 131: realworld.v1.RealWorld.Register:output_type -> realworld.v1.UserResponse
+	63,  // 132: realworld.v1.RealWorld.GetCurrentUser:output_type -> realworld.v1.UserResponse
+	63,  // 133: realworld.v1.RealWorld.UpdateUser:output_type -> realworld.v1.UserResponse
+	59,  // 134: realworld.v1.RealWorld.DeleteCurrentUser:output_type -> realworld.v1.DeleteCurrentUserResponse
+	80,  // 135: realworld.v1.RealWorld.ExportCurrentUser:output_type -> realworld.v1.UserExportResponse
+	79,  // 136: realworld.v1.RealWorld.SearchProfiles:output_type -> realworld.v1.MultipleProfileResponse
+	79,  // 137: realworld.v1.RealWorld.SuggestProfiles:output_type -> realworld.v1.MultipleProfileResponse
+	64,  // 138: realworld.v1.RealWorld.GetProfile:output_type -> realworld.v1.ProfileResponse
+	64,  // 139: realworld.v1.RealWorld.FollowUser:output_type -> realworld.v1.ProfileResponse
+	64,  // 140: realworld.v1.RealWorld.UnfollowUser:output_type -> realworld.v1.ProfileResponse
+	79,  // 141: realworld.v1.RealWorld.ListFollowers:output_type -> realworld.v1.MultipleProfileResponse
+	79,  // 142: realworld.v1.RealWorld.ListFollowing:output_type -> realworld.v1.MultipleProfileResponse
+	64,  // 143: realworld.v1.RealWorld.BlockUser:output_type -> realworld.v1.ProfileResponse
+	64,  // 144: realworld.v1.RealWorld.UnblockUser:output_type -> realworld.v1.ProfileResponse
+	64,  // 145: realworld.v1.RealWorld.MuteUser:output_type -> realworld.v1.ProfileResponse
+	64,  // 146: realworld.v1.RealWorld.UnmuteUser:output_type -> realworld.v1.ProfileResponse
+	79,  // 147: realworld.v1.RealWorld.ListBlockedUsers:output_type -> realworld.v1.MultipleProfileResponse
+	79,  // 148: realworld.v1.RealWorld.ListMutedUsers:output_type -> realworld.v1.MultipleProfileResponse
+	79,  // 149: realworld.v1.RealWorld.ListFollowRequests:output_type -> realworld.v1.MultipleProfileResponse
+	79,  // 150: realworld.v1.RealWorld.ListOutgoingFollowRequests:output_type -> realworld.v1.MultipleProfileResponse
+	64,  // 151: realworld.v1.RealWorld.ApproveFollowRequest:output_type -> realworld.v1.ProfileResponse
+	64,  // 152: realworld.v1.RealWorld.RejectFollowRequest:output_type -> realworld.v1.ProfileResponse
+	64,  // 153: realworld.v1.RealWorld.CancelFollowRequest:output_type -> realworld.v1.ProfileResponse
+	68,  // 154: realworld.v1.RealWorld.ListArticles:output_type -> realworld.v1.MultipleArticleResponse
+	68,  // 155: realworld.v1.RealWorld.FeedArticles:output_type -> realworld.v1.MultipleArticleResponse
+	67,  // 156: realworld.v1.RealWorld.GetArticle:output_type -> realworld.v1.SingleArticleResponse
+	67,  // 157: realworld.v1.RealWorld.CreateArticle:output_type -> realworld.v1.SingleArticleResponse
+	67,  // 158: realworld.v1.RealWorld.UpdateArticle:output_type -> realworld.v1.SingleArticleResponse
+	34,  // 159: realworld.v1.RealWorld.DeleteArticle:output_type -> realworld.v1.DeleteArticleResponse
+	69,  // 160: realworld.v1.RealWorld.AddComment:output_type -> realworld.v1.SingleCommentResponse
+	81,  // 161: realworld.v1.RealWorld.GetComments:output_type -> realworld.v1.MultipleCommentResponse
+	30,  // 162: realworld.v1.RealWorld.DeleteComment:output_type -> realworld.v1.DeleteCommentResponse
+	67,  // 163: realworld.v1.RealWorld.FavoriteArticle:output_type -> realworld.v1.SingleArticleResponse
+	67,  // 164: realworld.v1.RealWorld.UnfavoriteArticle:output_type -> realworld.v1.SingleArticleResponse
+	67,  // 165: realworld.v1.RealWorld.AddArticleReaction:output_type -> realworld.v1.SingleArticleResponse
+	67,  // 166: realworld.v1.RealWorld.RemoveArticleReaction:output_type -> realworld.v1.SingleArticleResponse
+	69,  // 167: realworld.v1.RealWorld.AddCommentReaction:output_type -> realworld.v1.SingleCommentResponse
+	69,  // 168: realworld.v1.RealWorld.RemoveCommentReaction:output_type -> realworld.v1.SingleCommentResponse
+	87,  // 169: realworld.v1.RealWorld.GetReactions:output_type -> realworld.v1.ReactionsListResponse
+	67,  // 170: realworld.v1.RealWorld.BookmarkArticle:output_type -> realworld.v1.SingleArticleResponse
+	67,  // 171: realworld.v1.RealWorld.UnbookmarkArticle:output_type -> realworld.v1.SingleArticleResponse
+	68,  // 172: realworld.v1.RealWorld.ListBookmarks:output_type -> realworld.v1.MultipleArticleResponse
+	75,  // 173: realworld.v1.RealWorld.ListBookmarkCollections:output_type -> realworld.v1.MultipleBookmarkCollectionResponse
+	74,  // 174: realworld.v1.RealWorld.CreateBookmarkCollection:output_type -> realworld.v1.SingleBookmarkCollectionResponse
+	74,  // 175: realworld.v1.RealWorld.UpdateBookmarkCollection:output_type -> realworld.v1.SingleBookmarkCollectionResponse
+	22,  // 176: realworld.v1.RealWorld.DeleteBookmarkCollection:output_type -> realworld.v1.DeleteBookmarkCollectionResponse
+	78,  // 177: realworld.v1.RealWorld.ListAttachments:output_type -> realworld.v1.MultipleAttachmentResponse
+	78,  // 178: realworld.v1.RealWorld.ListArticleAttachments:output_type -> realworld.v1.MultipleAttachmentResponse
+	26,  // 179: realworld.v1.RealWorld.DeleteAttachment:output_type -> realworld.v1.DeleteAttachmentResponse
+	82,  // 180: realworld.v1.RealWorld.GetTags:output_type -> realworld.v1.TagsListResponse
+	85,  // 181: realworld.v1.RealWorld.GetTrendingTags:output_type -> realworld.v1.TrendingTagsResponse
+	86,  // 182: realworld.v1.RealWorld.FollowTag:output_type -> realworld.v1.TagResponse
+	86,  // 183: realworld.v1.RealWorld.UnfollowTag:output_type -> realworld.v1.TagResponse
+	86,  // 184: realworld.v1.RealWorld.RenameTag:output_type -> realworld.v1.TagResponse
+	86,  // 185: realworld.v1.RealWorld.MergeTag:output_type -> realworld.v1.TagResponse
+	7,   // 186: realworld.v1.RealWorld.DeleteTag:output_type -> realworld.v1.DeleteTagResponse
+	86,  // 187: realworld.v1.RealWorld.AddTagAlias:output_type -> realworld.v1.TagResponse
+	86,  // 188: realworld.v1.RealWorld.RemoveTagAlias:output_type -> realworld.v1.TagResponse
+	93,  // 189: realworld.v1.RealWorld.ListNotifications:output_type -> realworld.v1.MultipleNotificationResponse
+	91,  // 190: realworld.v1.RealWorld.MarkNotificationRead:output_type -> realworld.v1.MarkNotificationsReadResponse
+	91,  // 191: realworld.v1.RealWorld.MarkAllNotificationsRead:output_type -> realworld.v1.MarkNotificationsReadResponse
+	97,  // 192: realworld.v1.RealWorld.GetNotificationPreferences:output_type -> realworld.v1.NotificationPreferencesResponse
+	97,  // 193: realworld.v1.RealWorld.UpdateNotificationPreferences:output_type -> realworld.v1.NotificationPreferencesResponse
+	130, // [130:194] is the sub-list for method output_type
+	66,  // [66:130] is the sub-list for method input_type
+	66,  // [66:66] is the sub-list for extension type_name
+	66,  // [66:66] is the sub-list for extension extendee
+	0,   // [0:66] is the sub-list for field type_name
 }
 
 func init() { file_realworld_v1_realworld_proto_init() }
@@ -2362,13 +7350,16 @@ func file_realworld_v1_realworld_proto_init() {
 	if File_realworld_v1_realworld_proto != nil {
 		return
 	}
+	file_realworld_v1_realworld_proto_msgTypes[94].OneofWrappers = []any{}
+	file_realworld_v1_realworld_proto_msgTypes[101].OneofWrappers = []any{}
+	file_realworld_v1_realworld_proto_msgTypes[103].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_realworld_v1_realworld_proto_rawDesc), len(file_realworld_v1_realworld_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   39,
+			NumMessages:   119,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
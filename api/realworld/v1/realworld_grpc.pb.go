@@ -19,40 +19,109 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	RealWorld_Login_FullMethodName             = "/realworld.v1.RealWorld/Login"
-	RealWorld_Register_FullMethodName          = "/realworld.v1.RealWorld/Register"
-	RealWorld_GetCurrentUser_FullMethodName    = "/realworld.v1.RealWorld/GetCurrentUser"
-	RealWorld_UpdateUser_FullMethodName        = "/realworld.v1.RealWorld/UpdateUser"
-	RealWorld_GetProfile_FullMethodName        = "/realworld.v1.RealWorld/GetProfile"
-	RealWorld_FollowUser_FullMethodName        = "/realworld.v1.RealWorld/FollowUser"
-	RealWorld_UnfollowUser_FullMethodName      = "/realworld.v1.RealWorld/UnfollowUser"
-	RealWorld_ListArticles_FullMethodName      = "/realworld.v1.RealWorld/ListArticles"
-	RealWorld_FeedArticles_FullMethodName      = "/realworld.v1.RealWorld/FeedArticles"
-	RealWorld_GetArticle_FullMethodName        = "/realworld.v1.RealWorld/GetArticle"
-	RealWorld_CreateArticle_FullMethodName     = "/realworld.v1.RealWorld/CreateArticle"
-	RealWorld_UpdateArticle_FullMethodName     = "/realworld.v1.RealWorld/UpdateArticle"
-	RealWorld_DeleteArticle_FullMethodName     = "/realworld.v1.RealWorld/DeleteArticle"
-	RealWorld_AddComment_FullMethodName        = "/realworld.v1.RealWorld/AddComment"
-	RealWorld_GetComments_FullMethodName       = "/realworld.v1.RealWorld/GetComments"
-	RealWorld_DeleteComment_FullMethodName     = "/realworld.v1.RealWorld/DeleteComment"
-	RealWorld_FavoriteArticle_FullMethodName   = "/realworld.v1.RealWorld/FavoriteArticle"
-	RealWorld_UnfavoriteArticle_FullMethodName = "/realworld.v1.RealWorld/UnfavoriteArticle"
-	RealWorld_GetTags_FullMethodName           = "/realworld.v1.RealWorld/GetTags"
+	RealWorld_Login_FullMethodName                         = "/realworld.v1.RealWorld/Login"
+	RealWorld_Register_FullMethodName                      = "/realworld.v1.RealWorld/Register"
+	RealWorld_GetCurrentUser_FullMethodName                = "/realworld.v1.RealWorld/GetCurrentUser"
+	RealWorld_UpdateUser_FullMethodName                    = "/realworld.v1.RealWorld/UpdateUser"
+	RealWorld_DeleteCurrentUser_FullMethodName             = "/realworld.v1.RealWorld/DeleteCurrentUser"
+	RealWorld_ExportCurrentUser_FullMethodName             = "/realworld.v1.RealWorld/ExportCurrentUser"
+	RealWorld_SearchProfiles_FullMethodName                = "/realworld.v1.RealWorld/SearchProfiles"
+	RealWorld_SuggestProfiles_FullMethodName               = "/realworld.v1.RealWorld/SuggestProfiles"
+	RealWorld_GetProfile_FullMethodName                    = "/realworld.v1.RealWorld/GetProfile"
+	RealWorld_FollowUser_FullMethodName                    = "/realworld.v1.RealWorld/FollowUser"
+	RealWorld_UnfollowUser_FullMethodName                  = "/realworld.v1.RealWorld/UnfollowUser"
+	RealWorld_ListFollowers_FullMethodName                 = "/realworld.v1.RealWorld/ListFollowers"
+	RealWorld_ListFollowing_FullMethodName                 = "/realworld.v1.RealWorld/ListFollowing"
+	RealWorld_BlockUser_FullMethodName                     = "/realworld.v1.RealWorld/BlockUser"
+	RealWorld_UnblockUser_FullMethodName                   = "/realworld.v1.RealWorld/UnblockUser"
+	RealWorld_MuteUser_FullMethodName                      = "/realworld.v1.RealWorld/MuteUser"
+	RealWorld_UnmuteUser_FullMethodName                    = "/realworld.v1.RealWorld/UnmuteUser"
+	RealWorld_ListBlockedUsers_FullMethodName              = "/realworld.v1.RealWorld/ListBlockedUsers"
+	RealWorld_ListMutedUsers_FullMethodName                = "/realworld.v1.RealWorld/ListMutedUsers"
+	RealWorld_ListFollowRequests_FullMethodName            = "/realworld.v1.RealWorld/ListFollowRequests"
+	RealWorld_ListOutgoingFollowRequests_FullMethodName    = "/realworld.v1.RealWorld/ListOutgoingFollowRequests"
+	RealWorld_ApproveFollowRequest_FullMethodName          = "/realworld.v1.RealWorld/ApproveFollowRequest"
+	RealWorld_RejectFollowRequest_FullMethodName           = "/realworld.v1.RealWorld/RejectFollowRequest"
+	RealWorld_CancelFollowRequest_FullMethodName           = "/realworld.v1.RealWorld/CancelFollowRequest"
+	RealWorld_ListArticles_FullMethodName                  = "/realworld.v1.RealWorld/ListArticles"
+	RealWorld_FeedArticles_FullMethodName                  = "/realworld.v1.RealWorld/FeedArticles"
+	RealWorld_GetArticle_FullMethodName                    = "/realworld.v1.RealWorld/GetArticle"
+	RealWorld_CreateArticle_FullMethodName                 = "/realworld.v1.RealWorld/CreateArticle"
+	RealWorld_UpdateArticle_FullMethodName                 = "/realworld.v1.RealWorld/UpdateArticle"
+	RealWorld_DeleteArticle_FullMethodName                 = "/realworld.v1.RealWorld/DeleteArticle"
+	RealWorld_AddComment_FullMethodName                    = "/realworld.v1.RealWorld/AddComment"
+	RealWorld_GetComments_FullMethodName                   = "/realworld.v1.RealWorld/GetComments"
+	RealWorld_DeleteComment_FullMethodName                 = "/realworld.v1.RealWorld/DeleteComment"
+	RealWorld_FavoriteArticle_FullMethodName               = "/realworld.v1.RealWorld/FavoriteArticle"
+	RealWorld_UnfavoriteArticle_FullMethodName             = "/realworld.v1.RealWorld/UnfavoriteArticle"
+	RealWorld_AddArticleReaction_FullMethodName            = "/realworld.v1.RealWorld/AddArticleReaction"
+	RealWorld_RemoveArticleReaction_FullMethodName         = "/realworld.v1.RealWorld/RemoveArticleReaction"
+	RealWorld_AddCommentReaction_FullMethodName            = "/realworld.v1.RealWorld/AddCommentReaction"
+	RealWorld_RemoveCommentReaction_FullMethodName         = "/realworld.v1.RealWorld/RemoveCommentReaction"
+	RealWorld_GetReactions_FullMethodName                  = "/realworld.v1.RealWorld/GetReactions"
+	RealWorld_BookmarkArticle_FullMethodName               = "/realworld.v1.RealWorld/BookmarkArticle"
+	RealWorld_UnbookmarkArticle_FullMethodName             = "/realworld.v1.RealWorld/UnbookmarkArticle"
+	RealWorld_ListBookmarks_FullMethodName                 = "/realworld.v1.RealWorld/ListBookmarks"
+	RealWorld_ListBookmarkCollections_FullMethodName       = "/realworld.v1.RealWorld/ListBookmarkCollections"
+	RealWorld_CreateBookmarkCollection_FullMethodName      = "/realworld.v1.RealWorld/CreateBookmarkCollection"
+	RealWorld_UpdateBookmarkCollection_FullMethodName      = "/realworld.v1.RealWorld/UpdateBookmarkCollection"
+	RealWorld_DeleteBookmarkCollection_FullMethodName      = "/realworld.v1.RealWorld/DeleteBookmarkCollection"
+	RealWorld_ListAttachments_FullMethodName               = "/realworld.v1.RealWorld/ListAttachments"
+	RealWorld_ListArticleAttachments_FullMethodName        = "/realworld.v1.RealWorld/ListArticleAttachments"
+	RealWorld_DeleteAttachment_FullMethodName              = "/realworld.v1.RealWorld/DeleteAttachment"
+	RealWorld_GetTags_FullMethodName                       = "/realworld.v1.RealWorld/GetTags"
+	RealWorld_GetTrendingTags_FullMethodName               = "/realworld.v1.RealWorld/GetTrendingTags"
+	RealWorld_FollowTag_FullMethodName                     = "/realworld.v1.RealWorld/FollowTag"
+	RealWorld_UnfollowTag_FullMethodName                   = "/realworld.v1.RealWorld/UnfollowTag"
+	RealWorld_RenameTag_FullMethodName                     = "/realworld.v1.RealWorld/RenameTag"
+	RealWorld_MergeTag_FullMethodName                      = "/realworld.v1.RealWorld/MergeTag"
+	RealWorld_DeleteTag_FullMethodName                     = "/realworld.v1.RealWorld/DeleteTag"
+	RealWorld_AddTagAlias_FullMethodName                   = "/realworld.v1.RealWorld/AddTagAlias"
+	RealWorld_RemoveTagAlias_FullMethodName                = "/realworld.v1.RealWorld/RemoveTagAlias"
+	RealWorld_ListNotifications_FullMethodName             = "/realworld.v1.RealWorld/ListNotifications"
+	RealWorld_MarkNotificationRead_FullMethodName          = "/realworld.v1.RealWorld/MarkNotificationRead"
+	RealWorld_MarkAllNotificationsRead_FullMethodName      = "/realworld.v1.RealWorld/MarkAllNotificationsRead"
+	RealWorld_GetNotificationPreferences_FullMethodName    = "/realworld.v1.RealWorld/GetNotificationPreferences"
+	RealWorld_UpdateNotificationPreferences_FullMethodName = "/realworld.v1.RealWorld/UpdateNotificationPreferences"
 )
 
 // RealWorldClient is the client API for RealWorld service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 //
-// The greeting service definition.
+// The RealWorld service definition.
 type RealWorldClient interface {
 	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*UserResponse, error)
 	Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*UserResponse, error)
 	GetCurrentUser(ctx context.Context, in *GetCurrentUserRequest, opts ...grpc.CallOption) (*UserResponse, error)
 	UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UserResponse, error)
+	// 注销账号 - 按配置的策略匿名化或删除
+	DeleteCurrentUser(ctx context.Context, in *DeleteCurrentUserRequest, opts ...grpc.CallOption) (*DeleteCurrentUserResponse, error)
+	// 导出当前用户的所有个人数据
+	ExportCurrentUser(ctx context.Context, in *ExportCurrentUserRequest, opts ...grpc.CallOption) (*UserExportResponse, error)
+	// 按username前缀和bio模糊搜索用户
+	SearchProfiles(ctx context.Context, in *SearchProfilesRequest, opts ...grpc.CallOption) (*MultipleProfileResponse, error)
+	// 推荐关注 - 需要在GetProfile之前注册, 否则会被{username}匹配
+	SuggestProfiles(ctx context.Context, in *SuggestProfilesRequest, opts ...grpc.CallOption) (*MultipleProfileResponse, error)
 	GetProfile(ctx context.Context, in *GetProfileRequest, opts ...grpc.CallOption) (*ProfileResponse, error)
 	FollowUser(ctx context.Context, in *FollowUserRequest, opts ...grpc.CallOption) (*ProfileResponse, error)
 	UnfollowUser(ctx context.Context, in *UnfollowUserRequest, opts ...grpc.CallOption) (*ProfileResponse, error)
+	ListFollowers(ctx context.Context, in *ListFollowsRequest, opts ...grpc.CallOption) (*MultipleProfileResponse, error)
+	ListFollowing(ctx context.Context, in *ListFollowsRequest, opts ...grpc.CallOption) (*MultipleProfileResponse, error)
+	// 拉黑 - 同时解除双向关注
+	BlockUser(ctx context.Context, in *BlockUserRequest, opts ...grpc.CallOption) (*ProfileResponse, error)
+	UnblockUser(ctx context.Context, in *UnblockUserRequest, opts ...grpc.CallOption) (*ProfileResponse, error)
+	// 静音 - 只在自己的feed和评论中隐藏对方
+	MuteUser(ctx context.Context, in *MuteUserRequest, opts ...grpc.CallOption) (*ProfileResponse, error)
+	UnmuteUser(ctx context.Context, in *UnmuteUserRequest, opts ...grpc.CallOption) (*ProfileResponse, error)
+	ListBlockedUsers(ctx context.Context, in *ListBlockedUsersRequest, opts ...grpc.CallOption) (*MultipleProfileResponse, error)
+	ListMutedUsers(ctx context.Context, in *ListMutedUsersRequest, opts ...grpc.CallOption) (*MultipleProfileResponse, error)
+	// 关注私密账号需要对方同意 - 收到的和发出的关注申请
+	ListFollowRequests(ctx context.Context, in *ListFollowRequestsRequest, opts ...grpc.CallOption) (*MultipleProfileResponse, error)
+	ListOutgoingFollowRequests(ctx context.Context, in *ListFollowRequestsRequest, opts ...grpc.CallOption) (*MultipleProfileResponse, error)
+	ApproveFollowRequest(ctx context.Context, in *ApproveFollowRequestRequest, opts ...grpc.CallOption) (*ProfileResponse, error)
+	RejectFollowRequest(ctx context.Context, in *RejectFollowRequestRequest, opts ...grpc.CallOption) (*ProfileResponse, error)
+	CancelFollowRequest(ctx context.Context, in *CancelFollowRequestRequest, opts ...grpc.CallOption) (*ProfileResponse, error)
 	ListArticles(ctx context.Context, in *ListArticlesRequest, opts ...grpc.CallOption) (*MultipleArticleResponse, error)
 	FeedArticles(ctx context.Context, in *FeedArticlesRequest, opts ...grpc.CallOption) (*MultipleArticleResponse, error)
 	GetArticle(ctx context.Context, in *GetArticleRequest, opts ...grpc.CallOption) (*SingleArticleResponse, error)
@@ -64,7 +133,48 @@ type RealWorldClient interface {
 	DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*DeleteCommentResponse, error)
 	FavoriteArticle(ctx context.Context, in *FavoriteArticleRequest, opts ...grpc.CallOption) (*SingleArticleResponse, error)
 	UnfavoriteArticle(ctx context.Context, in *UnfavoriteArticleRequest, opts ...grpc.CallOption) (*SingleArticleResponse, error)
+	// 表情回应 - 可用的表情由配置决定, 每个用户对同一目标的同一表情只计一次
+	AddArticleReaction(ctx context.Context, in *AddArticleReactionRequest, opts ...grpc.CallOption) (*SingleArticleResponse, error)
+	RemoveArticleReaction(ctx context.Context, in *RemoveArticleReactionRequest, opts ...grpc.CallOption) (*SingleArticleResponse, error)
+	AddCommentReaction(ctx context.Context, in *AddCommentReactionRequest, opts ...grpc.CallOption) (*SingleCommentResponse, error)
+	RemoveCommentReaction(ctx context.Context, in *RemoveCommentReactionRequest, opts ...grpc.CallOption) (*SingleCommentResponse, error)
+	GetReactions(ctx context.Context, in *GetReactionsRequest, opts ...grpc.CallOption) (*ReactionsListResponse, error)
+	// 书签 - 只有自己可见, 和公开的收藏分开
+	BookmarkArticle(ctx context.Context, in *BookmarkArticleRequest, opts ...grpc.CallOption) (*SingleArticleResponse, error)
+	UnbookmarkArticle(ctx context.Context, in *UnbookmarkArticleRequest, opts ...grpc.CallOption) (*SingleArticleResponse, error)
+	ListBookmarks(ctx context.Context, in *ListBookmarksRequest, opts ...grpc.CallOption) (*MultipleArticleResponse, error)
+	ListBookmarkCollections(ctx context.Context, in *ListBookmarkCollectionsRequest, opts ...grpc.CallOption) (*MultipleBookmarkCollectionResponse, error)
+	CreateBookmarkCollection(ctx context.Context, in *CreateBookmarkCollectionRequest, opts ...grpc.CallOption) (*SingleBookmarkCollectionResponse, error)
+	UpdateBookmarkCollection(ctx context.Context, in *UpdateBookmarkCollectionRequest, opts ...grpc.CallOption) (*SingleBookmarkCollectionResponse, error)
+	// 删除收藏夹不会删除其中的书签
+	DeleteBookmarkCollection(ctx context.Context, in *DeleteBookmarkCollectionRequest, opts ...grpc.CallOption) (*DeleteBookmarkCollectionResponse, error)
+	// 附件的上传是multipart请求, 由http服务单独注册路由
+	// 当前用户还没有被文章引用的附件
+	ListAttachments(ctx context.Context, in *ListAttachmentsRequest, opts ...grpc.CallOption) (*MultipleAttachmentResponse, error)
+	ListArticleAttachments(ctx context.Context, in *ListArticleAttachmentsRequest, opts ...grpc.CallOption) (*MultipleAttachmentResponse, error)
+	DeleteAttachment(ctx context.Context, in *DeleteAttachmentRequest, opts ...grpc.CallOption) (*DeleteAttachmentResponse, error)
+	// 按使用的文章数排序, 没有文章使用的标签不返回
 	GetTags(ctx context.Context, in *GetTagsRequest, opts ...grpc.CallOption) (*TagsListResponse, error)
+	// 最近一段时间使用增长最快的标签
+	GetTrendingTags(ctx context.Context, in *GetTrendingTagsRequest, opts ...grpc.CallOption) (*TrendingTagsResponse, error)
+	// 关注标签 - 标签下的新文章会出现在feed中
+	FollowTag(ctx context.Context, in *FollowTagRequest, opts ...grpc.CallOption) (*TagResponse, error)
+	UnfollowTag(ctx context.Context, in *UnfollowTagRequest, opts ...grpc.CallOption) (*TagResponse, error)
+	// 标签管理 - 只有管理员可以调用
+	// 重命名后旧名称自动成为别名
+	RenameTag(ctx context.Context, in *RenameTagRequest, opts ...grpc.CallOption) (*TagResponse, error)
+	// 把tag合并到target, 文章和关注转移到target, tag的名称和别名成为target的别名
+	MergeTag(ctx context.Context, in *MergeTagRequest, opts ...grpc.CallOption) (*TagResponse, error)
+	DeleteTag(ctx context.Context, in *DeleteTagRequest, opts ...grpc.CallOption) (*DeleteTagResponse, error)
+	AddTagAlias(ctx context.Context, in *AddTagAliasRequest, opts ...grpc.CallOption) (*TagResponse, error)
+	RemoveTagAlias(ctx context.Context, in *RemoveTagAliasRequest, opts ...grpc.CallOption) (*TagResponse, error)
+	// 通知 - 关注, 收藏, 评论和提及, 同类的通知合并为一条
+	ListNotifications(ctx context.Context, in *ListNotificationsRequest, opts ...grpc.CallOption) (*MultipleNotificationResponse, error)
+	MarkNotificationRead(ctx context.Context, in *MarkNotificationReadRequest, opts ...grpc.CallOption) (*MarkNotificationsReadResponse, error)
+	MarkAllNotificationsRead(ctx context.Context, in *MarkAllNotificationsReadRequest, opts ...grpc.CallOption) (*MarkNotificationsReadResponse, error)
+	GetNotificationPreferences(ctx context.Context, in *GetNotificationPreferencesRequest, opts ...grpc.CallOption) (*NotificationPreferencesResponse, error)
+	// 只修改传入的类型
+	UpdateNotificationPreferences(ctx context.Context, in *UpdateNotificationPreferencesRequest, opts ...grpc.CallOption) (*NotificationPreferencesResponse, error)
 }
 
 type realWorldClient struct {
@@ -115,6 +225,46 @@ func (c *realWorldClient) UpdateUser(ctx context.Context, in *UpdateUserRequest,
 	return out, nil
 }
 
+func (c *realWorldClient) DeleteCurrentUser(ctx context.Context, in *DeleteCurrentUserRequest, opts ...grpc.CallOption) (*DeleteCurrentUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCurrentUserResponse)
+	err := c.cc.Invoke(ctx, RealWorld_DeleteCurrentUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realWorldClient) ExportCurrentUser(ctx context.Context, in *ExportCurrentUserRequest, opts ...grpc.CallOption) (*UserExportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserExportResponse)
+	err := c.cc.Invoke(ctx, RealWorld_ExportCurrentUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realWorldClient) SearchProfiles(ctx context.Context, in *SearchProfilesRequest, opts ...grpc.CallOption) (*MultipleProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MultipleProfileResponse)
+	err := c.cc.Invoke(ctx, RealWorld_SearchProfiles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realWorldClient) SuggestProfiles(ctx context.Context, in *SuggestProfilesRequest, opts ...grpc.CallOption) (*MultipleProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MultipleProfileResponse)
+	err := c.cc.Invoke(ctx, RealWorld_SuggestProfiles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *realWorldClient) GetProfile(ctx context.Context, in *GetProfileRequest, opts ...grpc.CallOption) (*ProfileResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ProfileResponse)
@@ -145,6 +295,136 @@ func (c *realWorldClient) UnfollowUser(ctx context.Context, in *UnfollowUserRequ
 	return out, nil
 }
 
+func (c *realWorldClient) ListFollowers(ctx context.Context, in *ListFollowsRequest, opts ...grpc.CallOption) (*MultipleProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MultipleProfileResponse)
+	err := c.cc.Invoke(ctx, RealWorld_ListFollowers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realWorldClient) ListFollowing(ctx context.Context, in *ListFollowsRequest, opts ...grpc.CallOption) (*MultipleProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MultipleProfileResponse)
+	err := c.cc.Invoke(ctx, RealWorld_ListFollowing_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realWorldClient) BlockUser(ctx context.Context, in *BlockUserRequest, opts ...grpc.CallOption) (*ProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProfileResponse)
+	err := c.cc.Invoke(ctx, RealWorld_BlockUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realWorldClient) UnblockUser(ctx context.Context, in *UnblockUserRequest, opts ...grpc.CallOption) (*ProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProfileResponse)
+	err := c.cc.Invoke(ctx, RealWorld_UnblockUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realWorldClient) MuteUser(ctx context.Context, in *MuteUserRequest, opts ...grpc.CallOption) (*ProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProfileResponse)
+	err := c.cc.Invoke(ctx, RealWorld_MuteUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realWorldClient) UnmuteUser(ctx context.Context, in *UnmuteUserRequest, opts ...grpc.CallOption) (*ProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProfileResponse)
+	err := c.cc.Invoke(ctx, RealWorld_UnmuteUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realWorldClient) ListBlockedUsers(ctx context.Context, in *ListBlockedUsersRequest, opts ...grpc.CallOption) (*MultipleProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MultipleProfileResponse)
+	err := c.cc.Invoke(ctx, RealWorld_ListBlockedUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realWorldClient) ListMutedUsers(ctx context.Context, in *ListMutedUsersRequest, opts ...grpc.CallOption) (*MultipleProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MultipleProfileResponse)
+	err := c.cc.Invoke(ctx, RealWorld_ListMutedUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realWorldClient) ListFollowRequests(ctx context.Context, in *ListFollowRequestsRequest, opts ...grpc.CallOption) (*MultipleProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MultipleProfileResponse)
+	err := c.cc.Invoke(ctx, RealWorld_ListFollowRequests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realWorldClient) ListOutgoingFollowRequests(ctx context.Context, in *ListFollowRequestsRequest, opts ...grpc.CallOption) (*MultipleProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MultipleProfileResponse)
+	err := c.cc.Invoke(ctx, RealWorld_ListOutgoingFollowRequests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realWorldClient) ApproveFollowRequest(ctx context.Context, in *ApproveFollowRequestRequest, opts ...grpc.CallOption) (*ProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProfileResponse)
+	err := c.cc.Invoke(ctx, RealWorld_ApproveFollowRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realWorldClient) RejectFollowRequest(ctx context.Context, in *RejectFollowRequestRequest, opts ...grpc.CallOption) (*ProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProfileResponse)
+	err := c.cc.Invoke(ctx, RealWorld_RejectFollowRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realWorldClient) CancelFollowRequest(ctx context.Context, in *CancelFollowRequestRequest, opts ...grpc.CallOption) (*ProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProfileResponse)
+	err := c.cc.Invoke(ctx, RealWorld_CancelFollowRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *realWorldClient) ListArticles(ctx context.Context, in *ListArticlesRequest, opts ...grpc.CallOption) (*MultipleArticleResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(MultipleArticleResponse)
@@ -255,85 +535,481 @@ func (c *realWorldClient) UnfavoriteArticle(ctx context.Context, in *UnfavoriteA
 	return out, nil
 }
 
-func (c *realWorldClient) GetTags(ctx context.Context, in *GetTagsRequest, opts ...grpc.CallOption) (*TagsListResponse, error) {
+func (c *realWorldClient) AddArticleReaction(ctx context.Context, in *AddArticleReactionRequest, opts ...grpc.CallOption) (*SingleArticleResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(TagsListResponse)
-	err := c.cc.Invoke(ctx, RealWorld_GetTags_FullMethodName, in, out, cOpts...)
+	out := new(SingleArticleResponse)
+	err := c.cc.Invoke(ctx, RealWorld_AddArticleReaction_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// RealWorldServer is the server API for RealWorld service.
-// All implementations must embed UnimplementedRealWorldServer
-// for forward compatibility.
-//
-// The greeting service definition.
-type RealWorldServer interface {
-	Login(context.Context, *LoginRequest) (*UserResponse, error)
-	Register(context.Context, *RegisterRequest) (*UserResponse, error)
-	GetCurrentUser(context.Context, *GetCurrentUserRequest) (*UserResponse, error)
-	UpdateUser(context.Context, *UpdateUserRequest) (*UserResponse, error)
-	GetProfile(context.Context, *GetProfileRequest) (*ProfileResponse, error)
-	FollowUser(context.Context, *FollowUserRequest) (*ProfileResponse, error)
-	UnfollowUser(context.Context, *UnfollowUserRequest) (*ProfileResponse, error)
-	ListArticles(context.Context, *ListArticlesRequest) (*MultipleArticleResponse, error)
-	FeedArticles(context.Context, *FeedArticlesRequest) (*MultipleArticleResponse, error)
-	GetArticle(context.Context, *GetArticleRequest) (*SingleArticleResponse, error)
-	CreateArticle(context.Context, *CreateArticleRequest) (*SingleArticleResponse, error)
-	UpdateArticle(context.Context, *UpdateArticleRequest) (*SingleArticleResponse, error)
-	DeleteArticle(context.Context, *DeleteArticleRequest) (*DeleteArticleResponse, error)
-	AddComment(context.Context, *AddCommentRequest) (*SingleCommentResponse, error)
-	GetComments(context.Context, *GetCommentsRequest) (*MultipleCommentResponse, error)
-	DeleteComment(context.Context, *DeleteCommentRequest) (*DeleteCommentResponse, error)
-	FavoriteArticle(context.Context, *FavoriteArticleRequest) (*SingleArticleResponse, error)
-	UnfavoriteArticle(context.Context, *UnfavoriteArticleRequest) (*SingleArticleResponse, error)
-	GetTags(context.Context, *GetTagsRequest) (*TagsListResponse, error)
-	mustEmbedUnimplementedRealWorldServer()
+func (c *realWorldClient) RemoveArticleReaction(ctx context.Context, in *RemoveArticleReactionRequest, opts ...grpc.CallOption) (*SingleArticleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SingleArticleResponse)
+	err := c.cc.Invoke(ctx, RealWorld_RemoveArticleReaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedRealWorldServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedRealWorldServer struct{}
-
-func (UnimplementedRealWorldServer) Login(context.Context, *LoginRequest) (*UserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
-}
-func (UnimplementedRealWorldServer) Register(context.Context, *RegisterRequest) (*UserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
+func (c *realWorldClient) AddCommentReaction(ctx context.Context, in *AddCommentReactionRequest, opts ...grpc.CallOption) (*SingleCommentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SingleCommentResponse)
+	err := c.cc.Invoke(ctx, RealWorld_AddCommentReaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedRealWorldServer) GetCurrentUser(context.Context, *GetCurrentUserRequest) (*UserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCurrentUser not implemented")
+
+func (c *realWorldClient) RemoveCommentReaction(ctx context.Context, in *RemoveCommentReactionRequest, opts ...grpc.CallOption) (*SingleCommentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SingleCommentResponse)
+	err := c.cc.Invoke(ctx, RealWorld_RemoveCommentReaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedRealWorldServer) UpdateUser(context.Context, *UpdateUserRequest) (*UserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateUser not implemented")
+
+func (c *realWorldClient) GetReactions(ctx context.Context, in *GetReactionsRequest, opts ...grpc.CallOption) (*ReactionsListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReactionsListResponse)
+	err := c.cc.Invoke(ctx, RealWorld_GetReactions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedRealWorldServer) GetProfile(context.Context, *GetProfileRequest) (*ProfileResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProfile not implemented")
+
+func (c *realWorldClient) BookmarkArticle(ctx context.Context, in *BookmarkArticleRequest, opts ...grpc.CallOption) (*SingleArticleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SingleArticleResponse)
+	err := c.cc.Invoke(ctx, RealWorld_BookmarkArticle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedRealWorldServer) FollowUser(context.Context, *FollowUserRequest) (*ProfileResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FollowUser not implemented")
+
+func (c *realWorldClient) UnbookmarkArticle(ctx context.Context, in *UnbookmarkArticleRequest, opts ...grpc.CallOption) (*SingleArticleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SingleArticleResponse)
+	err := c.cc.Invoke(ctx, RealWorld_UnbookmarkArticle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedRealWorldServer) UnfollowUser(context.Context, *UnfollowUserRequest) (*ProfileResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnfollowUser not implemented")
+
+func (c *realWorldClient) ListBookmarks(ctx context.Context, in *ListBookmarksRequest, opts ...grpc.CallOption) (*MultipleArticleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MultipleArticleResponse)
+	err := c.cc.Invoke(ctx, RealWorld_ListBookmarks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedRealWorldServer) ListArticles(context.Context, *ListArticlesRequest) (*MultipleArticleResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListArticles not implemented")
+
+func (c *realWorldClient) ListBookmarkCollections(ctx context.Context, in *ListBookmarkCollectionsRequest, opts ...grpc.CallOption) (*MultipleBookmarkCollectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MultipleBookmarkCollectionResponse)
+	err := c.cc.Invoke(ctx, RealWorld_ListBookmarkCollections_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedRealWorldServer) FeedArticles(context.Context, *FeedArticlesRequest) (*MultipleArticleResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FeedArticles not implemented")
+
+func (c *realWorldClient) CreateBookmarkCollection(ctx context.Context, in *CreateBookmarkCollectionRequest, opts ...grpc.CallOption) (*SingleBookmarkCollectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SingleBookmarkCollectionResponse)
+	err := c.cc.Invoke(ctx, RealWorld_CreateBookmarkCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedRealWorldServer) GetArticle(context.Context, *GetArticleRequest) (*SingleArticleResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetArticle not implemented")
+
+func (c *realWorldClient) UpdateBookmarkCollection(ctx context.Context, in *UpdateBookmarkCollectionRequest, opts ...grpc.CallOption) (*SingleBookmarkCollectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SingleBookmarkCollectionResponse)
+	err := c.cc.Invoke(ctx, RealWorld_UpdateBookmarkCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedRealWorldServer) CreateArticle(context.Context, *CreateArticleRequest) (*SingleArticleResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateArticle not implemented")
+
+func (c *realWorldClient) DeleteBookmarkCollection(ctx context.Context, in *DeleteBookmarkCollectionRequest, opts ...grpc.CallOption) (*DeleteBookmarkCollectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteBookmarkCollectionResponse)
+	err := c.cc.Invoke(ctx, RealWorld_DeleteBookmarkCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedRealWorldServer) UpdateArticle(context.Context, *UpdateArticleRequest) (*SingleArticleResponse, error) {
+
+func (c *realWorldClient) ListAttachments(ctx context.Context, in *ListAttachmentsRequest, opts ...grpc.CallOption) (*MultipleAttachmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MultipleAttachmentResponse)
+	err := c.cc.Invoke(ctx, RealWorld_ListAttachments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realWorldClient) ListArticleAttachments(ctx context.Context, in *ListArticleAttachmentsRequest, opts ...grpc.CallOption) (*MultipleAttachmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MultipleAttachmentResponse)
+	err := c.cc.Invoke(ctx, RealWorld_ListArticleAttachments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realWorldClient) DeleteAttachment(ctx context.Context, in *DeleteAttachmentRequest, opts ...grpc.CallOption) (*DeleteAttachmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAttachmentResponse)
+	err := c.cc.Invoke(ctx, RealWorld_DeleteAttachment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realWorldClient) GetTags(ctx context.Context, in *GetTagsRequest, opts ...grpc.CallOption) (*TagsListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TagsListResponse)
+	err := c.cc.Invoke(ctx, RealWorld_GetTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realWorldClient) GetTrendingTags(ctx context.Context, in *GetTrendingTagsRequest, opts ...grpc.CallOption) (*TrendingTagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TrendingTagsResponse)
+	err := c.cc.Invoke(ctx, RealWorld_GetTrendingTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realWorldClient) FollowTag(ctx context.Context, in *FollowTagRequest, opts ...grpc.CallOption) (*TagResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TagResponse)
+	err := c.cc.Invoke(ctx, RealWorld_FollowTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realWorldClient) UnfollowTag(ctx context.Context, in *UnfollowTagRequest, opts ...grpc.CallOption) (*TagResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TagResponse)
+	err := c.cc.Invoke(ctx, RealWorld_UnfollowTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realWorldClient) RenameTag(ctx context.Context, in *RenameTagRequest, opts ...grpc.CallOption) (*TagResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TagResponse)
+	err := c.cc.Invoke(ctx, RealWorld_RenameTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realWorldClient) MergeTag(ctx context.Context, in *MergeTagRequest, opts ...grpc.CallOption) (*TagResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TagResponse)
+	err := c.cc.Invoke(ctx, RealWorld_MergeTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realWorldClient) DeleteTag(ctx context.Context, in *DeleteTagRequest, opts ...grpc.CallOption) (*DeleteTagResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteTagResponse)
+	err := c.cc.Invoke(ctx, RealWorld_DeleteTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realWorldClient) AddTagAlias(ctx context.Context, in *AddTagAliasRequest, opts ...grpc.CallOption) (*TagResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TagResponse)
+	err := c.cc.Invoke(ctx, RealWorld_AddTagAlias_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realWorldClient) RemoveTagAlias(ctx context.Context, in *RemoveTagAliasRequest, opts ...grpc.CallOption) (*TagResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TagResponse)
+	err := c.cc.Invoke(ctx, RealWorld_RemoveTagAlias_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realWorldClient) ListNotifications(ctx context.Context, in *ListNotificationsRequest, opts ...grpc.CallOption) (*MultipleNotificationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MultipleNotificationResponse)
+	err := c.cc.Invoke(ctx, RealWorld_ListNotifications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realWorldClient) MarkNotificationRead(ctx context.Context, in *MarkNotificationReadRequest, opts ...grpc.CallOption) (*MarkNotificationsReadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarkNotificationsReadResponse)
+	err := c.cc.Invoke(ctx, RealWorld_MarkNotificationRead_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realWorldClient) MarkAllNotificationsRead(ctx context.Context, in *MarkAllNotificationsReadRequest, opts ...grpc.CallOption) (*MarkNotificationsReadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarkNotificationsReadResponse)
+	err := c.cc.Invoke(ctx, RealWorld_MarkAllNotificationsRead_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realWorldClient) GetNotificationPreferences(ctx context.Context, in *GetNotificationPreferencesRequest, opts ...grpc.CallOption) (*NotificationPreferencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NotificationPreferencesResponse)
+	err := c.cc.Invoke(ctx, RealWorld_GetNotificationPreferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realWorldClient) UpdateNotificationPreferences(ctx context.Context, in *UpdateNotificationPreferencesRequest, opts ...grpc.CallOption) (*NotificationPreferencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NotificationPreferencesResponse)
+	err := c.cc.Invoke(ctx, RealWorld_UpdateNotificationPreferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RealWorldServer is the server API for RealWorld service.
+// All implementations must embed UnimplementedRealWorldServer
+// for forward compatibility.
+//
+// The RealWorld service definition.
+type RealWorldServer interface {
+	Login(context.Context, *LoginRequest) (*UserResponse, error)
+	Register(context.Context, *RegisterRequest) (*UserResponse, error)
+	GetCurrentUser(context.Context, *GetCurrentUserRequest) (*UserResponse, error)
+	UpdateUser(context.Context, *UpdateUserRequest) (*UserResponse, error)
+	// 注销账号 - 按配置的策略匿名化或删除
+	DeleteCurrentUser(context.Context, *DeleteCurrentUserRequest) (*DeleteCurrentUserResponse, error)
+	// 导出当前用户的所有个人数据
+	ExportCurrentUser(context.Context, *ExportCurrentUserRequest) (*UserExportResponse, error)
+	// 按username前缀和bio模糊搜索用户
+	SearchProfiles(context.Context, *SearchProfilesRequest) (*MultipleProfileResponse, error)
+	// 推荐关注 - 需要在GetProfile之前注册, 否则会被{username}匹配
+	SuggestProfiles(context.Context, *SuggestProfilesRequest) (*MultipleProfileResponse, error)
+	GetProfile(context.Context, *GetProfileRequest) (*ProfileResponse, error)
+	FollowUser(context.Context, *FollowUserRequest) (*ProfileResponse, error)
+	UnfollowUser(context.Context, *UnfollowUserRequest) (*ProfileResponse, error)
+	ListFollowers(context.Context, *ListFollowsRequest) (*MultipleProfileResponse, error)
+	ListFollowing(context.Context, *ListFollowsRequest) (*MultipleProfileResponse, error)
+	// 拉黑 - 同时解除双向关注
+	BlockUser(context.Context, *BlockUserRequest) (*ProfileResponse, error)
+	UnblockUser(context.Context, *UnblockUserRequest) (*ProfileResponse, error)
+	// 静音 - 只在自己的feed和评论中隐藏对方
+	MuteUser(context.Context, *MuteUserRequest) (*ProfileResponse, error)
+	UnmuteUser(context.Context, *UnmuteUserRequest) (*ProfileResponse, error)
+	ListBlockedUsers(context.Context, *ListBlockedUsersRequest) (*MultipleProfileResponse, error)
+	ListMutedUsers(context.Context, *ListMutedUsersRequest) (*MultipleProfileResponse, error)
+	// 关注私密账号需要对方同意 - 收到的和发出的关注申请
+	ListFollowRequests(context.Context, *ListFollowRequestsRequest) (*MultipleProfileResponse, error)
+	ListOutgoingFollowRequests(context.Context, *ListFollowRequestsRequest) (*MultipleProfileResponse, error)
+	ApproveFollowRequest(context.Context, *ApproveFollowRequestRequest) (*ProfileResponse, error)
+	RejectFollowRequest(context.Context, *RejectFollowRequestRequest) (*ProfileResponse, error)
+	CancelFollowRequest(context.Context, *CancelFollowRequestRequest) (*ProfileResponse, error)
+	ListArticles(context.Context, *ListArticlesRequest) (*MultipleArticleResponse, error)
+	FeedArticles(context.Context, *FeedArticlesRequest) (*MultipleArticleResponse, error)
+	GetArticle(context.Context, *GetArticleRequest) (*SingleArticleResponse, error)
+	CreateArticle(context.Context, *CreateArticleRequest) (*SingleArticleResponse, error)
+	UpdateArticle(context.Context, *UpdateArticleRequest) (*SingleArticleResponse, error)
+	DeleteArticle(context.Context, *DeleteArticleRequest) (*DeleteArticleResponse, error)
+	AddComment(context.Context, *AddCommentRequest) (*SingleCommentResponse, error)
+	GetComments(context.Context, *GetCommentsRequest) (*MultipleCommentResponse, error)
+	DeleteComment(context.Context, *DeleteCommentRequest) (*DeleteCommentResponse, error)
+	FavoriteArticle(context.Context, *FavoriteArticleRequest) (*SingleArticleResponse, error)
+	UnfavoriteArticle(context.Context, *UnfavoriteArticleRequest) (*SingleArticleResponse, error)
+	// 表情回应 - 可用的表情由配置决定, 每个用户对同一目标的同一表情只计一次
+	AddArticleReaction(context.Context, *AddArticleReactionRequest) (*SingleArticleResponse, error)
+	RemoveArticleReaction(context.Context, *RemoveArticleReactionRequest) (*SingleArticleResponse, error)
+	AddCommentReaction(context.Context, *AddCommentReactionRequest) (*SingleCommentResponse, error)
+	RemoveCommentReaction(context.Context, *RemoveCommentReactionRequest) (*SingleCommentResponse, error)
+	GetReactions(context.Context, *GetReactionsRequest) (*ReactionsListResponse, error)
+	// 书签 - 只有自己可见, 和公开的收藏分开
+	BookmarkArticle(context.Context, *BookmarkArticleRequest) (*SingleArticleResponse, error)
+	UnbookmarkArticle(context.Context, *UnbookmarkArticleRequest) (*SingleArticleResponse, error)
+	ListBookmarks(context.Context, *ListBookmarksRequest) (*MultipleArticleResponse, error)
+	ListBookmarkCollections(context.Context, *ListBookmarkCollectionsRequest) (*MultipleBookmarkCollectionResponse, error)
+	CreateBookmarkCollection(context.Context, *CreateBookmarkCollectionRequest) (*SingleBookmarkCollectionResponse, error)
+	UpdateBookmarkCollection(context.Context, *UpdateBookmarkCollectionRequest) (*SingleBookmarkCollectionResponse, error)
+	// 删除收藏夹不会删除其中的书签
+	DeleteBookmarkCollection(context.Context, *DeleteBookmarkCollectionRequest) (*DeleteBookmarkCollectionResponse, error)
+	// 附件的上传是multipart请求, 由http服务单独注册路由
+	// 当前用户还没有被文章引用的附件
+	ListAttachments(context.Context, *ListAttachmentsRequest) (*MultipleAttachmentResponse, error)
+	ListArticleAttachments(context.Context, *ListArticleAttachmentsRequest) (*MultipleAttachmentResponse, error)
+	DeleteAttachment(context.Context, *DeleteAttachmentRequest) (*DeleteAttachmentResponse, error)
+	// 按使用的文章数排序, 没有文章使用的标签不返回
+	GetTags(context.Context, *GetTagsRequest) (*TagsListResponse, error)
+	// 最近一段时间使用增长最快的标签
+	GetTrendingTags(context.Context, *GetTrendingTagsRequest) (*TrendingTagsResponse, error)
+	// 关注标签 - 标签下的新文章会出现在feed中
+	FollowTag(context.Context, *FollowTagRequest) (*TagResponse, error)
+	UnfollowTag(context.Context, *UnfollowTagRequest) (*TagResponse, error)
+	// 标签管理 - 只有管理员可以调用
+	// 重命名后旧名称自动成为别名
+	RenameTag(context.Context, *RenameTagRequest) (*TagResponse, error)
+	// 把tag合并到target, 文章和关注转移到target, tag的名称和别名成为target的别名
+	MergeTag(context.Context, *MergeTagRequest) (*TagResponse, error)
+	DeleteTag(context.Context, *DeleteTagRequest) (*DeleteTagResponse, error)
+	AddTagAlias(context.Context, *AddTagAliasRequest) (*TagResponse, error)
+	RemoveTagAlias(context.Context, *RemoveTagAliasRequest) (*TagResponse, error)
+	// 通知 - 关注, 收藏, 评论和提及, 同类的通知合并为一条
+	ListNotifications(context.Context, *ListNotificationsRequest) (*MultipleNotificationResponse, error)
+	MarkNotificationRead(context.Context, *MarkNotificationReadRequest) (*MarkNotificationsReadResponse, error)
+	MarkAllNotificationsRead(context.Context, *MarkAllNotificationsReadRequest) (*MarkNotificationsReadResponse, error)
+	GetNotificationPreferences(context.Context, *GetNotificationPreferencesRequest) (*NotificationPreferencesResponse, error)
+	// 只修改传入的类型
+	UpdateNotificationPreferences(context.Context, *UpdateNotificationPreferencesRequest) (*NotificationPreferencesResponse, error)
+	mustEmbedUnimplementedRealWorldServer()
+}
+
+// UnimplementedRealWorldServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRealWorldServer struct{}
+
+func (UnimplementedRealWorldServer) Login(context.Context, *LoginRequest) (*UserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+}
+func (UnimplementedRealWorldServer) Register(context.Context, *RegisterRequest) (*UserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
+}
+func (UnimplementedRealWorldServer) GetCurrentUser(context.Context, *GetCurrentUserRequest) (*UserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCurrentUser not implemented")
+}
+func (UnimplementedRealWorldServer) UpdateUser(context.Context, *UpdateUserRequest) (*UserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUser not implemented")
+}
+func (UnimplementedRealWorldServer) DeleteCurrentUser(context.Context, *DeleteCurrentUserRequest) (*DeleteCurrentUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCurrentUser not implemented")
+}
+func (UnimplementedRealWorldServer) ExportCurrentUser(context.Context, *ExportCurrentUserRequest) (*UserExportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportCurrentUser not implemented")
+}
+func (UnimplementedRealWorldServer) SearchProfiles(context.Context, *SearchProfilesRequest) (*MultipleProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchProfiles not implemented")
+}
+func (UnimplementedRealWorldServer) SuggestProfiles(context.Context, *SuggestProfilesRequest) (*MultipleProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuggestProfiles not implemented")
+}
+func (UnimplementedRealWorldServer) GetProfile(context.Context, *GetProfileRequest) (*ProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProfile not implemented")
+}
+func (UnimplementedRealWorldServer) FollowUser(context.Context, *FollowUserRequest) (*ProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FollowUser not implemented")
+}
+func (UnimplementedRealWorldServer) UnfollowUser(context.Context, *UnfollowUserRequest) (*ProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfollowUser not implemented")
+}
+func (UnimplementedRealWorldServer) ListFollowers(context.Context, *ListFollowsRequest) (*MultipleProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFollowers not implemented")
+}
+func (UnimplementedRealWorldServer) ListFollowing(context.Context, *ListFollowsRequest) (*MultipleProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFollowing not implemented")
+}
+func (UnimplementedRealWorldServer) BlockUser(context.Context, *BlockUserRequest) (*ProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockUser not implemented")
+}
+func (UnimplementedRealWorldServer) UnblockUser(context.Context, *UnblockUserRequest) (*ProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnblockUser not implemented")
+}
+func (UnimplementedRealWorldServer) MuteUser(context.Context, *MuteUserRequest) (*ProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MuteUser not implemented")
+}
+func (UnimplementedRealWorldServer) UnmuteUser(context.Context, *UnmuteUserRequest) (*ProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnmuteUser not implemented")
+}
+func (UnimplementedRealWorldServer) ListBlockedUsers(context.Context, *ListBlockedUsersRequest) (*MultipleProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBlockedUsers not implemented")
+}
+func (UnimplementedRealWorldServer) ListMutedUsers(context.Context, *ListMutedUsersRequest) (*MultipleProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMutedUsers not implemented")
+}
+func (UnimplementedRealWorldServer) ListFollowRequests(context.Context, *ListFollowRequestsRequest) (*MultipleProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFollowRequests not implemented")
+}
+func (UnimplementedRealWorldServer) ListOutgoingFollowRequests(context.Context, *ListFollowRequestsRequest) (*MultipleProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOutgoingFollowRequests not implemented")
+}
+func (UnimplementedRealWorldServer) ApproveFollowRequest(context.Context, *ApproveFollowRequestRequest) (*ProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveFollowRequest not implemented")
+}
+func (UnimplementedRealWorldServer) RejectFollowRequest(context.Context, *RejectFollowRequestRequest) (*ProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectFollowRequest not implemented")
+}
+func (UnimplementedRealWorldServer) CancelFollowRequest(context.Context, *CancelFollowRequestRequest) (*ProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelFollowRequest not implemented")
+}
+func (UnimplementedRealWorldServer) ListArticles(context.Context, *ListArticlesRequest) (*MultipleArticleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListArticles not implemented")
+}
+func (UnimplementedRealWorldServer) FeedArticles(context.Context, *FeedArticlesRequest) (*MultipleArticleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeedArticles not implemented")
+}
+func (UnimplementedRealWorldServer) GetArticle(context.Context, *GetArticleRequest) (*SingleArticleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetArticle not implemented")
+}
+func (UnimplementedRealWorldServer) CreateArticle(context.Context, *CreateArticleRequest) (*SingleArticleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateArticle not implemented")
+}
+func (UnimplementedRealWorldServer) UpdateArticle(context.Context, *UpdateArticleRequest) (*SingleArticleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateArticle not implemented")
 }
 func (UnimplementedRealWorldServer) DeleteArticle(context.Context, *DeleteArticleRequest) (*DeleteArticleResponse, error) {
@@ -351,371 +1027,1265 @@ func (UnimplementedRealWorldServer) DeleteComment(context.Context, *DeleteCommen
 func (UnimplementedRealWorldServer) FavoriteArticle(context.Context, *FavoriteArticleRequest) (*SingleArticleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FavoriteArticle not implemented")
 }
-func (UnimplementedRealWorldServer) UnfavoriteArticle(context.Context, *UnfavoriteArticleRequest) (*SingleArticleResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnfavoriteArticle not implemented")
+func (UnimplementedRealWorldServer) UnfavoriteArticle(context.Context, *UnfavoriteArticleRequest) (*SingleArticleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfavoriteArticle not implemented")
+}
+func (UnimplementedRealWorldServer) AddArticleReaction(context.Context, *AddArticleReactionRequest) (*SingleArticleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddArticleReaction not implemented")
+}
+func (UnimplementedRealWorldServer) RemoveArticleReaction(context.Context, *RemoveArticleReactionRequest) (*SingleArticleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveArticleReaction not implemented")
+}
+func (UnimplementedRealWorldServer) AddCommentReaction(context.Context, *AddCommentReactionRequest) (*SingleCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCommentReaction not implemented")
+}
+func (UnimplementedRealWorldServer) RemoveCommentReaction(context.Context, *RemoveCommentReactionRequest) (*SingleCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCommentReaction not implemented")
+}
+func (UnimplementedRealWorldServer) GetReactions(context.Context, *GetReactionsRequest) (*ReactionsListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReactions not implemented")
+}
+func (UnimplementedRealWorldServer) BookmarkArticle(context.Context, *BookmarkArticleRequest) (*SingleArticleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BookmarkArticle not implemented")
+}
+func (UnimplementedRealWorldServer) UnbookmarkArticle(context.Context, *UnbookmarkArticleRequest) (*SingleArticleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbookmarkArticle not implemented")
+}
+func (UnimplementedRealWorldServer) ListBookmarks(context.Context, *ListBookmarksRequest) (*MultipleArticleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBookmarks not implemented")
+}
+func (UnimplementedRealWorldServer) ListBookmarkCollections(context.Context, *ListBookmarkCollectionsRequest) (*MultipleBookmarkCollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBookmarkCollections not implemented")
+}
+func (UnimplementedRealWorldServer) CreateBookmarkCollection(context.Context, *CreateBookmarkCollectionRequest) (*SingleBookmarkCollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBookmarkCollection not implemented")
+}
+func (UnimplementedRealWorldServer) UpdateBookmarkCollection(context.Context, *UpdateBookmarkCollectionRequest) (*SingleBookmarkCollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBookmarkCollection not implemented")
+}
+func (UnimplementedRealWorldServer) DeleteBookmarkCollection(context.Context, *DeleteBookmarkCollectionRequest) (*DeleteBookmarkCollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBookmarkCollection not implemented")
+}
+func (UnimplementedRealWorldServer) ListAttachments(context.Context, *ListAttachmentsRequest) (*MultipleAttachmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAttachments not implemented")
+}
+func (UnimplementedRealWorldServer) ListArticleAttachments(context.Context, *ListArticleAttachmentsRequest) (*MultipleAttachmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListArticleAttachments not implemented")
+}
+func (UnimplementedRealWorldServer) DeleteAttachment(context.Context, *DeleteAttachmentRequest) (*DeleteAttachmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAttachment not implemented")
+}
+func (UnimplementedRealWorldServer) GetTags(context.Context, *GetTagsRequest) (*TagsListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTags not implemented")
+}
+func (UnimplementedRealWorldServer) GetTrendingTags(context.Context, *GetTrendingTagsRequest) (*TrendingTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrendingTags not implemented")
+}
+func (UnimplementedRealWorldServer) FollowTag(context.Context, *FollowTagRequest) (*TagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FollowTag not implemented")
+}
+func (UnimplementedRealWorldServer) UnfollowTag(context.Context, *UnfollowTagRequest) (*TagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfollowTag not implemented")
+}
+func (UnimplementedRealWorldServer) RenameTag(context.Context, *RenameTagRequest) (*TagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameTag not implemented")
+}
+func (UnimplementedRealWorldServer) MergeTag(context.Context, *MergeTagRequest) (*TagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeTag not implemented")
+}
+func (UnimplementedRealWorldServer) DeleteTag(context.Context, *DeleteTagRequest) (*DeleteTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTag not implemented")
+}
+func (UnimplementedRealWorldServer) AddTagAlias(context.Context, *AddTagAliasRequest) (*TagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTagAlias not implemented")
+}
+func (UnimplementedRealWorldServer) RemoveTagAlias(context.Context, *RemoveTagAliasRequest) (*TagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveTagAlias not implemented")
+}
+func (UnimplementedRealWorldServer) ListNotifications(context.Context, *ListNotificationsRequest) (*MultipleNotificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNotifications not implemented")
+}
+func (UnimplementedRealWorldServer) MarkNotificationRead(context.Context, *MarkNotificationReadRequest) (*MarkNotificationsReadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkNotificationRead not implemented")
+}
+func (UnimplementedRealWorldServer) MarkAllNotificationsRead(context.Context, *MarkAllNotificationsReadRequest) (*MarkNotificationsReadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkAllNotificationsRead not implemented")
+}
+func (UnimplementedRealWorldServer) GetNotificationPreferences(context.Context, *GetNotificationPreferencesRequest) (*NotificationPreferencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNotificationPreferences not implemented")
+}
+func (UnimplementedRealWorldServer) UpdateNotificationPreferences(context.Context, *UpdateNotificationPreferencesRequest) (*NotificationPreferencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNotificationPreferences not implemented")
+}
+func (UnimplementedRealWorldServer) mustEmbedUnimplementedRealWorldServer() {}
+func (UnimplementedRealWorldServer) testEmbeddedByValue()                   {}
+
+// UnsafeRealWorldServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RealWorldServer will
+// result in compilation errors.
+type UnsafeRealWorldServer interface {
+	mustEmbedUnimplementedRealWorldServer()
+}
+
+func RegisterRealWorldServer(s grpc.ServiceRegistrar, srv RealWorldServer) {
+	// If the following call pancis, it indicates UnimplementedRealWorldServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RealWorld_ServiceDesc, srv)
+}
+
+func _RealWorld_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealWorldServer).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RealWorld_Login_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealWorldServer).Login(ctx, req.(*LoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealWorld_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealWorldServer).Register(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RealWorld_Register_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealWorldServer).Register(ctx, req.(*RegisterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealWorld_GetCurrentUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCurrentUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealWorldServer).GetCurrentUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RealWorld_GetCurrentUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealWorldServer).GetCurrentUser(ctx, req.(*GetCurrentUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealWorld_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealWorldServer).UpdateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RealWorld_UpdateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealWorldServer).UpdateUser(ctx, req.(*UpdateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealWorld_DeleteCurrentUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCurrentUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealWorldServer).DeleteCurrentUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RealWorld_DeleteCurrentUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealWorldServer).DeleteCurrentUser(ctx, req.(*DeleteCurrentUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealWorld_ExportCurrentUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportCurrentUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealWorldServer).ExportCurrentUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RealWorld_ExportCurrentUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealWorldServer).ExportCurrentUser(ctx, req.(*ExportCurrentUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealWorld_SearchProfiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchProfilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealWorldServer).SearchProfiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RealWorld_SearchProfiles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealWorldServer).SearchProfiles(ctx, req.(*SearchProfilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealWorld_SuggestProfiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestProfilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealWorldServer).SuggestProfiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RealWorld_SuggestProfiles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealWorldServer).SuggestProfiles(ctx, req.(*SuggestProfilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealWorld_GetProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealWorldServer).GetProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RealWorld_GetProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealWorldServer).GetProfile(ctx, req.(*GetProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealWorld_FollowUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FollowUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealWorldServer).FollowUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RealWorld_FollowUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealWorldServer).FollowUser(ctx, req.(*FollowUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealWorld_UnfollowUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnfollowUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealWorldServer).UnfollowUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RealWorld_UnfollowUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealWorldServer).UnfollowUser(ctx, req.(*UnfollowUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealWorld_ListFollowers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFollowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealWorldServer).ListFollowers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RealWorld_ListFollowers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealWorldServer).ListFollowers(ctx, req.(*ListFollowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealWorld_ListFollowing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFollowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealWorldServer).ListFollowing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RealWorld_ListFollowing_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealWorldServer).ListFollowing(ctx, req.(*ListFollowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealWorld_BlockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealWorldServer).BlockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RealWorld_BlockUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealWorldServer).BlockUser(ctx, req.(*BlockUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealWorld_UnblockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnblockUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealWorldServer).UnblockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RealWorld_UnblockUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealWorldServer).UnblockUser(ctx, req.(*UnblockUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealWorld_MuteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MuteUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealWorldServer).MuteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RealWorld_MuteUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealWorldServer).MuteUser(ctx, req.(*MuteUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealWorld_UnmuteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnmuteUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealWorldServer).UnmuteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RealWorld_UnmuteUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealWorldServer).UnmuteUser(ctx, req.(*UnmuteUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealWorld_ListBlockedUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBlockedUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealWorldServer).ListBlockedUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RealWorld_ListBlockedUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealWorldServer).ListBlockedUsers(ctx, req.(*ListBlockedUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealWorld_ListMutedUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMutedUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealWorldServer).ListMutedUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RealWorld_ListMutedUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealWorldServer).ListMutedUsers(ctx, req.(*ListMutedUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealWorld_ListFollowRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFollowRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealWorldServer).ListFollowRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RealWorld_ListFollowRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealWorldServer).ListFollowRequests(ctx, req.(*ListFollowRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealWorld_ListOutgoingFollowRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFollowRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealWorldServer).ListOutgoingFollowRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RealWorld_ListOutgoingFollowRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealWorldServer).ListOutgoingFollowRequests(ctx, req.(*ListFollowRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealWorld_ApproveFollowRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveFollowRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealWorldServer).ApproveFollowRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RealWorld_ApproveFollowRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealWorldServer).ApproveFollowRequest(ctx, req.(*ApproveFollowRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealWorld_RejectFollowRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectFollowRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealWorldServer).RejectFollowRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RealWorld_RejectFollowRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealWorldServer).RejectFollowRequest(ctx, req.(*RejectFollowRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealWorld_CancelFollowRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelFollowRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealWorldServer).CancelFollowRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RealWorld_CancelFollowRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealWorldServer).CancelFollowRequest(ctx, req.(*CancelFollowRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealWorld_ListArticles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListArticlesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealWorldServer).ListArticles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RealWorld_ListArticles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealWorldServer).ListArticles(ctx, req.(*ListArticlesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealWorld_FeedArticles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FeedArticlesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealWorldServer).FeedArticles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RealWorld_FeedArticles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealWorldServer).FeedArticles(ctx, req.(*FeedArticlesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealWorld_GetArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetArticleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealWorldServer).GetArticle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RealWorld_GetArticle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealWorldServer).GetArticle(ctx, req.(*GetArticleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealWorld_CreateArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateArticleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealWorldServer).CreateArticle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RealWorld_CreateArticle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealWorldServer).CreateArticle(ctx, req.(*CreateArticleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealWorld_UpdateArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateArticleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealWorldServer).UpdateArticle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RealWorld_UpdateArticle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealWorldServer).UpdateArticle(ctx, req.(*UpdateArticleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealWorld_DeleteArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteArticleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealWorldServer).DeleteArticle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RealWorld_DeleteArticle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealWorldServer).DeleteArticle(ctx, req.(*DeleteArticleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealWorld_AddComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealWorldServer).AddComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RealWorld_AddComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealWorldServer).AddComment(ctx, req.(*AddCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealWorld_GetComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealWorldServer).GetComments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RealWorld_GetComments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealWorldServer).GetComments(ctx, req.(*GetCommentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealWorld_DeleteComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealWorldServer).DeleteComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RealWorld_DeleteComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealWorldServer).DeleteComment(ctx, req.(*DeleteCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealWorld_FavoriteArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FavoriteArticleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealWorldServer).FavoriteArticle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RealWorld_FavoriteArticle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealWorldServer).FavoriteArticle(ctx, req.(*FavoriteArticleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealWorld_UnfavoriteArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnfavoriteArticleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealWorldServer).UnfavoriteArticle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RealWorld_UnfavoriteArticle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealWorldServer).UnfavoriteArticle(ctx, req.(*UnfavoriteArticleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealWorld_AddArticleReaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddArticleReactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealWorldServer).AddArticleReaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RealWorld_AddArticleReaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealWorldServer).AddArticleReaction(ctx, req.(*AddArticleReactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealWorld_RemoveArticleReaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveArticleReactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealWorldServer).RemoveArticleReaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RealWorld_RemoveArticleReaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealWorldServer).RemoveArticleReaction(ctx, req.(*RemoveArticleReactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealWorld_AddCommentReaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCommentReactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealWorldServer).AddCommentReaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RealWorld_AddCommentReaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealWorldServer).AddCommentReaction(ctx, req.(*AddCommentReactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealWorld_RemoveCommentReaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveCommentReactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealWorldServer).RemoveCommentReaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RealWorld_RemoveCommentReaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealWorldServer).RemoveCommentReaction(ctx, req.(*RemoveCommentReactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealWorld_GetReactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealWorldServer).GetReactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RealWorld_GetReactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealWorldServer).GetReactions(ctx, req.(*GetReactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealWorld_BookmarkArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BookmarkArticleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealWorldServer).BookmarkArticle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RealWorld_BookmarkArticle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealWorldServer).BookmarkArticle(ctx, req.(*BookmarkArticleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealWorld_UnbookmarkArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnbookmarkArticleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealWorldServer).UnbookmarkArticle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RealWorld_UnbookmarkArticle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealWorldServer).UnbookmarkArticle(ctx, req.(*UnbookmarkArticleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedRealWorldServer) GetTags(context.Context, *GetTagsRequest) (*TagsListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTags not implemented")
+
+func _RealWorld_ListBookmarks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBookmarksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealWorldServer).ListBookmarks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RealWorld_ListBookmarks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealWorldServer).ListBookmarks(ctx, req.(*ListBookmarksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedRealWorldServer) mustEmbedUnimplementedRealWorldServer() {}
-func (UnimplementedRealWorldServer) testEmbeddedByValue()                   {}
 
-// UnsafeRealWorldServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to RealWorldServer will
-// result in compilation errors.
-type UnsafeRealWorldServer interface {
-	mustEmbedUnimplementedRealWorldServer()
+func _RealWorld_ListBookmarkCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBookmarkCollectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealWorldServer).ListBookmarkCollections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RealWorld_ListBookmarkCollections_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealWorldServer).ListBookmarkCollections(ctx, req.(*ListBookmarkCollectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterRealWorldServer(s grpc.ServiceRegistrar, srv RealWorldServer) {
-	// If the following call pancis, it indicates UnimplementedRealWorldServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _RealWorld_CreateBookmarkCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBookmarkCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&RealWorld_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(RealWorldServer).CreateBookmarkCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RealWorld_CreateBookmarkCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealWorldServer).CreateBookmarkCollection(ctx, req.(*CreateBookmarkCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _RealWorld_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoginRequest)
+func _RealWorld_UpdateBookmarkCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBookmarkCollectionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RealWorldServer).Login(ctx, in)
+		return srv.(RealWorldServer).UpdateBookmarkCollection(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RealWorld_Login_FullMethodName,
+		FullMethod: RealWorld_UpdateBookmarkCollection_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RealWorldServer).Login(ctx, req.(*LoginRequest))
+		return srv.(RealWorldServer).UpdateBookmarkCollection(ctx, req.(*UpdateBookmarkCollectionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RealWorld_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RegisterRequest)
+func _RealWorld_DeleteBookmarkCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBookmarkCollectionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RealWorldServer).Register(ctx, in)
+		return srv.(RealWorldServer).DeleteBookmarkCollection(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RealWorld_Register_FullMethodName,
+		FullMethod: RealWorld_DeleteBookmarkCollection_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RealWorldServer).Register(ctx, req.(*RegisterRequest))
+		return srv.(RealWorldServer).DeleteBookmarkCollection(ctx, req.(*DeleteBookmarkCollectionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RealWorld_GetCurrentUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCurrentUserRequest)
+func _RealWorld_ListAttachments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAttachmentsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RealWorldServer).GetCurrentUser(ctx, in)
+		return srv.(RealWorldServer).ListAttachments(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RealWorld_GetCurrentUser_FullMethodName,
+		FullMethod: RealWorld_ListAttachments_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RealWorldServer).GetCurrentUser(ctx, req.(*GetCurrentUserRequest))
+		return srv.(RealWorldServer).ListAttachments(ctx, req.(*ListAttachmentsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RealWorld_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateUserRequest)
+func _RealWorld_ListArticleAttachments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListArticleAttachmentsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RealWorldServer).UpdateUser(ctx, in)
+		return srv.(RealWorldServer).ListArticleAttachments(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RealWorld_UpdateUser_FullMethodName,
+		FullMethod: RealWorld_ListArticleAttachments_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RealWorldServer).UpdateUser(ctx, req.(*UpdateUserRequest))
+		return srv.(RealWorldServer).ListArticleAttachments(ctx, req.(*ListArticleAttachmentsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RealWorld_GetProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetProfileRequest)
+func _RealWorld_DeleteAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAttachmentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RealWorldServer).GetProfile(ctx, in)
+		return srv.(RealWorldServer).DeleteAttachment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RealWorld_GetProfile_FullMethodName,
+		FullMethod: RealWorld_DeleteAttachment_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RealWorldServer).GetProfile(ctx, req.(*GetProfileRequest))
+		return srv.(RealWorldServer).DeleteAttachment(ctx, req.(*DeleteAttachmentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RealWorld_FollowUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FollowUserRequest)
+func _RealWorld_GetTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTagsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RealWorldServer).FollowUser(ctx, in)
+		return srv.(RealWorldServer).GetTags(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RealWorld_FollowUser_FullMethodName,
+		FullMethod: RealWorld_GetTags_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RealWorldServer).FollowUser(ctx, req.(*FollowUserRequest))
+		return srv.(RealWorldServer).GetTags(ctx, req.(*GetTagsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RealWorld_UnfollowUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UnfollowUserRequest)
+func _RealWorld_GetTrendingTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTrendingTagsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RealWorldServer).UnfollowUser(ctx, in)
+		return srv.(RealWorldServer).GetTrendingTags(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RealWorld_UnfollowUser_FullMethodName,
+		FullMethod: RealWorld_GetTrendingTags_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RealWorldServer).UnfollowUser(ctx, req.(*UnfollowUserRequest))
+		return srv.(RealWorldServer).GetTrendingTags(ctx, req.(*GetTrendingTagsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RealWorld_ListArticles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListArticlesRequest)
+func _RealWorld_FollowTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FollowTagRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RealWorldServer).ListArticles(ctx, in)
+		return srv.(RealWorldServer).FollowTag(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RealWorld_ListArticles_FullMethodName,
+		FullMethod: RealWorld_FollowTag_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RealWorldServer).ListArticles(ctx, req.(*ListArticlesRequest))
+		return srv.(RealWorldServer).FollowTag(ctx, req.(*FollowTagRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RealWorld_FeedArticles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FeedArticlesRequest)
+func _RealWorld_UnfollowTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnfollowTagRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RealWorldServer).FeedArticles(ctx, in)
+		return srv.(RealWorldServer).UnfollowTag(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RealWorld_FeedArticles_FullMethodName,
+		FullMethod: RealWorld_UnfollowTag_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RealWorldServer).FeedArticles(ctx, req.(*FeedArticlesRequest))
+		return srv.(RealWorldServer).UnfollowTag(ctx, req.(*UnfollowTagRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RealWorld_GetArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetArticleRequest)
+func _RealWorld_RenameTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameTagRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RealWorldServer).GetArticle(ctx, in)
+		return srv.(RealWorldServer).RenameTag(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RealWorld_GetArticle_FullMethodName,
+		FullMethod: RealWorld_RenameTag_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RealWorldServer).GetArticle(ctx, req.(*GetArticleRequest))
+		return srv.(RealWorldServer).RenameTag(ctx, req.(*RenameTagRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RealWorld_CreateArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateArticleRequest)
+func _RealWorld_MergeTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeTagRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RealWorldServer).CreateArticle(ctx, in)
+		return srv.(RealWorldServer).MergeTag(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RealWorld_CreateArticle_FullMethodName,
+		FullMethod: RealWorld_MergeTag_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RealWorldServer).CreateArticle(ctx, req.(*CreateArticleRequest))
+		return srv.(RealWorldServer).MergeTag(ctx, req.(*MergeTagRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RealWorld_UpdateArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateArticleRequest)
+func _RealWorld_DeleteTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTagRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RealWorldServer).UpdateArticle(ctx, in)
+		return srv.(RealWorldServer).DeleteTag(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RealWorld_UpdateArticle_FullMethodName,
+		FullMethod: RealWorld_DeleteTag_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RealWorldServer).UpdateArticle(ctx, req.(*UpdateArticleRequest))
+		return srv.(RealWorldServer).DeleteTag(ctx, req.(*DeleteTagRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RealWorld_DeleteArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteArticleRequest)
+func _RealWorld_AddTagAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddTagAliasRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RealWorldServer).DeleteArticle(ctx, in)
+		return srv.(RealWorldServer).AddTagAlias(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RealWorld_DeleteArticle_FullMethodName,
+		FullMethod: RealWorld_AddTagAlias_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RealWorldServer).DeleteArticle(ctx, req.(*DeleteArticleRequest))
+		return srv.(RealWorldServer).AddTagAlias(ctx, req.(*AddTagAliasRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RealWorld_AddComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddCommentRequest)
+func _RealWorld_RemoveTagAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveTagAliasRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RealWorldServer).AddComment(ctx, in)
+		return srv.(RealWorldServer).RemoveTagAlias(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RealWorld_AddComment_FullMethodName,
+		FullMethod: RealWorld_RemoveTagAlias_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RealWorldServer).AddComment(ctx, req.(*AddCommentRequest))
+		return srv.(RealWorldServer).RemoveTagAlias(ctx, req.(*RemoveTagAliasRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RealWorld_GetComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCommentsRequest)
+func _RealWorld_ListNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNotificationsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RealWorldServer).GetComments(ctx, in)
+		return srv.(RealWorldServer).ListNotifications(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RealWorld_GetComments_FullMethodName,
+		FullMethod: RealWorld_ListNotifications_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RealWorldServer).GetComments(ctx, req.(*GetCommentsRequest))
+		return srv.(RealWorldServer).ListNotifications(ctx, req.(*ListNotificationsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RealWorld_DeleteComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteCommentRequest)
+func _RealWorld_MarkNotificationRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkNotificationReadRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RealWorldServer).DeleteComment(ctx, in)
+		return srv.(RealWorldServer).MarkNotificationRead(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RealWorld_DeleteComment_FullMethodName,
+		FullMethod: RealWorld_MarkNotificationRead_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RealWorldServer).DeleteComment(ctx, req.(*DeleteCommentRequest))
+		return srv.(RealWorldServer).MarkNotificationRead(ctx, req.(*MarkNotificationReadRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RealWorld_FavoriteArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FavoriteArticleRequest)
+func _RealWorld_MarkAllNotificationsRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkAllNotificationsReadRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RealWorldServer).FavoriteArticle(ctx, in)
+		return srv.(RealWorldServer).MarkAllNotificationsRead(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RealWorld_FavoriteArticle_FullMethodName,
+		FullMethod: RealWorld_MarkAllNotificationsRead_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RealWorldServer).FavoriteArticle(ctx, req.(*FavoriteArticleRequest))
+		return srv.(RealWorldServer).MarkAllNotificationsRead(ctx, req.(*MarkAllNotificationsReadRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RealWorld_UnfavoriteArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UnfavoriteArticleRequest)
+func _RealWorld_GetNotificationPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNotificationPreferencesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RealWorldServer).UnfavoriteArticle(ctx, in)
+		return srv.(RealWorldServer).GetNotificationPreferences(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RealWorld_UnfavoriteArticle_FullMethodName,
+		FullMethod: RealWorld_GetNotificationPreferences_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RealWorldServer).UnfavoriteArticle(ctx, req.(*UnfavoriteArticleRequest))
+		return srv.(RealWorldServer).GetNotificationPreferences(ctx, req.(*GetNotificationPreferencesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RealWorld_GetTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTagsRequest)
+func _RealWorld_UpdateNotificationPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNotificationPreferencesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RealWorldServer).GetTags(ctx, in)
+		return srv.(RealWorldServer).UpdateNotificationPreferences(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RealWorld_GetTags_FullMethodName,
+		FullMethod: RealWorld_UpdateNotificationPreferences_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RealWorldServer).GetTags(ctx, req.(*GetTagsRequest))
+		return srv.(RealWorldServer).UpdateNotificationPreferences(ctx, req.(*UpdateNotificationPreferencesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -743,6 +2313,22 @@ var RealWorld_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateUser",
 			Handler:    _RealWorld_UpdateUser_Handler,
 		},
+		{
+			MethodName: "DeleteCurrentUser",
+			Handler:    _RealWorld_DeleteCurrentUser_Handler,
+		},
+		{
+			MethodName: "ExportCurrentUser",
+			Handler:    _RealWorld_ExportCurrentUser_Handler,
+		},
+		{
+			MethodName: "SearchProfiles",
+			Handler:    _RealWorld_SearchProfiles_Handler,
+		},
+		{
+			MethodName: "SuggestProfiles",
+			Handler:    _RealWorld_SuggestProfiles_Handler,
+		},
 		{
 			MethodName: "GetProfile",
 			Handler:    _RealWorld_GetProfile_Handler,
@@ -755,6 +2341,58 @@ var RealWorld_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UnfollowUser",
 			Handler:    _RealWorld_UnfollowUser_Handler,
 		},
+		{
+			MethodName: "ListFollowers",
+			Handler:    _RealWorld_ListFollowers_Handler,
+		},
+		{
+			MethodName: "ListFollowing",
+			Handler:    _RealWorld_ListFollowing_Handler,
+		},
+		{
+			MethodName: "BlockUser",
+			Handler:    _RealWorld_BlockUser_Handler,
+		},
+		{
+			MethodName: "UnblockUser",
+			Handler:    _RealWorld_UnblockUser_Handler,
+		},
+		{
+			MethodName: "MuteUser",
+			Handler:    _RealWorld_MuteUser_Handler,
+		},
+		{
+			MethodName: "UnmuteUser",
+			Handler:    _RealWorld_UnmuteUser_Handler,
+		},
+		{
+			MethodName: "ListBlockedUsers",
+			Handler:    _RealWorld_ListBlockedUsers_Handler,
+		},
+		{
+			MethodName: "ListMutedUsers",
+			Handler:    _RealWorld_ListMutedUsers_Handler,
+		},
+		{
+			MethodName: "ListFollowRequests",
+			Handler:    _RealWorld_ListFollowRequests_Handler,
+		},
+		{
+			MethodName: "ListOutgoingFollowRequests",
+			Handler:    _RealWorld_ListOutgoingFollowRequests_Handler,
+		},
+		{
+			MethodName: "ApproveFollowRequest",
+			Handler:    _RealWorld_ApproveFollowRequest_Handler,
+		},
+		{
+			MethodName: "RejectFollowRequest",
+			Handler:    _RealWorld_RejectFollowRequest_Handler,
+		},
+		{
+			MethodName: "CancelFollowRequest",
+			Handler:    _RealWorld_CancelFollowRequest_Handler,
+		},
 		{
 			MethodName: "ListArticles",
 			Handler:    _RealWorld_ListArticles_Handler,
@@ -799,10 +2437,122 @@ var RealWorld_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UnfavoriteArticle",
 			Handler:    _RealWorld_UnfavoriteArticle_Handler,
 		},
+		{
+			MethodName: "AddArticleReaction",
+			Handler:    _RealWorld_AddArticleReaction_Handler,
+		},
+		{
+			MethodName: "RemoveArticleReaction",
+			Handler:    _RealWorld_RemoveArticleReaction_Handler,
+		},
+		{
+			MethodName: "AddCommentReaction",
+			Handler:    _RealWorld_AddCommentReaction_Handler,
+		},
+		{
+			MethodName: "RemoveCommentReaction",
+			Handler:    _RealWorld_RemoveCommentReaction_Handler,
+		},
+		{
+			MethodName: "GetReactions",
+			Handler:    _RealWorld_GetReactions_Handler,
+		},
+		{
+			MethodName: "BookmarkArticle",
+			Handler:    _RealWorld_BookmarkArticle_Handler,
+		},
+		{
+			MethodName: "UnbookmarkArticle",
+			Handler:    _RealWorld_UnbookmarkArticle_Handler,
+		},
+		{
+			MethodName: "ListBookmarks",
+			Handler:    _RealWorld_ListBookmarks_Handler,
+		},
+		{
+			MethodName: "ListBookmarkCollections",
+			Handler:    _RealWorld_ListBookmarkCollections_Handler,
+		},
+		{
+			MethodName: "CreateBookmarkCollection",
+			Handler:    _RealWorld_CreateBookmarkCollection_Handler,
+		},
+		{
+			MethodName: "UpdateBookmarkCollection",
+			Handler:    _RealWorld_UpdateBookmarkCollection_Handler,
+		},
+		{
+			MethodName: "DeleteBookmarkCollection",
+			Handler:    _RealWorld_DeleteBookmarkCollection_Handler,
+		},
+		{
+			MethodName: "ListAttachments",
+			Handler:    _RealWorld_ListAttachments_Handler,
+		},
+		{
+			MethodName: "ListArticleAttachments",
+			Handler:    _RealWorld_ListArticleAttachments_Handler,
+		},
+		{
+			MethodName: "DeleteAttachment",
+			Handler:    _RealWorld_DeleteAttachment_Handler,
+		},
 		{
 			MethodName: "GetTags",
 			Handler:    _RealWorld_GetTags_Handler,
 		},
+		{
+			MethodName: "GetTrendingTags",
+			Handler:    _RealWorld_GetTrendingTags_Handler,
+		},
+		{
+			MethodName: "FollowTag",
+			Handler:    _RealWorld_FollowTag_Handler,
+		},
+		{
+			MethodName: "UnfollowTag",
+			Handler:    _RealWorld_UnfollowTag_Handler,
+		},
+		{
+			MethodName: "RenameTag",
+			Handler:    _RealWorld_RenameTag_Handler,
+		},
+		{
+			MethodName: "MergeTag",
+			Handler:    _RealWorld_MergeTag_Handler,
+		},
+		{
+			MethodName: "DeleteTag",
+			Handler:    _RealWorld_DeleteTag_Handler,
+		},
+		{
+			MethodName: "AddTagAlias",
+			Handler:    _RealWorld_AddTagAlias_Handler,
+		},
+		{
+			MethodName: "RemoveTagAlias",
+			Handler:    _RealWorld_RemoveTagAlias_Handler,
+		},
+		{
+			MethodName: "ListNotifications",
+			Handler:    _RealWorld_ListNotifications_Handler,
+		},
+		{
+			MethodName: "MarkNotificationRead",
+			Handler:    _RealWorld_MarkNotificationRead_Handler,
+		},
+		{
+			MethodName: "MarkAllNotificationsRead",
+			Handler:    _RealWorld_MarkAllNotificationsRead_Handler,
+		},
+		{
+			MethodName: "GetNotificationPreferences",
+			Handler:    _RealWorld_GetNotificationPreferences_Handler,
+		},
+		{
+			MethodName: "UpdateNotificationPreferences",
+			Handler:    _RealWorld_UpdateNotificationPreferences_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "realworld/v1/realworld.proto",
@@ -19,45 +19,155 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationRealWorldAddArticleReaction = "/realworld.v1.RealWorld/AddArticleReaction"
 const OperationRealWorldAddComment = "/realworld.v1.RealWorld/AddComment"
+const OperationRealWorldAddCommentReaction = "/realworld.v1.RealWorld/AddCommentReaction"
+const OperationRealWorldAddTagAlias = "/realworld.v1.RealWorld/AddTagAlias"
+const OperationRealWorldApproveFollowRequest = "/realworld.v1.RealWorld/ApproveFollowRequest"
+const OperationRealWorldBlockUser = "/realworld.v1.RealWorld/BlockUser"
+const OperationRealWorldBookmarkArticle = "/realworld.v1.RealWorld/BookmarkArticle"
+const OperationRealWorldCancelFollowRequest = "/realworld.v1.RealWorld/CancelFollowRequest"
 const OperationRealWorldCreateArticle = "/realworld.v1.RealWorld/CreateArticle"
+const OperationRealWorldCreateBookmarkCollection = "/realworld.v1.RealWorld/CreateBookmarkCollection"
 const OperationRealWorldDeleteArticle = "/realworld.v1.RealWorld/DeleteArticle"
+const OperationRealWorldDeleteAttachment = "/realworld.v1.RealWorld/DeleteAttachment"
+const OperationRealWorldDeleteBookmarkCollection = "/realworld.v1.RealWorld/DeleteBookmarkCollection"
 const OperationRealWorldDeleteComment = "/realworld.v1.RealWorld/DeleteComment"
+const OperationRealWorldDeleteCurrentUser = "/realworld.v1.RealWorld/DeleteCurrentUser"
+const OperationRealWorldDeleteTag = "/realworld.v1.RealWorld/DeleteTag"
+const OperationRealWorldExportCurrentUser = "/realworld.v1.RealWorld/ExportCurrentUser"
 const OperationRealWorldFavoriteArticle = "/realworld.v1.RealWorld/FavoriteArticle"
 const OperationRealWorldFeedArticles = "/realworld.v1.RealWorld/FeedArticles"
+const OperationRealWorldFollowTag = "/realworld.v1.RealWorld/FollowTag"
 const OperationRealWorldFollowUser = "/realworld.v1.RealWorld/FollowUser"
 const OperationRealWorldGetArticle = "/realworld.v1.RealWorld/GetArticle"
 const OperationRealWorldGetComments = "/realworld.v1.RealWorld/GetComments"
 const OperationRealWorldGetCurrentUser = "/realworld.v1.RealWorld/GetCurrentUser"
+const OperationRealWorldGetNotificationPreferences = "/realworld.v1.RealWorld/GetNotificationPreferences"
 const OperationRealWorldGetProfile = "/realworld.v1.RealWorld/GetProfile"
+const OperationRealWorldGetReactions = "/realworld.v1.RealWorld/GetReactions"
 const OperationRealWorldGetTags = "/realworld.v1.RealWorld/GetTags"
+const OperationRealWorldGetTrendingTags = "/realworld.v1.RealWorld/GetTrendingTags"
+const OperationRealWorldListArticleAttachments = "/realworld.v1.RealWorld/ListArticleAttachments"
 const OperationRealWorldListArticles = "/realworld.v1.RealWorld/ListArticles"
+const OperationRealWorldListAttachments = "/realworld.v1.RealWorld/ListAttachments"
+const OperationRealWorldListBlockedUsers = "/realworld.v1.RealWorld/ListBlockedUsers"
+const OperationRealWorldListBookmarkCollections = "/realworld.v1.RealWorld/ListBookmarkCollections"
+const OperationRealWorldListBookmarks = "/realworld.v1.RealWorld/ListBookmarks"
+const OperationRealWorldListFollowRequests = "/realworld.v1.RealWorld/ListFollowRequests"
+const OperationRealWorldListFollowers = "/realworld.v1.RealWorld/ListFollowers"
+const OperationRealWorldListFollowing = "/realworld.v1.RealWorld/ListFollowing"
+const OperationRealWorldListMutedUsers = "/realworld.v1.RealWorld/ListMutedUsers"
+const OperationRealWorldListNotifications = "/realworld.v1.RealWorld/ListNotifications"
+const OperationRealWorldListOutgoingFollowRequests = "/realworld.v1.RealWorld/ListOutgoingFollowRequests"
 const OperationRealWorldLogin = "/realworld.v1.RealWorld/Login"
+const OperationRealWorldMarkAllNotificationsRead = "/realworld.v1.RealWorld/MarkAllNotificationsRead"
+const OperationRealWorldMarkNotificationRead = "/realworld.v1.RealWorld/MarkNotificationRead"
+const OperationRealWorldMergeTag = "/realworld.v1.RealWorld/MergeTag"
+const OperationRealWorldMuteUser = "/realworld.v1.RealWorld/MuteUser"
 const OperationRealWorldRegister = "/realworld.v1.RealWorld/Register"
+const OperationRealWorldRejectFollowRequest = "/realworld.v1.RealWorld/RejectFollowRequest"
+const OperationRealWorldRemoveArticleReaction = "/realworld.v1.RealWorld/RemoveArticleReaction"
+const OperationRealWorldRemoveCommentReaction = "/realworld.v1.RealWorld/RemoveCommentReaction"
+const OperationRealWorldRemoveTagAlias = "/realworld.v1.RealWorld/RemoveTagAlias"
+const OperationRealWorldRenameTag = "/realworld.v1.RealWorld/RenameTag"
+const OperationRealWorldSearchProfiles = "/realworld.v1.RealWorld/SearchProfiles"
+const OperationRealWorldSuggestProfiles = "/realworld.v1.RealWorld/SuggestProfiles"
+const OperationRealWorldUnblockUser = "/realworld.v1.RealWorld/UnblockUser"
+const OperationRealWorldUnbookmarkArticle = "/realworld.v1.RealWorld/UnbookmarkArticle"
 const OperationRealWorldUnfavoriteArticle = "/realworld.v1.RealWorld/UnfavoriteArticle"
+const OperationRealWorldUnfollowTag = "/realworld.v1.RealWorld/UnfollowTag"
 const OperationRealWorldUnfollowUser = "/realworld.v1.RealWorld/UnfollowUser"
+const OperationRealWorldUnmuteUser = "/realworld.v1.RealWorld/UnmuteUser"
 const OperationRealWorldUpdateArticle = "/realworld.v1.RealWorld/UpdateArticle"
+const OperationRealWorldUpdateBookmarkCollection = "/realworld.v1.RealWorld/UpdateBookmarkCollection"
+const OperationRealWorldUpdateNotificationPreferences = "/realworld.v1.RealWorld/UpdateNotificationPreferences"
 const OperationRealWorldUpdateUser = "/realworld.v1.RealWorld/UpdateUser"
 
 type RealWorldHTTPServer interface {
+	// 表情回应 - 可用的表情由配置决定, 每个用户对同一目标的同一表情只计一次
+	AddArticleReaction(context.Context, *AddArticleReactionRequest) (*SingleArticleResponse, error)
 	AddComment(context.Context, *AddCommentRequest) (*SingleCommentResponse, error)
+	AddCommentReaction(context.Context, *AddCommentReactionRequest) (*SingleCommentResponse, error)
+	AddTagAlias(context.Context, *AddTagAliasRequest) (*TagResponse, error)
+	ApproveFollowRequest(context.Context, *ApproveFollowRequestRequest) (*ProfileResponse, error)
+	// 拉黑 - 同时解除双向关注
+	BlockUser(context.Context, *BlockUserRequest) (*ProfileResponse, error)
+	// 书签 - 只有自己可见, 和公开的收藏分开
+	BookmarkArticle(context.Context, *BookmarkArticleRequest) (*SingleArticleResponse, error)
+	CancelFollowRequest(context.Context, *CancelFollowRequestRequest) (*ProfileResponse, error)
 	CreateArticle(context.Context, *CreateArticleRequest) (*SingleArticleResponse, error)
+	CreateBookmarkCollection(context.Context, *CreateBookmarkCollectionRequest) (*SingleBookmarkCollectionResponse, error)
 	DeleteArticle(context.Context, *DeleteArticleRequest) (*DeleteArticleResponse, error)
+	DeleteAttachment(context.Context, *DeleteAttachmentRequest) (*DeleteAttachmentResponse, error)
+	// 删除收藏夹不会删除其中的书签
+	DeleteBookmarkCollection(context.Context, *DeleteBookmarkCollectionRequest) (*DeleteBookmarkCollectionResponse, error)
 	DeleteComment(context.Context, *DeleteCommentRequest) (*DeleteCommentResponse, error)
+	// 注销账号 - 按配置的策略匿名化或删除
+	DeleteCurrentUser(context.Context, *DeleteCurrentUserRequest) (*DeleteCurrentUserResponse, error)
+	DeleteTag(context.Context, *DeleteTagRequest) (*DeleteTagResponse, error)
+	// 导出当前用户的所有个人数据
+	ExportCurrentUser(context.Context, *ExportCurrentUserRequest) (*UserExportResponse, error)
 	FavoriteArticle(context.Context, *FavoriteArticleRequest) (*SingleArticleResponse, error)
 	FeedArticles(context.Context, *FeedArticlesRequest) (*MultipleArticleResponse, error)
+	// 关注标签 - 标签下的新文章会出现在feed中
+	FollowTag(context.Context, *FollowTagRequest) (*TagResponse, error)
 	FollowUser(context.Context, *FollowUserRequest) (*ProfileResponse, error)
 	GetArticle(context.Context, *GetArticleRequest) (*SingleArticleResponse, error)
 	GetComments(context.Context, *GetCommentsRequest) (*MultipleCommentResponse, error)
 	GetCurrentUser(context.Context, *GetCurrentUserRequest) (*UserResponse, error)
+	GetNotificationPreferences(context.Context, *GetNotificationPreferencesRequest) (*NotificationPreferencesResponse, error)
 	GetProfile(context.Context, *GetProfileRequest) (*ProfileResponse, error)
+	GetReactions(context.Context, *GetReactionsRequest) (*ReactionsListResponse, error)
+	// 按使用的文章数排序, 没有文章使用的标签不返回
 	GetTags(context.Context, *GetTagsRequest) (*TagsListResponse, error)
+	// 最近一段时间使用增长最快的标签
+	GetTrendingTags(context.Context, *GetTrendingTagsRequest) (*TrendingTagsResponse, error)
+	ListArticleAttachments(context.Context, *ListArticleAttachmentsRequest) (*MultipleAttachmentResponse, error)
 	ListArticles(context.Context, *ListArticlesRequest) (*MultipleArticleResponse, error)
+	// 附件的上传是multipart请求, 由http服务单独注册路由
+	// 当前用户还没有被文章引用的附件
+	ListAttachments(context.Context, *ListAttachmentsRequest) (*MultipleAttachmentResponse, error)
+	ListBlockedUsers(context.Context, *ListBlockedUsersRequest) (*MultipleProfileResponse, error)
+	ListBookmarkCollections(context.Context, *ListBookmarkCollectionsRequest) (*MultipleBookmarkCollectionResponse, error)
+	ListBookmarks(context.Context, *ListBookmarksRequest) (*MultipleArticleResponse, error)
+	// 关注私密账号需要对方同意 - 收到的和发出的关注申请
+	ListFollowRequests(context.Context, *ListFollowRequestsRequest) (*MultipleProfileResponse, error)
+	ListFollowers(context.Context, *ListFollowsRequest) (*MultipleProfileResponse, error)
+	ListFollowing(context.Context, *ListFollowsRequest) (*MultipleProfileResponse, error)
+	ListMutedUsers(context.Context, *ListMutedUsersRequest) (*MultipleProfileResponse, error)
+	// 通知 - 关注, 收藏, 评论和提及, 同类的通知合并为一条
+	ListNotifications(context.Context, *ListNotificationsRequest) (*MultipleNotificationResponse, error)
+	ListOutgoingFollowRequests(context.Context, *ListFollowRequestsRequest) (*MultipleProfileResponse, error)
 	Login(context.Context, *LoginRequest) (*UserResponse, error)
+	MarkAllNotificationsRead(context.Context, *MarkAllNotificationsReadRequest) (*MarkNotificationsReadResponse, error)
+	MarkNotificationRead(context.Context, *MarkNotificationReadRequest) (*MarkNotificationsReadResponse, error)
+	// 把tag合并到target, 文章和关注转移到target, tag的名称和别名成为target的别名
+	MergeTag(context.Context, *MergeTagRequest) (*TagResponse, error)
+	// 静音 - 只在自己的feed和评论中隐藏对方
+	MuteUser(context.Context, *MuteUserRequest) (*ProfileResponse, error)
 	Register(context.Context, *RegisterRequest) (*UserResponse, error)
+	RejectFollowRequest(context.Context, *RejectFollowRequestRequest) (*ProfileResponse, error)
+	RemoveArticleReaction(context.Context, *RemoveArticleReactionRequest) (*SingleArticleResponse, error)
+	RemoveCommentReaction(context.Context, *RemoveCommentReactionRequest) (*SingleCommentResponse, error)
+	RemoveTagAlias(context.Context, *RemoveTagAliasRequest) (*TagResponse, error)
+	// 标签管理 - 只有管理员可以调用
+	// 重命名后旧名称自动成为别名
+	RenameTag(context.Context, *RenameTagRequest) (*TagResponse, error)
+	// 按username前缀和bio模糊搜索用户
+	SearchProfiles(context.Context, *SearchProfilesRequest) (*MultipleProfileResponse, error)
+	// 推荐关注 - 需要在GetProfile之前注册, 否则会被{username}匹配
+	SuggestProfiles(context.Context, *SuggestProfilesRequest) (*MultipleProfileResponse, error)
+	UnblockUser(context.Context, *UnblockUserRequest) (*ProfileResponse, error)
+	UnbookmarkArticle(context.Context, *UnbookmarkArticleRequest) (*SingleArticleResponse, error)
 	UnfavoriteArticle(context.Context, *UnfavoriteArticleRequest) (*SingleArticleResponse, error)
+	UnfollowTag(context.Context, *UnfollowTagRequest) (*TagResponse, error)
 	UnfollowUser(context.Context, *UnfollowUserRequest) (*ProfileResponse, error)
+	UnmuteUser(context.Context, *UnmuteUserRequest) (*ProfileResponse, error)
 	UpdateArticle(context.Context, *UpdateArticleRequest) (*SingleArticleResponse, error)
+	UpdateBookmarkCollection(context.Context, *UpdateBookmarkCollectionRequest) (*SingleBookmarkCollectionResponse, error)
+	// 只修改传入的类型
+	UpdateNotificationPreferences(context.Context, *UpdateNotificationPreferencesRequest) (*NotificationPreferencesResponse, error)
 	UpdateUser(context.Context, *UpdateUserRequest) (*UserResponse, error)
 }
 
@@ -67,9 +177,26 @@ func RegisterRealWorldHTTPServer(s *http.Server, srv RealWorldHTTPServer) {
 	r.POST("/api/users", _RealWorld_Register0_HTTP_Handler(srv))
 	r.GET("/api/user", _RealWorld_GetCurrentUser0_HTTP_Handler(srv))
 	r.PUT("/api/user", _RealWorld_UpdateUser0_HTTP_Handler(srv))
+	r.DELETE("/api/user", _RealWorld_DeleteCurrentUser0_HTTP_Handler(srv))
+	r.GET("/api/user/export", _RealWorld_ExportCurrentUser0_HTTP_Handler(srv))
+	r.GET("/api/profiles", _RealWorld_SearchProfiles0_HTTP_Handler(srv))
+	r.GET("/api/profiles/suggestions", _RealWorld_SuggestProfiles0_HTTP_Handler(srv))
 	r.GET("/api/profiles/{username}", _RealWorld_GetProfile0_HTTP_Handler(srv))
 	r.POST("/api/profiles/{username}/follow", _RealWorld_FollowUser0_HTTP_Handler(srv))
 	r.DELETE("/api/profiles/{username}/follow", _RealWorld_UnfollowUser0_HTTP_Handler(srv))
+	r.GET("/api/profiles/{username}/followers", _RealWorld_ListFollowers0_HTTP_Handler(srv))
+	r.GET("/api/profiles/{username}/following", _RealWorld_ListFollowing0_HTTP_Handler(srv))
+	r.POST("/api/profiles/{username}/block", _RealWorld_BlockUser0_HTTP_Handler(srv))
+	r.DELETE("/api/profiles/{username}/block", _RealWorld_UnblockUser0_HTTP_Handler(srv))
+	r.POST("/api/profiles/{username}/mute", _RealWorld_MuteUser0_HTTP_Handler(srv))
+	r.DELETE("/api/profiles/{username}/mute", _RealWorld_UnmuteUser0_HTTP_Handler(srv))
+	r.GET("/api/user/blocks", _RealWorld_ListBlockedUsers0_HTTP_Handler(srv))
+	r.GET("/api/user/mutes", _RealWorld_ListMutedUsers0_HTTP_Handler(srv))
+	r.GET("/api/user/follow-requests", _RealWorld_ListFollowRequests0_HTTP_Handler(srv))
+	r.GET("/api/user/follow-requests/outgoing", _RealWorld_ListOutgoingFollowRequests0_HTTP_Handler(srv))
+	r.POST("/api/user/follow-requests/{username}/approve", _RealWorld_ApproveFollowRequest0_HTTP_Handler(srv))
+	r.POST("/api/user/follow-requests/{username}/reject", _RealWorld_RejectFollowRequest0_HTTP_Handler(srv))
+	r.DELETE("/api/profiles/{username}/follow-request", _RealWorld_CancelFollowRequest0_HTTP_Handler(srv))
 	r.GET("/api/articles", _RealWorld_ListArticles0_HTTP_Handler(srv))
 	r.GET("/api/articles/feed", _RealWorld_FeedArticles0_HTTP_Handler(srv))
 	r.GET("/api/articles/{slug}", _RealWorld_GetArticle0_HTTP_Handler(srv))
@@ -81,7 +208,35 @@ func RegisterRealWorldHTTPServer(s *http.Server, srv RealWorldHTTPServer) {
 	r.DELETE("/api/articles/{slug}/comments/{id}", _RealWorld_DeleteComment0_HTTP_Handler(srv))
 	r.POST("/api/articles/{slug}/favorite", _RealWorld_FavoriteArticle0_HTTP_Handler(srv))
 	r.DELETE("/api/articles/{slug}/favorite", _RealWorld_UnfavoriteArticle0_HTTP_Handler(srv))
+	r.POST("/api/articles/{slug}/reactions", _RealWorld_AddArticleReaction0_HTTP_Handler(srv))
+	r.DELETE("/api/articles/{slug}/reactions/{reaction}", _RealWorld_RemoveArticleReaction0_HTTP_Handler(srv))
+	r.POST("/api/articles/{slug}/comments/{id}/reactions", _RealWorld_AddCommentReaction0_HTTP_Handler(srv))
+	r.DELETE("/api/articles/{slug}/comments/{id}/reactions/{reaction}", _RealWorld_RemoveCommentReaction0_HTTP_Handler(srv))
+	r.GET("/api/reactions", _RealWorld_GetReactions0_HTTP_Handler(srv))
+	r.POST("/api/articles/{slug}/bookmark", _RealWorld_BookmarkArticle0_HTTP_Handler(srv))
+	r.DELETE("/api/articles/{slug}/bookmark", _RealWorld_UnbookmarkArticle0_HTTP_Handler(srv))
+	r.GET("/api/user/bookmarks", _RealWorld_ListBookmarks0_HTTP_Handler(srv))
+	r.GET("/api/user/bookmarks/collections", _RealWorld_ListBookmarkCollections0_HTTP_Handler(srv))
+	r.POST("/api/user/bookmarks/collections", _RealWorld_CreateBookmarkCollection0_HTTP_Handler(srv))
+	r.PUT("/api/user/bookmarks/collections/{id}", _RealWorld_UpdateBookmarkCollection0_HTTP_Handler(srv))
+	r.DELETE("/api/user/bookmarks/collections/{id}", _RealWorld_DeleteBookmarkCollection0_HTTP_Handler(srv))
+	r.GET("/api/attachments", _RealWorld_ListAttachments0_HTTP_Handler(srv))
+	r.GET("/api/articles/{slug}/attachments", _RealWorld_ListArticleAttachments0_HTTP_Handler(srv))
+	r.DELETE("/api/attachments/{id}", _RealWorld_DeleteAttachment0_HTTP_Handler(srv))
 	r.GET("/api/tags", _RealWorld_GetTags0_HTTP_Handler(srv))
+	r.GET("/api/tags/trending", _RealWorld_GetTrendingTags0_HTTP_Handler(srv))
+	r.POST("/api/tags/{tag}/follow", _RealWorld_FollowTag0_HTTP_Handler(srv))
+	r.DELETE("/api/tags/{tag}/follow", _RealWorld_UnfollowTag0_HTTP_Handler(srv))
+	r.PUT("/api/admin/tags/{tag}", _RealWorld_RenameTag0_HTTP_Handler(srv))
+	r.POST("/api/admin/tags/{tag}/merge", _RealWorld_MergeTag0_HTTP_Handler(srv))
+	r.DELETE("/api/admin/tags/{tag}", _RealWorld_DeleteTag0_HTTP_Handler(srv))
+	r.POST("/api/admin/tags/{tag}/aliases", _RealWorld_AddTagAlias0_HTTP_Handler(srv))
+	r.DELETE("/api/admin/tags/{tag}/aliases/{alias}", _RealWorld_RemoveTagAlias0_HTTP_Handler(srv))
+	r.GET("/api/notifications", _RealWorld_ListNotifications0_HTTP_Handler(srv))
+	r.POST("/api/notifications/{id}/read", _RealWorld_MarkNotificationRead0_HTTP_Handler(srv))
+	r.POST("/api/notifications/read", _RealWorld_MarkAllNotificationsRead0_HTTP_Handler(srv))
+	r.GET("/api/notifications/preferences", _RealWorld_GetNotificationPreferences0_HTTP_Handler(srv))
+	r.PUT("/api/notifications/preferences", _RealWorld_UpdateNotificationPreferences0_HTTP_Handler(srv))
 }
 
 func _RealWorld_Login0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
@@ -169,6 +324,82 @@ func _RealWorld_UpdateUser0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.
 	}
 }
 
+func _RealWorld_DeleteCurrentUser0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteCurrentUserRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRealWorldDeleteCurrentUser)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteCurrentUser(ctx, req.(*DeleteCurrentUserRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteCurrentUserResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RealWorld_ExportCurrentUser0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ExportCurrentUserRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRealWorldExportCurrentUser)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ExportCurrentUser(ctx, req.(*ExportCurrentUserRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UserExportResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RealWorld_SearchProfiles0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SearchProfilesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRealWorldSearchProfiles)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SearchProfiles(ctx, req.(*SearchProfilesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*MultipleProfileResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RealWorld_SuggestProfiles0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SuggestProfilesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRealWorldSuggestProfiles)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SuggestProfiles(ctx, req.(*SuggestProfilesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*MultipleProfileResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _RealWorld_GetProfile0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in GetProfileRequest
@@ -238,91 +469,100 @@ func _RealWorld_UnfollowUser0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx htt
 	}
 }
 
-func _RealWorld_ListArticles0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+func _RealWorld_ListFollowers0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in ListArticlesRequest
+		var in ListFollowsRequest
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationRealWorldListArticles)
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRealWorldListFollowers)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.ListArticles(ctx, req.(*ListArticlesRequest))
+			return srv.ListFollowers(ctx, req.(*ListFollowsRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*MultipleArticleResponse)
+		reply := out.(*MultipleProfileResponse)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _RealWorld_FeedArticles0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+func _RealWorld_ListFollowing0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in FeedArticlesRequest
+		var in ListFollowsRequest
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationRealWorldFeedArticles)
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRealWorldListFollowing)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.FeedArticles(ctx, req.(*FeedArticlesRequest))
+			return srv.ListFollowing(ctx, req.(*ListFollowsRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*MultipleArticleResponse)
+		reply := out.(*MultipleProfileResponse)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _RealWorld_GetArticle0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+func _RealWorld_BlockUser0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in GetArticleRequest
+		var in BlockUserRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
 		if err := ctx.BindVars(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationRealWorldGetArticle)
+		http.SetOperation(ctx, OperationRealWorldBlockUser)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.GetArticle(ctx, req.(*GetArticleRequest))
+			return srv.BlockUser(ctx, req.(*BlockUserRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*SingleArticleResponse)
+		reply := out.(*ProfileResponse)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _RealWorld_CreateArticle0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+func _RealWorld_UnblockUser0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in CreateArticleRequest
-		if err := ctx.Bind(&in); err != nil {
+		var in UnblockUserRequest
+		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		if err := ctx.BindQuery(&in); err != nil {
+		if err := ctx.BindVars(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationRealWorldCreateArticle)
+		http.SetOperation(ctx, OperationRealWorldUnblockUser)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.CreateArticle(ctx, req.(*CreateArticleRequest))
+			return srv.UnblockUser(ctx, req.(*UnblockUserRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*SingleArticleResponse)
+		reply := out.(*ProfileResponse)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _RealWorld_UpdateArticle0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+func _RealWorld_MuteUser0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in UpdateArticleRequest
+		var in MuteUserRequest
 		if err := ctx.Bind(&in); err != nil {
 			return err
 		}
@@ -332,113 +572,145 @@ func _RealWorld_UpdateArticle0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx ht
 		if err := ctx.BindVars(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationRealWorldUpdateArticle)
+		http.SetOperation(ctx, OperationRealWorldMuteUser)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.UpdateArticle(ctx, req.(*UpdateArticleRequest))
+			return srv.MuteUser(ctx, req.(*MuteUserRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*SingleArticleResponse)
+		reply := out.(*ProfileResponse)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _RealWorld_DeleteArticle0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+func _RealWorld_UnmuteUser0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in DeleteArticleRequest
+		var in UnmuteUserRequest
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
 		if err := ctx.BindVars(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationRealWorldDeleteArticle)
+		http.SetOperation(ctx, OperationRealWorldUnmuteUser)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.DeleteArticle(ctx, req.(*DeleteArticleRequest))
+			return srv.UnmuteUser(ctx, req.(*UnmuteUserRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*DeleteArticleResponse)
+		reply := out.(*ProfileResponse)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _RealWorld_AddComment0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+func _RealWorld_ListBlockedUsers0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in AddCommentRequest
-		if err := ctx.Bind(&in); err != nil {
+		var in ListBlockedUsersRequest
+		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		if err := ctx.BindQuery(&in); err != nil {
+		http.SetOperation(ctx, OperationRealWorldListBlockedUsers)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListBlockedUsers(ctx, req.(*ListBlockedUsersRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
 			return err
 		}
-		if err := ctx.BindVars(&in); err != nil {
+		reply := out.(*MultipleProfileResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RealWorld_ListMutedUsers0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListMutedUsersRequest
+		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationRealWorldAddComment)
+		http.SetOperation(ctx, OperationRealWorldListMutedUsers)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.AddComment(ctx, req.(*AddCommentRequest))
+			return srv.ListMutedUsers(ctx, req.(*ListMutedUsersRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*SingleCommentResponse)
+		reply := out.(*MultipleProfileResponse)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _RealWorld_GetComments0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+func _RealWorld_ListFollowRequests0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in GetCommentsRequest
+		var in ListFollowRequestsRequest
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		if err := ctx.BindVars(&in); err != nil {
+		http.SetOperation(ctx, OperationRealWorldListFollowRequests)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListFollowRequests(ctx, req.(*ListFollowRequestsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationRealWorldGetComments)
+		reply := out.(*MultipleProfileResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RealWorld_ListOutgoingFollowRequests0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListFollowRequestsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRealWorldListOutgoingFollowRequests)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.GetComments(ctx, req.(*GetCommentsRequest))
+			return srv.ListOutgoingFollowRequests(ctx, req.(*ListFollowRequestsRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*MultipleCommentResponse)
+		reply := out.(*MultipleProfileResponse)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _RealWorld_DeleteComment0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+func _RealWorld_ApproveFollowRequest0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in DeleteCommentRequest
+		var in ApproveFollowRequestRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
 		if err := ctx.BindVars(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationRealWorldDeleteComment)
+		http.SetOperation(ctx, OperationRealWorldApproveFollowRequest)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.DeleteComment(ctx, req.(*DeleteCommentRequest))
+			return srv.ApproveFollowRequest(ctx, req.(*ApproveFollowRequestRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*DeleteCommentResponse)
+		reply := out.(*ProfileResponse)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _RealWorld_FavoriteArticle0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+func _RealWorld_RejectFollowRequest0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in FavoriteArticleRequest
+		var in RejectFollowRequestRequest
 		if err := ctx.Bind(&in); err != nil {
 			return err
 		}
@@ -448,160 +720,1397 @@ func _RealWorld_FavoriteArticle0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx
 		if err := ctx.BindVars(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationRealWorldFavoriteArticle)
+		http.SetOperation(ctx, OperationRealWorldRejectFollowRequest)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.FavoriteArticle(ctx, req.(*FavoriteArticleRequest))
+			return srv.RejectFollowRequest(ctx, req.(*RejectFollowRequestRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*SingleArticleResponse)
+		reply := out.(*ProfileResponse)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _RealWorld_UnfavoriteArticle0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+func _RealWorld_CancelFollowRequest0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in UnfavoriteArticleRequest
+		var in CancelFollowRequestRequest
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
 		if err := ctx.BindVars(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationRealWorldUnfavoriteArticle)
+		http.SetOperation(ctx, OperationRealWorldCancelFollowRequest)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.UnfavoriteArticle(ctx, req.(*UnfavoriteArticleRequest))
+			return srv.CancelFollowRequest(ctx, req.(*CancelFollowRequestRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*SingleArticleResponse)
+		reply := out.(*ProfileResponse)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _RealWorld_GetTags0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+func _RealWorld_ListArticles0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in GetTagsRequest
+		var in ListArticlesRequest
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationRealWorldGetTags)
+		http.SetOperation(ctx, OperationRealWorldListArticles)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.GetTags(ctx, req.(*GetTagsRequest))
+			return srv.ListArticles(ctx, req.(*ListArticlesRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*TagsListResponse)
+		reply := out.(*MultipleArticleResponse)
 		return ctx.Result(200, reply)
 	}
 }
 
-type RealWorldHTTPClient interface {
-	AddComment(ctx context.Context, req *AddCommentRequest, opts ...http.CallOption) (rsp *SingleCommentResponse, err error)
-	CreateArticle(ctx context.Context, req *CreateArticleRequest, opts ...http.CallOption) (rsp *SingleArticleResponse, err error)
-	DeleteArticle(ctx context.Context, req *DeleteArticleRequest, opts ...http.CallOption) (rsp *DeleteArticleResponse, err error)
-	DeleteComment(ctx context.Context, req *DeleteCommentRequest, opts ...http.CallOption) (rsp *DeleteCommentResponse, err error)
-	FavoriteArticle(ctx context.Context, req *FavoriteArticleRequest, opts ...http.CallOption) (rsp *SingleArticleResponse, err error)
-	FeedArticles(ctx context.Context, req *FeedArticlesRequest, opts ...http.CallOption) (rsp *MultipleArticleResponse, err error)
-	FollowUser(ctx context.Context, req *FollowUserRequest, opts ...http.CallOption) (rsp *ProfileResponse, err error)
-	GetArticle(ctx context.Context, req *GetArticleRequest, opts ...http.CallOption) (rsp *SingleArticleResponse, err error)
-	GetComments(ctx context.Context, req *GetCommentsRequest, opts ...http.CallOption) (rsp *MultipleCommentResponse, err error)
-	GetCurrentUser(ctx context.Context, req *GetCurrentUserRequest, opts ...http.CallOption) (rsp *UserResponse, err error)
-	GetProfile(ctx context.Context, req *GetProfileRequest, opts ...http.CallOption) (rsp *ProfileResponse, err error)
-	GetTags(ctx context.Context, req *GetTagsRequest, opts ...http.CallOption) (rsp *TagsListResponse, err error)
-	ListArticles(ctx context.Context, req *ListArticlesRequest, opts ...http.CallOption) (rsp *MultipleArticleResponse, err error)
-	Login(ctx context.Context, req *LoginRequest, opts ...http.CallOption) (rsp *UserResponse, err error)
-	Register(ctx context.Context, req *RegisterRequest, opts ...http.CallOption) (rsp *UserResponse, err error)
-	UnfavoriteArticle(ctx context.Context, req *UnfavoriteArticleRequest, opts ...http.CallOption) (rsp *SingleArticleResponse, err error)
-	UnfollowUser(ctx context.Context, req *UnfollowUserRequest, opts ...http.CallOption) (rsp *ProfileResponse, err error)
-	UpdateArticle(ctx context.Context, req *UpdateArticleRequest, opts ...http.CallOption) (rsp *SingleArticleResponse, err error)
-	UpdateUser(ctx context.Context, req *UpdateUserRequest, opts ...http.CallOption) (rsp *UserResponse, err error)
-}
-
-type RealWorldHTTPClientImpl struct {
-	cc *http.Client
-}
-
-func NewRealWorldHTTPClient(client *http.Client) RealWorldHTTPClient {
-	return &RealWorldHTTPClientImpl{client}
-}
-
-func (c *RealWorldHTTPClientImpl) AddComment(ctx context.Context, in *AddCommentRequest, opts ...http.CallOption) (*SingleCommentResponse, error) {
-	var out SingleCommentResponse
-	pattern := "/api/articles/{slug}/comments"
-	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationRealWorldAddComment))
-	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
-	if err != nil {
-		return nil, err
+func _RealWorld_FeedArticles0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in FeedArticlesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRealWorldFeedArticles)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.FeedArticles(ctx, req.(*FeedArticlesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*MultipleArticleResponse)
+		return ctx.Result(200, reply)
 	}
-	return &out, nil
 }
 
-func (c *RealWorldHTTPClientImpl) CreateArticle(ctx context.Context, in *CreateArticleRequest, opts ...http.CallOption) (*SingleArticleResponse, error) {
-	var out SingleArticleResponse
-	pattern := "/api/articles"
-	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationRealWorldCreateArticle))
-	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
-	if err != nil {
-		return nil, err
+func _RealWorld_GetArticle0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetArticleRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRealWorldGetArticle)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetArticle(ctx, req.(*GetArticleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SingleArticleResponse)
+		return ctx.Result(200, reply)
 	}
-	return &out, nil
+}
+
+func _RealWorld_CreateArticle0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateArticleRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRealWorldCreateArticle)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateArticle(ctx, req.(*CreateArticleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SingleArticleResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RealWorld_UpdateArticle0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateArticleRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRealWorldUpdateArticle)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateArticle(ctx, req.(*UpdateArticleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SingleArticleResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RealWorld_DeleteArticle0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteArticleRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRealWorldDeleteArticle)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteArticle(ctx, req.(*DeleteArticleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteArticleResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RealWorld_AddComment0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AddCommentRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRealWorldAddComment)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AddComment(ctx, req.(*AddCommentRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SingleCommentResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RealWorld_GetComments0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetCommentsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRealWorldGetComments)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetComments(ctx, req.(*GetCommentsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*MultipleCommentResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RealWorld_DeleteComment0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteCommentRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRealWorldDeleteComment)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteComment(ctx, req.(*DeleteCommentRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteCommentResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RealWorld_FavoriteArticle0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in FavoriteArticleRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRealWorldFavoriteArticle)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.FavoriteArticle(ctx, req.(*FavoriteArticleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SingleArticleResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RealWorld_UnfavoriteArticle0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UnfavoriteArticleRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRealWorldUnfavoriteArticle)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UnfavoriteArticle(ctx, req.(*UnfavoriteArticleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SingleArticleResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RealWorld_AddArticleReaction0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AddArticleReactionRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRealWorldAddArticleReaction)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AddArticleReaction(ctx, req.(*AddArticleReactionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SingleArticleResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RealWorld_RemoveArticleReaction0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RemoveArticleReactionRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRealWorldRemoveArticleReaction)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RemoveArticleReaction(ctx, req.(*RemoveArticleReactionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SingleArticleResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RealWorld_AddCommentReaction0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AddCommentReactionRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRealWorldAddCommentReaction)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AddCommentReaction(ctx, req.(*AddCommentReactionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SingleCommentResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RealWorld_RemoveCommentReaction0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RemoveCommentReactionRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRealWorldRemoveCommentReaction)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RemoveCommentReaction(ctx, req.(*RemoveCommentReactionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SingleCommentResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RealWorld_GetReactions0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetReactionsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRealWorldGetReactions)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetReactions(ctx, req.(*GetReactionsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ReactionsListResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RealWorld_BookmarkArticle0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in BookmarkArticleRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRealWorldBookmarkArticle)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.BookmarkArticle(ctx, req.(*BookmarkArticleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SingleArticleResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RealWorld_UnbookmarkArticle0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UnbookmarkArticleRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRealWorldUnbookmarkArticle)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UnbookmarkArticle(ctx, req.(*UnbookmarkArticleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SingleArticleResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RealWorld_ListBookmarks0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListBookmarksRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRealWorldListBookmarks)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListBookmarks(ctx, req.(*ListBookmarksRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*MultipleArticleResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RealWorld_ListBookmarkCollections0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListBookmarkCollectionsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRealWorldListBookmarkCollections)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListBookmarkCollections(ctx, req.(*ListBookmarkCollectionsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*MultipleBookmarkCollectionResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RealWorld_CreateBookmarkCollection0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateBookmarkCollectionRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRealWorldCreateBookmarkCollection)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateBookmarkCollection(ctx, req.(*CreateBookmarkCollectionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SingleBookmarkCollectionResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RealWorld_UpdateBookmarkCollection0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateBookmarkCollectionRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRealWorldUpdateBookmarkCollection)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateBookmarkCollection(ctx, req.(*UpdateBookmarkCollectionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SingleBookmarkCollectionResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RealWorld_DeleteBookmarkCollection0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteBookmarkCollectionRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRealWorldDeleteBookmarkCollection)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteBookmarkCollection(ctx, req.(*DeleteBookmarkCollectionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteBookmarkCollectionResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RealWorld_ListAttachments0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListAttachmentsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRealWorldListAttachments)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListAttachments(ctx, req.(*ListAttachmentsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*MultipleAttachmentResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RealWorld_ListArticleAttachments0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListArticleAttachmentsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRealWorldListArticleAttachments)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListArticleAttachments(ctx, req.(*ListArticleAttachmentsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*MultipleAttachmentResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RealWorld_DeleteAttachment0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteAttachmentRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRealWorldDeleteAttachment)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteAttachment(ctx, req.(*DeleteAttachmentRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteAttachmentResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RealWorld_GetTags0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetTagsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRealWorldGetTags)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetTags(ctx, req.(*GetTagsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*TagsListResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RealWorld_GetTrendingTags0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetTrendingTagsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRealWorldGetTrendingTags)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetTrendingTags(ctx, req.(*GetTrendingTagsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*TrendingTagsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RealWorld_FollowTag0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in FollowTagRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRealWorldFollowTag)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.FollowTag(ctx, req.(*FollowTagRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*TagResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RealWorld_UnfollowTag0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UnfollowTagRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRealWorldUnfollowTag)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UnfollowTag(ctx, req.(*UnfollowTagRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*TagResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RealWorld_RenameTag0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RenameTagRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRealWorldRenameTag)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RenameTag(ctx, req.(*RenameTagRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*TagResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RealWorld_MergeTag0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in MergeTagRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRealWorldMergeTag)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.MergeTag(ctx, req.(*MergeTagRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*TagResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RealWorld_DeleteTag0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteTagRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRealWorldDeleteTag)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteTag(ctx, req.(*DeleteTagRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteTagResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RealWorld_AddTagAlias0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AddTagAliasRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRealWorldAddTagAlias)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AddTagAlias(ctx, req.(*AddTagAliasRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*TagResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RealWorld_RemoveTagAlias0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RemoveTagAliasRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRealWorldRemoveTagAlias)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RemoveTagAlias(ctx, req.(*RemoveTagAliasRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*TagResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RealWorld_ListNotifications0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListNotificationsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRealWorldListNotifications)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListNotifications(ctx, req.(*ListNotificationsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*MultipleNotificationResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RealWorld_MarkNotificationRead0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in MarkNotificationReadRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRealWorldMarkNotificationRead)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.MarkNotificationRead(ctx, req.(*MarkNotificationReadRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*MarkNotificationsReadResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RealWorld_MarkAllNotificationsRead0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in MarkAllNotificationsReadRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRealWorldMarkAllNotificationsRead)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.MarkAllNotificationsRead(ctx, req.(*MarkAllNotificationsReadRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*MarkNotificationsReadResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RealWorld_GetNotificationPreferences0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetNotificationPreferencesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRealWorldGetNotificationPreferences)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetNotificationPreferences(ctx, req.(*GetNotificationPreferencesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*NotificationPreferencesResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RealWorld_UpdateNotificationPreferences0_HTTP_Handler(srv RealWorldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateNotificationPreferencesRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRealWorldUpdateNotificationPreferences)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateNotificationPreferences(ctx, req.(*UpdateNotificationPreferencesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*NotificationPreferencesResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+type RealWorldHTTPClient interface {
+	AddArticleReaction(ctx context.Context, req *AddArticleReactionRequest, opts ...http.CallOption) (rsp *SingleArticleResponse, err error)
+	AddComment(ctx context.Context, req *AddCommentRequest, opts ...http.CallOption) (rsp *SingleCommentResponse, err error)
+	AddCommentReaction(ctx context.Context, req *AddCommentReactionRequest, opts ...http.CallOption) (rsp *SingleCommentResponse, err error)
+	AddTagAlias(ctx context.Context, req *AddTagAliasRequest, opts ...http.CallOption) (rsp *TagResponse, err error)
+	ApproveFollowRequest(ctx context.Context, req *ApproveFollowRequestRequest, opts ...http.CallOption) (rsp *ProfileResponse, err error)
+	BlockUser(ctx context.Context, req *BlockUserRequest, opts ...http.CallOption) (rsp *ProfileResponse, err error)
+	BookmarkArticle(ctx context.Context, req *BookmarkArticleRequest, opts ...http.CallOption) (rsp *SingleArticleResponse, err error)
+	CancelFollowRequest(ctx context.Context, req *CancelFollowRequestRequest, opts ...http.CallOption) (rsp *ProfileResponse, err error)
+	CreateArticle(ctx context.Context, req *CreateArticleRequest, opts ...http.CallOption) (rsp *SingleArticleResponse, err error)
+	CreateBookmarkCollection(ctx context.Context, req *CreateBookmarkCollectionRequest, opts ...http.CallOption) (rsp *SingleBookmarkCollectionResponse, err error)
+	DeleteArticle(ctx context.Context, req *DeleteArticleRequest, opts ...http.CallOption) (rsp *DeleteArticleResponse, err error)
+	DeleteAttachment(ctx context.Context, req *DeleteAttachmentRequest, opts ...http.CallOption) (rsp *DeleteAttachmentResponse, err error)
+	DeleteBookmarkCollection(ctx context.Context, req *DeleteBookmarkCollectionRequest, opts ...http.CallOption) (rsp *DeleteBookmarkCollectionResponse, err error)
+	DeleteComment(ctx context.Context, req *DeleteCommentRequest, opts ...http.CallOption) (rsp *DeleteCommentResponse, err error)
+	DeleteCurrentUser(ctx context.Context, req *DeleteCurrentUserRequest, opts ...http.CallOption) (rsp *DeleteCurrentUserResponse, err error)
+	DeleteTag(ctx context.Context, req *DeleteTagRequest, opts ...http.CallOption) (rsp *DeleteTagResponse, err error)
+	ExportCurrentUser(ctx context.Context, req *ExportCurrentUserRequest, opts ...http.CallOption) (rsp *UserExportResponse, err error)
+	FavoriteArticle(ctx context.Context, req *FavoriteArticleRequest, opts ...http.CallOption) (rsp *SingleArticleResponse, err error)
+	FeedArticles(ctx context.Context, req *FeedArticlesRequest, opts ...http.CallOption) (rsp *MultipleArticleResponse, err error)
+	FollowTag(ctx context.Context, req *FollowTagRequest, opts ...http.CallOption) (rsp *TagResponse, err error)
+	FollowUser(ctx context.Context, req *FollowUserRequest, opts ...http.CallOption) (rsp *ProfileResponse, err error)
+	GetArticle(ctx context.Context, req *GetArticleRequest, opts ...http.CallOption) (rsp *SingleArticleResponse, err error)
+	GetComments(ctx context.Context, req *GetCommentsRequest, opts ...http.CallOption) (rsp *MultipleCommentResponse, err error)
+	GetCurrentUser(ctx context.Context, req *GetCurrentUserRequest, opts ...http.CallOption) (rsp *UserResponse, err error)
+	GetNotificationPreferences(ctx context.Context, req *GetNotificationPreferencesRequest, opts ...http.CallOption) (rsp *NotificationPreferencesResponse, err error)
+	GetProfile(ctx context.Context, req *GetProfileRequest, opts ...http.CallOption) (rsp *ProfileResponse, err error)
+	GetReactions(ctx context.Context, req *GetReactionsRequest, opts ...http.CallOption) (rsp *ReactionsListResponse, err error)
+	GetTags(ctx context.Context, req *GetTagsRequest, opts ...http.CallOption) (rsp *TagsListResponse, err error)
+	GetTrendingTags(ctx context.Context, req *GetTrendingTagsRequest, opts ...http.CallOption) (rsp *TrendingTagsResponse, err error)
+	ListArticleAttachments(ctx context.Context, req *ListArticleAttachmentsRequest, opts ...http.CallOption) (rsp *MultipleAttachmentResponse, err error)
+	ListArticles(ctx context.Context, req *ListArticlesRequest, opts ...http.CallOption) (rsp *MultipleArticleResponse, err error)
+	ListAttachments(ctx context.Context, req *ListAttachmentsRequest, opts ...http.CallOption) (rsp *MultipleAttachmentResponse, err error)
+	ListBlockedUsers(ctx context.Context, req *ListBlockedUsersRequest, opts ...http.CallOption) (rsp *MultipleProfileResponse, err error)
+	ListBookmarkCollections(ctx context.Context, req *ListBookmarkCollectionsRequest, opts ...http.CallOption) (rsp *MultipleBookmarkCollectionResponse, err error)
+	ListBookmarks(ctx context.Context, req *ListBookmarksRequest, opts ...http.CallOption) (rsp *MultipleArticleResponse, err error)
+	ListFollowRequests(ctx context.Context, req *ListFollowRequestsRequest, opts ...http.CallOption) (rsp *MultipleProfileResponse, err error)
+	ListFollowers(ctx context.Context, req *ListFollowsRequest, opts ...http.CallOption) (rsp *MultipleProfileResponse, err error)
+	ListFollowing(ctx context.Context, req *ListFollowsRequest, opts ...http.CallOption) (rsp *MultipleProfileResponse, err error)
+	ListMutedUsers(ctx context.Context, req *ListMutedUsersRequest, opts ...http.CallOption) (rsp *MultipleProfileResponse, err error)
+	ListNotifications(ctx context.Context, req *ListNotificationsRequest, opts ...http.CallOption) (rsp *MultipleNotificationResponse, err error)
+	ListOutgoingFollowRequests(ctx context.Context, req *ListFollowRequestsRequest, opts ...http.CallOption) (rsp *MultipleProfileResponse, err error)
+	Login(ctx context.Context, req *LoginRequest, opts ...http.CallOption) (rsp *UserResponse, err error)
+	MarkAllNotificationsRead(ctx context.Context, req *MarkAllNotificationsReadRequest, opts ...http.CallOption) (rsp *MarkNotificationsReadResponse, err error)
+	MarkNotificationRead(ctx context.Context, req *MarkNotificationReadRequest, opts ...http.CallOption) (rsp *MarkNotificationsReadResponse, err error)
+	MergeTag(ctx context.Context, req *MergeTagRequest, opts ...http.CallOption) (rsp *TagResponse, err error)
+	MuteUser(ctx context.Context, req *MuteUserRequest, opts ...http.CallOption) (rsp *ProfileResponse, err error)
+	Register(ctx context.Context, req *RegisterRequest, opts ...http.CallOption) (rsp *UserResponse, err error)
+	RejectFollowRequest(ctx context.Context, req *RejectFollowRequestRequest, opts ...http.CallOption) (rsp *ProfileResponse, err error)
+	RemoveArticleReaction(ctx context.Context, req *RemoveArticleReactionRequest, opts ...http.CallOption) (rsp *SingleArticleResponse, err error)
+	RemoveCommentReaction(ctx context.Context, req *RemoveCommentReactionRequest, opts ...http.CallOption) (rsp *SingleCommentResponse, err error)
+	RemoveTagAlias(ctx context.Context, req *RemoveTagAliasRequest, opts ...http.CallOption) (rsp *TagResponse, err error)
+	RenameTag(ctx context.Context, req *RenameTagRequest, opts ...http.CallOption) (rsp *TagResponse, err error)
+	SearchProfiles(ctx context.Context, req *SearchProfilesRequest, opts ...http.CallOption) (rsp *MultipleProfileResponse, err error)
+	SuggestProfiles(ctx context.Context, req *SuggestProfilesRequest, opts ...http.CallOption) (rsp *MultipleProfileResponse, err error)
+	UnblockUser(ctx context.Context, req *UnblockUserRequest, opts ...http.CallOption) (rsp *ProfileResponse, err error)
+	UnbookmarkArticle(ctx context.Context, req *UnbookmarkArticleRequest, opts ...http.CallOption) (rsp *SingleArticleResponse, err error)
+	UnfavoriteArticle(ctx context.Context, req *UnfavoriteArticleRequest, opts ...http.CallOption) (rsp *SingleArticleResponse, err error)
+	UnfollowTag(ctx context.Context, req *UnfollowTagRequest, opts ...http.CallOption) (rsp *TagResponse, err error)
+	UnfollowUser(ctx context.Context, req *UnfollowUserRequest, opts ...http.CallOption) (rsp *ProfileResponse, err error)
+	UnmuteUser(ctx context.Context, req *UnmuteUserRequest, opts ...http.CallOption) (rsp *ProfileResponse, err error)
+	UpdateArticle(ctx context.Context, req *UpdateArticleRequest, opts ...http.CallOption) (rsp *SingleArticleResponse, err error)
+	UpdateBookmarkCollection(ctx context.Context, req *UpdateBookmarkCollectionRequest, opts ...http.CallOption) (rsp *SingleBookmarkCollectionResponse, err error)
+	UpdateNotificationPreferences(ctx context.Context, req *UpdateNotificationPreferencesRequest, opts ...http.CallOption) (rsp *NotificationPreferencesResponse, err error)
+	UpdateUser(ctx context.Context, req *UpdateUserRequest, opts ...http.CallOption) (rsp *UserResponse, err error)
+}
+
+type RealWorldHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewRealWorldHTTPClient(client *http.Client) RealWorldHTTPClient {
+	return &RealWorldHTTPClientImpl{client}
+}
+
+func (c *RealWorldHTTPClientImpl) AddArticleReaction(ctx context.Context, in *AddArticleReactionRequest, opts ...http.CallOption) (*SingleArticleResponse, error) {
+	var out SingleArticleResponse
+	pattern := "/api/articles/{slug}/reactions"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationRealWorldAddArticleReaction))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *RealWorldHTTPClientImpl) AddComment(ctx context.Context, in *AddCommentRequest, opts ...http.CallOption) (*SingleCommentResponse, error) {
+	var out SingleCommentResponse
+	pattern := "/api/articles/{slug}/comments"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationRealWorldAddComment))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *RealWorldHTTPClientImpl) AddCommentReaction(ctx context.Context, in *AddCommentReactionRequest, opts ...http.CallOption) (*SingleCommentResponse, error) {
+	var out SingleCommentResponse
+	pattern := "/api/articles/{slug}/comments/{id}/reactions"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationRealWorldAddCommentReaction))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *RealWorldHTTPClientImpl) AddTagAlias(ctx context.Context, in *AddTagAliasRequest, opts ...http.CallOption) (*TagResponse, error) {
+	var out TagResponse
+	pattern := "/api/admin/tags/{tag}/aliases"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationRealWorldAddTagAlias))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *RealWorldHTTPClientImpl) ApproveFollowRequest(ctx context.Context, in *ApproveFollowRequestRequest, opts ...http.CallOption) (*ProfileResponse, error) {
+	var out ProfileResponse
+	pattern := "/api/user/follow-requests/{username}/approve"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationRealWorldApproveFollowRequest))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *RealWorldHTTPClientImpl) BlockUser(ctx context.Context, in *BlockUserRequest, opts ...http.CallOption) (*ProfileResponse, error) {
+	var out ProfileResponse
+	pattern := "/api/profiles/{username}/block"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationRealWorldBlockUser))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *RealWorldHTTPClientImpl) BookmarkArticle(ctx context.Context, in *BookmarkArticleRequest, opts ...http.CallOption) (*SingleArticleResponse, error) {
+	var out SingleArticleResponse
+	pattern := "/api/articles/{slug}/bookmark"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationRealWorldBookmarkArticle))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *RealWorldHTTPClientImpl) CancelFollowRequest(ctx context.Context, in *CancelFollowRequestRequest, opts ...http.CallOption) (*ProfileResponse, error) {
+	var out ProfileResponse
+	pattern := "/api/profiles/{username}/follow-request"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationRealWorldCancelFollowRequest))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *RealWorldHTTPClientImpl) CreateArticle(ctx context.Context, in *CreateArticleRequest, opts ...http.CallOption) (*SingleArticleResponse, error) {
+	var out SingleArticleResponse
+	pattern := "/api/articles"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationRealWorldCreateArticle))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *RealWorldHTTPClientImpl) CreateBookmarkCollection(ctx context.Context, in *CreateBookmarkCollectionRequest, opts ...http.CallOption) (*SingleBookmarkCollectionResponse, error) {
+	var out SingleBookmarkCollectionResponse
+	pattern := "/api/user/bookmarks/collections"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationRealWorldCreateBookmarkCollection))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
 }
 
 func (c *RealWorldHTTPClientImpl) DeleteArticle(ctx context.Context, in *DeleteArticleRequest, opts ...http.CallOption) (*DeleteArticleResponse, error) {
 	var out DeleteArticleResponse
 	pattern := "/api/articles/{slug}"
 	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationRealWorldDeleteArticle))
+	opts = append(opts, http.Operation(OperationRealWorldDeleteArticle))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *RealWorldHTTPClientImpl) DeleteAttachment(ctx context.Context, in *DeleteAttachmentRequest, opts ...http.CallOption) (*DeleteAttachmentResponse, error) {
+	var out DeleteAttachmentResponse
+	pattern := "/api/attachments/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationRealWorldDeleteAttachment))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *RealWorldHTTPClientImpl) DeleteBookmarkCollection(ctx context.Context, in *DeleteBookmarkCollectionRequest, opts ...http.CallOption) (*DeleteBookmarkCollectionResponse, error) {
+	var out DeleteBookmarkCollectionResponse
+	pattern := "/api/user/bookmarks/collections/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationRealWorldDeleteBookmarkCollection))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *RealWorldHTTPClientImpl) DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...http.CallOption) (*DeleteCommentResponse, error) {
+	var out DeleteCommentResponse
+	pattern := "/api/articles/{slug}/comments/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationRealWorldDeleteComment))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *RealWorldHTTPClientImpl) DeleteCurrentUser(ctx context.Context, in *DeleteCurrentUserRequest, opts ...http.CallOption) (*DeleteCurrentUserResponse, error) {
+	var out DeleteCurrentUserResponse
+	pattern := "/api/user"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationRealWorldDeleteCurrentUser))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *RealWorldHTTPClientImpl) DeleteTag(ctx context.Context, in *DeleteTagRequest, opts ...http.CallOption) (*DeleteTagResponse, error) {
+	var out DeleteTagResponse
+	pattern := "/api/admin/tags/{tag}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationRealWorldDeleteTag))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *RealWorldHTTPClientImpl) ExportCurrentUser(ctx context.Context, in *ExportCurrentUserRequest, opts ...http.CallOption) (*UserExportResponse, error) {
+	var out UserExportResponse
+	pattern := "/api/user/export"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationRealWorldExportCurrentUser))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *RealWorldHTTPClientImpl) FavoriteArticle(ctx context.Context, in *FavoriteArticleRequest, opts ...http.CallOption) (*SingleArticleResponse, error) {
+	var out SingleArticleResponse
+	pattern := "/api/articles/{slug}/favorite"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationRealWorldFavoriteArticle))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *RealWorldHTTPClientImpl) FeedArticles(ctx context.Context, in *FeedArticlesRequest, opts ...http.CallOption) (*MultipleArticleResponse, error) {
+	var out MultipleArticleResponse
+	pattern := "/api/articles/feed"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationRealWorldFeedArticles))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *RealWorldHTTPClientImpl) FollowTag(ctx context.Context, in *FollowTagRequest, opts ...http.CallOption) (*TagResponse, error) {
+	var out TagResponse
+	pattern := "/api/tags/{tag}/follow"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationRealWorldFollowTag))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *RealWorldHTTPClientImpl) FollowUser(ctx context.Context, in *FollowUserRequest, opts ...http.CallOption) (*ProfileResponse, error) {
+	var out ProfileResponse
+	pattern := "/api/profiles/{username}/follow"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationRealWorldFollowUser))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *RealWorldHTTPClientImpl) GetArticle(ctx context.Context, in *GetArticleRequest, opts ...http.CallOption) (*SingleArticleResponse, error) {
+	var out SingleArticleResponse
+	pattern := "/api/articles/{slug}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationRealWorldGetArticle))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *RealWorldHTTPClientImpl) GetComments(ctx context.Context, in *GetCommentsRequest, opts ...http.CallOption) (*MultipleCommentResponse, error) {
+	var out MultipleCommentResponse
+	pattern := "/api/articles/{slug}/comments"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationRealWorldGetComments))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *RealWorldHTTPClientImpl) GetCurrentUser(ctx context.Context, in *GetCurrentUserRequest, opts ...http.CallOption) (*UserResponse, error) {
+	var out UserResponse
+	pattern := "/api/user"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationRealWorldGetCurrentUser))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *RealWorldHTTPClientImpl) GetNotificationPreferences(ctx context.Context, in *GetNotificationPreferencesRequest, opts ...http.CallOption) (*NotificationPreferencesResponse, error) {
+	var out NotificationPreferencesResponse
+	pattern := "/api/notifications/preferences"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationRealWorldGetNotificationPreferences))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *RealWorldHTTPClientImpl) GetProfile(ctx context.Context, in *GetProfileRequest, opts ...http.CallOption) (*ProfileResponse, error) {
+	var out ProfileResponse
+	pattern := "/api/profiles/{username}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationRealWorldGetProfile))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *RealWorldHTTPClientImpl) GetReactions(ctx context.Context, in *GetReactionsRequest, opts ...http.CallOption) (*ReactionsListResponse, error) {
+	var out ReactionsListResponse
+	pattern := "/api/reactions"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationRealWorldGetReactions))
 	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &out, nil
 }
 
-func (c *RealWorldHTTPClientImpl) DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...http.CallOption) (*DeleteCommentResponse, error) {
-	var out DeleteCommentResponse
-	pattern := "/api/articles/{slug}/comments/{id}"
+func (c *RealWorldHTTPClientImpl) GetTags(ctx context.Context, in *GetTagsRequest, opts ...http.CallOption) (*TagsListResponse, error) {
+	var out TagsListResponse
+	pattern := "/api/tags"
 	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationRealWorldDeleteComment))
+	opts = append(opts, http.Operation(OperationRealWorldGetTags))
 	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &out, nil
 }
 
-func (c *RealWorldHTTPClientImpl) FavoriteArticle(ctx context.Context, in *FavoriteArticleRequest, opts ...http.CallOption) (*SingleArticleResponse, error) {
-	var out SingleArticleResponse
-	pattern := "/api/articles/{slug}/favorite"
-	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationRealWorldFavoriteArticle))
+func (c *RealWorldHTTPClientImpl) GetTrendingTags(ctx context.Context, in *GetTrendingTagsRequest, opts ...http.CallOption) (*TrendingTagsResponse, error) {
+	var out TrendingTagsResponse
+	pattern := "/api/tags/trending"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationRealWorldGetTrendingTags))
 	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &out, nil
 }
 
-func (c *RealWorldHTTPClientImpl) FeedArticles(ctx context.Context, in *FeedArticlesRequest, opts ...http.CallOption) (*MultipleArticleResponse, error) {
+func (c *RealWorldHTTPClientImpl) ListArticleAttachments(ctx context.Context, in *ListArticleAttachmentsRequest, opts ...http.CallOption) (*MultipleAttachmentResponse, error) {
+	var out MultipleAttachmentResponse
+	pattern := "/api/articles/{slug}/attachments"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationRealWorldListArticleAttachments))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *RealWorldHTTPClientImpl) ListArticles(ctx context.Context, in *ListArticlesRequest, opts ...http.CallOption) (*MultipleArticleResponse, error) {
 	var out MultipleArticleResponse
-	pattern := "/api/articles/feed"
+	pattern := "/api/articles"
 	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationRealWorldFeedArticles))
+	opts = append(opts, http.Operation(OperationRealWorldListArticles))
 	opts = append(opts, http.PathTemplate(pattern))
 	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
 	if err != nil {
@@ -610,24 +2119,24 @@ func (c *RealWorldHTTPClientImpl) FeedArticles(ctx context.Context, in *FeedArti
 	return &out, nil
 }
 
-func (c *RealWorldHTTPClientImpl) FollowUser(ctx context.Context, in *FollowUserRequest, opts ...http.CallOption) (*ProfileResponse, error) {
-	var out ProfileResponse
-	pattern := "/api/profiles/{username}/follow"
-	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationRealWorldFollowUser))
+func (c *RealWorldHTTPClientImpl) ListAttachments(ctx context.Context, in *ListAttachmentsRequest, opts ...http.CallOption) (*MultipleAttachmentResponse, error) {
+	var out MultipleAttachmentResponse
+	pattern := "/api/attachments"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationRealWorldListAttachments))
 	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &out, nil
 }
 
-func (c *RealWorldHTTPClientImpl) GetArticle(ctx context.Context, in *GetArticleRequest, opts ...http.CallOption) (*SingleArticleResponse, error) {
-	var out SingleArticleResponse
-	pattern := "/api/articles/{slug}"
+func (c *RealWorldHTTPClientImpl) ListBlockedUsers(ctx context.Context, in *ListBlockedUsersRequest, opts ...http.CallOption) (*MultipleProfileResponse, error) {
+	var out MultipleProfileResponse
+	pattern := "/api/user/blocks"
 	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationRealWorldGetArticle))
+	opts = append(opts, http.Operation(OperationRealWorldListBlockedUsers))
 	opts = append(opts, http.PathTemplate(pattern))
 	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
 	if err != nil {
@@ -636,11 +2145,11 @@ func (c *RealWorldHTTPClientImpl) GetArticle(ctx context.Context, in *GetArticle
 	return &out, nil
 }
 
-func (c *RealWorldHTTPClientImpl) GetComments(ctx context.Context, in *GetCommentsRequest, opts ...http.CallOption) (*MultipleCommentResponse, error) {
-	var out MultipleCommentResponse
-	pattern := "/api/articles/{slug}/comments"
+func (c *RealWorldHTTPClientImpl) ListBookmarkCollections(ctx context.Context, in *ListBookmarkCollectionsRequest, opts ...http.CallOption) (*MultipleBookmarkCollectionResponse, error) {
+	var out MultipleBookmarkCollectionResponse
+	pattern := "/api/user/bookmarks/collections"
 	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationRealWorldGetComments))
+	opts = append(opts, http.Operation(OperationRealWorldListBookmarkCollections))
 	opts = append(opts, http.PathTemplate(pattern))
 	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
 	if err != nil {
@@ -649,11 +2158,11 @@ func (c *RealWorldHTTPClientImpl) GetComments(ctx context.Context, in *GetCommen
 	return &out, nil
 }
 
-func (c *RealWorldHTTPClientImpl) GetCurrentUser(ctx context.Context, in *GetCurrentUserRequest, opts ...http.CallOption) (*UserResponse, error) {
-	var out UserResponse
-	pattern := "/api/user"
+func (c *RealWorldHTTPClientImpl) ListBookmarks(ctx context.Context, in *ListBookmarksRequest, opts ...http.CallOption) (*MultipleArticleResponse, error) {
+	var out MultipleArticleResponse
+	pattern := "/api/user/bookmarks"
 	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationRealWorldGetCurrentUser))
+	opts = append(opts, http.Operation(OperationRealWorldListBookmarks))
 	opts = append(opts, http.PathTemplate(pattern))
 	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
 	if err != nil {
@@ -662,11 +2171,11 @@ func (c *RealWorldHTTPClientImpl) GetCurrentUser(ctx context.Context, in *GetCur
 	return &out, nil
 }
 
-func (c *RealWorldHTTPClientImpl) GetProfile(ctx context.Context, in *GetProfileRequest, opts ...http.CallOption) (*ProfileResponse, error) {
-	var out ProfileResponse
-	pattern := "/api/profiles/{username}"
+func (c *RealWorldHTTPClientImpl) ListFollowRequests(ctx context.Context, in *ListFollowRequestsRequest, opts ...http.CallOption) (*MultipleProfileResponse, error) {
+	var out MultipleProfileResponse
+	pattern := "/api/user/follow-requests"
 	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationRealWorldGetProfile))
+	opts = append(opts, http.Operation(OperationRealWorldListFollowRequests))
 	opts = append(opts, http.PathTemplate(pattern))
 	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
 	if err != nil {
@@ -675,11 +2184,11 @@ func (c *RealWorldHTTPClientImpl) GetProfile(ctx context.Context, in *GetProfile
 	return &out, nil
 }
 
-func (c *RealWorldHTTPClientImpl) GetTags(ctx context.Context, in *GetTagsRequest, opts ...http.CallOption) (*TagsListResponse, error) {
-	var out TagsListResponse
-	pattern := "/api/tags"
+func (c *RealWorldHTTPClientImpl) ListFollowers(ctx context.Context, in *ListFollowsRequest, opts ...http.CallOption) (*MultipleProfileResponse, error) {
+	var out MultipleProfileResponse
+	pattern := "/api/profiles/{username}/followers"
 	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationRealWorldGetTags))
+	opts = append(opts, http.Operation(OperationRealWorldListFollowers))
 	opts = append(opts, http.PathTemplate(pattern))
 	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
 	if err != nil {
@@ -688,11 +2197,50 @@ func (c *RealWorldHTTPClientImpl) GetTags(ctx context.Context, in *GetTagsReques
 	return &out, nil
 }
 
-func (c *RealWorldHTTPClientImpl) ListArticles(ctx context.Context, in *ListArticlesRequest, opts ...http.CallOption) (*MultipleArticleResponse, error) {
-	var out MultipleArticleResponse
-	pattern := "/api/articles"
+func (c *RealWorldHTTPClientImpl) ListFollowing(ctx context.Context, in *ListFollowsRequest, opts ...http.CallOption) (*MultipleProfileResponse, error) {
+	var out MultipleProfileResponse
+	pattern := "/api/profiles/{username}/following"
 	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationRealWorldListArticles))
+	opts = append(opts, http.Operation(OperationRealWorldListFollowing))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *RealWorldHTTPClientImpl) ListMutedUsers(ctx context.Context, in *ListMutedUsersRequest, opts ...http.CallOption) (*MultipleProfileResponse, error) {
+	var out MultipleProfileResponse
+	pattern := "/api/user/mutes"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationRealWorldListMutedUsers))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *RealWorldHTTPClientImpl) ListNotifications(ctx context.Context, in *ListNotificationsRequest, opts ...http.CallOption) (*MultipleNotificationResponse, error) {
+	var out MultipleNotificationResponse
+	pattern := "/api/notifications"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationRealWorldListNotifications))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *RealWorldHTTPClientImpl) ListOutgoingFollowRequests(ctx context.Context, in *ListFollowRequestsRequest, opts ...http.CallOption) (*MultipleProfileResponse, error) {
+	var out MultipleProfileResponse
+	pattern := "/api/user/follow-requests/outgoing"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationRealWorldListOutgoingFollowRequests))
 	opts = append(opts, http.PathTemplate(pattern))
 	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
 	if err != nil {
@@ -714,6 +2262,58 @@ func (c *RealWorldHTTPClientImpl) Login(ctx context.Context, in *LoginRequest, o
 	return &out, nil
 }
 
+func (c *RealWorldHTTPClientImpl) MarkAllNotificationsRead(ctx context.Context, in *MarkAllNotificationsReadRequest, opts ...http.CallOption) (*MarkNotificationsReadResponse, error) {
+	var out MarkNotificationsReadResponse
+	pattern := "/api/notifications/read"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationRealWorldMarkAllNotificationsRead))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *RealWorldHTTPClientImpl) MarkNotificationRead(ctx context.Context, in *MarkNotificationReadRequest, opts ...http.CallOption) (*MarkNotificationsReadResponse, error) {
+	var out MarkNotificationsReadResponse
+	pattern := "/api/notifications/{id}/read"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationRealWorldMarkNotificationRead))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *RealWorldHTTPClientImpl) MergeTag(ctx context.Context, in *MergeTagRequest, opts ...http.CallOption) (*TagResponse, error) {
+	var out TagResponse
+	pattern := "/api/admin/tags/{tag}/merge"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationRealWorldMergeTag))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *RealWorldHTTPClientImpl) MuteUser(ctx context.Context, in *MuteUserRequest, opts ...http.CallOption) (*ProfileResponse, error) {
+	var out ProfileResponse
+	pattern := "/api/profiles/{username}/mute"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationRealWorldMuteUser))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *RealWorldHTTPClientImpl) Register(ctx context.Context, in *RegisterRequest, opts ...http.CallOption) (*UserResponse, error) {
 	var out UserResponse
 	pattern := "/api/users"
@@ -727,6 +2327,123 @@ func (c *RealWorldHTTPClientImpl) Register(ctx context.Context, in *RegisterRequ
 	return &out, nil
 }
 
+func (c *RealWorldHTTPClientImpl) RejectFollowRequest(ctx context.Context, in *RejectFollowRequestRequest, opts ...http.CallOption) (*ProfileResponse, error) {
+	var out ProfileResponse
+	pattern := "/api/user/follow-requests/{username}/reject"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationRealWorldRejectFollowRequest))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *RealWorldHTTPClientImpl) RemoveArticleReaction(ctx context.Context, in *RemoveArticleReactionRequest, opts ...http.CallOption) (*SingleArticleResponse, error) {
+	var out SingleArticleResponse
+	pattern := "/api/articles/{slug}/reactions/{reaction}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationRealWorldRemoveArticleReaction))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *RealWorldHTTPClientImpl) RemoveCommentReaction(ctx context.Context, in *RemoveCommentReactionRequest, opts ...http.CallOption) (*SingleCommentResponse, error) {
+	var out SingleCommentResponse
+	pattern := "/api/articles/{slug}/comments/{id}/reactions/{reaction}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationRealWorldRemoveCommentReaction))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *RealWorldHTTPClientImpl) RemoveTagAlias(ctx context.Context, in *RemoveTagAliasRequest, opts ...http.CallOption) (*TagResponse, error) {
+	var out TagResponse
+	pattern := "/api/admin/tags/{tag}/aliases/{alias}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationRealWorldRemoveTagAlias))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *RealWorldHTTPClientImpl) RenameTag(ctx context.Context, in *RenameTagRequest, opts ...http.CallOption) (*TagResponse, error) {
+	var out TagResponse
+	pattern := "/api/admin/tags/{tag}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationRealWorldRenameTag))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *RealWorldHTTPClientImpl) SearchProfiles(ctx context.Context, in *SearchProfilesRequest, opts ...http.CallOption) (*MultipleProfileResponse, error) {
+	var out MultipleProfileResponse
+	pattern := "/api/profiles"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationRealWorldSearchProfiles))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *RealWorldHTTPClientImpl) SuggestProfiles(ctx context.Context, in *SuggestProfilesRequest, opts ...http.CallOption) (*MultipleProfileResponse, error) {
+	var out MultipleProfileResponse
+	pattern := "/api/profiles/suggestions"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationRealWorldSuggestProfiles))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *RealWorldHTTPClientImpl) UnblockUser(ctx context.Context, in *UnblockUserRequest, opts ...http.CallOption) (*ProfileResponse, error) {
+	var out ProfileResponse
+	pattern := "/api/profiles/{username}/block"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationRealWorldUnblockUser))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *RealWorldHTTPClientImpl) UnbookmarkArticle(ctx context.Context, in *UnbookmarkArticleRequest, opts ...http.CallOption) (*SingleArticleResponse, error) {
+	var out SingleArticleResponse
+	pattern := "/api/articles/{slug}/bookmark"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationRealWorldUnbookmarkArticle))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *RealWorldHTTPClientImpl) UnfavoriteArticle(ctx context.Context, in *UnfavoriteArticleRequest, opts ...http.CallOption) (*SingleArticleResponse, error) {
 	var out SingleArticleResponse
 	pattern := "/api/articles/{slug}/favorite"
@@ -740,6 +2457,19 @@ func (c *RealWorldHTTPClientImpl) UnfavoriteArticle(ctx context.Context, in *Unf
 	return &out, nil
 }
 
+func (c *RealWorldHTTPClientImpl) UnfollowTag(ctx context.Context, in *UnfollowTagRequest, opts ...http.CallOption) (*TagResponse, error) {
+	var out TagResponse
+	pattern := "/api/tags/{tag}/follow"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationRealWorldUnfollowTag))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *RealWorldHTTPClientImpl) UnfollowUser(ctx context.Context, in *UnfollowUserRequest, opts ...http.CallOption) (*ProfileResponse, error) {
 	var out ProfileResponse
 	pattern := "/api/profiles/{username}/follow"
@@ -753,6 +2483,19 @@ func (c *RealWorldHTTPClientImpl) UnfollowUser(ctx context.Context, in *Unfollow
 	return &out, nil
 }
 
+func (c *RealWorldHTTPClientImpl) UnmuteUser(ctx context.Context, in *UnmuteUserRequest, opts ...http.CallOption) (*ProfileResponse, error) {
+	var out ProfileResponse
+	pattern := "/api/profiles/{username}/mute"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationRealWorldUnmuteUser))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *RealWorldHTTPClientImpl) UpdateArticle(ctx context.Context, in *UpdateArticleRequest, opts ...http.CallOption) (*SingleArticleResponse, error) {
 	var out SingleArticleResponse
 	pattern := "/api/articles/{slug}"
@@ -766,6 +2509,32 @@ func (c *RealWorldHTTPClientImpl) UpdateArticle(ctx context.Context, in *UpdateA
 	return &out, nil
 }
 
+func (c *RealWorldHTTPClientImpl) UpdateBookmarkCollection(ctx context.Context, in *UpdateBookmarkCollectionRequest, opts ...http.CallOption) (*SingleBookmarkCollectionResponse, error) {
+	var out SingleBookmarkCollectionResponse
+	pattern := "/api/user/bookmarks/collections/{id}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationRealWorldUpdateBookmarkCollection))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *RealWorldHTTPClientImpl) UpdateNotificationPreferences(ctx context.Context, in *UpdateNotificationPreferencesRequest, opts ...http.CallOption) (*NotificationPreferencesResponse, error) {
+	var out NotificationPreferencesResponse
+	pattern := "/api/notifications/preferences"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationRealWorldUpdateNotificationPreferences))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *RealWorldHTTPClientImpl) UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...http.CallOption) (*UserResponse, error) {
 	var out UserResponse
 	pattern := "/api/user"
@@ -2,9 +2,11 @@ package main
 
 import (
 	"flag"
+	"fmt"
 	"os"
 
 	"kratos-realworld/internal/conf"
+	"kratos-realworld/internal/server"
 
 	"github.com/go-kratos/kratos/v2"
 	"github.com/go-kratos/kratos/v2/config"
@@ -24,15 +26,18 @@ var (
 	Version string
 	// flagconf is the config flag.
 	flagconf string
+	// flagmigrations is the migrations directory for migrate create.
+	flagmigrations string
 
 	id, _ = os.Hostname()
 )
 
 func init() {
 	flag.StringVar(&flagconf, "conf", "../../configs", "config path, eg: -conf config.yaml")
+	flag.StringVar(&flagmigrations, "migrations", "../../internal/data/migrations", "migrations path for migrate create")
 }
 
-func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server) *kratos.App {
+func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server, js *server.JobServer) *kratos.App {
 	return kratos.New(
 		kratos.ID(id),
 		kratos.Name(Name),
@@ -42,6 +47,7 @@ func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server) *kratos.App {
 		kratos.Server(
 			gs,
 			hs,
+			js,
 		),
 	)
 }
@@ -72,7 +78,16 @@ func main() {
 		panic(err)
 	}
 
-	app, cleanup, err := wireApp(bc.Server, bc.Data, bc.Jwt, logger)
+	// migrate子命令: -conf ../../configs migrate up
+	if flag.Arg(0) == "migrate" {
+		if err := runMigrate(bc.Data, flag.Args()[1:], logger); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	app, cleanup, err := wireApp(bc.Server, bc.Data, bc.Jwt, bc.Auth, bc.Account, bc.Media, bc.Social, bc.Events, logger)
 	if err != nil {
 		panic(err)
 	}
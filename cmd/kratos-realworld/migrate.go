@@ -0,0 +1,83 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"kratos-realworld/internal/conf"
+	"kratos-realworld/internal/data"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const migrateUsage = "usage: migrate up | down [n] | status | create <name>"
+
+// migrate子命令 - 不启动服务, 只执行数据库迁移
+func runMigrate(c *conf.Data, args []string, logger log.Logger) error {
+	if len(args) == 0 {
+		return errors.New(migrateUsage)
+	}
+	if args[0] == "create" {
+		if len(args) != 2 {
+			return errors.New(migrateUsage)
+		}
+		files, err := data.CreateMigration(flagmigrations, args[1])
+		for _, file := range files {
+			fmt.Println("created", file)
+		}
+		return err
+	}
+
+	db, err := data.OpenDB(c, logger)
+	if err != nil {
+		return err
+	}
+	m, err := data.NewMigrator(db, logger)
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+
+	switch args[0] {
+	case "up":
+		done, err := m.Up(ctx)
+		for _, s := range done {
+			fmt.Printf("applied  %04d_%s\n", s.Version, s.Name)
+		}
+		if err == nil && len(done) == 0 {
+			fmt.Println("no pending migrations")
+		}
+		return err
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			if steps, err = strconv.Atoi(args[1]); err != nil || steps < 1 {
+				return fmt.Errorf("invalid number of migrations: %s", args[1])
+			}
+		}
+		done, err := m.Down(ctx, steps)
+		for _, s := range done {
+			fmt.Printf("reverted %04d_%s\n", s.Version, s.Name)
+		}
+		return err
+	case "status":
+		status, err := m.Status(ctx)
+		if err != nil {
+			return err
+		}
+		for _, s := range status {
+			if s.AppliedAt != nil {
+				fmt.Printf("%04d_%s  applied at %s\n", s.Version, s.Name, s.AppliedAt.Format("2006-01-02 15:04:05"))
+			} else if s.Baseline {
+				fmt.Printf("%04d_%s  pending (existing database not baselined yet, run migrate up)\n", s.Version, s.Name)
+			} else {
+				fmt.Printf("%04d_%s  pending\n", s.Version, s.Name)
+			}
+		}
+		return nil
+	default:
+		return errors.New(migrateUsage)
+	}
+}
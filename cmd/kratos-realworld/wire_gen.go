@@ -23,24 +23,52 @@ import (
 // Injectors from wire.go:
 
 // wireApp init kratos application.
-func wireApp(confServer *conf.Server, confData *conf.Data, jwt *conf.JWT, logger log.Logger) (*kratos.App, func(), error) {
-	db := data.NewDB(confData)
-	dataData, cleanup, err := data.NewData(confData, logger, db)
+func wireApp(confServer *conf.Server, confData *conf.Data, jwt *conf.JWT, auth *conf.Auth, account *conf.Account, media *conf.Media, social *conf.Social, events *conf.Events, logger log.Logger) (*kratos.App, func(), error) {
+	db := data.NewDB(confData, logger)
+	cache, cleanup, err := data.NewCache(confData)
 	if err != nil {
 		return nil, nil, err
 	}
+	dataData, cleanup2, err := data.NewData(confData, logger, db, cache)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
 	userRepo := data.NewUserRepo(dataData, logger)
 	profileRepo := data.NewProfileRepo(dataData, logger)
-	userUsecase := biz.NewUserUsecase(userRepo, profileRepo, logger, jwt)
+	transaction := data.NewTransaction(dataData)
+	outboxRepo := data.NewOutboxRepo(dataData, logger)
+	eventBus := biz.NewEventBus(outboxRepo, events, logger)
+	passwordPolicy, err := biz.NewPasswordPolicy(auth)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	userUsecase := biz.NewUserUsecase(userRepo, profileRepo, transaction, eventBus, logger, jwt, account, passwordPolicy)
 	articleRepo := data.NewArticleRepo(dataData, logger)
 	commentRepo := data.NewCommentRepo(dataData, logger)
 	tagRepo := data.NewTagRepo(dataData, logger)
-	socialUsecase := biz.NewSocialUsecase(articleRepo, commentRepo, tagRepo, logger)
-	realWorldService := service.NewRealWorldService(userUsecase, socialUsecase)
+	attachmentRepo := data.NewAttachmentRepo(dataData, logger)
+	bookmarkRepo := data.NewBookmarkRepo(dataData, logger)
+	reactionRepo := data.NewReactionRepo(dataData, logger)
+	socialUsecase := biz.NewSocialUsecase(articleRepo, commentRepo, tagRepo, profileRepo, attachmentRepo, bookmarkRepo, reactionRepo, transaction, eventBus, social, logger)
+	blobStore, err := data.NewBlobStore(confData)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	mediaUsecase := biz.NewMediaUsecase(blobStore, attachmentRepo, articleRepo, media, logger)
+	notificationRepo := data.NewNotificationRepo(dataData, logger)
+	notificationUsecase := biz.NewNotificationUsecase(notificationRepo, userRepo, profileRepo, eventBus, logger)
+	realWorldService := service.NewRealWorldService(userUsecase, socialUsecase, mediaUsecase, notificationUsecase)
 	grpcServer := server.NewGRPCServer(confServer, realWorldService, logger)
 	httpServer := server.NewHTTPServer(confServer, jwt, realWorldService, logger)
-	app := newApp(logger, grpcServer, httpServer)
+	jobServer := server.NewJobServer(mediaUsecase, socialUsecase, eventBus, logger)
+	app := newApp(logger, grpcServer, httpServer, jobServer)
 	return app, func() {
+		cleanup2()
 		cleanup()
 	}, nil
 }
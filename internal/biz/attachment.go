@@ -0,0 +1,289 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"kratos-realworld/internal/pkg/imaging"
+	"kratos-realworld/internal/pkg/middleware/auth"
+)
+
+// 文章中的图片附件
+// 在编辑器中上传后处于未发布状态, 只能通过签名url访问;
+// 文章的正文或封面引用了附件的url后关联到文章, 之后可以公开访问
+type Attachment struct {
+	ID     uint
+	UserID uint
+	// 0表示还没有被文章引用
+	ArticleID   uint
+	Key         string
+	URL         string
+	PreviewURL  string
+	ContentType string
+	Size        int64
+	Width       int
+	Height      int
+	CreatedAt   time.Time
+}
+
+// 用户附件占用的空间和配额
+type AttachmentUsage struct {
+	UsedBytes  int64
+	QuotaBytes int64
+}
+
+type AttachmentRepo interface {
+	// 在配额内创建附件记录, 超过配额时返回错误
+	CreateAttachment(ctx context.Context, a *Attachment, quota int64) (*Attachment, error)
+	GetAttachment(ctx context.Context, id uint) (*Attachment, error)
+	GetAttachmentByKey(ctx context.Context, key string) (*Attachment, error)
+	// 用户还没有被文章引用的附件
+	ListPendingAttachments(ctx context.Context, uid uint) ([]*Attachment, error)
+	ListArticleAttachments(ctx context.Context, aid uint) ([]*Attachment, error)
+	// link中的附件关联到文章, unlink中的附件解除关联, 之后按未引用的附件清理
+	LinkAttachments(ctx context.Context, aid uint, link []uint, unlink []uint) error
+	// 删除附件记录并释放配额, 使用它作为封面的文章去掉封面
+	DeleteAttachment(ctx context.Context, id uint) error
+	// 在before之前上传或解除关联后一直没有被引用的附件, 以及文章已经删除的附件
+	ListOrphanedAttachments(ctx context.Context, before time.Time, limit int) ([]*Attachment, error)
+	GetAttachmentBytes(ctx context.Context, uid uint) (int64, error)
+}
+
+const (
+	attachmentKeyPrefix = "attachments/"
+
+	defaultAttachmentMaxBytes   = 5 << 20
+	defaultAttachmentQuotaBytes = 100 << 20
+	defaultAttachmentPendingTTL = 24 * time.Hour
+	defaultSignedURLTTL         = time.Hour
+	// 超过这个边长的图片等比缩小后保存
+	maxAttachmentSide   = 2048
+	maxAttachmentPixels = 6000 * 6000
+	// 清理任务每批处理的数量
+	cleanupBatchSize = 100
+)
+
+// 上传文件的大小限制 - service层读取请求体时也需要
+func (uc *MediaUsecase) MaxAttachmentBytes() int64 {
+	if n := uc.mc.GetAttachment().GetMaxBytes(); n > 0 {
+		return n
+	}
+	return defaultAttachmentMaxBytes
+}
+
+func (uc *MediaUsecase) attachmentQuota() int64 {
+	if n := uc.mc.GetAttachment().GetQuotaBytes(); n > 0 {
+		return n
+	}
+	return defaultAttachmentQuotaBytes
+}
+
+func (uc *MediaUsecase) pendingTTL() time.Duration {
+	if d := uc.mc.GetAttachment().GetPendingTtl(); d != nil && d.AsDuration() > 0 {
+		return d.AsDuration()
+	}
+	return defaultAttachmentPendingTTL
+}
+
+func (uc *MediaUsecase) signedURLTTL() time.Duration {
+	if d := uc.mc.GetAttachment().GetSignedUrlTtl(); d != nil && d.AsDuration() > 0 {
+		return d.AsDuration()
+	}
+	return defaultSignedURLTTL
+}
+
+// 清理任务的执行间隔, 默认1小时
+func (uc *MediaUsecase) CleanupInterval() time.Duration {
+	if d := uc.mc.GetAttachment().GetCleanupInterval(); d != nil && d.AsDuration() > 0 {
+		return d.AsDuration()
+	}
+	return time.Hour
+}
+
+// 已发布的附件直接使用公开的url, 未发布的使用带过期时间的签名url
+func (uc *MediaUsecase) withPreviewURL(a *Attachment) (*Attachment, error) {
+	if a.ArticleID != 0 {
+		a.PreviewURL = a.URL
+		return a, nil
+	}
+	signed, err := uc.bs.SignedURL(a.Key, time.Now().Add(uc.signedURLTTL()))
+	if err != nil {
+		return nil, err
+	}
+	a.PreviewURL = signed
+	return a, nil
+}
+
+// 上传附件 - slug为空时是新文章编辑器中上传的, 等文章引用后再关联
+// slug不为空时只有文章作者可以上传, 上传后直接关联到文章
+func (uc *MediaUsecase) UploadAttachment(ctx context.Context, slug string, contentType string, data []byte) (*Attachment, error) {
+	currentUser, _ := auth.FromContext(ctx)
+	currentUid := currentUser.UserID
+
+	var aid uint
+	if slug != "" {
+		article, err := uc.ar.GetArticleBySlug(ctx, slug)
+		if err != nil {
+			return nil, err
+		}
+		if !verifyAuthor(ctx, article, currentUid) {
+			return nil, ErrNotArticleAuthor
+		}
+		aid = article.ID
+	}
+
+	img, format, err := decodeUpload(contentType, data, uc.MaxAttachmentBytes(), maxAttachmentPixels)
+	if err != nil {
+		return nil, err
+	}
+	img = imaging.Fit(img, maxAttachmentSide)
+	out, outType, err := imaging.Encode(img, format)
+	if err != nil {
+		return nil, err
+	}
+
+	key := fmt.Sprintf("%s%d/%s.%s", attachmentKeyPrefix, currentUid, randomKey(), imageExtensions[outType])
+	bounds := img.Bounds()
+	// 先占用配额再写文件, 写文件失败时删除记录释放配额
+	a, err := uc.atr.CreateAttachment(ctx, &Attachment{
+		UserID:      currentUid,
+		ArticleID:   aid,
+		Key:         key,
+		URL:         uc.bs.URL(key),
+		ContentType: outType,
+		Size:        int64(len(out)),
+		Width:       bounds.Dx(),
+		Height:      bounds.Dy(),
+	}, uc.attachmentQuota())
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.bs.Put(ctx, key, outType, out); err != nil {
+		uc.log.Errorf("put attachment %s error: %v", key, err)
+		if err := uc.atr.DeleteAttachment(ctx, a.ID); err != nil {
+			uc.log.Errorf("delete attachment %d error: %v", a.ID, err)
+		}
+		return nil, err
+	}
+	return uc.withPreviewURL(a)
+}
+
+// 当前用户还没有被文章引用的附件
+func (uc *MediaUsecase) ListAttachments(ctx context.Context) ([]*Attachment, *AttachmentUsage, error) {
+	currentUser, _ := auth.FromContext(ctx)
+	attachments, err := uc.atr.ListPendingAttachments(ctx, currentUser.UserID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return uc.attachmentsWithUsage(ctx, currentUser.UserID, attachments)
+}
+
+// 文章的附件, 只有作者可以查看
+func (uc *MediaUsecase) ListArticleAttachments(ctx context.Context, slug string) ([]*Attachment, *AttachmentUsage, error) {
+	currentUser, _ := auth.FromContext(ctx)
+	article, err := uc.ar.GetArticleBySlug(ctx, slug)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !verifyAuthor(ctx, article, currentUser.UserID) {
+		return nil, nil, ErrNotArticleAuthor
+	}
+	attachments, err := uc.atr.ListArticleAttachments(ctx, article.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return uc.attachmentsWithUsage(ctx, currentUser.UserID, attachments)
+}
+
+func (uc *MediaUsecase) attachmentsWithUsage(ctx context.Context, uid uint, attachments []*Attachment) ([]*Attachment, *AttachmentUsage, error) {
+	for _, a := range attachments {
+		if _, err := uc.withPreviewURL(a); err != nil {
+			return nil, nil, err
+		}
+	}
+	used, err := uc.atr.GetAttachmentBytes(ctx, uid)
+	if err != nil {
+		return nil, nil, err
+	}
+	return attachments, &AttachmentUsage{UsedBytes: used, QuotaBytes: uc.attachmentQuota()}, nil
+}
+
+// 删除附件 - 只有上传者可以删除
+func (uc *MediaUsecase) DeleteAttachment(ctx context.Context, id uint) error {
+	currentUser, _ := auth.FromContext(ctx)
+	a, err := uc.atr.GetAttachment(ctx, id)
+	if err != nil {
+		return err
+	}
+	if a.UserID != currentUser.UserID {
+		return ForbiddenError("you are not the owner of this attachment")
+	}
+	if err := uc.atr.DeleteAttachment(ctx, id); err != nil {
+		return err
+	}
+	// 记录已经删除, 文件删除失败只会留下无法访问的文件
+	if err := uc.bs.Delete(ctx, a.Key); err != nil {
+		uc.log.Errorf("delete attachment file %s error: %v", a.Key, err)
+	}
+	return nil
+}
+
+// 清理没有被引用的附件, 返回清理的数量
+func (uc *MediaUsecase) CleanupAttachments(ctx context.Context) (int, error) {
+	before := time.Now().Add(-uc.pendingTTL())
+	total := 0
+	for {
+		attachments, err := uc.atr.ListOrphanedAttachments(ctx, before, cleanupBatchSize)
+		if err != nil {
+			return total, err
+		}
+		for _, a := range attachments {
+			// 先删文件, 失败时保留记录, 下次再清理
+			if err := uc.bs.Delete(ctx, a.Key); err != nil {
+				return total, err
+			}
+			if err := uc.atr.DeleteAttachment(ctx, a.ID); err != nil {
+				return total, err
+			}
+			total++
+		}
+		if len(attachments) < cleanupBatchSize {
+			return total, nil
+		}
+	}
+}
+
+// 正文或封面中引用到的附件
+func referencedAttachments(article *Article, attachments []*Attachment) (referenced []*Attachment, unreferenced []*Attachment) {
+	for _, a := range attachments {
+		if article.CoverImage == a.URL || strings.Contains(article.Body, a.URL) {
+			referenced = append(referenced, a)
+		} else {
+			unreferenced = append(unreferenced, a)
+		}
+	}
+	return referenced, unreferenced
+}
+
+// 封面只能使用作者自己上传的附件
+func checkCoverImage(cover string, attachments []*Attachment) error {
+	if cover == "" {
+		return nil
+	}
+	for _, a := range attachments {
+		if a.URL == cover {
+			return nil
+		}
+	}
+	return ValidationError("cover_image", "must be an uploaded attachment")
+}
+
+func attachmentIDs(attachments []*Attachment) []uint {
+	ids := make([]uint, len(attachments))
+	for i, a := range attachments {
+		ids[i] = a.ID
+	}
+	return ids
+}
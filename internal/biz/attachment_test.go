@@ -0,0 +1,141 @@
+package biz
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"kratos-realworld/internal/conf"
+	"kratos-realworld/internal/pkg/middleware/auth"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-playground/assert/v2"
+)
+
+// 内存中的AttachmentRepo
+type memoryAttachments struct {
+	AttachmentRepo
+	list []*Attachment
+	used int64
+}
+
+func (r *memoryAttachments) CreateAttachment(ctx context.Context, a *Attachment, quota int64) (*Attachment, error) {
+	if r.used+a.Size > quota {
+		return nil, errors.Forbidden("ATTACHMENT_QUOTA_EXCEEDED", "attachment storage quota exceeded")
+	}
+	r.used += a.Size
+	a.ID = uint(len(r.list) + 1)
+	r.list = append(r.list, a)
+	return a, nil
+}
+
+func (r *memoryAttachments) GetAttachmentByKey(ctx context.Context, key string) (*Attachment, error) {
+	for _, a := range r.list {
+		if a.Key == key {
+			return a, nil
+		}
+	}
+	return nil, errors.NotFound("ATTACHMENT_NOT_FOUND", "attachment not found")
+}
+
+func TestUploadAttachment(t *testing.T) {
+	ctx := auth.WithContext(context.Background(), &auth.CurrentUser{UserID: 7})
+	blobs := memoryBlobs{}
+	attachments := &memoryAttachments{}
+	uc := NewMediaUsecase(blobs, attachments, nil, &conf.Media{}, log.DefaultLogger)
+
+	a, err := uc.UploadAttachment(ctx, "", "image/png", encodePNG(t, 3000, 1500))
+	assert.Equal(t, nil, err)
+	assert.Equal(t, uint(0), a.ArticleID)
+	// 超过最大边长时等比缩小
+	assert.Equal(t, maxAttachmentSide, a.Width)
+	assert.Equal(t, maxAttachmentSide/2, a.Height)
+	assert.Equal(t, int64(len(blobs[a.Key].Data)), a.Size)
+	assert.Equal(t, "/media/"+a.Key, a.URL)
+	// 未发布的附件预览使用签名url
+	assert.NotEqual(t, a.URL, a.PreviewURL)
+}
+
+func TestUploadAttachmentQuota(t *testing.T) {
+	ctx := auth.WithContext(context.Background(), &auth.CurrentUser{UserID: 7})
+	blobs := memoryBlobs{}
+	attachments := &memoryAttachments{}
+	uc := NewMediaUsecase(blobs, attachments, nil, &conf.Media{Attachment: &conf.Media_Attachment{QuotaBytes: 100}}, log.DefaultLogger)
+
+	_, err := uc.UploadAttachment(ctx, "", "image/png", encodePNG(t, 64, 64))
+	assert.Equal(t, true, errors.IsForbidden(err))
+	// 超过配额时不写文件
+	assert.Equal(t, 0, len(blobs))
+}
+
+func TestGetMediaPendingAttachment(t *testing.T) {
+	ctx := context.Background()
+	blobs := memoryBlobs{}
+	attachments := &memoryAttachments{}
+	uc := NewMediaUsecase(blobs, attachments, nil, &conf.Media{}, log.DefaultLogger)
+
+	a, err := uc.UploadAttachment(auth.WithContext(ctx, &auth.CurrentUser{UserID: 7}), "", "image/png", encodePNG(t, 8, 8))
+	assert.Equal(t, nil, err)
+
+	// 未发布的附件只能通过签名访问
+	_, err = uc.GetMedia(ctx, a.Key, "", "")
+	assert.Equal(t, true, errors.IsNotFound(err))
+	expires := strconv.FormatInt(time.Now().Add(time.Minute).Unix(), 10)
+	blob, err := uc.GetMedia(ctx, a.Key, expires, a.Key)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, false, blob.Expires.IsZero())
+
+	_, err = uc.GetMedia(ctx, a.Key, expires, "forged")
+	assert.Equal(t, true, errors.IsForbidden(err))
+	expired := strconv.FormatInt(time.Now().Add(-time.Minute).Unix(), 10)
+	_, err = uc.GetMedia(ctx, a.Key, expired, a.Key)
+	assert.Equal(t, true, errors.IsForbidden(err))
+
+	// 关联到文章后可以公开访问
+	a.ArticleID = 1
+	blob, err = uc.GetMedia(ctx, a.Key, "", "")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, true, blob.Expires.IsZero())
+}
+
+func TestGetMediaRedirect(t *testing.T) {
+	ctx := context.Background()
+	blobs := redirectBlobs{memoryBlobs{}}
+	uc := NewMediaUsecase(blobs, &memoryAttachments{}, nil, &conf.Media{}, log.DefaultLogger)
+
+	a, err := uc.UploadAttachment(auth.WithContext(ctx, &auth.CurrentUser{UserID: 7}), "", "image/png", encodePNG(t, 8, 8))
+	assert.Equal(t, nil, err)
+	preview, err := url.Parse(a.PreviewURL)
+	assert.Equal(t, nil, err)
+
+	// 签名校验通过后跳转, 不读取文件内容
+	expires := preview.Query().Get("expires")
+	blob, err := uc.GetMedia(ctx, a.Key, expires, preview.Query().Get("signature"))
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "https://bucket.example.com/"+a.Key+"?X-Amz-Expires="+expires, blob.RedirectURL)
+	assert.Equal(t, 0, len(blob.Data))
+	_, err = uc.GetMedia(ctx, a.Key, expires, "forged")
+	assert.Equal(t, true, errors.IsForbidden(err))
+}
+
+func TestReferencedAttachments(t *testing.T) {
+	attachments := []*Attachment{
+		{ID: 1, URL: "/media/attachments/7/a.png"},
+		{ID: 2, URL: "/media/attachments/7/b.png"},
+		{ID: 3, URL: "/media/attachments/7/c.png"},
+	}
+	article := &Article{
+		Body:       "before ![](/media/attachments/7/a.png) after",
+		CoverImage: "/media/attachments/7/c.png",
+	}
+	referenced, unreferenced := referencedAttachments(article, attachments)
+	assert.Equal(t, []uint{1, 3}, attachmentIDs(referenced))
+	assert.Equal(t, []uint{2}, attachmentIDs(unreferenced))
+
+	assert.Equal(t, nil, checkCoverImage("", attachments))
+	assert.Equal(t, nil, checkCoverImage("/media/attachments/7/b.png", attachments))
+	assert.Equal(t, 422, errors.Code(checkCoverImage("https://example.com/x.png", attachments)))
+}
@@ -1,9 +1,19 @@
 package biz
 
-import "github.com/google/wire"
+import (
+	"context"
+
+	"github.com/google/wire"
+)
 
 // ProviderSet is biz providers.
-var ProviderSet = wire.NewSet(NewUserUsecase, NewSocialUsecase)
+var ProviderSet = wire.NewSet(NewUserUsecase, NewSocialUsecase, NewMediaUsecase, NewPasswordPolicy, NewEventBus, NewNotificationUsecase)
+
+// 事务 - data层把事务放进ctx, fn中用这个ctx调用的repo都在同一个事务中
+// fn返回错误时回滚, 嵌套调用时加入外层事务
+type Transaction interface {
+	Transaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
 
 // 业务逻辑相关
 /*
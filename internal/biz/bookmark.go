@@ -0,0 +1,179 @@
+package biz
+
+import (
+	"context"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"kratos-realworld/internal/pkg/middleware/auth"
+)
+
+// 书签 - 稍后阅读列表, 只有自己可见, 不影响收藏数
+// 书签可以放入一个命名的收藏夹, 也可以不放
+
+type BookmarkCollection struct {
+	ID             uint
+	UserID         uint
+	Name           string
+	BookmarksCount uint32
+	CreatedAt      time.Time
+}
+
+type ArticlePage struct {
+	Articles   []*Article
+	NextCursor string
+}
+
+type BookmarkRepo interface {
+	// collectionID为0表示不放入收藏夹; 已经加入书签时移动到新的收藏夹
+	CreateBookmark(ctx context.Context, uid uint, aid uint, collectionID uint) error
+	DeleteBookmark(ctx context.Context, uid uint, aid uint) error
+	// 按加入书签的时间倒序, cursor为上一页最后一个书签的id; collectionID为0时列出全部
+	ListBookmarks(ctx context.Context, uid uint, collectionID uint, cursor uint, limit int) ([]*Article, uint, error)
+
+	CreateCollection(ctx context.Context, uid uint, name string) (*BookmarkCollection, error)
+	GetCollection(ctx context.Context, id uint) (*BookmarkCollection, error)
+	ListCollections(ctx context.Context, uid uint) ([]*BookmarkCollection, error)
+	RenameCollection(ctx context.Context, id uint, name string) (*BookmarkCollection, error)
+	// 收藏夹中的书签保留, 移出收藏夹
+	DeleteCollection(ctx context.Context, id uint) error
+}
+
+const maxCollectionNameLength = 50
+
+func (uc *SocialUsecase) BookmarkArticle(ctx context.Context, slug string, collectionID uint) (*Article, error) {
+	currentUser, _ := auth.FromContext(ctx)
+	currentUid := currentUser.UserID
+
+	a, err := uc.ar.GetArticleBySlug(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.checkArticleVisible(ctx, a); err != nil {
+		return nil, err
+	}
+	if collectionID > 0 {
+		if _, err := uc.getOwnCollection(ctx, currentUid, collectionID); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := uc.br.CreateBookmark(ctx, currentUid, a.ID, collectionID); err != nil {
+		return nil, err
+	}
+	return uc.withViewerState(ctx, a, currentUid)
+}
+
+func (uc *SocialUsecase) UnbookmarkArticle(ctx context.Context, slug string) (*Article, error) {
+	currentUser, _ := auth.FromContext(ctx)
+	currentUid := currentUser.UserID
+
+	a, err := uc.ar.GetArticleBySlug(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.br.DeleteBookmark(ctx, currentUid, a.ID); err != nil {
+		return nil, err
+	}
+	return uc.withViewerState(ctx, a, currentUid)
+}
+
+// 单篇文章的收藏, 书签, 回应和关注作者状态
+func (uc *SocialUsecase) withViewerState(ctx context.Context, a *Article, currentUid uint) (*Article, error) {
+	articles := []*Article{a}
+	articles, err := uc.getArticleViewerStates(ctx, articles, currentUid)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := uc.getArticleReactions(ctx, articles, currentUid); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// 书签列表 - 拉黑和不可见的私密账号的文章不出现
+func (uc *SocialUsecase) ListBookmarks(ctx context.Context, collectionID uint, cursor string, limit int64) (*ArticlePage, error) {
+	after, err := decodeCursor(cursor)
+	if err != nil {
+		return nil, err
+	}
+	currentUser, _ := auth.FromContext(ctx)
+	currentUid := currentUser.UserID
+	if collectionID > 0 {
+		if _, err := uc.getOwnCollection(ctx, currentUid, collectionID); err != nil {
+			return nil, err
+		}
+	}
+
+	articles, next, err := uc.br.ListBookmarks(ctx, currentUid, collectionID, after, pageSize(limit))
+	if err != nil {
+		return nil, err
+	}
+	articles, err = uc.getArticleViewerStates(ctx, articles, currentUid)
+	if err != nil {
+		return nil, err
+	}
+	articles, err = uc.getArticleReactions(ctx, articles, currentUid)
+	if err != nil {
+		return nil, err
+	}
+	return &ArticlePage{Articles: articles, NextCursor: encodeCursor(next)}, nil
+}
+
+func (uc *SocialUsecase) ListBookmarkCollections(ctx context.Context) ([]*BookmarkCollection, error) {
+	currentUser, _ := auth.FromContext(ctx)
+	return uc.br.ListCollections(ctx, currentUser.UserID)
+}
+
+func (uc *SocialUsecase) CreateBookmarkCollection(ctx context.Context, name string) (*BookmarkCollection, error) {
+	name, err := collectionName(name)
+	if err != nil {
+		return nil, err
+	}
+	currentUser, _ := auth.FromContext(ctx)
+	return uc.br.CreateCollection(ctx, currentUser.UserID, name)
+}
+
+func (uc *SocialUsecase) RenameBookmarkCollection(ctx context.Context, id uint, name string) (*BookmarkCollection, error) {
+	name, err := collectionName(name)
+	if err != nil {
+		return nil, err
+	}
+	currentUser, _ := auth.FromContext(ctx)
+	if _, err := uc.getOwnCollection(ctx, currentUser.UserID, id); err != nil {
+		return nil, err
+	}
+	return uc.br.RenameCollection(ctx, id, name)
+}
+
+func (uc *SocialUsecase) DeleteBookmarkCollection(ctx context.Context, id uint) error {
+	currentUser, _ := auth.FromContext(ctx)
+	if _, err := uc.getOwnCollection(ctx, currentUser.UserID, id); err != nil {
+		return err
+	}
+	return uc.br.DeleteCollection(ctx, id)
+}
+
+// 别人的收藏夹按不存在处理
+func (uc *SocialUsecase) getOwnCollection(ctx context.Context, uid uint, id uint) (*BookmarkCollection, error) {
+	c, err := uc.br.GetCollection(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if c.UserID != uid {
+		return nil, ErrCollectionNotFound
+	}
+	return c, nil
+}
+
+func collectionName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", ValidationError("name", "can not be empty")
+	}
+	if utf8.RuneCountInString(name) > maxCollectionNameLength {
+		return "", ValidationError("name", "is too long")
+	}
+	return name, nil
+}
@@ -0,0 +1,79 @@
+package biz
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"kratos-realworld/internal/pkg/middleware/auth"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-playground/assert/v2"
+)
+
+// 内存中的BookmarkRepo, 只实现收藏夹部分
+type memoryBookmarks struct {
+	BookmarkRepo
+	collections []*BookmarkCollection
+}
+
+func (r *memoryBookmarks) CreateCollection(ctx context.Context, uid uint, name string) (*BookmarkCollection, error) {
+	c := &BookmarkCollection{ID: uint(len(r.collections) + 1), UserID: uid, Name: name}
+	r.collections = append(r.collections, c)
+	return c, nil
+}
+
+func (r *memoryBookmarks) GetCollection(ctx context.Context, id uint) (*BookmarkCollection, error) {
+	for _, c := range r.collections {
+		if c.ID == id {
+			return c, nil
+		}
+	}
+	return nil, errors.NotFound("COLLECTION_NOT_FOUND", "collection not found")
+}
+
+func (r *memoryBookmarks) RenameCollection(ctx context.Context, id uint, name string) (*BookmarkCollection, error) {
+	c, err := r.GetCollection(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	c.Name = name
+	return c, nil
+}
+
+func TestCollectionName(t *testing.T) {
+	name, err := collectionName("  reading list ")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "reading list", name)
+
+	_, err = collectionName("   ")
+	assert.Equal(t, int32(422), errors.FromError(err).Code)
+
+	// 按字符而不是字节计算长度
+	_, err = collectionName(strings.Repeat("书", maxCollectionNameLength))
+	assert.Equal(t, nil, err)
+	_, err = collectionName(strings.Repeat("a", maxCollectionNameLength+1))
+	assert.Equal(t, int32(422), errors.FromError(err).Code)
+}
+
+func TestRenameBookmarkCollection(t *testing.T) {
+	bookmarks := &memoryBookmarks{}
+	uc := NewSocialUsecase(nil, nil, nil, nil, nil, bookmarks, nil, nil, nil, nil, log.DefaultLogger)
+	owner := auth.WithContext(context.Background(), &auth.CurrentUser{UserID: 1})
+	other := auth.WithContext(context.Background(), &auth.CurrentUser{UserID: 2})
+
+	c, err := uc.CreateBookmarkCollection(owner, "later")
+	assert.Equal(t, nil, err)
+
+	c, err = uc.RenameBookmarkCollection(owner, c.ID, " soon ")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "soon", c.Name)
+
+	// 别人的收藏夹按不存在处理
+	_, err = uc.RenameBookmarkCollection(other, c.ID, "mine")
+	assert.Equal(t, true, errors.IsNotFound(err))
+	err = uc.DeleteBookmarkCollection(other, c.ID)
+	assert.Equal(t, true, errors.IsNotFound(err))
+	assert.Equal(t, "soon", bookmarks.collections[0].Name)
+}
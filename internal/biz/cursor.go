@@ -0,0 +1,47 @@
+package biz
+
+import (
+	"encoding/base64"
+	"strconv"
+)
+
+// 游标分页
+// 对外是不透明的字符串, 内部是上一页最后一条记录的id, 下一页从比它小的id开始
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+func pageSize(limit int64) int {
+	if limit <= 0 {
+		return defaultPageSize
+	}
+	if limit > maxPageSize {
+		return maxPageSize
+	}
+	return int(limit)
+}
+
+// id为0表示没有下一页
+func encodeCursor(id uint) string {
+	if id == 0 {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatUint(uint64(id), 10)))
+}
+
+func decodeCursor(cursor string) (uint, error) {
+	if cursor == "" {
+		return 0, nil
+	}
+	b, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, ValidationError("cursor", "is invalid")
+	}
+	id, err := strconv.ParseUint(string(b), 10, 64)
+	if err != nil || id == 0 {
+		return 0, ValidationError("cursor", "is invalid")
+	}
+	return uint(id), nil
+}
@@ -0,0 +1,28 @@
+package biz
+
+import (
+	"testing"
+
+	"github.com/go-playground/assert/v2"
+)
+
+func TestCursor(t *testing.T) {
+	assert.Equal(t, "", encodeCursor(0))
+
+	id, err := decodeCursor(encodeCursor(42))
+	assert.Equal(t, nil, err)
+	assert.Equal(t, uint(42), id)
+
+	id, err = decodeCursor("")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, uint(0), id)
+
+	_, err = decodeCursor("not a cursor!")
+	assert.NotEqual(t, nil, err)
+}
+
+func TestPageSize(t *testing.T) {
+	assert.Equal(t, defaultPageSize, pageSize(0))
+	assert.Equal(t, 5, pageSize(5))
+	assert.Equal(t, maxPageSize, pageSize(1000))
+}
@@ -0,0 +1,61 @@
+package biz
+
+import (
+	v1 "kratos-realworld/api/realworld/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// 领域错误 - reason定义在api/realworld/v1/error_reason.proto
+// errors.Is只比较reason和状态码, 返回时可以用WithMetadata等方法换掉message
+var (
+	ErrUserNotFound          = v1.ErrorUserNotFound("user not found")
+	ErrInvalidCredentials    = v1.ErrorInvalidCredentials("is invalid").WithMetadata(map[string]string{"field": "email or password"})
+	ErrNotArticleAuthor      = v1.ErrorForbidden("you are not the author of this article")
+	ErrArticleNotFound       = v1.ErrorArticleNotFound("article not found")
+	ErrCommentNotFound       = v1.ErrorCommentNotFound("comment not found")
+	ErrTagNotFound           = v1.ErrorTagNotFound("tag not found")
+	ErrAliasNotFound         = v1.ErrorAliasNotFound("alias not found")
+	ErrCollectionNotFound    = v1.ErrorCollectionNotFound("collection not found")
+	ErrAttachmentNotFound    = v1.ErrorAttachmentNotFound("attachment not found")
+	ErrMediaNotFound         = v1.ErrorMediaNotFound("media not found")
+	ErrFollowRequestNotFound = v1.ErrorFollowRequestNotFound("follow request not found")
+	ErrNotificationNotFound  = v1.ErrorNotificationNotFound("notification not found")
+	ErrFollowSelf            = v1.ErrorFollowSelf("cannot follow yourself")
+	ErrFollowExists          = v1.ErrorFollowExists("already followed")
+	ErrFollowNotFound        = v1.ErrorFollowNotFound("not followed")
+	ErrFollowRequestExists   = v1.ErrorFollowRequestExists("already requested")
+	ErrBlockSelf             = v1.ErrorBlockSelf("cannot block yourself")
+	ErrBlockExists           = v1.ErrorBlockExists("already blocked")
+	ErrBlockNotFound         = v1.ErrorBlockNotFound("not blocked")
+	ErrMuteSelf              = v1.ErrorMuteSelf("cannot mute yourself")
+	ErrMuteExists            = v1.ErrorMuteExists("already muted")
+	ErrMuteNotFound          = v1.ErrorMuteNotFound("not muted")
+	ErrPlaceholderUser       = v1.ErrorPlaceholderUser("placeholder user can not be deleted")
+	ErrPlaceholderTaken      = v1.ErrorPlaceholderTaken("placeholder username is used by a regular user")
+	ErrAttachmentQuota       = v1.ErrorAttachmentQuotaExceeded("attachment storage quota exceeded")
+	ErrMediaKeyInvalid       = v1.ErrorMediaKeyInvalid("invalid media key")
+	ErrMediaSignatureInvalid = v1.ErrorMediaSignatureInvalid("invalid media signature")
+	ErrMediaSignatureExpired = v1.ErrorMediaSignatureExpired("media url has expired")
+	ErrInternal              = v1.ErrorInternal("internal server error")
+)
+
+// 字段校验失败, 响应为{"errors":{field:[message]}}
+func ValidationError(field string, format string, args ...interface{}) *errors.Error {
+	return v1.ErrorValidationFailed(format, args...).WithMetadata(map[string]string{"field": field})
+}
+
+// 字段已被占用 - 违反唯一约束时使用
+func TakenError(field string) *errors.Error {
+	return ValidationError(field, "has already been taken")
+}
+
+// 被对方拉黑时不能执行的操作
+func BlockedError(action string) *errors.Error {
+	return v1.ErrorBlocked("you can not %s", action)
+}
+
+// 没有权限, 例如不是文章作者或不是管理员
+func ForbiddenError(format string, args ...interface{}) *errors.Error {
+	return v1.ErrorForbidden(format, args...)
+}
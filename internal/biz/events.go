@@ -0,0 +1,293 @@
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"kratos-realworld/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	defaultEventPollInterval    = time.Second
+	defaultEventBatchSize       = 100
+	defaultEventLease           = time.Minute
+	defaultEventMaxAttempts     = 10
+	defaultEventRetryBackoff    = time.Second
+	defaultEventMaxRetryBackoff = 10 * time.Minute
+)
+
+// 领域事件 - 在业务的事务中写入outbox, 提交后由EventBus投递给订阅者
+// 至少投递一次, 订阅者需要能处理重复的事件
+type Event interface {
+	// 事件类型, 写入outbox后不能修改
+	EventType() string
+}
+
+// 发布了文章
+type ArticlePublished struct {
+	ArticleID uint   `json:"article_id"`
+	AuthorID  uint   `json:"author_id"`
+	Slug      string `json:"slug"`
+	Title     string `json:"title"`
+}
+
+func (ArticlePublished) EventType() string { return "article.published" }
+
+// 收藏了文章, 重复收藏不产生事件
+type ArticleFavorited struct {
+	ArticleID uint `json:"article_id"`
+	AuthorID  uint `json:"author_id"`
+	UserID    uint `json:"user_id"`
+}
+
+func (ArticleFavorited) EventType() string { return "article.favorited" }
+
+// 添加了评论
+type CommentAdded struct {
+	CommentID       uint   `json:"comment_id"`
+	ArticleID       uint   `json:"article_id"`
+	ArticleAuthorID uint   `json:"article_author_id"`
+	AuthorID        uint   `json:"author_id"`
+	Body            string `json:"body"`
+}
+
+func (CommentAdded) EventType() string { return "comment.added" }
+
+// 开始关注用户, 包括关注申请被同意
+type UserFollowed struct {
+	FollowerID  uint `json:"follower_id"`
+	FollowingID uint `json:"following_id"`
+}
+
+func (UserFollowed) EventType() string { return "user.followed" }
+
+// outbox中事件的状态
+const (
+	EventPending   = "pending"
+	EventDelivered = "delivered"
+	// 超过最大次数仍然投递失败, 不再重试
+	EventDead = "dead"
+)
+
+// outbox中的一条事件
+type OutboxEvent struct {
+	ID      uint
+	Type    string
+	Payload []byte
+	Status  string
+	// 已经投递的次数
+	Attempts int
+	// 到这个时间后才能被领取
+	NextAttemptAt time.Time
+	// 已经处理成功的订阅者, 重试时跳过
+	Delivered []string
+	LastError string
+	CreatedAt time.Time
+}
+
+type OutboxRepo interface {
+	// 使用ctx中的事务写入, 和业务数据一起提交或回滚
+	AddEvents(ctx context.Context, events []*OutboxEvent) error
+	// 领取最多limit个到期的待投递事件, 按id顺序; 领取后lease时间内不会再被领取
+	ClaimEvents(ctx context.Context, now time.Time, limit int, lease time.Duration) ([]*OutboxEvent, error)
+	// 保存投递的结果
+	UpdateEvent(ctx context.Context, e *OutboxEvent) error
+	// 有新的事件提交时收到通知, 多次提交可能只通知一次
+	Notify() <-chan struct{}
+}
+
+// 一个订阅者, 收到事件的payload
+type eventSubscriber struct {
+	name   string
+	handle func(ctx context.Context, payload []byte) error
+}
+
+// 事件总线 - usecase在事务中Publish, 后台任务调用Dispatch投递给订阅者
+// 每个订阅者单独记录投递结果, 部分失败时只重试失败的订阅者
+type EventBus struct {
+	repo OutboxRepo
+	log  *log.Helper
+
+	mu          sync.RWMutex
+	subscribers map[string][]eventSubscriber
+
+	pollInterval    time.Duration
+	batchSize       int
+	lease           time.Duration
+	maxAttempts     int
+	retryBackoff    time.Duration
+	maxRetryBackoff time.Duration
+	now             func() time.Time
+}
+
+func NewEventBus(repo OutboxRepo, ec *conf.Events, logger log.Logger) *EventBus {
+	b := &EventBus{
+		repo:            repo,
+		log:             log.NewHelper(logger),
+		subscribers:     make(map[string][]eventSubscriber),
+		pollInterval:    defaultEventPollInterval,
+		batchSize:       defaultEventBatchSize,
+		lease:           defaultEventLease,
+		maxAttempts:     defaultEventMaxAttempts,
+		retryBackoff:    defaultEventRetryBackoff,
+		maxRetryBackoff: defaultEventMaxRetryBackoff,
+		now:             time.Now,
+	}
+	if d := ec.GetPollInterval().AsDuration(); d > 0 {
+		b.pollInterval = d
+	}
+	if n := ec.GetBatchSize(); n > 0 {
+		b.batchSize = int(n)
+	}
+	if d := ec.GetLease().AsDuration(); d > 0 {
+		b.lease = d
+	}
+	if n := ec.GetMaxAttempts(); n > 0 {
+		b.maxAttempts = int(n)
+	}
+	if d := ec.GetRetryBackoff().AsDuration(); d > 0 {
+		b.retryBackoff = d
+	}
+	if d := ec.GetMaxRetryBackoff().AsDuration(); d > 0 {
+		b.maxRetryBackoff = d
+	}
+	return b
+}
+
+// 订阅T类型的事件, name用来记录投递结果, 同一类型下不能重复
+// 需要在Dispatch开始前订阅
+func Subscribe[T Event](b *EventBus, name string, handle func(ctx context.Context, e T) error) {
+	var zero T
+	eventType := zero.EventType()
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for _, s := range b.subscribers[eventType] {
+		if s.name == name {
+			panic(fmt.Sprintf("duplicate subscriber %q for event %s", name, eventType))
+		}
+	}
+	b.subscribers[eventType] = append(b.subscribers[eventType], eventSubscriber{
+		name: name,
+		handle: func(ctx context.Context, payload []byte) error {
+			var e T
+			if err := json.Unmarshal(payload, &e); err != nil {
+				return err
+			}
+			return handle(ctx, e)
+		},
+	})
+}
+
+// 写入outbox, 需要在业务的事务中调用; b为nil时不记录事件
+func (b *EventBus) Publish(ctx context.Context, events ...Event) error {
+	if b == nil || len(events) == 0 {
+		return nil
+	}
+	now := b.now()
+	list := make([]*OutboxEvent, len(events))
+	for i, e := range events {
+		payload, err := json.Marshal(e)
+		if err != nil {
+			return err
+		}
+		list[i] = &OutboxEvent{
+			Type:          e.EventType(),
+			Payload:       payload,
+			Status:        EventPending,
+			NextAttemptAt: now,
+			CreatedAt:     now,
+		}
+	}
+	return b.repo.AddEvents(ctx, list)
+}
+
+func (b *EventBus) PollInterval() time.Duration {
+	return b.pollInterval
+}
+
+// 有新事件提交时的通知, 用来立即开始投递
+func (b *EventBus) Notify() <-chan struct{} {
+	return b.repo.Notify()
+}
+
+// 投递所有到期的事件, 直到没有可以领取的; 返回处理的事件数
+func (b *EventBus) Dispatch(ctx context.Context) (int, error) {
+	n := 0
+	for ctx.Err() == nil {
+		events, err := b.repo.ClaimEvents(ctx, b.now(), b.batchSize, b.lease)
+		if err != nil {
+			return n, err
+		}
+		for _, e := range events {
+			b.deliver(ctx, e)
+			// 保存失败时租期过后重新投递
+			if err := b.repo.UpdateEvent(ctx, e); err != nil {
+				return n, err
+			}
+			n++
+		}
+		if len(events) < b.batchSize {
+			break
+		}
+	}
+	return n, ctx.Err()
+}
+
+// 投递给还没有处理成功的订阅者, 结果记录在e中
+func (b *EventBus) deliver(ctx context.Context, e *OutboxEvent) {
+	b.mu.RLock()
+	subscribers := b.subscribers[e.Type]
+	b.mu.RUnlock()
+
+	var errs []string
+	for _, s := range subscribers {
+		if slices.Contains(e.Delivered, s.name) {
+			continue
+		}
+		if err := b.handle(ctx, s, e); err != nil {
+			errs = append(errs, s.name+": "+err.Error())
+			continue
+		}
+		e.Delivered = append(e.Delivered, s.name)
+	}
+	e.Attempts++
+	if len(errs) == 0 {
+		e.Status = EventDelivered
+		e.LastError = ""
+		return
+	}
+	e.LastError = strings.Join(errs, "; ")
+	if e.Attempts >= b.maxAttempts {
+		e.Status = EventDead
+		b.log.WithContext(ctx).Errorf("event %d (%s) dead after %d attempts: %s", e.ID, e.Type, e.Attempts, e.LastError)
+		return
+	}
+	e.NextAttemptAt = b.now().Add(b.retryDelay(e.Attempts))
+	b.log.WithContext(ctx).Warnf("event %d (%s) attempt %d failed: %s", e.ID, e.Type, e.Attempts, e.LastError)
+}
+
+// 订阅者panic时按失败处理, 不影响其他事件
+func (b *EventBus) handle(ctx context.Context, s eventSubscriber, e *OutboxEvent) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	return s.handle(ctx, e.Payload)
+}
+
+// 第attempts次失败后的重试间隔, 指数增长到上限为止
+func (b *EventBus) retryDelay(attempts int) time.Duration {
+	d := b.retryBackoff
+	for i := 1; i < attempts && d < b.maxRetryBackoff; i++ {
+		d *= 2
+	}
+	return min(d, b.maxRetryBackoff)
+}
@@ -0,0 +1,196 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"kratos-realworld/internal/conf"
+	"kratos-realworld/internal/pkg/middleware/auth"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-playground/assert/v2"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// 内存中的outbox, 领取时不考虑租期
+type memoryOutbox struct {
+	events []*OutboxEvent
+	// 每次写入时是否在事务中
+	inTx []bool
+}
+
+func (r *memoryOutbox) AddEvents(ctx context.Context, events []*OutboxEvent) error {
+	for _, e := range events {
+		e.ID = uint(len(r.events) + 1)
+		r.events = append(r.events, e)
+	}
+	r.inTx = append(r.inTx, inTx(ctx))
+	return nil
+}
+
+func (r *memoryOutbox) ClaimEvents(ctx context.Context, now time.Time, limit int, lease time.Duration) ([]*OutboxEvent, error) {
+	var events []*OutboxEvent
+	for _, e := range r.events {
+		if e.Status == EventPending && !e.NextAttemptAt.After(now) && len(events) < limit {
+			claimed := *e
+			events = append(events, &claimed)
+			e.NextAttemptAt = now.Add(lease)
+		}
+	}
+	return events, nil
+}
+
+func (r *memoryOutbox) UpdateEvent(ctx context.Context, e *OutboxEvent) error {
+	*r.events[e.ID-1] = *e
+	return nil
+}
+
+func (r *memoryOutbox) Notify() <-chan struct{} {
+	return nil
+}
+
+func newTestEventBus(outbox OutboxRepo, now *time.Time) *EventBus {
+	b := NewEventBus(outbox, &conf.Events{
+		MaxAttempts:     3,
+		RetryBackoff:    durationpb.New(time.Second),
+		MaxRetryBackoff: durationpb.New(90 * time.Second),
+	}, log.DefaultLogger)
+	b.now = func() time.Time { return *now }
+	return b
+}
+
+func TestPublishInTransaction(t *testing.T) {
+	ctx := auth.WithContext(context.Background(), &auth.CurrentUser{UserID: 7})
+	now := time.Now()
+	outbox := &memoryOutbox{}
+	uc := NewSocialUsecase(&txArticles{}, nil, nil, nil, &txAttachments{}, nil, nil, &memoryTx{}, newTestEventBus(outbox, &now), nil, log.DefaultLogger)
+
+	_, err := uc.CreateArticle(ctx, &Article{Title: "Hello World"})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, []bool{true}, outbox.inTx)
+	assert.Equal(t, 1, len(outbox.events))
+	assert.Equal(t, "article.published", outbox.events[0].Type)
+	assert.Equal(t, EventPending, outbox.events[0].Status)
+	assert.Equal(t, `{"article_id":1,"author_id":7,"slug":"hello-world","title":"Hello World"}`, string(outbox.events[0].Payload))
+}
+
+// 每个用户只有第一次收藏是新增的
+type favoriteArticles struct {
+	ArticleRepo
+	favorited map[uint]bool
+}
+
+func (r *favoriteArticles) GetArticleBySlug(ctx context.Context, slug string) (*Article, error) {
+	return &Article{ID: 1, Slug: slug, AuthorID: 3}, nil
+}
+
+func (r *favoriteArticles) GetArticleByAid(ctx context.Context, aid uint) (*Article, error) {
+	return &Article{ID: aid, AuthorID: 3}, nil
+}
+
+func (r *favoriteArticles) FavoriteArticle(ctx context.Context, aid uint, uid uint) (bool, error) {
+	added := !r.favorited[uid]
+	r.favorited[uid] = true
+	return added, nil
+}
+
+func (r *favoriteArticles) GetArticleViewerStates(ctx context.Context, aids []uint, uid uint) (map[uint]ArticleViewerState, error) {
+	return map[uint]ArticleViewerState{aids[0]: {Favorited: r.favorited[uid]}}, nil
+}
+
+// 重复收藏不再发布事件
+func TestFavoritePublishesOnce(t *testing.T) {
+	ctx := auth.WithContext(context.Background(), &auth.CurrentUser{UserID: 7})
+	now := time.Now()
+	outbox := &memoryOutbox{}
+	uc := NewSocialUsecase(&favoriteArticles{favorited: make(map[uint]bool)}, nil, nil, &stubNotifyProfiles{}, nil, nil, &stubReactions{}, &memoryTx{}, newTestEventBus(outbox, &now), nil, log.DefaultLogger)
+
+	for i := 0; i < 2; i++ {
+		a, err := uc.FavoriteArticle(ctx, "hello")
+		assert.Equal(t, nil, err)
+		assert.Equal(t, true, a.Favorited)
+	}
+	assert.Equal(t, 1, len(outbox.events))
+	assert.Equal(t, "article.favorited", outbox.events[0].Type)
+}
+
+func TestDispatchRetriesFailedSubscribers(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	outbox := &memoryOutbox{}
+	b := newTestEventBus(outbox, &now)
+
+	var indexed, notified []UserFollowed
+	Subscribe(b, "search", func(ctx context.Context, e UserFollowed) error {
+		indexed = append(indexed, e)
+		return nil
+	})
+	Subscribe(b, "notifications", func(ctx context.Context, e UserFollowed) error {
+		notified = append(notified, e)
+		if len(notified) < 3 {
+			return errors.New("unavailable")
+		}
+		return nil
+	})
+	assert.Equal(t, nil, b.Publish(ctx, UserFollowed{FollowerID: 1, FollowingID: 2}))
+
+	// 失败后按1s, 2s的间隔重试, 成功的订阅者不再收到
+	n, err := b.Dispatch(ctx)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 1, n)
+	e := outbox.events[0]
+	assert.Equal(t, EventPending, e.Status)
+	assert.Equal(t, []string{"search"}, e.Delivered)
+	assert.Equal(t, "notifications: unavailable", e.LastError)
+	assert.Equal(t, now.Add(time.Second), e.NextAttemptAt)
+
+	n, _ = b.Dispatch(ctx)
+	assert.Equal(t, 0, n)
+	now = now.Add(time.Second)
+	b.Dispatch(ctx)
+	assert.Equal(t, now.Add(2*time.Second), e.NextAttemptAt)
+	now = now.Add(2 * time.Second)
+	b.Dispatch(ctx)
+
+	assert.Equal(t, EventDelivered, e.Status)
+	assert.Equal(t, 3, e.Attempts)
+	assert.Equal(t, "", e.LastError)
+	assert.Equal(t, []UserFollowed{{FollowerID: 1, FollowingID: 2}}, indexed)
+	assert.Equal(t, 3, len(notified))
+}
+
+func TestDispatchDeadLetter(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	outbox := &memoryOutbox{}
+	b := newTestEventBus(outbox, &now)
+
+	// panic按失败处理
+	calls := 0
+	Subscribe(b, "webhooks", func(ctx context.Context, e CommentAdded) error {
+		calls++
+		panic("boom")
+	})
+	assert.Equal(t, nil, b.Publish(ctx, CommentAdded{CommentID: 1}, ArticlePublished{ArticleID: 1}))
+
+	for i := 0; i < 5; i++ {
+		b.Dispatch(ctx)
+		now = now.Add(time.Hour)
+	}
+	assert.Equal(t, 3, calls)
+	assert.Equal(t, EventDead, outbox.events[0].Status)
+	assert.Equal(t, "webhooks: panic: boom", outbox.events[0].LastError)
+	// 没有订阅者的事件直接完成
+	assert.Equal(t, EventDelivered, outbox.events[1].Status)
+}
+
+func TestRetryDelay(t *testing.T) {
+	now := time.Now()
+	b := newTestEventBus(&memoryOutbox{}, &now)
+	assert.Equal(t, time.Second, b.retryDelay(1))
+	assert.Equal(t, 4*time.Second, b.retryDelay(3))
+	assert.Equal(t, 90*time.Second, b.retryDelay(8))
+	assert.Equal(t, 90*time.Second, b.retryDelay(100))
+}
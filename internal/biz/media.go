@@ -0,0 +1,210 @@
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"image"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"kratos-realworld/internal/conf"
+	"kratos-realworld/internal/pkg/imaging"
+	"kratos-realworld/internal/pkg/middleware/auth"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 文件存储 - 本地文件系统或S3兼容的对象存储, key使用/分隔
+type BlobStore interface {
+	Put(ctx context.Context, key string, contentType string, data []byte) error
+	Get(ctx context.Context, key string) (*Blob, error)
+	Delete(ctx context.Context, key string) error
+	// 对外访问的url
+	URL(key string) string
+	// 带过期时间的签名url, 用于还不能公开访问的文件
+	SignedURL(key string, expires time.Time) (string, error)
+	// 校验签名url中的签名, 是否过期由调用方判断
+	VerifySignature(key string, expires time.Time, signature string) bool
+	// 签名校验通过后跳转到的地址, 例如s3的预签名url; 为空时由服务直接返回文件
+	RedirectURL(key string, expires time.Time) (string, error)
+}
+
+type Blob struct {
+	ContentType string
+	Data        []byte
+	// 通过签名url访问时为签名的过期时间, 这种文件不能被公共缓存
+	Expires time.Time
+	// 不为空时跳转到这个地址, 没有Data
+	RedirectURL string
+}
+
+// 上传后的头像 - URL为最大的缩略图, 用于UpdateUser的image字段
+type Avatar struct {
+	URL        string
+	Thumbnails []*Thumbnail
+}
+
+type Thumbnail struct {
+	Size int
+	URL  string
+}
+
+const (
+	defaultAvatarMaxBytes = 2 << 20
+	// 解码前按尺寸拒绝, 防止小文件解压出超大图片
+	maxAvatarPixels = 4096 * 4096
+)
+
+var defaultThumbnailSizes = []int{64, 128, 256}
+
+// 允许上传的图片类型
+var imageContentTypes = map[string]struct{}{
+	"image/jpeg": {},
+	"image/png":  {},
+	"image/gif":  {},
+}
+
+var imageExtensions = map[string]string{
+	"image/jpeg": "jpg",
+	"image/png":  "png",
+}
+
+type MediaUsecase struct {
+	bs    BlobStore
+	atr   AttachmentRepo
+	ar    ArticleRepo
+	mc    *conf.Media
+	sizes []int
+	log   *log.Helper
+}
+
+func NewMediaUsecase(bs BlobStore, atr AttachmentRepo, ar ArticleRepo, mc *conf.Media, logger log.Logger) *MediaUsecase {
+	sizes := defaultThumbnailSizes
+	if s := mc.GetAvatar().GetThumbnailSizes(); len(s) > 0 {
+		sizes = make([]int, 0, len(s))
+		for _, size := range s {
+			if size > 0 {
+				sizes = append(sizes, int(size))
+			}
+		}
+	}
+	return &MediaUsecase{bs: bs, atr: atr, ar: ar, mc: mc, sizes: sizes, log: log.NewHelper(logger)}
+}
+
+// 上传文件的大小限制 - service层读取请求体时也需要
+func (uc *MediaUsecase) MaxAvatarBytes() int64 {
+	if n := uc.mc.GetAvatar().GetMaxBytes(); n > 0 {
+		return n
+	}
+	return defaultAvatarMaxBytes
+}
+
+// 上传头像 - 校验类型和大小, 重新编码去掉EXIF, 生成正方形缩略图
+func (uc *MediaUsecase) UploadAvatar(ctx context.Context, contentType string, data []byte) (*Avatar, error) {
+	img, format, err := decodeUpload(contentType, data, uc.MaxAvatarBytes(), maxAvatarPixels)
+	if err != nil {
+		return nil, err
+	}
+
+	currentUser, _ := auth.FromContext(ctx)
+	prefix := fmt.Sprintf("avatars/%d/%s", currentUser.UserID, randomKey())
+
+	avatar := &Avatar{}
+	largest := 0
+	for _, size := range uc.sizes {
+		out, outType, err := imaging.Encode(imaging.Thumbnail(img, size), format)
+		if err != nil {
+			return nil, err
+		}
+		key := fmt.Sprintf("%s/%d.%s", prefix, size, imageExtensions[outType])
+		if err := uc.bs.Put(ctx, key, outType, out); err != nil {
+			uc.log.Errorf("put avatar %s error: %v", key, err)
+			return nil, err
+		}
+		thumb := &Thumbnail{Size: size, URL: uc.bs.URL(key)}
+		avatar.Thumbnails = append(avatar.Thumbnails, thumb)
+		if size > largest {
+			largest = size
+			avatar.URL = thumb.URL
+		}
+	}
+	return avatar, nil
+}
+
+// 校验上传的图片并解码 - 声明的类型和实际内容都需要是允许的图片类型
+func decodeUpload(contentType string, data []byte, maxBytes int64, maxPixels int) (image.Image, string, error) {
+	if len(data) == 0 {
+		return nil, "", ValidationError("image", "can not be empty")
+	}
+	if int64(len(data)) > maxBytes {
+		return nil, "", ValidationError("image", "is too large (maximum is %d bytes)", maxBytes)
+	}
+	sniffed := http.DetectContentType(data)
+	if _, ok := imageContentTypes[contentType]; !ok {
+		return nil, "", ValidationError("image", "content type is not supported")
+	}
+	if sniffed != contentType {
+		return nil, "", ValidationError("image", "content does not match content type")
+	}
+
+	img, format, err := imaging.Decode(data, maxPixels)
+	if err == imaging.ErrTooLarge {
+		return nil, "", ValidationError("image", "dimensions are too large")
+	}
+	if err != nil {
+		return nil, "", ValidationError("image", "can not be decoded")
+	}
+	return img, format, nil
+}
+
+// 读取文件 - 本地存储时由http服务直接返回
+// 带签名时校验签名和过期时间, s3存储跳转到预签名url; 不带签名时, 还没有被文章引用的附件按不存在处理
+func (uc *MediaUsecase) GetMedia(ctx context.Context, key string, expires string, signature string) (*Blob, error) {
+	var expiresAt time.Time
+	if signature != "" {
+		unix, err := strconv.ParseInt(expires, 10, 64)
+		if err != nil {
+			return nil, ErrMediaSignatureInvalid
+		}
+		expiresAt = time.Unix(unix, 0)
+		if !uc.bs.VerifySignature(key, expiresAt, signature) {
+			return nil, ErrMediaSignatureInvalid
+		}
+		if !time.Now().Before(expiresAt) {
+			return nil, ErrMediaSignatureExpired
+		}
+		redirect, err := uc.bs.RedirectURL(key, expiresAt)
+		if err != nil {
+			return nil, err
+		}
+		if redirect != "" {
+			return &Blob{Expires: expiresAt, RedirectURL: redirect}, nil
+		}
+	} else if strings.HasPrefix(key, attachmentKeyPrefix) {
+		a, err := uc.atr.GetAttachmentByKey(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+		if a.ArticleID == 0 {
+			return nil, ErrMediaNotFound
+		}
+	}
+
+	blob, err := uc.bs.Get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	blob.Expires = expiresAt
+	return blob, nil
+}
+
+// 每次上传使用新的随机目录, url不会被缓存污染
+func randomKey() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
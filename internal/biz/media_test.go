@@ -0,0 +1,112 @@
+package biz
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	"image/png"
+	"testing"
+	"time"
+
+	"kratos-realworld/internal/conf"
+	"kratos-realworld/internal/pkg/middleware/auth"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-playground/assert/v2"
+)
+
+// 内存中的BlobStore
+type memoryBlobs map[string]*Blob
+
+func (m memoryBlobs) Put(ctx context.Context, key string, contentType string, data []byte) error {
+	m[key] = &Blob{ContentType: contentType, Data: data}
+	return nil
+}
+
+func (m memoryBlobs) Get(ctx context.Context, key string) (*Blob, error) {
+	if b, ok := m[key]; ok {
+		return b, nil
+	}
+	return nil, errors.NotFound("MEDIA_NOT_FOUND", "media not found")
+}
+
+func (m memoryBlobs) Delete(ctx context.Context, key string) error {
+	delete(m, key)
+	return nil
+}
+
+func (m memoryBlobs) URL(key string) string {
+	return "/media/" + key
+}
+
+// 签名固定为key本身
+func (m memoryBlobs) SignedURL(key string, expires time.Time) (string, error) {
+	return fmt.Sprintf("/media/%s?expires=%d&signature=%s", key, expires.Unix(), key), nil
+}
+
+func (m memoryBlobs) VerifySignature(key string, expires time.Time, signature string) bool {
+	return signature == key
+}
+
+func (m memoryBlobs) RedirectURL(key string, expires time.Time) (string, error) {
+	return "", nil
+}
+
+// 签名校验后跳转的BlobStore, 和s3存储一样
+type redirectBlobs struct {
+	memoryBlobs
+}
+
+func (m redirectBlobs) RedirectURL(key string, expires time.Time) (string, error) {
+	return fmt.Sprintf("https://bucket.example.com/%s?X-Amz-Expires=%d", key, expires.Unix()), nil
+}
+
+func encodePNG(t *testing.T, w, h int) []byte {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, w, h))); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestUploadAvatar(t *testing.T) {
+	ctx := auth.WithContext(context.Background(), &auth.CurrentUser{UserID: 7})
+	blobs := memoryBlobs{}
+	uc := NewMediaUsecase(blobs, nil, nil, &conf.Media{Avatar: &conf.Media_Avatar{ThumbnailSizes: []int32{32, 16}}}, log.DefaultLogger)
+
+	avatar, err := uc.UploadAvatar(ctx, "image/png", encodePNG(t, 40, 30))
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 2, len(avatar.Thumbnails))
+	assert.Equal(t, 2, len(blobs))
+	// url为最大的缩略图
+	assert.Equal(t, avatar.Thumbnails[0].URL, avatar.URL)
+
+	key := avatar.URL[len("/media/"):]
+	img, err := png.Decode(bytes.NewReader(blobs[key].Data))
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 32, img.Bounds().Dx())
+	assert.Equal(t, 32, img.Bounds().Dy())
+}
+
+func TestUploadAvatarRejects(t *testing.T) {
+	ctx := auth.WithContext(context.Background(), &auth.CurrentUser{UserID: 7})
+	uc := NewMediaUsecase(memoryBlobs{}, nil, nil, &conf.Media{Avatar: &conf.Media_Avatar{MaxBytes: 1024}}, log.DefaultLogger)
+
+	cases := []struct {
+		contentType string
+		data        []byte
+	}{
+		{"image/png", nil},
+		{"image/svg+xml", []byte("<svg></svg>")},
+		// 声明的类型和内容不一致
+		{"image/jpeg", encodePNG(t, 4, 4)},
+		{"image/png", []byte("\x89PNG\r\n\x1a\nbroken")},
+		{"image/png", make([]byte, 2048)},
+	}
+	for _, c := range cases {
+		_, err := uc.UploadAvatar(ctx, c.contentType, c.data)
+		assert.Equal(t, 422, errors.Code(err))
+	}
+}
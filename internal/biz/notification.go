@@ -0,0 +1,342 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"kratos-realworld/internal/pkg/middleware/auth"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 通知类型, 每种类型都可以单独关闭
+const (
+	NotificationFollow   = "follow"
+	NotificationFavorite = "favorite"
+	NotificationComment  = "comment"
+	NotificationMention  = "mention"
+)
+
+var notificationTypes = []string{NotificationFollow, NotificationFavorite, NotificationComment, NotificationMention}
+
+// 一条评论中最多通知的被提及用户数
+const maxMentions = 10
+
+// @username, 前面不能是字母数字(排除邮箱), 结尾的标点不算在用户名中
+var mentionRe = regexp.MustCompile(`(?:^|[^\w@.])@(\w[\w.-]*)`)
+
+// 通知 - 同一分组的多个操作者合并为一条, 例如"alice and 3 others favorited your article"
+type Notification struct {
+	ID     uint
+	UserID uint
+	Type   string
+	// 分组的key, 最近一条同组的通知未读时, 新的操作者合并到这条通知中
+	GroupKey    string
+	ArticleID   uint
+	CommentID   uint
+	ActorID     uint
+	ActorsCount uint32
+	Read        bool
+	// 最近一个操作者加入的时间
+	CreatedAt time.Time
+
+	// 文章已删除的通知不返回
+	ArticleSlug  string
+	ArticleTitle string
+	// 最近的操作者, 已删除的用户为nil
+	Actor   *ProfileResp
+	Message string
+}
+
+type NotificationPage struct {
+	Notifications []*Notification
+	UnreadCount   int
+	NextCursor    string
+}
+
+type NotificationRepo interface {
+	// 把n.ActorID加入n.UserID的n.GroupKey分组: 最近一条同组通知已经有这个操作者时不变(包括重复投递的事件),
+	// 未读时合并并移到最前, 否则新建一条
+	AddNotification(ctx context.Context, n *Notification) error
+	// 按id倒序, 排除文章已删除的通知; cursor为上一页最后一条的id
+	ListNotifications(ctx context.Context, uid uint, cursor uint, limit int) ([]*Notification, uint, error)
+	CountUnreadNotifications(ctx context.Context, uid uint) (int, error)
+	// 不存在或不属于uid时返回ErrNotificationNotFound
+	MarkNotificationRead(ctx context.Context, uid uint, id uint) error
+	MarkAllNotificationsRead(ctx context.Context, uid uint) error
+	// 只返回保存过的类型, 没有保存的类型默认开启
+	GetNotificationPreferences(ctx context.Context, uid uint) (map[string]bool, error)
+	UpdateNotificationPreferences(ctx context.Context, uid uint, preferences map[string]bool) error
+}
+
+type NotificationUsecase struct {
+	nr  NotificationRepo
+	ur  UserRepo
+	pr  ProfileRepo
+	log *log.Helper
+}
+
+// 订阅SocialUsecase和UserUsecase发布的事件生成通知
+func NewNotificationUsecase(nr NotificationRepo, ur UserRepo, pr ProfileRepo, eb *EventBus, logger log.Logger) *NotificationUsecase {
+	uc := &NotificationUsecase{nr: nr, ur: ur, pr: pr, log: log.NewHelper(logger)}
+	Subscribe(eb, "notifications", uc.onUserFollowed)
+	Subscribe(eb, "notifications", uc.onArticleFavorited)
+	Subscribe(eb, "notifications", uc.onCommentAdded)
+	return uc
+}
+
+func (uc *NotificationUsecase) onUserFollowed(ctx context.Context, e UserFollowed) error {
+	return uc.notify(ctx, &Notification{
+		UserID:   e.FollowingID,
+		Type:     NotificationFollow,
+		GroupKey: NotificationFollow,
+		ActorID:  e.FollowerID,
+	})
+}
+
+func (uc *NotificationUsecase) onArticleFavorited(ctx context.Context, e ArticleFavorited) error {
+	return uc.notify(ctx, &Notification{
+		UserID:    e.AuthorID,
+		Type:      NotificationFavorite,
+		GroupKey:  fmt.Sprintf("favorite:%d", e.ArticleID),
+		ArticleID: e.ArticleID,
+		ActorID:   e.UserID,
+	})
+}
+
+// 评论通知文章作者, 提及的其他用户各自收到一条不合并的通知
+func (uc *NotificationUsecase) onCommentAdded(ctx context.Context, e CommentAdded) error {
+	err := uc.notify(ctx, &Notification{
+		UserID:    e.ArticleAuthorID,
+		Type:      NotificationComment,
+		GroupKey:  fmt.Sprintf("comment:%d", e.ArticleID),
+		ArticleID: e.ArticleID,
+		CommentID: e.CommentID,
+		ActorID:   e.AuthorID,
+	})
+	if err != nil {
+		return err
+	}
+	for _, username := range parseMentions(e.Body) {
+		u, err := uc.ur.GetUserByUsername(ctx, username)
+		if err != nil {
+			if errors.Is(err, ErrUserNotFound) {
+				continue
+			}
+			return err
+		}
+		// 文章作者已经收到评论通知
+		if u.ID == e.ArticleAuthorID {
+			continue
+		}
+		visible, err := uc.articleVisible(ctx, e.ArticleAuthorID, u.ID)
+		if err != nil {
+			return err
+		}
+		if !visible {
+			continue
+		}
+		err = uc.notify(ctx, &Notification{
+			UserID:    u.ID,
+			Type:      NotificationMention,
+			GroupKey:  fmt.Sprintf("mention:%d", e.CommentID),
+			ArticleID: e.ArticleID,
+			CommentID: e.CommentID,
+			ActorID:   e.AuthorID,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// 私密账号的文章只有关注者能看到, 不能通过提及泄露评论
+func (uc *NotificationUsecase) articleVisible(ctx context.Context, authorID uint, uid uint) (bool, error) {
+	authors, err := uc.pr.GetProfilesByIDs(ctx, []uint{authorID})
+	if err != nil {
+		return false, err
+	}
+	if len(authors) == 0 || !authors[0].Private {
+		return true, nil
+	}
+	following, err := uc.pr.GetFollowingMap(ctx, uid, []uint{authorID})
+	if err != nil {
+		return false, err
+	}
+	return following[authorID], nil
+}
+
+// 不通知自己的操作, 关闭了这种通知, 以及拉黑和静音的用户
+func (uc *NotificationUsecase) notify(ctx context.Context, n *Notification) error {
+	if n.UserID == 0 || n.UserID == n.ActorID {
+		return nil
+	}
+	preferences, err := uc.nr.GetNotificationPreferences(ctx, n.UserID)
+	if err != nil {
+		return err
+	}
+	if enabled, ok := preferences[n.Type]; ok && !enabled {
+		return nil
+	}
+	blocked, err := uc.pr.IsBlocked(ctx, n.UserID, n.ActorID)
+	if err != nil {
+		return err
+	}
+	if blocked {
+		return nil
+	}
+	muted, err := uc.pr.IsMuted(ctx, n.UserID, n.ActorID)
+	if err != nil {
+		return err
+	}
+	if muted {
+		return nil
+	}
+	return uc.nr.AddNotification(ctx, n)
+}
+
+// 去重后的用户名, 按出现的顺序
+func parseMentions(body string) []string {
+	var names []string
+	seen := make(map[string]bool)
+	for _, m := range mentionRe.FindAllStringSubmatch(body, -1) {
+		name := strings.TrimRight(m[1], ".-")
+		if name == "" || seen[name] {
+			continue
+		}
+		seen[name] = true
+		names = append(names, name)
+		if len(names) == maxMentions {
+			break
+		}
+	}
+	return names
+}
+
+// 通知列表, 同时返回未读的数量
+func (uc *NotificationUsecase) ListNotifications(ctx context.Context, cursor string, limit int64) (*NotificationPage, error) {
+	after, err := decodeCursor(cursor)
+	if err != nil {
+		return nil, err
+	}
+	currentUser, _ := auth.FromContext(ctx)
+	notifications, next, err := uc.nr.ListNotifications(ctx, currentUser.UserID, after, pageSize(limit))
+	if err != nil {
+		return nil, err
+	}
+	unread, err := uc.nr.CountUnreadNotifications(ctx, currentUser.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 操作者的profile一次查询
+	actorIDs := make([]uint, 0, len(notifications))
+	for _, n := range notifications {
+		actorIDs = append(actorIDs, n.ActorID)
+	}
+	actors, err := uc.pr.GetProfilesByIDs(ctx, actorIDs)
+	if err != nil {
+		return nil, err
+	}
+	actorMap := make(map[uint]*ProfileResp, len(actors))
+	for _, p := range actors {
+		actorMap[p.ID] = p
+	}
+	for _, n := range notifications {
+		n.Actor = actorMap[n.ActorID]
+		n.Message = notificationMessage(n)
+	}
+	return &NotificationPage{
+		Notifications: notifications,
+		UnreadCount:   unread,
+		NextCursor:    encodeCursor(next),
+	}, nil
+}
+
+// 例如"alice and 3 others favorited your article "Hello""
+func notificationMessage(n *Notification) string {
+	who := "someone"
+	if n.Actor != nil {
+		who = n.Actor.Username
+	}
+	switch others := int(n.ActorsCount) - 1; {
+	case others == 1:
+		who += " and 1 other"
+	case others > 1:
+		who += fmt.Sprintf(" and %d others", others)
+	}
+	switch n.Type {
+	case NotificationFollow:
+		return who + " followed you"
+	case NotificationFavorite:
+		return fmt.Sprintf("%s favorited your article %q", who, n.ArticleTitle)
+	case NotificationComment:
+		return fmt.Sprintf("%s commented on your article %q", who, n.ArticleTitle)
+	case NotificationMention:
+		return fmt.Sprintf("%s mentioned you in a comment on %q", who, n.ArticleTitle)
+	default:
+		return who
+	}
+}
+
+// 标记已读, 返回剩余的未读数量
+func (uc *NotificationUsecase) MarkNotificationRead(ctx context.Context, id uint) (int, error) {
+	currentUser, _ := auth.FromContext(ctx)
+	if err := uc.nr.MarkNotificationRead(ctx, currentUser.UserID, id); err != nil {
+		return 0, err
+	}
+	return uc.nr.CountUnreadNotifications(ctx, currentUser.UserID)
+}
+
+func (uc *NotificationUsecase) MarkAllNotificationsRead(ctx context.Context) (int, error) {
+	currentUser, _ := auth.FromContext(ctx)
+	if err := uc.nr.MarkAllNotificationsRead(ctx, currentUser.UserID); err != nil {
+		return 0, err
+	}
+	return uc.nr.CountUnreadNotifications(ctx, currentUser.UserID)
+}
+
+// 所有类型的开关, 没有保存过的类型为开启
+func (uc *NotificationUsecase) GetNotificationPreferences(ctx context.Context) (map[string]bool, error) {
+	currentUser, _ := auth.FromContext(ctx)
+	saved, err := uc.nr.GetNotificationPreferences(ctx, currentUser.UserID)
+	if err != nil {
+		return nil, err
+	}
+	preferences := make(map[string]bool, len(notificationTypes))
+	for _, t := range notificationTypes {
+		enabled, ok := saved[t]
+		preferences[t] = !ok || enabled
+	}
+	return preferences, nil
+}
+
+// 只修改传入的类型
+func (uc *NotificationUsecase) UpdateNotificationPreferences(ctx context.Context, preferences map[string]bool) (map[string]bool, error) {
+	for t := range preferences {
+		if !isNotificationType(t) {
+			return nil, ValidationError("preferences", "unknown notification type %s", t)
+		}
+	}
+	if len(preferences) > 0 {
+		currentUser, _ := auth.FromContext(ctx)
+		if err := uc.nr.UpdateNotificationPreferences(ctx, currentUser.UserID, preferences); err != nil {
+			return nil, err
+		}
+	}
+	return uc.GetNotificationPreferences(ctx)
+}
+
+func isNotificationType(t string) bool {
+	for _, nt := range notificationTypes {
+		if nt == t {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,172 @@
+package biz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"kratos-realworld/internal/pkg/middleware/auth"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-playground/assert/v2"
+)
+
+// 只记录新增的通知, 不合并
+type memoryNotifications struct {
+	NotificationRepo
+	added       []*Notification
+	preferences map[uint]map[string]bool
+}
+
+func (r *memoryNotifications) AddNotification(ctx context.Context, n *Notification) error {
+	r.added = append(r.added, n)
+	return nil
+}
+
+func (r *memoryNotifications) GetNotificationPreferences(ctx context.Context, uid uint) (map[string]bool, error) {
+	return r.preferences[uid], nil
+}
+
+func (r *memoryNotifications) UpdateNotificationPreferences(ctx context.Context, uid uint, preferences map[string]bool) error {
+	if r.preferences[uid] == nil {
+		r.preferences[uid] = make(map[string]bool)
+	}
+	for t, enabled := range preferences {
+		r.preferences[uid][t] = enabled
+	}
+	return nil
+}
+
+type stubNotifyUsers struct {
+	UserRepo
+	users map[string]uint
+}
+
+func (r *stubNotifyUsers) GetUserByUsername(ctx context.Context, username string) (*User, error) {
+	id, ok := r.users[username]
+	if !ok {
+		return nil, ErrUserNotFound
+	}
+	return &User{ID: id, Username: username}, nil
+}
+
+// blocks和mutes的key为{uid, 对方}
+type stubNotifyProfiles struct {
+	ProfileRepo
+	private   map[uint]bool
+	following map[[2]uint]bool
+	blocks    map[[2]uint]bool
+	mutes     map[[2]uint]bool
+}
+
+func (r *stubNotifyProfiles) GetProfilesByIDs(ctx context.Context, uids []uint) ([]*ProfileResp, error) {
+	profiles := make([]*ProfileResp, len(uids))
+	for i, id := range uids {
+		profiles[i] = &ProfileResp{ID: id, Private: r.private[id]}
+	}
+	return profiles, nil
+}
+
+func (r *stubNotifyProfiles) GetFollowingMap(ctx context.Context, uid uint, uids []uint) (map[uint]bool, error) {
+	following := make(map[uint]bool)
+	for _, id := range uids {
+		following[id] = r.following[[2]uint{uid, id}]
+	}
+	return following, nil
+}
+
+func (r *stubNotifyProfiles) IsBlocked(ctx context.Context, uid uint, otherID uint) (bool, error) {
+	return r.blocks[[2]uint{uid, otherID}] || r.blocks[[2]uint{otherID, uid}], nil
+}
+
+func (r *stubNotifyProfiles) IsMuted(ctx context.Context, uid uint, targetID uint) (bool, error) {
+	return r.mutes[[2]uint{uid, targetID}], nil
+}
+
+func newTestNotificationUsecase(nr NotificationRepo, users map[string]uint, pr ProfileRepo) (*NotificationUsecase, *EventBus) {
+	now := time.Now()
+	eb := newTestEventBus(&memoryOutbox{}, &now)
+	return NewNotificationUsecase(nr, &stubNotifyUsers{users: users}, pr, eb, log.DefaultLogger), eb
+}
+
+func TestNotifyFilters(t *testing.T) {
+	ctx := context.Background()
+	nr := &memoryNotifications{preferences: map[uint]map[string]bool{3: {NotificationFollow: false}}}
+	pr := &stubNotifyProfiles{
+		blocks: map[[2]uint]bool{{4, 1}: true},
+		mutes:  map[[2]uint]bool{{5, 1}: true},
+	}
+	_, eb := newTestNotificationUsecase(nr, nil, pr)
+
+	// 自己, 关闭了关注通知, 拉黑了对方, 静音了对方
+	for _, uid := range []uint{1, 3, 4, 5} {
+		assert.Equal(t, nil, eb.Publish(ctx, UserFollowed{FollowerID: 1, FollowingID: uid}))
+	}
+	// 被静音的用户静音对方不影响对方收到通知
+	assert.Equal(t, nil, eb.Publish(ctx, UserFollowed{FollowerID: 5, FollowingID: 1}))
+	assert.Equal(t, nil, eb.Publish(ctx, ArticleFavorited{ArticleID: 9, AuthorID: 3, UserID: 1}))
+	_, err := eb.Dispatch(ctx)
+	assert.Equal(t, nil, err)
+
+	assert.Equal(t, 2, len(nr.added))
+	assert.Equal(t, &Notification{UserID: 1, Type: NotificationFollow, GroupKey: "follow", ActorID: 5}, nr.added[0])
+	assert.Equal(t, &Notification{UserID: 3, Type: NotificationFavorite, GroupKey: "favorite:9", ArticleID: 9, ActorID: 1}, nr.added[1])
+}
+
+func TestCommentMentions(t *testing.T) {
+	ctx := context.Background()
+	nr := &memoryNotifications{}
+	users := map[string]uint{"author": 1, "commenter": 2, "fan": 3, "stranger": 4}
+	pr := &stubNotifyProfiles{
+		private:   map[uint]bool{1: true},
+		following: map[[2]uint]bool{{3, 1}: true},
+	}
+	_, eb := newTestNotificationUsecase(nr, users, pr)
+
+	// 作者只收到评论通知, 私密账号的文章不通知没有关注作者的用户, 不存在的用户忽略
+	body := "@author @fan @stranger @nobody @commenter thanks"
+	assert.Equal(t, nil, eb.Publish(ctx, CommentAdded{CommentID: 5, ArticleID: 9, ArticleAuthorID: 1, AuthorID: 2, Body: body}))
+	_, err := eb.Dispatch(ctx)
+	assert.Equal(t, nil, err)
+
+	assert.Equal(t, 2, len(nr.added))
+	assert.Equal(t, &Notification{UserID: 1, Type: NotificationComment, GroupKey: "comment:9", ArticleID: 9, CommentID: 5, ActorID: 2}, nr.added[0])
+	assert.Equal(t, &Notification{UserID: 3, Type: NotificationMention, GroupKey: "mention:5", ArticleID: 9, CommentID: 5, ActorID: 2}, nr.added[1])
+}
+
+func TestParseMentions(t *testing.T) {
+	assert.Equal(t, []string{"jake", "jane.doe", "bob"}, parseMentions("@jake, @jane.doe. hi @jake (@bob)"))
+	assert.Equal(t, 0, len(parseMentions("mail jake@example.com or @@jake")))
+	body := ""
+	for _, c := range "abcdefghijkl" {
+		body += "@" + string(c) + " "
+	}
+	assert.Equal(t, maxMentions, len(parseMentions(body)))
+}
+
+func TestNotificationMessage(t *testing.T) {
+	alice := &ProfileResp{Username: "alice"}
+	assert.Equal(t, "alice followed you", notificationMessage(&Notification{Type: NotificationFollow, ActorsCount: 1, Actor: alice}))
+	assert.Equal(t, `alice and 1 other favorited your article "Hello"`,
+		notificationMessage(&Notification{Type: NotificationFavorite, ActorsCount: 2, Actor: alice, ArticleTitle: "Hello"}))
+	assert.Equal(t, `alice and 3 others commented on your article "Hello"`,
+		notificationMessage(&Notification{Type: NotificationComment, ActorsCount: 4, Actor: alice, ArticleTitle: "Hello"}))
+	assert.Equal(t, `someone mentioned you in a comment on "Hello"`,
+		notificationMessage(&Notification{Type: NotificationMention, ActorsCount: 1, ArticleTitle: "Hello"}))
+}
+
+func TestNotificationPreferences(t *testing.T) {
+	ctx := auth.WithContext(context.Background(), &auth.CurrentUser{UserID: 7})
+	nr := &memoryNotifications{preferences: make(map[uint]map[string]bool)}
+	uc, _ := newTestNotificationUsecase(nr, nil, &stubNotifyProfiles{})
+
+	// 没有保存过的类型为开启
+	preferences, err := uc.UpdateNotificationPreferences(ctx, map[string]bool{NotificationFavorite: false})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, map[string]bool{"follow": true, "favorite": false, "comment": true, "mention": true}, preferences)
+
+	_, err = uc.UpdateNotificationPreferences(ctx, map[string]bool{"digest": true})
+	assert.Equal(t, int32(422), errors.FromError(err).Code)
+	assert.Equal(t, map[string]bool{NotificationFavorite: false}, nr.preferences[7])
+}
@@ -0,0 +1,95 @@
+package biz
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	"kratos-realworld/internal/conf"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	defaultPasswordMinLength = 8
+	// bcrypt只接受72字节以内的密码, 超过时Hash会报错
+	maxPasswordBytes = 72
+)
+
+// 密码策略 - 长度 / 泄露密码列表 / 不能和用户名邮箱相同 / bcrypt cost
+type PasswordPolicy struct {
+	minLength int
+	cost      int
+	breached  map[string]struct{}
+}
+
+func NewPasswordPolicy(c *conf.Auth) (*PasswordPolicy, error) {
+	pc := c.GetPassword()
+	p := &PasswordPolicy{
+		minLength: int(pc.GetMinLength()),
+		cost:      int(pc.GetBcryptCost()),
+		breached:  make(map[string]struct{}),
+	}
+	if p.minLength <= 0 {
+		p.minLength = defaultPasswordMinLength
+	}
+	if p.cost == 0 {
+		p.cost = bcrypt.DefaultCost
+	}
+	if p.cost < bcrypt.MinCost || p.cost > bcrypt.MaxCost {
+		return nil, fmt.Errorf("bcrypt cost %d out of range [%d, %d]", p.cost, bcrypt.MinCost, bcrypt.MaxCost)
+	}
+
+	// 泄露密码列表 - 启动时一次性加载到内存
+	if path := pc.GetBreachedList(); path != "" {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, fmt.Errorf("open breached password list: %w", err)
+		}
+		defer f.Close()
+		scanner := bufio.NewScanner(f)
+		for scanner.Scan() {
+			if line := strings.TrimSpace(scanner.Text()); line != "" {
+				p.breached[strings.ToLower(line)] = struct{}{}
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			return nil, fmt.Errorf("read breached password list: %w", err)
+		}
+	}
+	return p, nil
+}
+
+// 校验密码 - 返回422, 和Login的参数校验保持一致
+func (p *PasswordPolicy) Validate(password string, username string, email string) error {
+	if len(password) == 0 {
+		return ValidationError("password", "can not be empty")
+	}
+	if len([]rune(password)) < p.minLength {
+		return ValidationError("password", "is too short (minimum is %d characters)", p.minLength)
+	}
+	if len(password) > maxPasswordBytes {
+		return ValidationError("password", "is too long (maximum is %d bytes)", maxPasswordBytes)
+	}
+	if (username != "" && strings.EqualFold(password, username)) || (email != "" && strings.EqualFold(password, email)) {
+		return ValidationError("password", "can not be the same as username or email")
+	}
+	if _, ok := p.breached[strings.ToLower(password)]; ok {
+		return ValidationError("password", "has appeared in a data breach, please choose another one")
+	}
+	return nil
+}
+
+func (p *PasswordPolicy) Hash(password string) (string, error) {
+	return hashPassword(password, p.cost)
+}
+
+// 数据库中的hash的cost低于当前配置 - 登录成功后需要重新hash
+func (p *PasswordPolicy) NeedsRehash(hash string) bool {
+	cost, err := bcrypt.Cost([]byte(hash))
+	if err != nil {
+		return false
+	}
+	return cost < p.cost
+}
@@ -0,0 +1,232 @@
+package biz
+
+import (
+	"context"
+
+	"kratos-realworld/internal/pkg/middleware/auth"
+)
+
+// 表情回应 - 文章和评论都可以回应, 计数单独存放, 按目标批量读取
+
+const (
+	ReactionTargetArticle = "article"
+	ReactionTargetComment = "comment"
+)
+
+// 没有配置时可用的表情
+var defaultReactions = []string{"👍", "❤️", "🎉", "🤔", "😄", "👀"}
+
+type ReactionCount struct {
+	Reaction string
+	Count    uint32
+}
+
+type ReactionRepo interface {
+	// 已经回应过时不报错, 计数只在新增时加一
+	AddReaction(ctx context.Context, uid uint, targetType string, targetID uint, reaction string) error
+	// 没有回应过时不报错
+	RemoveReaction(ctx context.Context, uid uint, targetType string, targetID uint, reaction string) error
+	GetReactionCounts(ctx context.Context, targetType string, targetIDs []uint) (map[uint][]ReactionCount, error)
+	GetUserReactions(ctx context.Context, uid uint, targetType string, targetIDs []uint) (map[uint][]string, error)
+}
+
+func (uc *SocialUsecase) GetReactions(ctx context.Context) []string {
+	return uc.reactions
+}
+
+func (uc *SocialUsecase) checkReaction(reaction string) error {
+	for _, r := range uc.reactions {
+		if r == reaction {
+			return nil
+		}
+	}
+	return ValidationError("reaction", "is not allowed")
+}
+
+// 一次查出目标的回应数和当前用户的回应, 按配置的顺序排列, 已经从配置中去掉的表情不返回
+func (uc *SocialUsecase) loadReactions(ctx context.Context, targetType string, ids []uint, currentUid uint) (map[uint][]ReactionCount, map[uint][]string, error) {
+	if len(ids) == 0 {
+		return nil, nil, nil
+	}
+	counts, err := uc.rr.GetReactionCounts(ctx, targetType, ids)
+	if err != nil {
+		return nil, nil, err
+	}
+	var mine map[uint][]string
+	if currentUid > 0 {
+		mine, err = uc.rr.GetUserReactions(ctx, currentUid, targetType, ids)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	order := make(map[string]int, len(uc.reactions))
+	for i, r := range uc.reactions {
+		order[r] = i
+	}
+	sortedCounts := make(map[uint][]ReactionCount, len(counts))
+	for id, list := range counts {
+		sorted := make([]ReactionCount, len(uc.reactions))
+		for _, c := range list {
+			if i, ok := order[c.Reaction]; ok {
+				sorted[i] = c
+			}
+		}
+		for _, c := range sorted {
+			if c.Count > 0 {
+				sortedCounts[id] = append(sortedCounts[id], c)
+			}
+		}
+	}
+	sortedMine := make(map[uint][]string, len(mine))
+	for id, list := range mine {
+		sorted := make([]string, len(uc.reactions))
+		for _, r := range list {
+			if i, ok := order[r]; ok {
+				sorted[i] = r
+			}
+		}
+		for _, r := range sorted {
+			if r != "" {
+				sortedMine[id] = append(sortedMine[id], r)
+			}
+		}
+	}
+	return sortedCounts, sortedMine, nil
+}
+
+// 文章的回应数和当前用户的回应, currentUid为0表示未登录
+func (uc *SocialUsecase) getArticleReactions(ctx context.Context, articles []*Article, currentUid uint) ([]*Article, error) {
+	aids := make([]uint, 0, len(articles))
+	for _, article := range articles {
+		aids = append(aids, article.ID)
+	}
+	counts, mine, err := uc.loadReactions(ctx, ReactionTargetArticle, aids, currentUid)
+	if err != nil {
+		return nil, err
+	}
+	for _, article := range articles {
+		article.Reactions = counts[article.ID]
+		article.MyReactions = mine[article.ID]
+	}
+	return articles, nil
+}
+
+func (uc *SocialUsecase) getCommentReactions(ctx context.Context, comments []*Comment, currentUid uint) ([]*Comment, error) {
+	cids := make([]uint, 0, len(comments))
+	for _, comment := range comments {
+		cids = append(cids, comment.ID)
+	}
+	counts, mine, err := uc.loadReactions(ctx, ReactionTargetComment, cids, currentUid)
+	if err != nil {
+		return nil, err
+	}
+	for _, comment := range comments {
+		comment.Reactions = counts[comment.ID]
+		comment.MyReactions = mine[comment.ID]
+	}
+	return comments, nil
+}
+
+func (uc *SocialUsecase) AddArticleReaction(ctx context.Context, slug string, reaction string) (*Article, error) {
+	if err := uc.checkReaction(reaction); err != nil {
+		return nil, err
+	}
+	currentUser, _ := auth.FromContext(ctx)
+	currentUid := currentUser.UserID
+
+	a, err := uc.ar.GetArticleBySlug(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.checkNotBlocked(ctx, currentUid, a, "react to"); err != nil {
+		return nil, err
+	}
+	if err := uc.checkPrivateVisible(ctx, currentUid, a); err != nil {
+		return nil, err
+	}
+
+	if err := uc.rr.AddReaction(ctx, currentUid, ReactionTargetArticle, a.ID, reaction); err != nil {
+		return nil, err
+	}
+	return uc.withViewerState(ctx, a, currentUid)
+}
+
+func (uc *SocialUsecase) RemoveArticleReaction(ctx context.Context, slug string, reaction string) (*Article, error) {
+	currentUser, _ := auth.FromContext(ctx)
+	currentUid := currentUser.UserID
+
+	a, err := uc.ar.GetArticleBySlug(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.rr.RemoveReaction(ctx, currentUid, ReactionTargetArticle, a.ID, reaction); err != nil {
+		return nil, err
+	}
+	return uc.withViewerState(ctx, a, currentUid)
+}
+
+// 评论必须属于这篇文章
+func (uc *SocialUsecase) getArticleComment(ctx context.Context, slug string, id uint) (*Article, *Comment, error) {
+	a, err := uc.ar.GetArticleBySlug(ctx, slug)
+	if err != nil {
+		return nil, nil, err
+	}
+	c, err := uc.cr.GetCommentByID(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if c.ArticleID != a.ID {
+		return nil, nil, ErrCommentNotFound
+	}
+	return a, c, nil
+}
+
+func (uc *SocialUsecase) AddCommentReaction(ctx context.Context, slug string, id uint, reaction string) (*Comment, error) {
+	if err := uc.checkReaction(reaction); err != nil {
+		return nil, err
+	}
+	currentUser, _ := auth.FromContext(ctx)
+	currentUid := currentUser.UserID
+
+	a, c, err := uc.getArticleComment(ctx, slug, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.checkArticleVisible(ctx, a); err != nil {
+		return nil, err
+	}
+	// 和评论作者之间存在拉黑时也不能回应
+	blocked, err := uc.pr.IsBlocked(ctx, currentUid, c.AuthorID)
+	if err != nil {
+		return nil, err
+	}
+	if blocked {
+		return nil, BlockedError("react to this comment")
+	}
+
+	if err := uc.rr.AddReaction(ctx, currentUid, ReactionTargetComment, c.ID, reaction); err != nil {
+		return nil, err
+	}
+	if _, err := uc.getCommentReactions(ctx, []*Comment{c}, currentUid); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (uc *SocialUsecase) RemoveCommentReaction(ctx context.Context, slug string, id uint, reaction string) (*Comment, error) {
+	currentUser, _ := auth.FromContext(ctx)
+	currentUid := currentUser.UserID
+
+	_, c, err := uc.getArticleComment(ctx, slug, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.rr.RemoveReaction(ctx, currentUid, ReactionTargetComment, c.ID, reaction); err != nil {
+		return nil, err
+	}
+	if _, err := uc.getCommentReactions(ctx, []*Comment{c}, currentUid); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
@@ -0,0 +1,56 @@
+package biz
+
+import (
+	"context"
+	"testing"
+
+	"kratos-realworld/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-playground/assert/v2"
+)
+
+// 固定返回的ReactionRepo
+type stubReactions struct {
+	ReactionRepo
+	counts map[uint][]ReactionCount
+	mine   map[uint][]string
+}
+
+func (r *stubReactions) GetReactionCounts(ctx context.Context, targetType string, targetIDs []uint) (map[uint][]ReactionCount, error) {
+	return r.counts, nil
+}
+
+func (r *stubReactions) GetUserReactions(ctx context.Context, uid uint, targetType string, targetIDs []uint) (map[uint][]string, error) {
+	return r.mine, nil
+}
+
+func TestCheckReaction(t *testing.T) {
+	uc := NewSocialUsecase(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, log.DefaultLogger)
+	assert.Equal(t, nil, uc.checkReaction("👍"))
+	assert.Equal(t, int32(422), errors.FromError(uc.checkReaction("💩")).Code)
+
+	uc = NewSocialUsecase(nil, nil, nil, nil, nil, nil, nil, nil, nil, &conf.Social{Reactions: []string{"💩"}}, log.DefaultLogger)
+	assert.Equal(t, nil, uc.checkReaction("💩"))
+	assert.Equal(t, int32(422), errors.FromError(uc.checkReaction("👍")).Code)
+}
+
+func TestGetArticleReactions(t *testing.T) {
+	reactions := &stubReactions{
+		counts: map[uint][]ReactionCount{
+			1: {{Reaction: "🎉", Count: 1}, {Reaction: "removed", Count: 5}, {Reaction: "👍", Count: 3}},
+		},
+		mine: map[uint][]string{
+			1: {"🎉", "👍"},
+		},
+	}
+	uc := NewSocialUsecase(nil, nil, nil, nil, nil, nil, reactions, nil, nil, &conf.Social{Reactions: []string{"👍", "❤️", "🎉"}}, log.DefaultLogger)
+
+	articles, err := uc.getArticleReactions(context.Background(), []*Article{{ID: 1}, {ID: 2}}, 7)
+	assert.Equal(t, nil, err)
+	// 按配置的顺序排列, 不在配置中的表情不返回
+	assert.Equal(t, []ReactionCount{{Reaction: "👍", Count: 3}, {Reaction: "🎉", Count: 1}}, articles[0].Reactions)
+	assert.Equal(t, []string{"👍", "🎉"}, articles[0].MyReactions)
+	assert.Equal(t, 0, len(articles[1].Reactions))
+}
@@ -2,54 +2,44 @@ package biz
 
 import (
 	"context"
+	"kratos-realworld/internal/conf"
 	"kratos-realworld/internal/pkg/middleware/auth"
 	"kratos-realworld/internal/pkg/utils"
 	"time"
 
-	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 )
 
+// 修正收藏数时每批检查的文章数
+const reconcileBatchSize = 500
+
 // 权限判断
 func verifyAuthor(ctx context.Context, article *Article, currentUid uint) bool {
 	return currentUid == article.AuthorID
 }
 
-// 关于当前用户与文章之间的关系 收藏关系
-func (uc *SocialUsecase) getArticleFavoritedByUid(ctx context.Context, articles []*Article, currentUid uint) ([]*Article, error) {
-	aids := make([]uint, 0)
-	for _, article := range articles {
-		aids = append(aids, article.ID)
-	}
-	favoriteMap, err := uc.ar.GetIsFavorited(ctx, aids, currentUid)
-	if err != nil {
-		return nil, err
+// 当前用户与文章之间的收藏, 书签和关注作者的关系, 一次查询完成
+func (uc *SocialUsecase) getArticleViewerStates(ctx context.Context, articles []*Article, currentUid uint) ([]*Article, error) {
+	if len(articles) == 0 {
+		return articles, nil
 	}
-	for _, article := range articles {
-		article.Favorited = favoriteMap[article.ID]
-	}
-	return articles, nil
-}
-
-// 关于当前用户与文章作者之间的 关注关系
-func (uc *SocialUsecase) getArticleAuthorFollowedByUid(ctx context.Context, articles []*Article, currentUid uint) ([]*Article, error) {
-	uc.log.Infof("关于当前用户与文章作者之间的 关注关系")
-	authorIds := make([]uint, 0)
-	for _, article := range articles {
-		authorIds = append(authorIds, article.AuthorID)
+	aids := make([]uint, len(articles))
+	for i, article := range articles {
+		aids[i] = article.ID
 	}
-
-	// 批量查询所有作者的关注状态
-	followingMap, err := uc.ar.GetOneIsFollowingAnother(ctx, currentUid, authorIds)
+	states, err := uc.ar.GetArticleViewerStates(ctx, aids, currentUid)
 	if err != nil {
 		return nil, err
 	}
-
-	// 将关注状态映射到每篇文章
 	for _, article := range articles {
-		article.Author.Following = followingMap[article.AuthorID]
+		state := states[article.ID]
+		article.Favorited = state.Favorited
+		article.Bookmarked = state.Bookmarked
+		// 指针需要进行保护
+		if article.Author != nil {
+			article.Author.Following = state.Following
+		}
 	}
-
 	return articles, nil
 }
 
@@ -65,6 +55,12 @@ type Article struct {
 	UpdatedAt      time.Time
 	Favorited      bool
 	FavoritesCount uint32
+	Bookmarked     bool
+	CoverImage     string
+	Reactions      []ReactionCount
+	MyReactions    []string
+	// 更新文章时使用, nil表示不修改封面, 空字符串表示去掉封面
+	CoverImageUpdate *string
 
 	// 作者的uid 从请求获取
 	AuthorID uint
@@ -79,15 +75,27 @@ type Comment struct {
 	UpdatedAt time.Time
 	Author    *ProfileResp
 
+	Reactions   []ReactionCount
+	MyReactions []string
+
 	// 传给data层的时候, 需要将authorID和articleID传给data层 - 方便查询
 	// author
 	AuthorID uint
 	// article
-	ArticleID uint
+	ArticleID   uint
+	ArticleSlug string
 }
 
 type Tag string
 
+// 当前用户与一篇文章之间的关系
+type ArticleViewerState struct {
+	Favorited  bool
+	Bookmarked bool
+	// 是否关注文章的作者
+	Following bool
+}
+
 // social - article / comment / tag
 type ArticleRepo interface {
 	CreateArticle(ctx context.Context, article *Article) (*Article, error)
@@ -96,22 +104,51 @@ type ArticleRepo interface {
 	UpdateArticle(ctx context.Context, article *Article) (*Article, error)
 	GetArticleByAid(ctx context.Context, aid uint) (*Article, error)
 
-	FavoriteArticle(ctx context.Context, aid uint, uid uint) error
+	// 已经收藏时不报错, 收藏数只在新增时加一; 返回是否新增了收藏
+	FavoriteArticle(ctx context.Context, aid uint, uid uint) (bool, error)
+	// 没有收藏时不报错
 	UnfavoriteArticle(ctx context.Context, aid uint, uid uint) error
-	GetIsFavorited(ctx context.Context, aids []uint, uid uint) (map[uint]bool, error)
+	// 检查afterID之后按id顺序的limit篇文章, 修正和收藏表不一致的收藏数
+	// 返回修正的数量和检查到的最后一篇文章的id, 没有更多文章时id为0
+	ReconcileFavoritesCounts(ctx context.Context, afterID uint, limit int) (int, uint, error)
+	// 收藏, 书签和关注作者的状态在一条查询中完成, 不存在的文章不出现在结果中
+	GetArticleViewerStates(ctx context.Context, aids []uint, uid uint) (map[uint]ArticleViewerState, error)
 
 	ListArticlesByOptions(ctx context.Context, options *ListOptions) ([]*Article, error)
-	GetOneIsFollowingAnother(ctx context.Context, uid_1 uint, uids []uint) (map[uint]bool, error)
+	// 关注的作者和关注的标签下的文章, 去重后按id倒序, cursor为上一页最后一篇文章的id
+	ListFeedArticles(ctx context.Context, uid uint, cursor uint, offset int, limit int) ([]*Article, uint, error)
 }
 
 type CommentRepo interface {
 	AddComment(ctx context.Context, c *Comment) (*Comment, error)
 	DeleteCommentByID(ctx context.Context, id uint) error
-	GetCommentsByID(ctx context.Context, cid uint) ([]*Comment, error)
+	GetCommentByID(ctx context.Context, id uint) (*Comment, error)
+	// viewerUid不为0时, 过滤掉与其互相拉黑以及被其静音的用户的评论
+	GetCommentsByID(ctx context.Context, cid uint, viewerUid uint) ([]*Comment, error)
 }
 
 type TagRepo interface {
-	GetTags(ctx context.Context) ([]Tag, error)
+	// 按使用的文章数倒序, prefix为空时不过滤, limit为0时返回全部
+	GetTags(ctx context.Context, prefix string, limit int) ([]*TagInfo, error)
+	// since之后发布的文章使用标签的记录
+	ListTagUses(ctx context.Context, since time.Time) ([]*TagUse, error)
+	GetTag(ctx context.Context, name string) (*TagInfo, error)
+	// 已经关注时不报错
+	FollowTag(ctx context.Context, uid uint, tagID uint) error
+	// 没有关注时不报错
+	UnfollowTag(ctx context.Context, uid uint, tagID uint) error
+	GetFollowedTags(ctx context.Context, uid uint) ([]Tag, error)
+
+	// 别名到规范标签名的映射, 不是别名的名称不出现在结果中
+	ResolveAliases(ctx context.Context, names []string) (map[string]string, error)
+	ListAliases(ctx context.Context, tagID uint) ([]string, error)
+	AddAlias(ctx context.Context, tagID uint, alias string) error
+	RemoveAlias(ctx context.Context, tagID uint, alias string) error
+	// 旧名称成为别名
+	RenameTag(ctx context.Context, tagID uint, name string) error
+	// 在一个事务中把文章, 关注和别名转移到target, 再删除source
+	MergeTags(ctx context.Context, sourceID uint, targetID uint) error
+	DeleteTag(ctx context.Context, tagID uint) error
 }
 
 // GreeterUsecase is a Greeter usecase.
@@ -119,15 +156,105 @@ type SocialUsecase struct {
 	ar  ArticleRepo
 	cr  CommentRepo
 	tr  TagRepo
+	pr  ProfileRepo
+	atr AttachmentRepo
+	br  BookmarkRepo
+	rr  ReactionRepo
+	tm  Transaction
+	eb  *EventBus
 	log *log.Helper
+
+	// 可用的表情回应
+	reactions []string
+	// 热门标签的时间窗口
+	trendingWindow time.Duration
+	// 可以管理标签的用户
+	admins map[uint]bool
+	// 修正收藏数的任务间隔
+	reconcileInterval time.Duration
 }
 
 func NewSocialUsecase(ar ArticleRepo,
 	cr CommentRepo,
 	tr TagRepo,
+	pr ProfileRepo,
+	atr AttachmentRepo,
+	br BookmarkRepo,
+	rr ReactionRepo,
+	tm Transaction,
+	eb *EventBus,
+	sc *conf.Social,
 	logger log.Logger,
 ) *SocialUsecase {
-	return &SocialUsecase{ar: ar, cr: cr, tr: tr, log: log.NewHelper(logger)}
+	reactions := defaultReactions
+	if len(sc.GetReactions()) > 0 {
+		reactions = sc.GetReactions()
+	}
+	trendingWindow := defaultTrendingWindow
+	if sc.GetTrendingWindow() != nil {
+		trendingWindow = sc.GetTrendingWindow().AsDuration()
+	}
+	reconcileInterval := time.Hour
+	if d := sc.GetFavoritesReconcileInterval(); d != nil && d.AsDuration() > 0 {
+		reconcileInterval = d.AsDuration()
+	}
+	admins := make(map[uint]bool)
+	for _, uid := range sc.GetAdminUserIds() {
+		admins[uint(uid)] = true
+	}
+	return &SocialUsecase{
+		ar: ar, cr: cr, tr: tr, pr: pr, atr: atr, br: br, rr: rr, tm: tm, eb: eb,
+		reactions:         reactions,
+		trendingWindow:    trendingWindow,
+		admins:            admins,
+		reconcileInterval: reconcileInterval,
+		log:               log.NewHelper(logger),
+	}
+}
+
+// 文章对当前用户是否可见 - 拉黑关系下对方的文章按不存在处理
+func (uc *SocialUsecase) checkArticleVisible(ctx context.Context, article *Article) error {
+	var currentUid uint
+	if currentUser, ok := auth.FromContext(ctx); ok {
+		currentUid = currentUser.UserID
+		blocked, err := uc.pr.IsBlocked(ctx, currentUid, article.AuthorID)
+		if err != nil {
+			return err
+		}
+		if blocked {
+			return ErrArticleNotFound
+		}
+	}
+	return uc.checkPrivateVisible(ctx, currentUid, article)
+}
+
+// 私密账号的文章只对作者本人和已关注的用户可见, currentUid为0表示未登录
+func (uc *SocialUsecase) checkPrivateVisible(ctx context.Context, currentUid uint, article *Article) error {
+	if article.Author == nil || !article.Author.Private || article.AuthorID == currentUid {
+		return nil
+	}
+	if currentUid > 0 {
+		followingMap, err := uc.pr.GetFollowingMap(ctx, currentUid, []uint{article.AuthorID})
+		if err != nil {
+			return err
+		}
+		if followingMap[article.AuthorID] {
+			return nil
+		}
+	}
+	return ErrArticleNotFound
+}
+
+// 当前用户和文章作者之间存在拉黑时, 不能评论和收藏
+func (uc *SocialUsecase) checkNotBlocked(ctx context.Context, currentUid uint, article *Article, action string) error {
+	blocked, err := uc.pr.IsBlocked(ctx, currentUid, article.AuthorID)
+	if err != nil {
+		return err
+	}
+	if blocked {
+		return BlockedError(action + " this article")
+	}
+	return nil
 }
 
 func (uc *SocialUsecase) CreateArticle(ctx context.Context, a *Article) (*Article, error) {
@@ -137,9 +264,41 @@ func (uc *SocialUsecase) CreateArticle(ctx context.Context, a *Article) (*Articl
 	currentUid := currentUser.UserID
 	a.AuthorID = currentUid
 	a.Slug = utils.Slugify(a.Title)
+	tags, err := uc.canonicalTags(ctx, a.TagList)
+	if err != nil {
+		return nil, err
+	}
+	a.TagList = tags
 
-	// data层创建文章
-	article, err := uc.ar.CreateArticle(ctx, a)
+	// 创建文章和关联附件在同一个事务中
+	var article *Article
+	err = uc.tm.Transaction(ctx, func(ctx context.Context) error {
+		// 封面和正文可以引用编辑器中上传的附件
+		pending, err := uc.atr.ListPendingAttachments(ctx, currentUid)
+		if err != nil {
+			return err
+		}
+		if err := checkCoverImage(a.CoverImage, pending); err != nil {
+			return err
+		}
+
+		// data层创建文章
+		article, err = uc.ar.CreateArticle(ctx, a)
+		if err != nil {
+			return err
+		}
+		if referenced, _ := referencedAttachments(article, pending); len(referenced) > 0 {
+			if err := uc.atr.LinkAttachments(ctx, article.ID, attachmentIDs(referenced), nil); err != nil {
+				return err
+			}
+		}
+		return uc.eb.Publish(ctx, ArticlePublished{
+			ArticleID: article.ID,
+			AuthorID:  article.AuthorID,
+			Slug:      article.Slug,
+			Title:     article.Title,
+		})
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -160,6 +319,20 @@ func (uc *SocialUsecase) GetArticle(ctx context.Context, slug string) (*Article,
 	if err != nil {
 		return nil, err
 	}
+	if err := uc.checkArticleVisible(ctx, article); err != nil {
+		return nil, err
+	}
+	// 登录时获取是否收藏, 书签和关注作者
+	var currentUid uint
+	if currentUser, ok := auth.FromContext(ctx); ok {
+		currentUid = currentUser.UserID
+		if _, err := uc.getArticleViewerStates(ctx, []*Article{article}, currentUid); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := uc.getArticleReactions(ctx, []*Article{article}, currentUid); err != nil {
+		return nil, err
+	}
 	return article, nil
 }
 
@@ -176,7 +349,7 @@ func (uc *SocialUsecase) DeleteArticle(ctx context.Context, slug string) error {
 	currentUser, _ := auth.FromContext(ctx)
 	currentUid := currentUser.UserID
 	if !verifyAuthor(ctx, a, currentUid) {
-		return errors.Forbidden("FORBIDDEN", "you are not the author of this article")
+		return ErrNotArticleAuthor
 	}
 
 	// 删除文章
@@ -195,30 +368,66 @@ func (uc *SocialUsecase) UpdateArticle(ctx context.Context, article *Article) (*
 	currentUser, _ := auth.FromContext(ctx)
 	currentUid := currentUser.UserID
 	if !verifyAuthor(ctx, a, currentUid) {
-		return nil, errors.Forbidden("FORBIDDEN", "you are not the author of this article")
+		return nil, ErrNotArticleAuthor
+	}
+	if len(article.TagList) > 0 {
+		if article.TagList, err = uc.canonicalTags(ctx, article.TagList); err != nil {
+			return nil, err
+		}
 	}
 
 	// 需要更新的请求
 	updateArticle := &Article{
-		Slug:        article.Slug,
-		Title:       article.Title,
-		Description: article.Description,
-		Body:        article.Body,
-		TagList:     article.TagList,
-	}
+		Slug:             article.Slug,
+		Title:            article.Title,
+		Description:      article.Description,
+		Body:             article.Body,
+		TagList:          article.TagList,
+		CoverImageUpdate: article.CoverImageUpdate,
+	}
+
+	// 更新文章和附件关联在同一个事务中
+	err = uc.tm.Transaction(ctx, func(ctx context.Context) error {
+		// 可以引用的附件 - 编辑器中新上传的和已经关联到这篇文章的
+		pending, err := uc.atr.ListPendingAttachments(ctx, currentUid)
+		if err != nil {
+			return err
+		}
+		linked, err := uc.atr.ListArticleAttachments(ctx, a.ID)
+		if err != nil {
+			return err
+		}
+		if updateArticle.CoverImageUpdate != nil {
+			if err := checkCoverImage(*updateArticle.CoverImageUpdate, append(pending, linked...)); err != nil {
+				return err
+			}
+		}
 
-	article, err = uc.ar.UpdateArticle(ctx, updateArticle)
+		article, err = uc.ar.UpdateArticle(ctx, updateArticle)
+		if err != nil {
+			uc.log.Errorf("update article error: %v", err)
+			return err
+		}
+
+		// 新引用的附件关联到文章, 不再引用的解除关联
+		link, _ := referencedAttachments(article, pending)
+		_, unlink := referencedAttachments(article, linked)
+		if len(link) > 0 || len(unlink) > 0 {
+			return uc.atr.LinkAttachments(ctx, article.ID, attachmentIDs(link), attachmentIDs(unlink))
+		}
+		return nil
+	})
 	if err != nil {
-		uc.log.Errorf("update article error: %v", err)
 		return nil, err
 	}
 
-	// 获取是否收藏
-	favoriteMap, err := uc.ar.GetIsFavorited(ctx, []uint{article.ID}, currentUid)
-	if err != nil {
+	// 获取是否收藏, 书签和关注作者
+	if _, err := uc.getArticleViewerStates(ctx, []*Article{article}, currentUid); err != nil {
+		return nil, err
+	}
+	if _, err := uc.getArticleReactions(ctx, []*Article{article}, currentUid); err != nil {
 		return nil, err
 	}
-	article.Favorited = favoriteMap[article.ID]
 
 	return article, nil
 }
@@ -234,25 +443,38 @@ func (uc *SocialUsecase) FavoriteArticle(ctx context.Context, slug string) (*Art
 	// 获取用户
 	currentUser, _ := auth.FromContext(ctx)
 	currentUid := currentUser.UserID
-
-	// 添加喜欢
-	err = uc.ar.FavoriteArticle(ctx, a.ID, currentUid)
-	if err != nil {
+	if err := uc.checkNotBlocked(ctx, currentUid, a, "favorite"); err != nil {
+		return nil, err
+	}
+	if err := uc.checkPrivateVisible(ctx, currentUid, a); err != nil {
 		return nil, err
 	}
 
-	// 更新文章信息
-	a, err = uc.ar.GetArticleByAid(ctx, a.ID)
+	// 添加喜欢, 和读取更新后的文章在同一个事务中; 只有新的收藏才发布事件
+	err = uc.tm.Transaction(ctx, func(ctx context.Context) error {
+		added, err := uc.ar.FavoriteArticle(ctx, a.ID, currentUid)
+		if err != nil {
+			return err
+		}
+		if added {
+			if err := uc.eb.Publish(ctx, ArticleFavorited{ArticleID: a.ID, AuthorID: a.AuthorID, UserID: currentUid}); err != nil {
+				return err
+			}
+		}
+		a, err = uc.ar.GetArticleByAid(ctx, a.ID)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	// 获取是否收藏
-	favoriteMap, err := uc.ar.GetIsFavorited(ctx, []uint{a.ID}, currentUid)
-	if err != nil {
+	// 获取是否收藏, 书签和关注作者
+	if _, err := uc.getArticleViewerStates(ctx, []*Article{a}, currentUid); err != nil {
+		return nil, err
+	}
+	if _, err := uc.getArticleReactions(ctx, []*Article{a}, currentUid); err != nil {
 		return nil, err
 	}
-	a.Favorited = favoriteMap[a.ID]
 
 	return a, nil
 }
@@ -269,25 +491,61 @@ func (uc *SocialUsecase) UnfavoriteArticle(ctx context.Context, slug string) (*A
 	currentUser, _ := auth.FromContext(ctx)
 	currentUid := currentUser.UserID
 
-	err = uc.ar.UnfavoriteArticle(ctx, a.ID, currentUid)
+	err = uc.tm.Transaction(ctx, func(ctx context.Context) error {
+		if err := uc.ar.UnfavoriteArticle(ctx, a.ID, currentUid); err != nil {
+			return err
+		}
+		a, err = uc.ar.GetArticleByAid(ctx, a.ID)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	// 更新文章信息
-	a, err = uc.ar.GetArticleByAid(ctx, a.ID)
-	if err != nil {
+	// 和收藏一样返回书签和关注作者的状态
+	if _, err := uc.getArticleViewerStates(ctx, []*Article{a}, currentUid); err != nil {
+		return nil, err
+	}
+	if _, err := uc.getArticleReactions(ctx, []*Article{a}, currentUid); err != nil {
 		return nil, err
 	}
 
 	return a, nil
 }
 
+// 修正收藏数的任务间隔, 默认1小时
+func (uc *SocialUsecase) ReconcileInterval() time.Duration {
+	return uc.reconcileInterval
+}
+
+// 按收藏表修正所有文章的收藏数, 返回修正的数量
+// 收藏数在收藏和取消收藏时原子增减, 这里只修复异常情况下的漂移
+func (uc *SocialUsecase) ReconcileFavoritesCounts(ctx context.Context) (int, error) {
+	total := 0
+	var after uint
+	for {
+		fixed, last, err := uc.ar.ReconcileFavoritesCounts(ctx, after, reconcileBatchSize)
+		total += fixed
+		if err != nil || last == 0 {
+			return total, err
+		}
+		after = last
+	}
+}
+
 // 查询文章
 func (uc *SocialUsecase) ListArticles(ctx context.Context, opts ...ListOption) ([]*Article, error) {
 	uc.log.Infof("list articles by opts: %v", opts)
 	// 查询参数 - 根据service层进行配置
 	options := NewListOptions(opts...)
+	// 按别名过滤时使用规范的标签
+	if options.Tag != "" {
+		tag, err := uc.canonicalTagName(ctx, options.Tag)
+		if err != nil {
+			return nil, err
+		}
+		options.Tag = tag
+	}
 	// 当前用户 - 得看是否登录
 	currentUser, _ := auth.FromContext(ctx)
 	if currentUser != nil {
@@ -299,14 +557,15 @@ func (uc *SocialUsecase) ListArticles(ctx context.Context, opts ...ListOption) (
 		return nil, err
 	}
 
-	// 如果有鉴权登录, 查询aid和uid的收藏关系 + uid和authorId的follow关系
+	// 回应数不需要登录
+	articles, err = uc.getArticleReactions(ctx, articles, options.CurrentUid)
+	if err != nil {
+		return nil, err
+	}
+
+	// 如果有鉴权登录, 查询aid和uid的收藏, 书签关系 + uid和authorId的follow关系
 	if currentUser != nil {
-		currentUid := currentUser.UserID
-		articles, err = uc.getArticleFavoritedByUid(ctx, articles, currentUid)
-		if err != nil {
-			return nil, err
-		}
-		articles, err = uc.getArticleAuthorFollowedByUid(ctx, articles, currentUid)
+		articles, err = uc.getArticleViewerStates(ctx, articles, currentUser.UserID)
 		if err != nil {
 			return nil, err
 		}
@@ -316,34 +575,39 @@ func (uc *SocialUsecase) ListArticles(ctx context.Context, opts ...ListOption) (
 }
 
 // 查询文章 - 登录用户与其关注用户的关系
-func (uc *SocialUsecase) FeedArticles(ctx context.Context, opts ...ListOption) ([]*Article, error) {
-	uc.log.Info("feed artile by opts: %v", opts)
+// 关注的作者和关注的标签合并成一个feed, 使用游标分页; 没有cursor时兼容offset
+func (uc *SocialUsecase) FeedArticles(ctx context.Context, cursor string, opts ...ListOption) (*ArticlePage, error) {
+	uc.log.Infof("feed artile by opts: %v", opts)
 	options := NewListOptions(opts...)
-	currentUser, _ := auth.FromContext(ctx)
-	var currentUid uint
-	if currentUser != nil {
-		currentUid = currentUser.UserID
-		options.CurrentUid = currentUid
+	after, err := decodeCursor(cursor)
+	if err != nil {
+		return nil, err
 	}
-	uc.log.Infof("feed articles by uid: %v", options.CurrentUid)
-	articles, err := uc.ar.ListArticlesByOptions(ctx, options)
+	offset := int(options.Offset)
+	if after > 0 {
+		offset = 0
+	}
+	currentUser, _ := auth.FromContext(ctx)
+	currentUid := currentUser.UserID
+	uc.log.Infof("feed articles by uid: %v", currentUid)
+	articles, next, err := uc.ar.ListFeedArticles(ctx, currentUid, after, offset, pageSize(options.Limit))
 	if err != nil {
 		return nil, err
 	}
 
-	// uid和aid的收藏关系
-	articles, err = uc.getArticleFavoritedByUid(ctx, articles, currentUid)
+	// uid和aid的收藏, 书签关系 + uid和authorId的follow关系
+	articles, err = uc.getArticleViewerStates(ctx, articles, currentUid)
 	if err != nil {
 		return nil, err
 	}
 
-	// uid和authorId的follow关系
-	articles, err = uc.getArticleAuthorFollowedByUid(ctx, articles, currentUid)
+	// 回应数和uid的回应
+	articles, err = uc.getArticleReactions(ctx, articles, currentUid)
 	if err != nil {
 		return nil, err
 	}
 
-	return articles, nil
+	return &ArticlePage{Articles: articles, NextCursor: encodeCursor(next)}, nil
 }
 
 func (uc *SocialUsecase) AddComment(ctx context.Context, slug string, c *Comment) (*Comment, error) {
@@ -359,10 +623,31 @@ func (uc *SocialUsecase) AddComment(ctx context.Context, slug string, c *Comment
 		return nil, err
 	}
 
+	if err := uc.checkNotBlocked(ctx, currentUid, a, "comment on"); err != nil {
+		return nil, err
+	}
+	if err := uc.checkPrivateVisible(ctx, currentUid, a); err != nil {
+		return nil, err
+	}
+
 	c.ArticleID = a.ID
 	c.AuthorID = currentUid
 
-	comment, err := uc.cr.AddComment(ctx, c)
+	// 评论和事件在同一个事务中
+	var comment *Comment
+	err = uc.tm.Transaction(ctx, func(ctx context.Context) error {
+		comment, err = uc.cr.AddComment(ctx, c)
+		if err != nil {
+			return err
+		}
+		return uc.eb.Publish(ctx, CommentAdded{
+			CommentID:       comment.ID,
+			ArticleID:       a.ID,
+			ArticleAuthorID: a.AuthorID,
+			AuthorID:        currentUid,
+			Body:            comment.Body,
+		})
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -380,7 +665,7 @@ func (uc *SocialUsecase) DeleteComment(ctx context.Context, slug string, id uint
 		return err
 	}
 	if !verifyAuthor(ctx, a, currentUid) {
-		return errors.Forbidden("FORBIDDEN", "you are not the author of this article")
+		return ErrNotArticleAuthor
 	}
 
 	err = uc.cr.DeleteCommentByID(ctx, id)
@@ -394,13 +679,17 @@ func (uc *SocialUsecase) GetComments(ctx context.Context, slug string) ([]*Comme
 		return nil, err
 	}
 
-	comments, err := uc.cr.GetCommentsByID(ctx, a.ID)
-	if err != nil {
+	if err := uc.checkArticleVisible(ctx, a); err != nil {
 		return nil, err
 	}
-	return comments, nil
-}
 
-func (uc *SocialUsecase) GetTags(ctx context.Context) ([]Tag, error) {
-	return uc.tr.GetTags(ctx)
+	var currentUid uint
+	if currentUser, ok := auth.FromContext(ctx); ok {
+		currentUid = currentUser.UserID
+	}
+	comments, err := uc.cr.GetCommentsByID(ctx, a.ID, currentUid)
+	if err != nil {
+		return nil, err
+	}
+	return uc.getCommentReactions(ctx, comments, currentUid)
 }
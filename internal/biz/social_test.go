@@ -0,0 +1,201 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"kratos-realworld/internal/pkg/middleware/auth"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-playground/assert/v2"
+)
+
+type txKey struct{}
+
+// 记录事务结果的Transaction, fn中的ctx带有txKey
+type memoryTx struct {
+	committed  int
+	rolledBack int
+}
+
+func (tm *memoryTx) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if err := fn(context.WithValue(ctx, txKey{}, tm)); err != nil {
+		tm.rolledBack++
+		return err
+	}
+	tm.committed++
+	return nil
+}
+
+func inTx(ctx context.Context) bool {
+	return ctx.Value(txKey{}) != nil
+}
+
+type txArticles struct {
+	ArticleRepo
+	created []bool
+}
+
+func (r *txArticles) CreateArticle(ctx context.Context, a *Article) (*Article, error) {
+	r.created = append(r.created, inTx(ctx))
+	a.ID = uint(len(r.created))
+	return a, nil
+}
+
+type txAttachments struct {
+	AttachmentRepo
+	pending []*Attachment
+	linkErr error
+}
+
+func (r *txAttachments) ListPendingAttachments(ctx context.Context, uid uint) ([]*Attachment, error) {
+	return r.pending, nil
+}
+
+func (r *txAttachments) LinkAttachments(ctx context.Context, aid uint, link []uint, unlink []uint) error {
+	if !inTx(ctx) {
+		return errors.New("not in transaction")
+	}
+	return r.linkErr
+}
+
+func TestCreateArticleTransaction(t *testing.T) {
+	ctx := auth.WithContext(context.Background(), &auth.CurrentUser{UserID: 7})
+	articles := &txArticles{}
+	attachments := &txAttachments{pending: []*Attachment{{ID: 1, URL: "/media/attachments/7/a.png"}}}
+	tm := &memoryTx{}
+	uc := NewSocialUsecase(articles, nil, nil, nil, attachments, nil, nil, tm, nil, nil, log.DefaultLogger)
+
+	article := &Article{Title: "hello", Body: "![](/media/attachments/7/a.png)"}
+	_, err := uc.CreateArticle(ctx, article)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, []bool{true}, articles.created)
+	assert.Equal(t, 1, tm.committed)
+
+	// 关联附件失败时整个创建回滚
+	attachments.linkErr = errors.New("link failed")
+	_, err = uc.CreateArticle(ctx, &Article{Title: "world", Body: article.Body})
+	assert.Equal(t, attachments.linkErr, err)
+	assert.Equal(t, 1, tm.rolledBack)
+}
+
+// 每批返回一个修正, 共三批
+type reconcileArticles struct {
+	ArticleRepo
+	afters []uint
+}
+
+func (r *reconcileArticles) ReconcileFavoritesCounts(ctx context.Context, afterID uint, limit int) (int, uint, error) {
+	r.afters = append(r.afters, afterID)
+	if len(r.afters) > 3 {
+		return 0, 0, nil
+	}
+	return 1, afterID + uint(limit), nil
+}
+
+func TestReconcileFavoritesCounts(t *testing.T) {
+	articles := &reconcileArticles{}
+	uc := NewSocialUsecase(articles, nil, nil, nil, nil, nil, nil, nil, nil, nil, log.DefaultLogger)
+
+	fixed, err := uc.ReconcileFavoritesCounts(context.Background())
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 3, fixed)
+	assert.Equal(t, []uint{0, reconcileBatchSize, 2 * reconcileBatchSize, 3 * reconcileBatchSize}, articles.afters)
+	assert.Equal(t, time.Hour, uc.ReconcileInterval())
+}
+
+// 固定返回文章列表和当前用户的状态, 记录查询状态的次数
+type viewerArticles struct {
+	ArticleRepo
+	articles    []*Article
+	states      map[uint]ArticleViewerState
+	stateCalls  int
+	stateViewer uint
+}
+
+func (r *viewerArticles) ListArticlesByOptions(ctx context.Context, options *ListOptions) ([]*Article, error) {
+	return r.articles, nil
+}
+
+func (r *viewerArticles) GetArticleViewerStates(ctx context.Context, aids []uint, uid uint) (map[uint]ArticleViewerState, error) {
+	r.stateCalls++
+	r.stateViewer = uid
+	return r.states, nil
+}
+
+func TestListArticlesViewerStates(t *testing.T) {
+	articles := &viewerArticles{
+		articles: []*Article{
+			{ID: 1, AuthorID: 3, Author: &ProfileResp{ID: 3}},
+			{ID: 2, AuthorID: 4, Author: &ProfileResp{ID: 4}},
+		},
+		states: map[uint]ArticleViewerState{
+			1: {Favorited: true, Following: true},
+			2: {Bookmarked: true},
+		},
+	}
+	uc := NewSocialUsecase(articles, nil, nil, nil, nil, nil, &stubReactions{}, nil, nil, nil, log.DefaultLogger)
+
+	// 未登录时不查询
+	_, err := uc.ListArticles(context.Background())
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 0, articles.stateCalls)
+
+	ctx := auth.WithContext(context.Background(), &auth.CurrentUser{UserID: 7})
+	list, err := uc.ListArticles(ctx)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 1, articles.stateCalls)
+	assert.Equal(t, uint(7), articles.stateViewer)
+	assert.Equal(t, true, list[0].Favorited)
+	assert.Equal(t, false, list[0].Bookmarked)
+	assert.Equal(t, true, list[0].Author.Following)
+	assert.Equal(t, false, list[1].Favorited)
+	assert.Equal(t, true, list[1].Bookmarked)
+	assert.Equal(t, false, list[1].Author.Following)
+}
+
+// 按slug和id都返回第一篇文章的副本
+func (r *viewerArticles) GetArticleBySlug(ctx context.Context, slug string) (*Article, error) {
+	a := *r.articles[0]
+	author := *a.Author
+	a.Author = &author
+	return &a, nil
+}
+
+func (r *viewerArticles) GetArticleByAid(ctx context.Context, aid uint) (*Article, error) {
+	return r.GetArticleBySlug(ctx, "")
+}
+
+func (r *viewerArticles) UnfavoriteArticle(ctx context.Context, aid uint, uid uint) error {
+	return nil
+}
+
+func TestGetArticleViewerStates(t *testing.T) {
+	ctx := auth.WithContext(context.Background(), &auth.CurrentUser{UserID: 7})
+	articles := &viewerArticles{
+		articles: []*Article{{ID: 1, AuthorID: 3, Author: &ProfileResp{ID: 3}}},
+		states:   map[uint]ArticleViewerState{1: {Favorited: true, Bookmarked: true, Following: true}},
+	}
+	uc := NewSocialUsecase(articles, nil, nil, &stubNotifyProfiles{}, nil, nil, &stubReactions{}, &memoryTx{}, nil, nil, log.DefaultLogger)
+
+	a, err := uc.GetArticle(ctx, "hello")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, true, a.Favorited)
+	assert.Equal(t, true, a.Bookmarked)
+	assert.Equal(t, true, a.Author.Following)
+
+	// 取消收藏后仍然返回书签和关注作者的状态
+	articles.states[1] = ArticleViewerState{Bookmarked: true, Following: true}
+	a, err = uc.UnfavoriteArticle(ctx, "hello")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, false, a.Favorited)
+	assert.Equal(t, true, a.Bookmarked)
+	assert.Equal(t, true, a.Author.Following)
+
+	// 未登录时不查询
+	_, err = uc.GetArticle(context.Background(), "hello")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 2, articles.stateCalls)
+}
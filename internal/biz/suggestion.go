@@ -0,0 +1,88 @@
+package biz
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"kratos-realworld/internal/pkg/middleware/auth"
+)
+
+// 推荐关注的候选人, 以及用于排序的各项信号
+type SuggestionSignal struct {
+	UserID         uint
+	MutualFollows  int // 当前用户关注的人里有几个关注了他
+	SharedTags     int // 他的文章和当前用户收藏的文章有几个相同的tag
+	RecentArticles int // 最近一段时间发布的文章数
+}
+
+const (
+	// 最近活跃的统计窗口
+	suggestionWindow = 30 * 24 * time.Hour
+	// 每项信号最多取的候选人数量
+	maxSuggestionCandidates = 200
+	// 最近活跃只作为补充, 避免高产作者压过社交关系
+	maxRecentArticlesScore = 5
+)
+
+func (s *SuggestionSignal) score() int {
+	recent := s.RecentArticles
+	if recent > maxRecentArticlesScore {
+		recent = maxRecentArticlesScore
+	}
+	return s.MutualFollows*3 + s.SharedTags*2 + recent
+}
+
+// 按分数从高到低排序, 分数相同时新用户优先
+func rankSuggestions(signals []*SuggestionSignal) []uint {
+	sort.Slice(signals, func(i, j int) bool {
+		si, sj := signals[i].score(), signals[j].score()
+		if si != sj {
+			return si > sj
+		}
+		return signals[i].UserID > signals[j].UserID
+	})
+	uids := make([]uint, len(signals))
+	for i, s := range signals {
+		uids[i] = s.UserID
+	}
+	return uids
+}
+
+// 推荐关注 - 候选人数量有限, 在内存中排序后按偏移量分页
+func (uc *UserUsecase) SuggestProfiles(ctx context.Context, cursor string, limit int64) (*ProfilePage, error) {
+	offset, err := decodeCursor(cursor)
+	if err != nil {
+		return nil, err
+	}
+	currentUser, _ := auth.FromContext(ctx)
+	signals, err := uc.pr.GetSuggestionSignals(ctx, currentUser.UserID, time.Now().Add(-suggestionWindow), maxSuggestionCandidates)
+	if err != nil {
+		return nil, err
+	}
+	uids := rankSuggestions(signals)
+
+	start := int(offset)
+	if start > len(uids) {
+		start = len(uids)
+	}
+	end := start + pageSize(limit)
+	var next uint
+	if end < len(uids) {
+		next = uint(end)
+	} else {
+		end = len(uids)
+	}
+
+	profiles := []*ProfileResp{}
+	if start < end {
+		profiles, err = uc.pr.GetProfilesByIDs(ctx, uids[start:end])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &ProfilePage{
+		Profiles:   profiles,
+		NextCursor: encodeCursor(next),
+	}, nil
+}
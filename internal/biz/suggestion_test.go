@@ -0,0 +1,62 @@
+package biz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"kratos-realworld/internal/pkg/middleware/auth"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-playground/assert/v2"
+)
+
+func TestRankSuggestions(t *testing.T) {
+	uids := rankSuggestions([]*SuggestionSignal{
+		{UserID: 1, RecentArticles: 50},
+		{UserID: 2, MutualFollows: 2},
+		{UserID: 3, SharedTags: 1, RecentArticles: 1},
+		{UserID: 4, SharedTags: 1, RecentArticles: 1},
+	})
+	// 最近活跃有上限, 社交关系优先; 同分时新用户优先
+	assert.Equal(t, []uint{2, 1, 4, 3}, uids)
+}
+
+// 固定返回候选人的ProfileRepo
+type suggestionProfiles struct {
+	ProfileRepo
+	signals []*SuggestionSignal
+}
+
+func (r *suggestionProfiles) GetSuggestionSignals(ctx context.Context, uid uint, since time.Time, limit int) ([]*SuggestionSignal, error) {
+	return r.signals, nil
+}
+
+func (r *suggestionProfiles) GetProfilesByIDs(ctx context.Context, uids []uint) ([]*ProfileResp, error) {
+	profiles := make([]*ProfileResp, len(uids))
+	for i, id := range uids {
+		profiles[i] = &ProfileResp{ID: id}
+	}
+	return profiles, nil
+}
+
+func TestSuggestProfilesPaging(t *testing.T) {
+	ctx := auth.WithContext(context.Background(), &auth.CurrentUser{UserID: 7})
+	r := &suggestionProfiles{}
+	for id := uint(1); id <= 5; id++ {
+		r.signals = append(r.signals, &SuggestionSignal{UserID: id, MutualFollows: int(id)})
+	}
+	uc := NewUserUsecase(nil, r, nil, nil, log.DefaultLogger, nil, nil, nil)
+
+	page, err := uc.SuggestProfiles(ctx, "", 3)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 3, len(page.Profiles))
+	assert.Equal(t, uint(5), page.Profiles[0].ID)
+	assert.NotEqual(t, "", page.NextCursor)
+
+	page, err = uc.SuggestProfiles(ctx, page.NextCursor, 3)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 2, len(page.Profiles))
+	assert.Equal(t, uint(1), page.Profiles[1].ID)
+	assert.Equal(t, "", page.NextCursor)
+}
@@ -0,0 +1,363 @@
+package biz
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"kratos-realworld/internal/pkg/middleware/auth"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+const (
+	defaultTrendingWindow = 7 * 24 * time.Hour
+	maxTagLength          = 32
+	maxTagsPerArticle     = 10
+)
+
+// 标签和当前用户的关注状态
+type TagInfo struct {
+	ID            uint
+	Name          Tag
+	ArticlesCount uint32
+	Following     bool
+	Aliases       []string
+}
+
+// 一篇文章使用了一个标签
+type TagUse struct {
+	Name      Tag
+	CreatedAt time.Time
+}
+
+type TrendingTag struct {
+	Name        Tag
+	Score       float64
+	RecentCount uint32
+}
+
+// 登录时带上当前用户是否关注了每个标签
+func (uc *SocialUsecase) GetTags(ctx context.Context, prefix string, limit int64) ([]*TagInfo, error) {
+	n := 0
+	if limit > 0 {
+		n = pageSize(limit)
+	}
+	tags, err := uc.tr.GetTags(ctx, prefix, n)
+	if err != nil {
+		return nil, err
+	}
+	if currentUser, ok := auth.FromContext(ctx); ok {
+		list, err := uc.tr.GetFollowedTags(ctx, currentUser.UserID)
+		if err != nil {
+			return nil, err
+		}
+		followed := make(map[Tag]bool, len(list))
+		for _, tag := range list {
+			followed[tag] = true
+		}
+		for _, tag := range tags {
+			tag.Following = followed[tag.Name]
+		}
+	}
+	return tags, nil
+}
+
+func (uc *SocialUsecase) GetTrendingTags(ctx context.Context, limit int64) ([]*TrendingTag, error) {
+	now := time.Now()
+	uses, err := uc.tr.ListTagUses(ctx, now.Add(-uc.trendingWindow))
+	if err != nil {
+		return nil, err
+	}
+	tags := trendingTags(uses, now, uc.trendingWindow)
+	if n := pageSize(limit); len(tags) > n {
+		tags = tags[:n]
+	}
+	return tags, nil
+}
+
+// 滑动窗口内的使用速度 - 每天使用的次数, 越新的使用权重越高, 刚发布为1, 窗口边缘降到0
+func trendingTags(uses []*TagUse, now time.Time, window time.Duration) []*TrendingTag {
+	byName := make(map[Tag]*TrendingTag)
+	days := window.Hours() / 24
+	for _, use := range uses {
+		age := now.Sub(use.CreatedAt)
+		if age < 0 {
+			age = 0
+		}
+		if age >= window {
+			continue
+		}
+		t, ok := byName[use.Name]
+		if !ok {
+			t = &TrendingTag{Name: use.Name}
+			byName[use.Name] = t
+		}
+		t.RecentCount++
+		t.Score += (1 - float64(age)/float64(window)) / days
+	}
+
+	tags := make([]*TrendingTag, 0, len(byName))
+	for _, t := range byName {
+		tags = append(tags, t)
+	}
+	sort.Slice(tags, func(i, j int) bool {
+		if tags[i].Score != tags[j].Score {
+			return tags[i].Score > tags[j].Score
+		}
+		return tags[i].Name < tags[j].Name
+	})
+	return tags
+}
+
+func (uc *SocialUsecase) FollowTag(ctx context.Context, name string) (*TagInfo, error) {
+	currentUser, _ := auth.FromContext(ctx)
+	tag, err := uc.lookupTag(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.tr.FollowTag(ctx, currentUser.UserID, tag.ID); err != nil {
+		return nil, err
+	}
+	tag.Following = true
+	return tag, nil
+}
+
+func (uc *SocialUsecase) UnfollowTag(ctx context.Context, name string) (*TagInfo, error) {
+	currentUser, _ := auth.FromContext(ctx)
+	tag, err := uc.lookupTag(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.tr.UnfollowTag(ctx, currentUser.UserID, tag.ID); err != nil {
+		return nil, err
+	}
+	tag.Following = false
+	return tag, nil
+}
+
+// 标签统一为小写, 连续的空白替换为-, 只允许字母, 数字和 - _ . + #
+func normalizeTag(name string) (string, error) {
+	name = strings.ToLower(strings.Join(strings.Fields(name), "-"))
+	if name == "" {
+		return "", ValidationError("tagList", "can not be empty")
+	}
+	if utf8.RuneCountInString(name) > maxTagLength {
+		return "", ValidationError("tagList", "tag %q is too long", name)
+	}
+	for _, r := range name {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && !strings.ContainsRune("-_.+#", r) {
+			return "", ValidationError("tagList", "tag %q contains invalid characters", name)
+		}
+	}
+	return name, nil
+}
+
+// 写入文章前规范化标签, 别名替换为规范的标签, 去重后保持原来的顺序; 空的标签直接忽略
+func (uc *SocialUsecase) canonicalTags(ctx context.Context, names []string) ([]string, error) {
+	normalized := make([]string, 0, len(names))
+	for _, name := range names {
+		if strings.TrimSpace(name) == "" {
+			continue
+		}
+		tag, err := normalizeTag(name)
+		if err != nil {
+			return nil, err
+		}
+		normalized = append(normalized, tag)
+	}
+	if len(normalized) == 0 {
+		return normalized, nil
+	}
+	aliases, err := uc.tr.ResolveAliases(ctx, normalized)
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[string]bool, len(normalized))
+	tags := make([]string, 0, len(normalized))
+	for _, tag := range normalized {
+		if canonical, ok := aliases[tag]; ok {
+			tag = canonical
+		}
+		if seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		tags = append(tags, tag)
+	}
+	if len(tags) > maxTagsPerArticle {
+		return nil, ValidationError("tagList", "can not have more than %d tags", maxTagsPerArticle)
+	}
+	return tags, nil
+}
+
+// 按名称或别名查找标签, 查询时不校验字符
+func (uc *SocialUsecase) canonicalTagName(ctx context.Context, name string) (string, error) {
+	tag, err := normalizeTag(name)
+	if err != nil {
+		return strings.TrimSpace(name), nil
+	}
+	aliases, err := uc.tr.ResolveAliases(ctx, []string{tag})
+	if err != nil {
+		return "", err
+	}
+	if canonical, ok := aliases[tag]; ok {
+		return canonical, nil
+	}
+	return tag, nil
+}
+
+func (uc *SocialUsecase) lookupTag(ctx context.Context, name string) (*TagInfo, error) {
+	name, err := uc.canonicalTagName(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return uc.tr.GetTag(ctx, name)
+}
+
+func (uc *SocialUsecase) requireAdmin(ctx context.Context) error {
+	currentUser, ok := auth.FromContext(ctx)
+	if !ok || !uc.admins[currentUser.UserID] {
+		return ForbiddenError("only admins can manage tags")
+	}
+	return nil
+}
+
+// 新名称不能是已有的标签或其他标签的别名
+func (uc *SocialUsecase) checkTagNameFree(ctx context.Context, tagID uint, name string, field string) error {
+	if _, err := uc.tr.GetTag(ctx, name); err == nil {
+		return ValidationError(field, "is already a tag, merge the tags instead")
+	} else if !errors.Is(err, ErrTagNotFound) {
+		return err
+	}
+	aliases, err := uc.tr.ResolveAliases(ctx, []string{name})
+	if err != nil {
+		return err
+	}
+	if canonical, ok := aliases[name]; ok {
+		tag, err := uc.tr.GetTag(ctx, canonical)
+		if err != nil {
+			return err
+		}
+		if tag.ID != tagID {
+			return ValidationError(field, "is already an alias of %s", canonical)
+		}
+	}
+	return nil
+}
+
+func (uc *SocialUsecase) tagWithAliases(ctx context.Context, name string) (*TagInfo, error) {
+	tag, err := uc.tr.GetTag(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	tag.Aliases, err = uc.tr.ListAliases(ctx, tag.ID)
+	if err != nil {
+		return nil, err
+	}
+	return tag, nil
+}
+
+func (uc *SocialUsecase) RenameTag(ctx context.Context, name string, newName string) (*TagInfo, error) {
+	if err := uc.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	tag, err := uc.tr.GetTag(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	newName, err = normalizeTag(newName)
+	if err != nil {
+		return nil, ValidationError("name", "%s", errors.FromError(err).Message)
+	}
+	if newName == string(tag.Name) {
+		return uc.tagWithAliases(ctx, newName)
+	}
+	// 检查和改名在同一个事务中, 并发时由唯一索引兜底
+	err = uc.tm.Transaction(ctx, func(ctx context.Context) error {
+		if err := uc.checkTagNameFree(ctx, tag.ID, newName, "name"); err != nil {
+			return err
+		}
+		return uc.tr.RenameTag(ctx, tag.ID, newName)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return uc.tagWithAliases(ctx, newName)
+}
+
+func (uc *SocialUsecase) MergeTag(ctx context.Context, name string, target string) (*TagInfo, error) {
+	if err := uc.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	var dest *TagInfo
+	err := uc.tm.Transaction(ctx, func(ctx context.Context) error {
+		source, err := uc.tr.GetTag(ctx, name)
+		if err != nil {
+			return err
+		}
+		if dest, err = uc.lookupTag(ctx, target); err != nil {
+			return err
+		}
+		if source.ID == dest.ID {
+			return ValidationError("target", "can not merge a tag into itself")
+		}
+		return uc.tr.MergeTags(ctx, source.ID, dest.ID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return uc.tagWithAliases(ctx, string(dest.Name))
+}
+
+func (uc *SocialUsecase) DeleteTag(ctx context.Context, name string) error {
+	if err := uc.requireAdmin(ctx); err != nil {
+		return err
+	}
+	tag, err := uc.tr.GetTag(ctx, name)
+	if err != nil {
+		return err
+	}
+	return uc.tr.DeleteTag(ctx, tag.ID)
+}
+
+func (uc *SocialUsecase) AddTagAlias(ctx context.Context, name string, alias string) (*TagInfo, error) {
+	if err := uc.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	tag, err := uc.tr.GetTag(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	alias, err = normalizeTag(alias)
+	if err != nil {
+		return nil, ValidationError("alias", "%s", errors.FromError(err).Message)
+	}
+	err = uc.tm.Transaction(ctx, func(ctx context.Context) error {
+		if err := uc.checkTagNameFree(ctx, tag.ID, alias, "alias"); err != nil {
+			return err
+		}
+		return uc.tr.AddAlias(ctx, tag.ID, alias)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return uc.tagWithAliases(ctx, name)
+}
+
+func (uc *SocialUsecase) RemoveTagAlias(ctx context.Context, name string, alias string) (*TagInfo, error) {
+	if err := uc.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	tag, err := uc.tr.GetTag(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.tr.RemoveAlias(ctx, tag.ID, alias); err != nil {
+		return nil, err
+	}
+	return uc.tagWithAliases(ctx, name)
+}
@@ -0,0 +1,166 @@
+package biz
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"kratos-realworld/internal/conf"
+	"kratos-realworld/internal/pkg/middleware/auth"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-playground/assert/v2"
+)
+
+// 内存中的TagRepo
+type memoryTags struct {
+	TagRepo
+	tags     []Tag
+	followed map[uint][]Tag
+	aliases  map[string]string
+}
+
+func (r *memoryTags) ResolveAliases(ctx context.Context, names []string) (map[string]string, error) {
+	result := make(map[string]string)
+	for _, name := range names {
+		if canonical, ok := r.aliases[name]; ok {
+			result[name] = canonical
+		}
+	}
+	return result, nil
+}
+
+func (r *memoryTags) GetTags(ctx context.Context, prefix string, limit int) ([]*TagInfo, error) {
+	list := make([]*TagInfo, len(r.tags))
+	for i, tag := range r.tags {
+		list[i] = &TagInfo{Name: tag}
+	}
+	return list, nil
+}
+
+func (r *memoryTags) GetFollowedTags(ctx context.Context, uid uint) ([]Tag, error) {
+	return r.followed[uid], nil
+}
+
+func TestGetTagsFollowing(t *testing.T) {
+	tags := &memoryTags{
+		tags:     []Tag{"go", "rust", "zig"},
+		followed: map[uint][]Tag{1: {"rust"}},
+	}
+	uc := NewSocialUsecase(nil, nil, tags, nil, nil, nil, nil, nil, nil, nil, log.DefaultLogger)
+
+	list, err := uc.GetTags(auth.WithContext(context.Background(), &auth.CurrentUser{UserID: 1}), "", 0)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, []*TagInfo{{Name: "go"}, {Name: "rust", Following: true}, {Name: "zig"}}, list)
+
+	// 未登录时都是未关注
+	list, err = uc.GetTags(context.Background(), "", 0)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, false, list[1].Following)
+}
+
+func TestTrendingTags(t *testing.T) {
+	now := time.Now()
+	window := 10 * 24 * time.Hour
+	day := 24 * time.Hour
+	uses := []*TagUse{
+		// 窗口开始时用得多, 之后没人用了
+		{Name: "old", CreatedAt: now.Add(-9 * day)},
+		{Name: "old", CreatedAt: now.Add(-9 * day)},
+		{Name: "old", CreatedAt: now.Add(-9 * day)},
+		// 最近刚开始用
+		{Name: "new", CreatedAt: now.Add(-day)},
+		{Name: "new", CreatedAt: now},
+		// 窗口之外的不计算
+		{Name: "gone", CreatedAt: now.Add(-11 * day)},
+	}
+
+	tags := trendingTags(uses, now, window)
+	assert.Equal(t, 2, len(tags))
+	assert.Equal(t, Tag("new"), tags[0].Name)
+	assert.Equal(t, uint32(2), tags[0].RecentCount)
+	assert.Equal(t, Tag("old"), tags[1].Name)
+	assert.Equal(t, uint32(3), tags[1].RecentCount)
+	// 刚发布的一次使用权重为1, 除以窗口的天数
+	assert.Equal(t, true, tags[0].Score > 0.18 && tags[0].Score < 0.2)
+}
+
+func TestNormalizeTag(t *testing.T) {
+	for in, want := range map[string]string{
+		"Go ":          "go",
+		"  Web   Dev ": "web-dev",
+		"C++":          "c++",
+		"数据库":          "数据库",
+	} {
+		got, err := normalizeTag(in)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, want, got)
+	}
+	for _, in := range []string{"bad/tag", "<script>", strings.Repeat("a", maxTagLength+1)} {
+		_, err := normalizeTag(in)
+		assert.Equal(t, int32(422), errors.FromError(err).Code)
+	}
+}
+
+func TestCanonicalTags(t *testing.T) {
+	tags := &memoryTags{aliases: map[string]string{"golang": "go"}}
+	uc := NewSocialUsecase(nil, nil, tags, nil, nil, nil, nil, nil, nil, nil, log.DefaultLogger)
+
+	// 别名替换后去重, 空的标签忽略
+	list, err := uc.canonicalTags(context.Background(), []string{"Golang", "", "go ", "Rust"})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, []string{"go", "rust"}, list)
+
+	many := make([]string, maxTagsPerArticle+1)
+	for i := range many {
+		many[i] = strings.Repeat("a", i+1)
+	}
+	_, err = uc.canonicalTags(context.Background(), many)
+	assert.Equal(t, int32(422), errors.FromError(err).Code)
+}
+
+func TestTagAdminOnly(t *testing.T) {
+	uc := NewSocialUsecase(nil, nil, &memoryTags{}, nil, nil, nil, nil, nil, nil, &conf.Social{AdminUserIds: []uint32{1}}, log.DefaultLogger)
+	err := uc.DeleteTag(auth.WithContext(context.Background(), &auth.CurrentUser{UserID: 2}), "go")
+	assert.Equal(t, true, errors.IsForbidden(err))
+}
+
+// 改名 - 记录新名称的检查和改名是否在事务中
+type renameTags struct {
+	memoryTags
+	name      string
+	checkInTx bool
+	renamed   bool
+}
+
+func (r *renameTags) GetTag(ctx context.Context, name string) (*TagInfo, error) {
+	if name != r.name {
+		r.checkInTx = inTx(ctx)
+		return nil, ErrTagNotFound
+	}
+	return &TagInfo{ID: 1, Name: Tag(name)}, nil
+}
+
+func (r *renameTags) RenameTag(ctx context.Context, tagID uint, name string) error {
+	r.renamed = inTx(ctx)
+	r.name = name
+	return nil
+}
+
+func (r *renameTags) ListAliases(ctx context.Context, tagID uint) ([]string, error) {
+	return nil, nil
+}
+
+func TestRenameTagInTransaction(t *testing.T) {
+	ctx := auth.WithContext(context.Background(), &auth.CurrentUser{UserID: 1})
+	tags := &renameTags{name: "go"}
+	uc := NewSocialUsecase(nil, nil, tags, nil, nil, nil, nil, &memoryTx{}, nil, &conf.Social{AdminUserIds: []uint32{1}}, log.DefaultLogger)
+
+	tag, err := uc.RenameTag(ctx, "go", "Golang")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, Tag("golang"), tag.Name)
+	assert.Equal(t, true, tags.checkInTx)
+	assert.Equal(t, true, tags.renamed)
+}
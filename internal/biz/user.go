@@ -2,10 +2,13 @@ package biz
 
 import (
 	"context"
+	"errors"
+	"strings"
+	"time"
+
 	"kratos-realworld/internal/conf"
 	"kratos-realworld/internal/pkg/middleware/auth"
 
-	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	"golang.org/x/crypto/bcrypt"
 )
@@ -19,6 +22,8 @@ type User struct {
 	Bio          string
 	Image        string
 	PasswordHash string
+	// 私密账号 - 关注需要本人同意, 文章只对粉丝可见
+	Private bool
 }
 
 // 更新用户数据
@@ -28,6 +33,8 @@ type UserUpdate struct {
 	Username string
 	Bio      string
 	Image    string
+	// nil表示不修改
+	Private *bool
 }
 
 // 响应 - data层的响应
@@ -37,23 +44,108 @@ type UserLogin struct {
 	Token    string
 	Bio      string
 	Image    string
+	Private  bool
+}
+
+// 导出的个人数据
+// 导出的数据覆盖删除账号时会删除的所有数据
+type UserExport struct {
+	User      *User
+	CreatedAt time.Time
+	Articles  []*Article
+	Comments  []*Comment
+	Favorites []*ExportFavorite
+	Following []*ExportFollow
+	Followers []*ExportFollow
+	// 发出和收到的关注申请
+	FollowRequestsSent     []*ExportFollow
+	FollowRequestsReceived []*ExportFollow
+	Blocked                []*ExportFollow
+	Muted                  []*ExportFollow
+	Attachments            []*Attachment
+	BookmarkCollections    []*BookmarkCollection
+	Bookmarks              []*ExportBookmark
+	Reactions              []*ExportReaction
+	FollowedTags           []*ExportTag
+	Notifications          []*ExportNotification
+	// 只包含保存过的设置
+	NotificationPreferences map[string]bool
+}
+
+type ExportFavorite struct {
+	Slug      string
+	CreatedAt time.Time
 }
 
+// 关注, 关注申请, 拉黑和静音的对方用户
+type ExportFollow struct {
+	Username  string
+	CreatedAt time.Time
+}
+
+type ExportBookmark struct {
+	Slug string
+	// 不在收藏夹中时为空
+	Collection string
+	CreatedAt  time.Time
+}
+
+type ExportReaction struct {
+	TargetType string
+	TargetID   uint
+	Reaction   string
+	CreatedAt  time.Time
+}
+
+type ExportTag struct {
+	Name      string
+	CreatedAt time.Time
+}
+
+type ExportNotification struct {
+	Type        string
+	ArticleSlug string
+	CommentID   uint
+	// 合并在这条通知中的操作者, 已删除的用户不导出
+	Actors    []string
+	Read      bool
+	CreatedAt time.Time
+}
+
+const (
+	defaultPlaceholderUsername = "deleted-user"
+	maxSearchQueryLength       = 100
+)
+
 type ProfileResp struct {
 	ID        uint
 	Username  string
 	Bio       string
 	Image     string
 	Following bool
+	Private   bool
+	// 当前用户已申请关注, 等待对方同意
+	FollowRequested bool
+
+	// 计数器 - users表中冗余维护, 不做count(*)
+	FollowersCount uint32
+	FollowingCount uint32
+	ArticlesCount  uint32
+}
+
+// 关注/粉丝列表的一页
+type ProfilePage struct {
+	Profiles   []*ProfileResp
+	NextCursor string
 }
 
 // hash password - 数据库中存储hash加密的pwd
-func hashPassword(pwd string) string {
-	hash, err := bcrypt.GenerateFromPassword([]byte(pwd), bcrypt.DefaultCost)
+func hashPassword(pwd string, cost int) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(pwd), cost)
 	if err != nil {
-		panic(err)
+		return "", err
 	}
-	return string(hash)
+	return string(hash), nil
 }
 
 // verify password - 登录时验证密码
@@ -70,28 +162,77 @@ type UserRepo interface {
 	GetUserByUsername(ctx context.Context, username string) (*User, error)
 	GetUserByID(ctx context.Context, uid uint) (*User, error)
 	UpdateUser(ctx context.Context, user *User) (*User, error)
+	UpdatePasswordHash(ctx context.Context, uid uint, hash string) error
+
+	// 注销账号 - 两种策略都会删除follow和favorite
+	AnonymizeUser(ctx context.Context, uid uint) error
+	DeleteUser(ctx context.Context, uid uint, placeholderUsername string) error
+	ExportUser(ctx context.Context, uid uint) (*UserExport, error)
 }
 
 type ProfileRepo interface {
 	GetProfileByUsername(ctx context.Context, username string) (*ProfileResp, error)
 	FollowUserByUsername(ctx context.Context, currentUserID uint, followingUserID uint) error
 	UnfollowUserByUsername(ctx context.Context, currentUserID uint, followingUserID uint) error
+
+	// 游标为follow记录的id, 返回下一页的游标, 0表示没有更多
+	ListFollowers(ctx context.Context, uid uint, cursor uint, limit int) ([]*ProfileResp, uint, error)
+	ListFollowing(ctx context.Context, uid uint, cursor uint, limit int) ([]*ProfileResp, uint, error)
+	// uid是否关注uids中的每个用户
+	GetFollowingMap(ctx context.Context, uid uint, uids []uint) (map[uint]bool, error)
+
+	// 拉黑会解除双方的关注关系
+	BlockUser(ctx context.Context, uid uint, targetID uint) error
+	UnblockUser(ctx context.Context, uid uint, targetID uint) error
+	MuteUser(ctx context.Context, uid uint, targetID uint) error
+	UnmuteUser(ctx context.Context, uid uint, targetID uint) error
+	ListBlockedUsers(ctx context.Context, uid uint, cursor uint, limit int) ([]*ProfileResp, uint, error)
+	ListMutedUsers(ctx context.Context, uid uint, cursor uint, limit int) ([]*ProfileResp, uint, error)
+	// 两个用户之间任一方向存在拉黑
+	IsBlocked(ctx context.Context, uid uint, otherID uint) (bool, error)
+	// uid是否静音了targetID
+	IsMuted(ctx context.Context, uid uint, targetID uint) (bool, error)
+
+	// 关注申请 - 同意后转为关注关系
+	CreateFollowRequest(ctx context.Context, uid uint, targetID uint) error
+	ApproveFollowRequest(ctx context.Context, requesterID uint, targetID uint) error
+	// 拒绝和撤回都是删除申请
+	DeleteFollowRequest(ctx context.Context, requesterID uint, targetID uint) error
+	// 私密账号改为公开时, 同意所有待处理的申请 - 返回新建了关注的申请人
+	ApproveAllFollowRequests(ctx context.Context, targetID uint) ([]uint, error)
+	ListIncomingFollowRequests(ctx context.Context, uid uint, cursor uint, limit int) ([]*ProfileResp, uint, error)
+	ListOutgoingFollowRequests(ctx context.Context, uid uint, cursor uint, limit int) ([]*ProfileResp, uint, error)
+
+	// 搜索用户 - 排除已注销以及和viewerUid互相拉黑的用户, 结果有排序所以游标为偏移量
+	SearchProfiles(ctx context.Context, viewerUid uint, query string, offset uint, limit int) ([]*ProfileResp, uint, error)
+	// 推荐关注的候选人 - 已排除自己, 已关注, 已申请关注和互相拉黑的用户
+	GetSuggestionSignals(ctx context.Context, uid uint, since time.Time, limit int) ([]*SuggestionSignal, error)
+	// 按uids的顺序返回
+	GetProfilesByIDs(ctx context.Context, uids []uint) ([]*ProfileResp, error)
 }
 
 // GreeterUsecase is a Greeter usecase.
 type UserUsecase struct {
 	ur   UserRepo
 	pr   ProfileRepo
+	tm   Transaction
+	eb   *EventBus
 	log  *log.Helper
 	jwtc *conf.JWT
+	ac   *conf.Account
+	pp   *PasswordPolicy
 }
 
 func NewUserUsecase(ur UserRepo,
 	pr ProfileRepo,
+	tm Transaction,
+	eb *EventBus,
 	logger log.Logger,
 	jwtc *conf.JWT,
+	ac *conf.Account,
+	pp *PasswordPolicy,
 ) *UserUsecase {
-	return &UserUsecase{ur: ur, pr: pr, log: log.NewHelper(logger), jwtc: jwtc}
+	return &UserUsecase{ur: ur, pr: pr, tm: tm, eb: eb, log: log.NewHelper(logger), jwtc: jwtc, ac: ac, pp: pp}
 }
 
 func (uc *UserUsecase) generateToken(uid uint) string {
@@ -99,10 +240,20 @@ func (uc *UserUsecase) generateToken(uid uint) string {
 }
 
 func (uc *UserUsecase) Register(ctx context.Context, username string, email string, password string) (*UserLogin, error) {
+	if err := uc.checkUsername(username); err != nil {
+		return nil, err
+	}
+	if err := uc.pp.Validate(password, username, email); err != nil {
+		return nil, err
+	}
+	hash, err := uc.pp.Hash(password)
+	if err != nil {
+		return nil, err
+	}
 	u := &User{
 		Username:     username,
 		Email:        email,
-		PasswordHash: hashPassword(password),
+		PasswordHash: hash,
 	}
 
 	if err := uc.ur.CreateUser(ctx, u); err != nil {
@@ -120,19 +271,31 @@ func (uc *UserUsecase) Register(ctx context.Context, username string, email stri
 func (uc *UserUsecase) Login(ctx context.Context, email string, password string) (*UserLogin, error) {
 	// invalid 逻辑放在biz层
 	if len(email) == 0 {
-		return nil, errors.New(422, "email", "can not be empty")
+		return nil, ValidationError("email", "can not be empty")
 	}
 	if len(password) == 0 {
-		return nil, errors.New(422, "password", "can not be empty")
+		return nil, ValidationError("password", "can not be empty")
 	}
 
 	u, err := uc.ur.GetUserByEmail(ctx, email)
+	// 不区分邮箱不存在和密码错误
+	if errors.Is(err, ErrUserNotFound) {
+		return nil, ErrInvalidCredentials
+	}
 	if err != nil {
 		return nil, err
 	}
 	// 比对登录密码 和 数据库对应的hash密码
 	if !verifyPassword(password, u.PasswordHash) {
-		return nil, errors.Unauthorized("password", "invalid password")
+		return nil, ErrInvalidCredentials
+	}
+	// cost配置调高后, 登录时透明地升级hash - 失败不影响登录
+	if uc.pp.NeedsRehash(u.PasswordHash) {
+		if hash, err := uc.pp.Hash(password); err != nil {
+			uc.log.Errorf("rehash password error: %v", err)
+		} else if err := uc.ur.UpdatePasswordHash(ctx, u.ID, hash); err != nil {
+			uc.log.Errorf("update password hash error: %v", err)
+		}
 	}
 	return &UserLogin{
 		Email:    u.Email,
@@ -140,6 +303,7 @@ func (uc *UserUsecase) Login(ctx context.Context, email string, password string)
 		Token:    uc.generateToken(u.ID),
 		Bio:      u.Bio,
 		Image:    u.Image,
+		Private:  u.Private,
 	}, nil
 }
 
@@ -165,20 +329,53 @@ func (uc *UserUsecase) UpdateUserInfo(ctx context.Context, userUpdate *UserUpdat
 	if userUpdate.Email != "" {
 		userFromDB.Email = userUpdate.Email
 	}
-	if userUpdate.Password != "" {
-		userFromDB.PasswordHash = hashPassword(userUpdate.Password)
-	}
-	if userUpdate.Username != "" {
+	if userUpdate.Username != "" && userUpdate.Username != userFromDB.Username {
+		if err := uc.checkUsername(userUpdate.Username); err != nil {
+			return nil, err
+		}
 		userFromDB.Username = userUpdate.Username
 	}
+	// 密码校验需要用更新后的username和email
+	if userUpdate.Password != "" {
+		if err := uc.pp.Validate(userUpdate.Password, userFromDB.Username, userFromDB.Email); err != nil {
+			return nil, err
+		}
+		hash, err := uc.pp.Hash(userUpdate.Password)
+		if err != nil {
+			return nil, err
+		}
+		userFromDB.PasswordHash = hash
+	}
 	if userUpdate.Bio != "" {
 		userFromDB.Bio = userUpdate.Bio
 	}
 	if userUpdate.Image != "" {
 		userFromDB.Image = userUpdate.Image
 	}
-	// 3. 更新数据库
-	userFromDB, err = uc.ur.UpdateUser(ctx, userFromDB)
+	wasPrivate := userFromDB.Private
+	if userUpdate.Private != nil {
+		userFromDB.Private = *userUpdate.Private
+	}
+	// 3. 更新数据库, 改为公开账号后待处理的申请没有意义了 - 在同一个事务中直接同意
+	err = uc.tm.Transaction(ctx, func(ctx context.Context) error {
+		updated, err := uc.ur.UpdateUser(ctx, userFromDB)
+		if err != nil {
+			return err
+		}
+		if wasPrivate && !updated.Private {
+			approved, err := uc.pr.ApproveAllFollowRequests(ctx, updated.ID)
+			if err != nil {
+				return err
+			}
+			for _, requesterID := range approved {
+				if err := uc.eb.Publish(ctx, UserFollowed{FollowerID: requesterID, FollowingID: updated.ID}); err != nil {
+					return err
+				}
+			}
+		}
+		userFromDB = updated
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -188,6 +385,7 @@ func (uc *UserUsecase) UpdateUserInfo(ctx context.Context, userUpdate *UserUpdat
 		Token:    uc.generateToken(userFromDB.ID),
 		Bio:      userFromDB.Bio,
 		Image:    userFromDB.Image,
+		Private:  userFromDB.Private,
 	}, nil
 }
 
@@ -213,11 +411,29 @@ func (uc *UserUsecase) FollowUser(ctx context.Context, username string) (*Profil
 
 	// 不允许自己关注自己
 	if currentUserID == followingUserID {
-		return nil, errors.BadRequest("FOLLOW_SELF", "cannot follow yourself")
+		return nil, ErrFollowSelf
 	}
 
-	// 2. 进行关注
-	if err := uc.pr.FollowUserByUsername(ctx, currentUserID, followingUserID); err != nil {
+	// 拉黑关系下不能关注
+	blocked, err := uc.pr.IsBlocked(ctx, currentUserID, followingUserID)
+	if err != nil {
+		return nil, err
+	}
+	if blocked {
+		return nil, BlockedError("follow this user")
+	}
+
+	// 2. 进行关注 - 私密账号只创建关注申请
+	if followingUserProfile.Private {
+		if err := uc.pr.CreateFollowRequest(ctx, currentUserID, followingUserID); err != nil {
+			return nil, err
+		}
+	} else if err := uc.tm.Transaction(ctx, func(ctx context.Context) error {
+		if err := uc.pr.FollowUserByUsername(ctx, currentUserID, followingUserID); err != nil {
+			return err
+		}
+		return uc.eb.Publish(ctx, UserFollowed{FollowerID: currentUserID, FollowingID: followingUserID})
+	}); err != nil {
 		return nil, err
 	}
 
@@ -253,3 +469,230 @@ func (uc *UserUsecase) UnfollowUser(ctx context.Context, username string) (*Prof
 
 	return followingProfile, nil
 }
+
+func (uc *UserUsecase) placeholderUsername() string {
+	if name := uc.ac.GetPlaceholderUsername(); name != "" {
+		return name
+	}
+	return defaultPlaceholderUsername
+}
+
+// 占位用户名和匿名化用户名(deleted-user-<id>)保留给系统, 不能注册或改用
+func (uc *UserUsecase) checkUsername(username string) error {
+	name := strings.ToLower(username)
+	if strings.HasPrefix(name, defaultPlaceholderUsername) || name == strings.ToLower(uc.placeholderUsername()) {
+		return ValidationError("username", "is reserved")
+	}
+	return nil
+}
+
+// 注销当前用户 - 文章和评论保留, 由配置决定匿名化还是删除
+func (uc *UserUsecase) DeleteCurrentUser(ctx context.Context) error {
+	currentUser, _ := auth.FromContext(ctx)
+	uid := currentUser.UserID
+
+	switch uc.ac.GetDeletionPolicy() {
+	case conf.Account_DELETE:
+		placeholder := uc.placeholderUsername()
+		uc.log.Infof("delete user: %d, content moved to %s", uid, placeholder)
+		return uc.ur.DeleteUser(ctx, uid, placeholder)
+	default:
+		uc.log.Infof("anonymize user: %d", uid)
+		return uc.ur.AnonymizeUser(ctx, uid)
+	}
+}
+
+// 导出当前用户的个人数据
+func (uc *UserUsecase) ExportCurrentUser(ctx context.Context) (*UserExport, error) {
+	currentUser, _ := auth.FromContext(ctx)
+	return uc.ur.ExportUser(ctx, currentUser.UserID)
+}
+
+// 粉丝列表
+func (uc *UserUsecase) ListFollowers(ctx context.Context, username string, cursor string, limit int64) (*ProfilePage, error) {
+	return uc.listFollows(ctx, username, cursor, limit, uc.pr.ListFollowers)
+}
+
+// 关注列表
+func (uc *UserUsecase) ListFollowing(ctx context.Context, username string, cursor string, limit int64) (*ProfilePage, error) {
+	return uc.listFollows(ctx, username, cursor, limit, uc.pr.ListFollowing)
+}
+
+func (uc *UserUsecase) listFollows(ctx context.Context, username string, cursor string, limit int64,
+	list func(ctx context.Context, uid uint, cursor uint, limit int) ([]*ProfileResp, uint, error),
+) (*ProfilePage, error) {
+	after, err := decodeCursor(cursor)
+	if err != nil {
+		return nil, err
+	}
+	profile, err := uc.pr.GetProfileByUsername(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+	profiles, next, err := list(ctx, profile.ID, after, pageSize(limit))
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.fillFollowing(ctx, profiles); err != nil {
+		return nil, err
+	}
+
+	return &ProfilePage{
+		Profiles:   profiles,
+		NextCursor: encodeCursor(next),
+	}, nil
+}
+
+// 登录用户与列表中每个用户的关注关系 - 批量查询
+func (uc *UserUsecase) fillFollowing(ctx context.Context, profiles []*ProfileResp) error {
+	currentUser, ok := auth.FromContext(ctx)
+	if !ok || len(profiles) == 0 {
+		return nil
+	}
+	uids := make([]uint, len(profiles))
+	for i, p := range profiles {
+		uids[i] = p.ID
+	}
+	followingMap, err := uc.pr.GetFollowingMap(ctx, currentUser.UserID, uids)
+	if err != nil {
+		return err
+	}
+	for _, p := range profiles {
+		p.Following = followingMap[p.ID]
+	}
+	return nil
+}
+
+// 搜索用户 - username前缀匹配优先, 其次是username和bio中包含关键字
+func (uc *UserUsecase) SearchProfiles(ctx context.Context, query string, cursor string, limit int64) (*ProfilePage, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, ValidationError("q", "can not be empty")
+	}
+	if len([]rune(query)) > maxSearchQueryLength {
+		return nil, ValidationError("q", "is too long")
+	}
+	offset, err := decodeCursor(cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	var viewerUid uint
+	if currentUser, ok := auth.FromContext(ctx); ok {
+		viewerUid = currentUser.UserID
+	}
+	profiles, next, err := uc.pr.SearchProfiles(ctx, viewerUid, query, offset, pageSize(limit))
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.fillFollowing(ctx, profiles); err != nil {
+		return nil, err
+	}
+	return &ProfilePage{
+		Profiles:   profiles,
+		NextCursor: encodeCursor(next),
+	}, nil
+}
+
+// 拉黑用户
+func (uc *UserUsecase) BlockUser(ctx context.Context, username string) (*ProfileResp, error) {
+	return uc.changeRelation(ctx, username, func(ctx context.Context, uid uint, targetID uint) error {
+		if uid == targetID {
+			return ErrBlockSelf
+		}
+		return uc.pr.BlockUser(ctx, uid, targetID)
+	})
+}
+
+func (uc *UserUsecase) UnblockUser(ctx context.Context, username string) (*ProfileResp, error) {
+	return uc.changeRelation(ctx, username, uc.pr.UnblockUser)
+}
+
+// 静音用户
+func (uc *UserUsecase) MuteUser(ctx context.Context, username string) (*ProfileResp, error) {
+	return uc.changeRelation(ctx, username, func(ctx context.Context, uid uint, targetID uint) error {
+		if uid == targetID {
+			return ErrMuteSelf
+		}
+		return uc.pr.MuteUser(ctx, uid, targetID)
+	})
+}
+
+func (uc *UserUsecase) UnmuteUser(ctx context.Context, username string) (*ProfileResp, error) {
+	return uc.changeRelation(ctx, username, uc.pr.UnmuteUser)
+}
+
+// 当前用户对username做拉黑/静音等操作, 返回操作后的profile
+func (uc *UserUsecase) changeRelation(ctx context.Context, username string, change func(ctx context.Context, uid uint, targetID uint) error) (*ProfileResp, error) {
+	currentUser, _ := auth.FromContext(ctx)
+	target, err := uc.pr.GetProfileByUsername(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+	if err := change(ctx, currentUser.UserID, target.ID); err != nil {
+		return nil, err
+	}
+	return uc.pr.GetProfileByUsername(ctx, username)
+}
+
+// 拉黑列表
+func (uc *UserUsecase) ListBlockedUsers(ctx context.Context, cursor string, limit int64) (*ProfilePage, error) {
+	return uc.listOwnRelations(ctx, cursor, limit, uc.pr.ListBlockedUsers)
+}
+
+// 静音列表
+func (uc *UserUsecase) ListMutedUsers(ctx context.Context, cursor string, limit int64) (*ProfilePage, error) {
+	return uc.listOwnRelations(ctx, cursor, limit, uc.pr.ListMutedUsers)
+}
+
+func (uc *UserUsecase) listOwnRelations(ctx context.Context, cursor string, limit int64,
+	list func(ctx context.Context, uid uint, cursor uint, limit int) ([]*ProfileResp, uint, error),
+) (*ProfilePage, error) {
+	after, err := decodeCursor(cursor)
+	if err != nil {
+		return nil, err
+	}
+	currentUser, _ := auth.FromContext(ctx)
+	profiles, next, err := list(ctx, currentUser.UserID, after, pageSize(limit))
+	if err != nil {
+		return nil, err
+	}
+	return &ProfilePage{
+		Profiles:   profiles,
+		NextCursor: encodeCursor(next),
+	}, nil
+}
+
+// 收到的关注申请
+func (uc *UserUsecase) ListFollowRequests(ctx context.Context, cursor string, limit int64) (*ProfilePage, error) {
+	return uc.listOwnRelations(ctx, cursor, limit, uc.pr.ListIncomingFollowRequests)
+}
+
+// 发出的关注申请
+func (uc *UserUsecase) ListOutgoingFollowRequests(ctx context.Context, cursor string, limit int64) (*ProfilePage, error) {
+	return uc.listOwnRelations(ctx, cursor, limit, uc.pr.ListOutgoingFollowRequests)
+}
+
+// 同意username的关注申请, 返回申请人的profile
+func (uc *UserUsecase) ApproveFollowRequest(ctx context.Context, username string) (*ProfileResp, error) {
+	return uc.changeRelation(ctx, username, func(ctx context.Context, uid uint, requesterID uint) error {
+		return uc.tm.Transaction(ctx, func(ctx context.Context) error {
+			if err := uc.pr.ApproveFollowRequest(ctx, requesterID, uid); err != nil {
+				return err
+			}
+			return uc.eb.Publish(ctx, UserFollowed{FollowerID: requesterID, FollowingID: uid})
+		})
+	})
+}
+
+// 拒绝username的关注申请
+func (uc *UserUsecase) RejectFollowRequest(ctx context.Context, username string) (*ProfileResp, error) {
+	return uc.changeRelation(ctx, username, func(ctx context.Context, uid uint, requesterID uint) error {
+		return uc.pr.DeleteFollowRequest(ctx, requesterID, uid)
+	})
+}
+
+// 撤回对username的关注申请
+func (uc *UserUsecase) CancelFollowRequest(ctx context.Context, username string) (*ProfileResp, error) {
+	return uc.changeRelation(ctx, username, uc.pr.DeleteFollowRequest)
+}
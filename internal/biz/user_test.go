@@ -1,14 +1,26 @@
 package biz
 
 import (
+	"context"
 	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
+	"kratos-realworld/internal/conf"
+	"kratos-realworld/internal/pkg/middleware/auth"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-playground/assert/v2"
+	"golang.org/x/crypto/bcrypt"
 )
 
 func TestHashPassword(t *testing.T) {
-	hash := hashPassword("123456")
+	hash, err := hashPassword("123456", bcrypt.DefaultCost)
+	assert.Equal(t, nil, err)
 	fmt.Printf("hash: %v\n", string(hash))
 }
 
@@ -17,3 +29,250 @@ func TestVerifyPassword(t *testing.T) {
 	assert.NotEqual(t, true, verifyPassword("123", "$2a$10$yHkYqPfmpCnIs8wEKN./u./CQ.pHxux6fa06VwnkvdZiRWiFjMsCS"))
 	assert.NotEqual(t, true, verifyPassword("123456", "/u./CQ.pHxux6fa06VwnkvdZiRWiFjMsCS"))
 }
+
+func TestPasswordPolicyValidate(t *testing.T) {
+	list := filepath.Join(t.TempDir(), "breached.txt")
+	if err := os.WriteFile(list, []byte("Password123\nqwertyuiop\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	p, err := NewPasswordPolicy(&conf.Auth{Password: &conf.Auth_Password{MinLength: 8, BreachedList: list}})
+	assert.Equal(t, nil, err)
+
+	assert.NotEqual(t, nil, p.Validate("", "john", "john@example.com"))
+	assert.NotEqual(t, nil, p.Validate("a", "john", "john@example.com"))
+	assert.NotEqual(t, nil, p.Validate("johnsmith", "JohnSmith", "john@example.com"))
+	assert.NotEqual(t, nil, p.Validate("john@example.com", "john", "john@example.com"))
+	assert.NotEqual(t, nil, p.Validate("password123", "john", "john@example.com"))
+	// bcrypt的72字节上限按字节计算, 多字节字符更早达到
+	assert.Equal(t, nil, p.Validate(strings.Repeat("a", 72), "john", "john@example.com"))
+	err = p.Validate(strings.Repeat("a", 73), "john", "john@example.com")
+	assert.Equal(t, int32(422), errors.FromError(err).Code)
+	assert.NotEqual(t, nil, p.Validate(strings.Repeat("密", 25), "john", "john@example.com"))
+	assert.Equal(t, nil, p.Validate("correct horse battery", "john", "john@example.com"))
+}
+
+func TestPasswordPolicyDefaults(t *testing.T) {
+	p, err := NewPasswordPolicy(nil)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, defaultPasswordMinLength, p.minLength)
+	assert.Equal(t, bcrypt.DefaultCost, p.cost)
+
+	_, err = NewPasswordPolicy(&conf.Auth{Password: &conf.Auth_Password{BcryptCost: 64}})
+	assert.NotEqual(t, nil, err)
+}
+
+func TestPasswordPolicyNeedsRehash(t *testing.T) {
+	p, err := NewPasswordPolicy(&conf.Auth{Password: &conf.Auth_Password{BcryptCost: int32(bcrypt.MinCost + 1)}})
+	assert.Equal(t, nil, err)
+
+	old, err := hashPassword("correct horse battery", bcrypt.MinCost)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, true, p.NeedsRehash(old))
+
+	current, err := p.Hash("correct horse battery")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, false, p.NeedsRehash(current))
+	assert.Equal(t, true, verifyPassword("correct horse battery", current))
+}
+
+// 只记录注销调用的UserRepo
+type deletionRecorder struct {
+	UserRepo
+	anonymized  uint
+	deleted     uint
+	placeholder string
+}
+
+func (r *deletionRecorder) AnonymizeUser(ctx context.Context, uid uint) error {
+	r.anonymized = uid
+	return nil
+}
+
+func (r *deletionRecorder) DeleteUser(ctx context.Context, uid uint, placeholderUsername string) error {
+	r.deleted = uid
+	r.placeholder = placeholderUsername
+	return nil
+}
+
+func TestDeleteCurrentUserPolicy(t *testing.T) {
+	ctx := auth.WithContext(context.Background(), &auth.CurrentUser{UserID: 7})
+
+	r := &deletionRecorder{}
+	uc := NewUserUsecase(r, nil, nil, nil, log.DefaultLogger, nil, nil, nil)
+	assert.Equal(t, nil, uc.DeleteCurrentUser(ctx))
+	assert.Equal(t, uint(7), r.anonymized)
+	assert.Equal(t, uint(0), r.deleted)
+
+	r = &deletionRecorder{}
+	uc = NewUserUsecase(r, nil, nil, nil, log.DefaultLogger, nil, &conf.Account{DeletionPolicy: conf.Account_DELETE}, nil)
+	assert.Equal(t, nil, uc.DeleteCurrentUser(ctx))
+	assert.Equal(t, uint(7), r.deleted)
+	assert.Equal(t, defaultPlaceholderUsername, r.placeholder)
+}
+
+func TestRegisterReservedUsername(t *testing.T) {
+	ctx := context.Background()
+	uc := NewUserUsecase(nil, nil, nil, nil, log.DefaultLogger, nil, &conf.Account{PlaceholderUsername: "ghost"}, nil)
+	for _, name := range []string{"deleted-user", "Deleted-User-3", "GHOST"} {
+		_, err := uc.Register(ctx, name, name+"@example.com", "correct horse battery")
+		assert.Equal(t, int32(422), errors.FromError(err).Code)
+		assert.Equal(t, "username", errors.FromError(err).Metadata["field"])
+	}
+}
+
+// 拉黑关系固定返回true的ProfileRepo
+type blockedProfiles struct {
+	ProfileRepo
+	followed bool
+}
+
+func (r *blockedProfiles) GetProfileByUsername(ctx context.Context, username string) (*ProfileResp, error) {
+	return &ProfileResp{ID: 9, Username: username}, nil
+}
+
+func (r *blockedProfiles) IsBlocked(ctx context.Context, uid uint, otherID uint) (bool, error) {
+	return true, nil
+}
+
+func (r *blockedProfiles) FollowUserByUsername(ctx context.Context, currentUserID uint, followingID uint) error {
+	r.followed = true
+	return nil
+}
+
+func TestFollowBlockedUser(t *testing.T) {
+	ctx := auth.WithContext(context.Background(), &auth.CurrentUser{UserID: 7})
+
+	r := &blockedProfiles{}
+	uc := NewUserUsecase(nil, r, nil, nil, log.DefaultLogger, nil, nil, nil)
+	_, err := uc.FollowUser(ctx, "jake")
+	assert.Equal(t, true, errors.IsForbidden(err))
+	assert.Equal(t, false, r.followed)
+}
+
+// 私密账号 - 记录关注申请
+type privateProfiles struct {
+	ProfileRepo
+	requested bool
+	followed  bool
+}
+
+func (r *privateProfiles) GetProfileByUsername(ctx context.Context, username string) (*ProfileResp, error) {
+	return &ProfileResp{ID: 9, Username: username, Private: true, FollowRequested: r.requested}, nil
+}
+
+func (r *privateProfiles) IsBlocked(ctx context.Context, uid uint, otherID uint) (bool, error) {
+	return false, nil
+}
+
+func (r *privateProfiles) CreateFollowRequest(ctx context.Context, uid uint, targetID uint) error {
+	r.requested = true
+	return nil
+}
+
+func (r *privateProfiles) FollowUserByUsername(ctx context.Context, currentUserID uint, followingID uint) error {
+	r.followed = true
+	return nil
+}
+
+func TestFollowPrivateUser(t *testing.T) {
+	ctx := auth.WithContext(context.Background(), &auth.CurrentUser{UserID: 7})
+
+	r := &privateProfiles{}
+	uc := NewUserUsecase(nil, r, nil, nil, log.DefaultLogger, nil, nil, nil)
+	profile, err := uc.FollowUser(ctx, "jake")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, false, r.followed)
+	assert.Equal(t, true, profile.FollowRequested)
+	assert.Equal(t, false, profile.Following)
+}
+
+// 私密账号改为公开 - 记录更新是否在事务中
+type privateUsers struct {
+	UserRepo
+	updatedInTx bool
+}
+
+func (r *privateUsers) GetUserByID(ctx context.Context, uid uint) (*User, error) {
+	return &User{ID: uid, Username: "jake", Private: true}, nil
+}
+
+func (r *privateUsers) UpdateUser(ctx context.Context, u *User) (*User, error) {
+	r.updatedInTx = inTx(ctx)
+	return u, nil
+}
+
+type approveFailure struct {
+	ProfileRepo
+}
+
+func (r *approveFailure) ApproveAllFollowRequests(ctx context.Context, targetID uint) ([]uint, error) {
+	return nil, fmt.Errorf("approve failed")
+}
+
+// 批量同意 - 返回新建了关注的申请人
+type bulkApprovals struct {
+	ProfileRepo
+	approved []uint
+}
+
+func (r *bulkApprovals) ApproveAllFollowRequests(ctx context.Context, targetID uint) ([]uint, error) {
+	return r.approved, nil
+}
+
+func TestUpdatePrivateToPublicTransaction(t *testing.T) {
+	ctx := auth.WithContext(context.Background(), &auth.CurrentUser{UserID: 7})
+	users := &privateUsers{}
+	tm := &memoryTx{}
+	uc := NewUserUsecase(users, &approveFailure{}, tm, nil, log.DefaultLogger, nil, nil, nil)
+
+	// 同意申请失败时账号的更新一起回滚
+	public := false
+	_, err := uc.UpdateUserInfo(ctx, &UserUpdate{Private: &public})
+	assert.Equal(t, "approve failed", err.Error())
+	assert.Equal(t, true, users.updatedInTx)
+	assert.Equal(t, 1, tm.rolledBack)
+}
+
+func TestUpdatePrivateToPublicPublishesFollows(t *testing.T) {
+	ctx := auth.WithContext(context.Background(), &auth.CurrentUser{UserID: 7})
+	now := time.Now()
+	outbox := &memoryOutbox{}
+	uc := NewUserUsecase(&privateUsers{}, &bulkApprovals{approved: []uint{3, 5}}, &memoryTx{}, newTestEventBus(outbox, &now), log.DefaultLogger, &conf.JWT{Secret: "secret"}, nil, nil)
+
+	// 每个通过的申请人发布一个关注事件, 和账号更新在同一个事务中
+	public := false
+	_, err := uc.UpdateUserInfo(ctx, &UserUpdate{Private: &public})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 2, len(outbox.events))
+	for _, e := range outbox.events {
+		assert.Equal(t, "user.followed", e.Type)
+	}
+	assert.Equal(t, []bool{true, true}, outbox.inTx)
+}
+
+// 只有一个用户的UserRepo
+type loginUsers struct {
+	UserRepo
+	user *User
+}
+
+func (r *loginUsers) GetUserByEmail(ctx context.Context, email string) (*User, error) {
+	if email != r.user.Email {
+		return nil, ErrUserNotFound
+	}
+	return r.user, nil
+}
+
+func TestLoginInvalidCredentials(t *testing.T) {
+	hash, err := hashPassword("correct horse battery", bcrypt.MinCost)
+	assert.Equal(t, nil, err)
+	r := &loginUsers{user: &User{ID: 7, Email: "jake@example.com", PasswordHash: hash}}
+	uc := NewUserUsecase(r, nil, nil, nil, log.DefaultLogger, nil, nil, nil)
+
+	// 邮箱不存在和密码错误返回相同的错误
+	_, err = uc.Login(context.Background(), "jacob@example.com", "correct horse battery")
+	assert.Equal(t, true, errors.Is(err, ErrInvalidCredentials))
+	_, err = uc.Login(context.Background(), "jake@example.com", "wrong password")
+	assert.Equal(t, true, errors.Is(err, ErrInvalidCredentials))
+	assert.Equal(t, "email or password", errors.FromError(err).Metadata["field"])
+}
@@ -22,11 +22,64 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type Account_DeletionPolicy int32
+
+const (
+	// 保留用户行, 清除个人信息, 文章和评论挂在匿名用户下
+	Account_ANONYMIZE Account_DeletionPolicy = 0
+	// 删除用户行, 文章和评论转移到统一的占位用户
+	Account_DELETE Account_DeletionPolicy = 1
+)
+
+// Enum value maps for Account_DeletionPolicy.
+var (
+	Account_DeletionPolicy_name = map[int32]string{
+		0: "ANONYMIZE",
+		1: "DELETE",
+	}
+	Account_DeletionPolicy_value = map[string]int32{
+		"ANONYMIZE": 0,
+		"DELETE":    1,
+	}
+)
+
+func (x Account_DeletionPolicy) Enum() *Account_DeletionPolicy {
+	p := new(Account_DeletionPolicy)
+	*p = x
+	return p
+}
+
+func (x Account_DeletionPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Account_DeletionPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_conf_conf_proto_enumTypes[0].Descriptor()
+}
+
+func (Account_DeletionPolicy) Type() protoreflect.EnumType {
+	return &file_conf_conf_proto_enumTypes[0]
+}
+
+func (x Account_DeletionPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Account_DeletionPolicy.Descriptor instead.
+func (Account_DeletionPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{5, 0}
+}
+
 type Bootstrap struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Server        *Server                `protobuf:"bytes,1,opt,name=server,proto3" json:"server,omitempty"`
 	Data          *Data                  `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
 	Jwt           *JWT                   `protobuf:"bytes,3,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	Auth          *Auth                  `protobuf:"bytes,4,opt,name=auth,proto3" json:"auth,omitempty"`
+	Account       *Account               `protobuf:"bytes,5,opt,name=account,proto3" json:"account,omitempty"`
+	Media         *Media                 `protobuf:"bytes,6,opt,name=media,proto3" json:"media,omitempty"`
+	Social        *Social                `protobuf:"bytes,7,opt,name=social,proto3" json:"social,omitempty"`
+	Events        *Events                `protobuf:"bytes,8,opt,name=events,proto3" json:"events,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -82,6 +135,41 @@ func (x *Bootstrap) GetJwt() *JWT {
 	return nil
 }
 
+func (x *Bootstrap) GetAuth() *Auth {
+	if x != nil {
+		return x.Auth
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetMedia() *Media {
+	if x != nil {
+		return x.Media
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetSocial() *Social {
+	if x != nil {
+		return x.Social
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetEvents() *Events {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
 type Server struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Http          *Server_HTTP           `protobuf:"bytes,1,opt,name=http,proto3" json:"http,omitempty"`
@@ -137,6 +225,8 @@ func (x *Server) GetGrpc() *Server_GRPC {
 type Data struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Database      *Data_Database         `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
+	Storage       *Data_Storage          `protobuf:"bytes,2,opt,name=storage,proto3" json:"storage,omitempty"`
+	Cache         *Data_Cache            `protobuf:"bytes,3,opt,name=cache,proto3" json:"cache,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -178,6 +268,20 @@ func (x *Data) GetDatabase() *Data_Database {
 	return nil
 }
 
+func (x *Data) GetStorage() *Data_Storage {
+	if x != nil {
+		return x.Storage
+	}
+	return nil
+}
+
+func (x *Data) GetCache() *Data_Cache {
+	if x != nil {
+		return x.Cache
+	}
+	return nil
+}
+
 type JWT struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Secret        string                 `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
@@ -222,6 +326,318 @@ func (x *JWT) GetSecret() string {
 	return ""
 }
 
+type Auth struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Password      *Auth_Password         `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Auth) Reset() {
+	*x = Auth{}
+	mi := &file_conf_conf_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Auth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Auth) ProtoMessage() {}
+
+func (x *Auth) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Auth.ProtoReflect.Descriptor instead.
+func (*Auth) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Auth) GetPassword() *Auth_Password {
+	if x != nil {
+		return x.Password
+	}
+	return nil
+}
+
+type Account struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	DeletionPolicy Account_DeletionPolicy `protobuf:"varint,1,opt,name=deletion_policy,json=deletionPolicy,proto3,enum=kratos.api.Account_DeletionPolicy" json:"deletion_policy,omitempty"`
+	// DELETE策略下的占位用户名
+	PlaceholderUsername string `protobuf:"bytes,2,opt,name=placeholder_username,json=placeholderUsername,proto3" json:"placeholder_username,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *Account) Reset() {
+	*x = Account{}
+	mi := &file_conf_conf_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Account) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Account) ProtoMessage() {}
+
+func (x *Account) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Account.ProtoReflect.Descriptor instead.
+func (*Account) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Account) GetDeletionPolicy() Account_DeletionPolicy {
+	if x != nil {
+		return x.DeletionPolicy
+	}
+	return Account_ANONYMIZE
+}
+
+func (x *Account) GetPlaceholderUsername() string {
+	if x != nil {
+		return x.PlaceholderUsername
+	}
+	return ""
+}
+
+type Media struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Avatar        *Media_Avatar          `protobuf:"bytes,1,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	Attachment    *Media_Attachment      `protobuf:"bytes,2,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Media) Reset() {
+	*x = Media{}
+	mi := &file_conf_conf_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Media) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Media) ProtoMessage() {}
+
+func (x *Media) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Media.ProtoReflect.Descriptor instead.
+func (*Media) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Media) GetAvatar() *Media_Avatar {
+	if x != nil {
+		return x.Avatar
+	}
+	return nil
+}
+
+func (x *Media) GetAttachment() *Media_Attachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+type Social struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 可用的表情回应, 顺序即返回时的顺序, 为空时使用默认的一组
+	Reactions []string `protobuf:"bytes,1,rep,name=reactions,proto3" json:"reactions,omitempty"`
+	// 热门标签统计的时间窗口, 默认7天
+	TrendingWindow *durationpb.Duration `protobuf:"bytes,2,opt,name=trending_window,json=trendingWindow,proto3" json:"trending_window,omitempty"`
+	// 管理员的用户id, 可以重命名, 合并和删除标签
+	AdminUserIds []uint32 `protobuf:"varint,3,rep,packed,name=admin_user_ids,json=adminUserIds,proto3" json:"admin_user_ids,omitempty"`
+	// 修正文章收藏数的任务执行间隔, 默认1h
+	FavoritesReconcileInterval *durationpb.Duration `protobuf:"bytes,4,opt,name=favorites_reconcile_interval,json=favoritesReconcileInterval,proto3" json:"favorites_reconcile_interval,omitempty"`
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *Social) Reset() {
+	*x = Social{}
+	mi := &file_conf_conf_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Social) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Social) ProtoMessage() {}
+
+func (x *Social) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Social.ProtoReflect.Descriptor instead.
+func (*Social) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Social) GetReactions() []string {
+	if x != nil {
+		return x.Reactions
+	}
+	return nil
+}
+
+func (x *Social) GetTrendingWindow() *durationpb.Duration {
+	if x != nil {
+		return x.TrendingWindow
+	}
+	return nil
+}
+
+func (x *Social) GetAdminUserIds() []uint32 {
+	if x != nil {
+		return x.AdminUserIds
+	}
+	return nil
+}
+
+func (x *Social) GetFavoritesReconcileInterval() *durationpb.Duration {
+	if x != nil {
+		return x.FavoritesReconcileInterval
+	}
+	return nil
+}
+
+// 领域事件 - 业务事务中写入outbox表, 由后台任务投递给订阅者
+type Events struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 没有新事件通知时检查outbox的间隔, 默认1s
+	PollInterval *durationpb.Duration `protobuf:"bytes,1,opt,name=poll_interval,json=pollInterval,proto3" json:"poll_interval,omitempty"`
+	// 每次领取的事件数, 默认100
+	BatchSize int32 `protobuf:"varint,2,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	// 领取后的租期, 超过后还没有投递结果的事件可以被重新领取, 默认1m
+	Lease *durationpb.Duration `protobuf:"bytes,3,opt,name=lease,proto3" json:"lease,omitempty"`
+	// 投递的最大次数, 仍然失败时标记为dead不再重试, 默认10
+	MaxAttempts int32 `protobuf:"varint,4,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`
+	// 第一次重试的间隔, 之后每次翻倍, 默认1s
+	RetryBackoff *durationpb.Duration `protobuf:"bytes,5,opt,name=retry_backoff,json=retryBackoff,proto3" json:"retry_backoff,omitempty"`
+	// 重试间隔的上限, 默认10m
+	MaxRetryBackoff *durationpb.Duration `protobuf:"bytes,6,opt,name=max_retry_backoff,json=maxRetryBackoff,proto3" json:"max_retry_backoff,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Events) Reset() {
+	*x = Events{}
+	mi := &file_conf_conf_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Events) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Events) ProtoMessage() {}
+
+func (x *Events) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Events.ProtoReflect.Descriptor instead.
+func (*Events) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Events) GetPollInterval() *durationpb.Duration {
+	if x != nil {
+		return x.PollInterval
+	}
+	return nil
+}
+
+func (x *Events) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+func (x *Events) GetLease() *durationpb.Duration {
+	if x != nil {
+		return x.Lease
+	}
+	return nil
+}
+
+func (x *Events) GetMaxAttempts() int32 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
+}
+
+func (x *Events) GetRetryBackoff() *durationpb.Duration {
+	if x != nil {
+		return x.RetryBackoff
+	}
+	return nil
+}
+
+func (x *Events) GetMaxRetryBackoff() *durationpb.Duration {
+	if x != nil {
+		return x.MaxRetryBackoff
+	}
+	return nil
+}
+
 type Server_HTTP struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
@@ -233,7 +649,7 @@ type Server_HTTP struct {
 
 func (x *Server_HTTP) Reset() {
 	*x = Server_HTTP{}
-	mi := &file_conf_conf_proto_msgTypes[4]
+	mi := &file_conf_conf_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -245,7 +661,7 @@ func (x *Server_HTTP) String() string {
 func (*Server_HTTP) ProtoMessage() {}
 
 func (x *Server_HTTP) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[4]
+	mi := &file_conf_conf_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -293,7 +709,7 @@ type Server_GRPC struct {
 
 func (x *Server_GRPC) Reset() {
 	*x = Server_GRPC{}
-	mi := &file_conf_conf_proto_msgTypes[5]
+	mi := &file_conf_conf_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -305,7 +721,7 @@ func (x *Server_GRPC) String() string {
 func (*Server_GRPC) ProtoMessage() {}
 
 func (x *Server_GRPC) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[5]
+	mi := &file_conf_conf_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -343,15 +759,36 @@ func (x *Server_GRPC) GetTimeout() *durationpb.Duration {
 }
 
 type Data_Database struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Dsn           string                 `protobuf:"bytes,1,opt,name=dsn,proto3" json:"dsn,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Dsn   string                 `protobuf:"bytes,1,opt,name=dsn,proto3" json:"dsn,omitempty"`
+	// mysql / postgres / sqlite / memory, 默认mysql; memory不连接数据库, 数据只保存在内存中
+	Driver string `protobuf:"bytes,2,opt,name=driver,proto3" json:"driver,omitempty"`
+	// 启动时不执行数据库迁移, 多个实例部署时由migrate子命令单独执行
+	SkipMigrations bool `protobuf:"varint,3,opt,name=skip_migrations,json=skipMigrations,proto3" json:"skip_migrations,omitempty"`
+	// 连接池, 0表示使用database/sql的默认值; sqlite固定只有一个连接
+	MaxOpenConns    int32                `protobuf:"varint,4,opt,name=max_open_conns,json=maxOpenConns,proto3" json:"max_open_conns,omitempty"`
+	MaxIdleConns    int32                `protobuf:"varint,5,opt,name=max_idle_conns,json=maxIdleConns,proto3" json:"max_idle_conns,omitempty"`
+	ConnMaxLifetime *durationpb.Duration `protobuf:"bytes,6,opt,name=conn_max_lifetime,json=connMaxLifetime,proto3" json:"conn_max_lifetime,omitempty"`
+	ConnMaxIdleTime *durationpb.Duration `protobuf:"bytes,7,opt,name=conn_max_idle_time,json=connMaxIdleTime,proto3" json:"conn_max_idle_time,omitempty"`
+	// 单条SQL的超时, 分读和写配置, 同时受请求deadline的限制; 0表示不单独限制
+	ReadTimeout  *durationpb.Duration `protobuf:"bytes,8,opt,name=read_timeout,json=readTimeout,proto3" json:"read_timeout,omitempty"`
+	WriteTimeout *durationpb.Duration `protobuf:"bytes,9,opt,name=write_timeout,json=writeTimeout,proto3" json:"write_timeout,omitempty"`
+	// 执行时间超过该值的SQL以warn级别记录, 0表示不记录
+	SlowThreshold *durationpb.Duration `protobuf:"bytes,10,opt,name=slow_threshold,json=slowThreshold,proto3" json:"slow_threshold,omitempty"`
+	// 只读副本, 查询发到副本, 写入和事务使用dsn的主库; 为空时都使用主库
+	ReplicaDsns []string `protobuf:"bytes,11,rep,name=replica_dsns,json=replicaDsns,proto3" json:"replica_dsns,omitempty"`
+	// 用户写入后这段时间内的查询使用主库, 保证能读到自己的写入; 0表示不保证
+	// 写入时间记录在处理写入的实例中, 多个实例时需要负载均衡按用户保持会话
+	ReplicaStickyWindow *durationpb.Duration `protobuf:"bytes,12,opt,name=replica_sticky_window,json=replicaStickyWindow,proto3" json:"replica_sticky_window,omitempty"`
+	// 副本健康检查的间隔, 默认10s; 检查失败的副本不再分配查询, 恢复后重新加入
+	ReplicaCheckInterval *durationpb.Duration `protobuf:"bytes,13,opt,name=replica_check_interval,json=replicaCheckInterval,proto3" json:"replica_check_interval,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
 func (x *Data_Database) Reset() {
 	*x = Data_Database{}
-	mi := &file_conf_conf_proto_msgTypes[6]
+	mi := &file_conf_conf_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -363,7 +800,7 @@ func (x *Data_Database) String() string {
 func (*Data_Database) ProtoMessage() {}
 
 func (x *Data_Database) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[6]
+	mi := &file_conf_conf_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -386,16 +823,671 @@ func (x *Data_Database) GetDsn() string {
 	return ""
 }
 
+func (x *Data_Database) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
+func (x *Data_Database) GetSkipMigrations() bool {
+	if x != nil {
+		return x.SkipMigrations
+	}
+	return false
+}
+
+func (x *Data_Database) GetMaxOpenConns() int32 {
+	if x != nil {
+		return x.MaxOpenConns
+	}
+	return 0
+}
+
+func (x *Data_Database) GetMaxIdleConns() int32 {
+	if x != nil {
+		return x.MaxIdleConns
+	}
+	return 0
+}
+
+func (x *Data_Database) GetConnMaxLifetime() *durationpb.Duration {
+	if x != nil {
+		return x.ConnMaxLifetime
+	}
+	return nil
+}
+
+func (x *Data_Database) GetConnMaxIdleTime() *durationpb.Duration {
+	if x != nil {
+		return x.ConnMaxIdleTime
+	}
+	return nil
+}
+
+func (x *Data_Database) GetReadTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.ReadTimeout
+	}
+	return nil
+}
+
+func (x *Data_Database) GetWriteTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.WriteTimeout
+	}
+	return nil
+}
+
+func (x *Data_Database) GetSlowThreshold() *durationpb.Duration {
+	if x != nil {
+		return x.SlowThreshold
+	}
+	return nil
+}
+
+func (x *Data_Database) GetReplicaDsns() []string {
+	if x != nil {
+		return x.ReplicaDsns
+	}
+	return nil
+}
+
+func (x *Data_Database) GetReplicaStickyWindow() *durationpb.Duration {
+	if x != nil {
+		return x.ReplicaStickyWindow
+	}
+	return nil
+}
+
+func (x *Data_Database) GetReplicaCheckInterval() *durationpb.Duration {
+	if x != nil {
+		return x.ReplicaCheckInterval
+	}
+	return nil
+}
+
+// 上传文件的存储 - local或s3
+type Data_Storage struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Driver string                 `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"`
+	// 对外访问的url前缀, 为空时local为/media/, s3为bucket的地址
+	BaseUrl string              `protobuf:"bytes,2,opt,name=base_url,json=baseUrl,proto3" json:"base_url,omitempty"`
+	Local   *Data_Storage_Local `protobuf:"bytes,3,opt,name=local,proto3" json:"local,omitempty"`
+	S3      *Data_Storage_S3    `protobuf:"bytes,4,opt,name=s3,proto3" json:"s3,omitempty"`
+	// 签名url使用的密钥, 必须配置, 重启后和多个实例之间需要保持一致
+	SigningKey    string `protobuf:"bytes,5,opt,name=signing_key,json=signingKey,proto3" json:"signing_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_Storage) Reset() {
+	*x = Data_Storage{}
+	mi := &file_conf_conf_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Storage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Storage) ProtoMessage() {}
+
+func (x *Data_Storage) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Storage.ProtoReflect.Descriptor instead.
+func (*Data_Storage) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{2, 1}
+}
+
+func (x *Data_Storage) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
+func (x *Data_Storage) GetBaseUrl() string {
+	if x != nil {
+		return x.BaseUrl
+	}
+	return ""
+}
+
+func (x *Data_Storage) GetLocal() *Data_Storage_Local {
+	if x != nil {
+		return x.Local
+	}
+	return nil
+}
+
+func (x *Data_Storage) GetS3() *Data_Storage_S3 {
+	if x != nil {
+		return x.S3
+	}
+	return nil
+}
+
+func (x *Data_Storage) GetSigningKey() string {
+	if x != nil {
+		return x.SigningKey
+	}
+	return ""
+}
+
+// 热点读的缓存 - 文章详情, 标签列表和用户资料, 不缓存和当前用户相关的字段
+type Data_Cache struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 为空时不缓存; local为进程内的LRU, 多实例部署时使用redis
+	Driver string `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"`
+	// 条目的过期时间, 默认60s
+	Ttl *durationpb.Duration `protobuf:"bytes,2,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	// local最多缓存的条目数, 默认10000
+	MaxEntries int32 `protobuf:"varint,3,opt,name=max_entries,json=maxEntries,proto3" json:"max_entries,omitempty"`
+	// key的前缀, 多个环境共用一个redis时区分
+	Prefix        string            `protobuf:"bytes,4,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Redis         *Data_Cache_Redis `protobuf:"bytes,5,opt,name=redis,proto3" json:"redis,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_Cache) Reset() {
+	*x = Data_Cache{}
+	mi := &file_conf_conf_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Cache) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Cache) ProtoMessage() {}
+
+func (x *Data_Cache) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Cache.ProtoReflect.Descriptor instead.
+func (*Data_Cache) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{2, 2}
+}
+
+func (x *Data_Cache) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
+func (x *Data_Cache) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+func (x *Data_Cache) GetMaxEntries() int32 {
+	if x != nil {
+		return x.MaxEntries
+	}
+	return 0
+}
+
+func (x *Data_Cache) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *Data_Cache) GetRedis() *Data_Cache_Redis {
+	if x != nil {
+		return x.Redis
+	}
+	return nil
+}
+
+type Data_Storage_Local struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Dir           string                 `protobuf:"bytes,1,opt,name=dir,proto3" json:"dir,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_Storage_Local) Reset() {
+	*x = Data_Storage_Local{}
+	mi := &file_conf_conf_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Storage_Local) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Storage_Local) ProtoMessage() {}
+
+func (x *Data_Storage_Local) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Storage_Local.ProtoReflect.Descriptor instead.
+func (*Data_Storage_Local) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{2, 1, 0}
+}
+
+func (x *Data_Storage_Local) GetDir() string {
+	if x != nil {
+		return x.Dir
+	}
+	return ""
+}
+
+// S3兼容的对象存储, 如MinIO
+type Data_Storage_S3 struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Endpoint  string                 `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	Region    string                 `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	Bucket    string                 `protobuf:"bytes,3,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	AccessKey string                 `protobuf:"bytes,4,opt,name=access_key,json=accessKey,proto3" json:"access_key,omitempty"`
+	SecretKey string                 `protobuf:"bytes,5,opt,name=secret_key,json=secretKey,proto3" json:"secret_key,omitempty"`
+	// 使用endpoint/bucket/key的形式访问, 而不是bucket.endpoint/key
+	PathStyle     bool `protobuf:"varint,6,opt,name=path_style,json=pathStyle,proto3" json:"path_style,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_Storage_S3) Reset() {
+	*x = Data_Storage_S3{}
+	mi := &file_conf_conf_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Storage_S3) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Storage_S3) ProtoMessage() {}
+
+func (x *Data_Storage_S3) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Storage_S3.ProtoReflect.Descriptor instead.
+func (*Data_Storage_S3) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{2, 1, 1}
+}
+
+func (x *Data_Storage_S3) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *Data_Storage_S3) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *Data_Storage_S3) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *Data_Storage_S3) GetAccessKey() string {
+	if x != nil {
+		return x.AccessKey
+	}
+	return ""
+}
+
+func (x *Data_Storage_S3) GetSecretKey() string {
+	if x != nil {
+		return x.SecretKey
+	}
+	return ""
+}
+
+func (x *Data_Storage_S3) GetPathStyle() bool {
+	if x != nil {
+		return x.PathStyle
+	}
+	return false
+}
+
+type Data_Cache_Redis struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Addr     string                 `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Password string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Db       int32                  `protobuf:"varint,3,opt,name=db,proto3" json:"db,omitempty"`
+	// 连接池的最大空闲连接数, 默认10
+	PoolSize int32 `protobuf:"varint,4,opt,name=pool_size,json=poolSize,proto3" json:"pool_size,omitempty"`
+	// 建立连接和单条命令的超时, 默认200ms
+	Timeout       *durationpb.Duration `protobuf:"bytes,5,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_Cache_Redis) Reset() {
+	*x = Data_Cache_Redis{}
+	mi := &file_conf_conf_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Cache_Redis) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Cache_Redis) ProtoMessage() {}
+
+func (x *Data_Cache_Redis) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Cache_Redis.ProtoReflect.Descriptor instead.
+func (*Data_Cache_Redis) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{2, 2, 0}
+}
+
+func (x *Data_Cache_Redis) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *Data_Cache_Redis) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *Data_Cache_Redis) GetDb() int32 {
+	if x != nil {
+		return x.Db
+	}
+	return 0
+}
+
+func (x *Data_Cache_Redis) GetPoolSize() int32 {
+	if x != nil {
+		return x.PoolSize
+	}
+	return 0
+}
+
+func (x *Data_Cache_Redis) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+type Auth_Password struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	MinLength int32                  `protobuf:"varint,1,opt,name=min_length,json=minLength,proto3" json:"min_length,omitempty"`
+	// 泄露密码列表文件, 每行一个密码, 为空则不校验
+	BreachedList string `protobuf:"bytes,2,opt,name=breached_list,json=breachedList,proto3" json:"breached_list,omitempty"`
+	// bcrypt cost, 调高后用户登录时会自动rehash
+	BcryptCost    int32 `protobuf:"varint,3,opt,name=bcrypt_cost,json=bcryptCost,proto3" json:"bcrypt_cost,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Auth_Password) Reset() {
+	*x = Auth_Password{}
+	mi := &file_conf_conf_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Auth_Password) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Auth_Password) ProtoMessage() {}
+
+func (x *Auth_Password) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Auth_Password.ProtoReflect.Descriptor instead.
+func (*Auth_Password) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 0}
+}
+
+func (x *Auth_Password) GetMinLength() int32 {
+	if x != nil {
+		return x.MinLength
+	}
+	return 0
+}
+
+func (x *Auth_Password) GetBreachedList() string {
+	if x != nil {
+		return x.BreachedList
+	}
+	return ""
+}
+
+func (x *Auth_Password) GetBcryptCost() int32 {
+	if x != nil {
+		return x.BcryptCost
+	}
+	return 0
+}
+
+type Media_Avatar struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 上传文件的大小限制, 默认2MB
+	MaxBytes int64 `protobuf:"varint,1,opt,name=max_bytes,json=maxBytes,proto3" json:"max_bytes,omitempty"`
+	// 正方形缩略图的边长, 默认64/128/256
+	ThumbnailSizes []int32 `protobuf:"varint,2,rep,packed,name=thumbnail_sizes,json=thumbnailSizes,proto3" json:"thumbnail_sizes,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Media_Avatar) Reset() {
+	*x = Media_Avatar{}
+	mi := &file_conf_conf_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Media_Avatar) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Media_Avatar) ProtoMessage() {}
+
+func (x *Media_Avatar) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Media_Avatar.ProtoReflect.Descriptor instead.
+func (*Media_Avatar) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{6, 0}
+}
+
+func (x *Media_Avatar) GetMaxBytes() int64 {
+	if x != nil {
+		return x.MaxBytes
+	}
+	return 0
+}
+
+func (x *Media_Avatar) GetThumbnailSizes() []int32 {
+	if x != nil {
+		return x.ThumbnailSizes
+	}
+	return nil
+}
+
+// 文章中的图片附件
+type Media_Attachment struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 单个文件的大小限制, 默认5MB
+	MaxBytes int64 `protobuf:"varint,1,opt,name=max_bytes,json=maxBytes,proto3" json:"max_bytes,omitempty"`
+	// 每个用户所有附件的总大小限制, 默认100MB
+	QuotaBytes int64 `protobuf:"varint,2,opt,name=quota_bytes,json=quotaBytes,proto3" json:"quota_bytes,omitempty"`
+	// 超过这个时间还没有被文章引用的附件会被清理, 默认24h
+	PendingTtl *durationpb.Duration `protobuf:"bytes,3,opt,name=pending_ttl,json=pendingTtl,proto3" json:"pending_ttl,omitempty"`
+	// 未发布附件的签名url有效期, 默认1h
+	SignedUrlTtl *durationpb.Duration `protobuf:"bytes,4,opt,name=signed_url_ttl,json=signedUrlTtl,proto3" json:"signed_url_ttl,omitempty"`
+	// 清理任务的执行间隔, 默认1h
+	CleanupInterval *durationpb.Duration `protobuf:"bytes,5,opt,name=cleanup_interval,json=cleanupInterval,proto3" json:"cleanup_interval,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Media_Attachment) Reset() {
+	*x = Media_Attachment{}
+	mi := &file_conf_conf_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Media_Attachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Media_Attachment) ProtoMessage() {}
+
+func (x *Media_Attachment) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Media_Attachment.ProtoReflect.Descriptor instead.
+func (*Media_Attachment) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{6, 1}
+}
+
+func (x *Media_Attachment) GetMaxBytes() int64 {
+	if x != nil {
+		return x.MaxBytes
+	}
+	return 0
+}
+
+func (x *Media_Attachment) GetQuotaBytes() int64 {
+	if x != nil {
+		return x.QuotaBytes
+	}
+	return 0
+}
+
+func (x *Media_Attachment) GetPendingTtl() *durationpb.Duration {
+	if x != nil {
+		return x.PendingTtl
+	}
+	return nil
+}
+
+func (x *Media_Attachment) GetSignedUrlTtl() *durationpb.Duration {
+	if x != nil {
+		return x.SignedUrlTtl
+	}
+	return nil
+}
+
+func (x *Media_Attachment) GetCleanupInterval() *durationpb.Duration {
+	if x != nil {
+		return x.CleanupInterval
+	}
+	return nil
+}
+
 var File_conf_conf_proto protoreflect.FileDescriptor
 
 const file_conf_conf_proto_rawDesc = "" +
 	"\n" +
 	"\x0fconf/conf.proto\x12\n" +
-	"kratos.api\x1a\x1egoogle/protobuf/duration.proto\"\x80\x01\n" +
+	"kratos.api\x1a\x1egoogle/protobuf/duration.proto\"\xd6\x02\n" +
 	"\tBootstrap\x12*\n" +
 	"\x06server\x18\x01 \x01(\v2\x12.kratos.api.ServerR\x06server\x12$\n" +
 	"\x04data\x18\x02 \x01(\v2\x10.kratos.api.DataR\x04data\x12!\n" +
-	"\x03jwt\x18\x03 \x01(\v2\x0f.kratos.api.JWTR\x03jwt\"\xb8\x02\n" +
+	"\x03jwt\x18\x03 \x01(\v2\x0f.kratos.api.JWTR\x03jwt\x12$\n" +
+	"\x04auth\x18\x04 \x01(\v2\x10.kratos.api.AuthR\x04auth\x12-\n" +
+	"\aaccount\x18\x05 \x01(\v2\x13.kratos.api.AccountR\aaccount\x12'\n" +
+	"\x05media\x18\x06 \x01(\v2\x11.kratos.api.MediaR\x05media\x12*\n" +
+	"\x06social\x18\a \x01(\v2\x12.kratos.api.SocialR\x06social\x12*\n" +
+	"\x06events\x18\b \x01(\v2\x12.kratos.api.EventsR\x06events\"\xb8\x02\n" +
 	"\x06Server\x12+\n" +
 	"\x04http\x18\x01 \x01(\v2\x17.kratos.api.Server.HTTPR\x04http\x12+\n" +
 	"\x04grpc\x18\x02 \x01(\v2\x17.kratos.api.Server.GRPCR\x04grpc\x1ai\n" +
@@ -406,13 +1498,105 @@ const file_conf_conf_proto_rawDesc = "" +
 	"\x04GRPC\x12\x18\n" +
 	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
 	"\x04addr\x18\x02 \x01(\tR\x04addr\x123\n" +
-	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\"[\n" +
+	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\"\xc3\f\n" +
 	"\x04Data\x125\n" +
-	"\bdatabase\x18\x01 \x01(\v2\x19.kratos.api.Data.DatabaseR\bdatabase\x1a\x1c\n" +
+	"\bdatabase\x18\x01 \x01(\v2\x19.kratos.api.Data.DatabaseR\bdatabase\x122\n" +
+	"\astorage\x18\x02 \x01(\v2\x18.kratos.api.Data.StorageR\astorage\x12,\n" +
+	"\x05cache\x18\x03 \x01(\v2\x16.kratos.api.Data.CacheR\x05cache\x1a\xbb\x05\n" +
 	"\bDatabase\x12\x10\n" +
-	"\x03dsn\x18\x01 \x01(\tR\x03dsn\"\x1d\n" +
+	"\x03dsn\x18\x01 \x01(\tR\x03dsn\x12\x16\n" +
+	"\x06driver\x18\x02 \x01(\tR\x06driver\x12'\n" +
+	"\x0fskip_migrations\x18\x03 \x01(\bR\x0eskipMigrations\x12$\n" +
+	"\x0emax_open_conns\x18\x04 \x01(\x05R\fmaxOpenConns\x12$\n" +
+	"\x0emax_idle_conns\x18\x05 \x01(\x05R\fmaxIdleConns\x12E\n" +
+	"\x11conn_max_lifetime\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\x0fconnMaxLifetime\x12F\n" +
+	"\x12conn_max_idle_time\x18\a \x01(\v2\x19.google.protobuf.DurationR\x0fconnMaxIdleTime\x12<\n" +
+	"\fread_timeout\x18\b \x01(\v2\x19.google.protobuf.DurationR\vreadTimeout\x12>\n" +
+	"\rwrite_timeout\x18\t \x01(\v2\x19.google.protobuf.DurationR\fwriteTimeout\x12@\n" +
+	"\x0eslow_threshold\x18\n" +
+	" \x01(\v2\x19.google.protobuf.DurationR\rslowThreshold\x12!\n" +
+	"\freplica_dsns\x18\v \x03(\tR\vreplicaDsns\x12M\n" +
+	"\x15replica_sticky_window\x18\f \x01(\v2\x19.google.protobuf.DurationR\x13replicaStickyWindow\x12O\n" +
+	"\x16replica_check_interval\x18\r \x01(\v2\x19.google.protobuf.DurationR\x14replicaCheckInterval\x1a\x8b\x03\n" +
+	"\aStorage\x12\x16\n" +
+	"\x06driver\x18\x01 \x01(\tR\x06driver\x12\x19\n" +
+	"\bbase_url\x18\x02 \x01(\tR\abaseUrl\x124\n" +
+	"\x05local\x18\x03 \x01(\v2\x1e.kratos.api.Data.Storage.LocalR\x05local\x12+\n" +
+	"\x02s3\x18\x04 \x01(\v2\x1b.kratos.api.Data.Storage.S3R\x02s3\x12\x1f\n" +
+	"\vsigning_key\x18\x05 \x01(\tR\n" +
+	"signingKey\x1a\x19\n" +
+	"\x05Local\x12\x10\n" +
+	"\x03dir\x18\x01 \x01(\tR\x03dir\x1a\xad\x01\n" +
+	"\x02S3\x12\x1a\n" +
+	"\bendpoint\x18\x01 \x01(\tR\bendpoint\x12\x16\n" +
+	"\x06region\x18\x02 \x01(\tR\x06region\x12\x16\n" +
+	"\x06bucket\x18\x03 \x01(\tR\x06bucket\x12\x1d\n" +
+	"\n" +
+	"access_key\x18\x04 \x01(\tR\taccessKey\x12\x1d\n" +
+	"\n" +
+	"secret_key\x18\x05 \x01(\tR\tsecretKey\x12\x1d\n" +
+	"\n" +
+	"path_style\x18\x06 \x01(\bR\tpathStyle\x1a\xd5\x02\n" +
+	"\x05Cache\x12\x16\n" +
+	"\x06driver\x18\x01 \x01(\tR\x06driver\x12+\n" +
+	"\x03ttl\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x03ttl\x12\x1f\n" +
+	"\vmax_entries\x18\x03 \x01(\x05R\n" +
+	"maxEntries\x12\x16\n" +
+	"\x06prefix\x18\x04 \x01(\tR\x06prefix\x122\n" +
+	"\x05redis\x18\x05 \x01(\v2\x1c.kratos.api.Data.Cache.RedisR\x05redis\x1a\x99\x01\n" +
+	"\x05Redis\x12\x12\n" +
+	"\x04addr\x18\x01 \x01(\tR\x04addr\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x0e\n" +
+	"\x02db\x18\x03 \x01(\x05R\x02db\x12\x1b\n" +
+	"\tpool_size\x18\x04 \x01(\x05R\bpoolSize\x123\n" +
+	"\atimeout\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\atimeout\"\x1d\n" +
 	"\x03JWT\x12\x16\n" +
-	"\x06secret\x18\x01 \x01(\tR\x06secretB%Z#kratos-realworld/internal/conf;confb\x06proto3"
+	"\x06secret\x18\x01 \x01(\tR\x06secret\"\xae\x01\n" +
+	"\x04Auth\x125\n" +
+	"\bpassword\x18\x01 \x01(\v2\x19.kratos.api.Auth.PasswordR\bpassword\x1ao\n" +
+	"\bPassword\x12\x1d\n" +
+	"\n" +
+	"min_length\x18\x01 \x01(\x05R\tminLength\x12#\n" +
+	"\rbreached_list\x18\x02 \x01(\tR\fbreachedList\x12\x1f\n" +
+	"\vbcrypt_cost\x18\x03 \x01(\x05R\n" +
+	"bcryptCost\"\xb6\x01\n" +
+	"\aAccount\x12K\n" +
+	"\x0fdeletion_policy\x18\x01 \x01(\x0e2\".kratos.api.Account.DeletionPolicyR\x0edeletionPolicy\x121\n" +
+	"\x14placeholder_username\x18\x02 \x01(\tR\x13placeholderUsername\"+\n" +
+	"\x0eDeletionPolicy\x12\r\n" +
+	"\tANONYMIZE\x10\x00\x12\n" +
+	"\n" +
+	"\x06DELETE\x10\x01\"\xd7\x03\n" +
+	"\x05Media\x120\n" +
+	"\x06avatar\x18\x01 \x01(\v2\x18.kratos.api.Media.AvatarR\x06avatar\x12<\n" +
+	"\n" +
+	"attachment\x18\x02 \x01(\v2\x1c.kratos.api.Media.AttachmentR\n" +
+	"attachment\x1aN\n" +
+	"\x06Avatar\x12\x1b\n" +
+	"\tmax_bytes\x18\x01 \x01(\x03R\bmaxBytes\x12'\n" +
+	"\x0fthumbnail_sizes\x18\x02 \x03(\x05R\x0ethumbnailSizes\x1a\x8d\x02\n" +
+	"\n" +
+	"Attachment\x12\x1b\n" +
+	"\tmax_bytes\x18\x01 \x01(\x03R\bmaxBytes\x12\x1f\n" +
+	"\vquota_bytes\x18\x02 \x01(\x03R\n" +
+	"quotaBytes\x12:\n" +
+	"\vpending_ttl\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\n" +
+	"pendingTtl\x12?\n" +
+	"\x0esigned_url_ttl\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\fsignedUrlTtl\x12D\n" +
+	"\x10cleanup_interval\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\x0fcleanupInterval\"\xed\x01\n" +
+	"\x06Social\x12\x1c\n" +
+	"\treactions\x18\x01 \x03(\tR\treactions\x12B\n" +
+	"\x0ftrending_window\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x0etrendingWindow\x12$\n" +
+	"\x0eadmin_user_ids\x18\x03 \x03(\rR\fadminUserIds\x12[\n" +
+	"\x1cfavorites_reconcile_interval\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\x1afavoritesReconcileInterval\"\xc2\x02\n" +
+	"\x06Events\x12>\n" +
+	"\rpoll_interval\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\fpollInterval\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\x02 \x01(\x05R\tbatchSize\x12/\n" +
+	"\x05lease\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\x05lease\x12!\n" +
+	"\fmax_attempts\x18\x04 \x01(\x05R\vmaxAttempts\x12>\n" +
+	"\rretry_backoff\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\fretryBackoff\x12E\n" +
+	"\x11max_retry_backoff\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\x0fmaxRetryBackoffB%Z#kratos-realworld/internal/conf;confb\x06proto3"
 
 var (
 	file_conf_conf_proto_rawDescOnce sync.Once
@@ -426,31 +1610,78 @@ func file_conf_conf_proto_rawDescGZIP() []byte {
 	return file_conf_conf_proto_rawDescData
 }
 
-var file_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_conf_conf_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
 var file_conf_conf_proto_goTypes = []any{
-	(*Bootstrap)(nil),           // 0: kratos.api.Bootstrap
-	(*Server)(nil),              // 1: kratos.api.Server
-	(*Data)(nil),                // 2: kratos.api.Data
-	(*JWT)(nil),                 // 3: kratos.api.JWT
-	(*Server_HTTP)(nil),         // 4: kratos.api.Server.HTTP
-	(*Server_GRPC)(nil),         // 5: kratos.api.Server.GRPC
-	(*Data_Database)(nil),       // 6: kratos.api.Data.Database
-	(*durationpb.Duration)(nil), // 7: google.protobuf.Duration
+	(Account_DeletionPolicy)(0), // 0: kratos.api.Account.DeletionPolicy
+	(*Bootstrap)(nil),           // 1: kratos.api.Bootstrap
+	(*Server)(nil),              // 2: kratos.api.Server
+	(*Data)(nil),                // 3: kratos.api.Data
+	(*JWT)(nil),                 // 4: kratos.api.JWT
+	(*Auth)(nil),                // 5: kratos.api.Auth
+	(*Account)(nil),             // 6: kratos.api.Account
+	(*Media)(nil),               // 7: kratos.api.Media
+	(*Social)(nil),              // 8: kratos.api.Social
+	(*Events)(nil),              // 9: kratos.api.Events
+	(*Server_HTTP)(nil),         // 10: kratos.api.Server.HTTP
+	(*Server_GRPC)(nil),         // 11: kratos.api.Server.GRPC
+	(*Data_Database)(nil),       // 12: kratos.api.Data.Database
+	(*Data_Storage)(nil),        // 13: kratos.api.Data.Storage
+	(*Data_Cache)(nil),          // 14: kratos.api.Data.Cache
+	(*Data_Storage_Local)(nil),  // 15: kratos.api.Data.Storage.Local
+	(*Data_Storage_S3)(nil),     // 16: kratos.api.Data.Storage.S3
+	(*Data_Cache_Redis)(nil),    // 17: kratos.api.Data.Cache.Redis
+	(*Auth_Password)(nil),       // 18: kratos.api.Auth.Password
+	(*Media_Avatar)(nil),        // 19: kratos.api.Media.Avatar
+	(*Media_Attachment)(nil),    // 20: kratos.api.Media.Attachment
+	(*durationpb.Duration)(nil), // 21: google.protobuf.Duration
 }
 var file_conf_conf_proto_depIdxs = []int32{
-	1, // 0: kratos.api.Bootstrap.server:type_name -> kratos.api.Server
-	2, // 1: kratos.api.Bootstrap.data:type_name -> kratos.api.Data
-	3, // 2: kratos.api.Bootstrap.jwt:type_name -> kratos.api.JWT
-	4, // 3: kratos.api.Server.http:type_name -> kratos.api.Server.HTTP
-	5, // 4: kratos.api.Server.grpc:type_name -> kratos.api.Server.GRPC
-	6, // 5: kratos.api.Data.database:type_name -> kratos.api.Data.Database
-	7, // 6: kratos.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
-	7, // 7: kratos.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
-	8, // [8:8] is the sub-list for method output_type
-	8, // [8:8] is the sub-list for method input_type
-	8, // [8:8] is the sub-list for extension type_name
-	8, // [8:8] is the sub-list for extension extendee
-	0, // [0:8] is the sub-list for field type_name
+	2,  // 0: kratos.api.Bootstrap.server:type_name -> kratos.api.Server
+	3,  // 1: kratos.api.Bootstrap.data:type_name -> kratos.api.Data
+	4,  // 2: kratos.api.Bootstrap.jwt:type_name -> kratos.api.JWT
+	5,  // 3: kratos.api.Bootstrap.auth:type_name -> kratos.api.Auth
+	6,  // 4: kratos.api.Bootstrap.account:type_name -> kratos.api.Account
+	7,  // 5: kratos.api.Bootstrap.media:type_name -> kratos.api.Media
+	8,  // 6: kratos.api.Bootstrap.social:type_name -> kratos.api.Social
+	9,  // 7: kratos.api.Bootstrap.events:type_name -> kratos.api.Events
+	10, // 8: kratos.api.Server.http:type_name -> kratos.api.Server.HTTP
+	11, // 9: kratos.api.Server.grpc:type_name -> kratos.api.Server.GRPC
+	12, // 10: kratos.api.Data.database:type_name -> kratos.api.Data.Database
+	13, // 11: kratos.api.Data.storage:type_name -> kratos.api.Data.Storage
+	14, // 12: kratos.api.Data.cache:type_name -> kratos.api.Data.Cache
+	18, // 13: kratos.api.Auth.password:type_name -> kratos.api.Auth.Password
+	0,  // 14: kratos.api.Account.deletion_policy:type_name -> kratos.api.Account.DeletionPolicy
+	19, // 15: kratos.api.Media.avatar:type_name -> kratos.api.Media.Avatar
+	20, // 16: kratos.api.Media.attachment:type_name -> kratos.api.Media.Attachment
+	21, // 17: kratos.api.Social.trending_window:type_name -> google.protobuf.Duration
+	21, // 18: kratos.api.Social.favorites_reconcile_interval:type_name -> google.protobuf.Duration
+	21, // 19: kratos.api.Events.poll_interval:type_name -> google.protobuf.Duration
+	21, // 20: kratos.api.Events.lease:type_name -> google.protobuf.Duration
+	21, // 21: kratos.api.Events.retry_backoff:type_name -> google.protobuf.Duration
+	21, // 22: kratos.api.Events.max_retry_backoff:type_name -> google.protobuf.Duration
+	21, // 23: kratos.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
+	21, // 24: kratos.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
+	21, // 25: kratos.api.Data.Database.conn_max_lifetime:type_name -> google.protobuf.Duration
+	21, // 26: kratos.api.Data.Database.conn_max_idle_time:type_name -> google.protobuf.Duration
+	21, // 27: kratos.api.Data.Database.read_timeout:type_name -> google.protobuf.Duration
+	21, // 28: kratos.api.Data.Database.write_timeout:type_name -> google.protobuf.Duration
+	21, // 29: kratos.api.Data.Database.slow_threshold:type_name -> google.protobuf.Duration
+	21, // 30: kratos.api.Data.Database.replica_sticky_window:type_name -> google.protobuf.Duration
+	21, // 31: kratos.api.Data.Database.replica_check_interval:type_name -> google.protobuf.Duration
+	15, // 32: kratos.api.Data.Storage.local:type_name -> kratos.api.Data.Storage.Local
+	16, // 33: kratos.api.Data.Storage.s3:type_name -> kratos.api.Data.Storage.S3
+	21, // 34: kratos.api.Data.Cache.ttl:type_name -> google.protobuf.Duration
+	17, // 35: kratos.api.Data.Cache.redis:type_name -> kratos.api.Data.Cache.Redis
+	21, // 36: kratos.api.Data.Cache.Redis.timeout:type_name -> google.protobuf.Duration
+	21, // 37: kratos.api.Media.Attachment.pending_ttl:type_name -> google.protobuf.Duration
+	21, // 38: kratos.api.Media.Attachment.signed_url_ttl:type_name -> google.protobuf.Duration
+	21, // 39: kratos.api.Media.Attachment.cleanup_interval:type_name -> google.protobuf.Duration
+	40, // [40:40] is the sub-list for method output_type
+	40, // [40:40] is the sub-list for method input_type
+	40, // [40:40] is the sub-list for extension type_name
+	40, // [40:40] is the sub-list for extension extendee
+	0,  // [0:40] is the sub-list for field type_name
 }
 
 func init() { file_conf_conf_proto_init() }
@@ -463,13 +1694,14 @@ func file_conf_conf_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_conf_conf_proto_rawDesc), len(file_conf_conf_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   7,
+			NumEnums:      1,
+			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_conf_conf_proto_goTypes,
 		DependencyIndexes: file_conf_conf_proto_depIdxs,
+		EnumInfos:         file_conf_conf_proto_enumTypes,
 		MessageInfos:      file_conf_conf_proto_msgTypes,
 	}.Build()
 	File_conf_conf_proto = out.File
@@ -0,0 +1,193 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"kratos-realworld/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+// 附件表 - 文件本身在BlobStore中, 这里记录归属和大小
+type Attachment struct {
+	gorm.Model
+	UserID uint `gorm:"index"`
+	// 0表示还没有被文章引用, 解除关联时更新updated_at, 清理按它判断
+	ArticleID   uint   `gorm:"index"`
+	BlobKey     string `gorm:"size:500;uniqueIndex"`
+	URL         string `gorm:"size:1000"`
+	ContentType string `gorm:"size:100"`
+	Size        int64
+	Width       int
+	Height      int
+}
+
+func convertAttachment(a Attachment) *biz.Attachment {
+	return &biz.Attachment{
+		ID:          a.ID,
+		UserID:      a.UserID,
+		ArticleID:   a.ArticleID,
+		Key:         a.BlobKey,
+		URL:         a.URL,
+		ContentType: a.ContentType,
+		Size:        a.Size,
+		Width:       a.Width,
+		Height:      a.Height,
+		CreatedAt:   a.CreatedAt,
+	}
+}
+
+func convertAttachments(attachments []Attachment) []*biz.Attachment {
+	list := make([]*biz.Attachment, len(attachments))
+	for i, a := range attachments {
+		list[i] = convertAttachment(a)
+	}
+	return list
+}
+
+type attachmentRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewAttachmentRepo(data *Data, logger log.Logger) biz.AttachmentRepo {
+	if data.mem != nil {
+		return &memoryAttachmentRepo{mem: data.mem}
+	}
+	return &attachmentRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// 用户的已用空间是冗余计数, 条件更新保证并发上传时也不会超过配额
+func (r *attachmentRepo) CreateAttachment(ctx context.Context, a *biz.Attachment, quota int64) (*biz.Attachment, error) {
+	po := Attachment{
+		UserID:      a.UserID,
+		ArticleID:   a.ArticleID,
+		BlobKey:     a.Key,
+		URL:         a.URL,
+		ContentType: a.ContentType,
+		Size:        a.Size,
+		Width:       a.Width,
+		Height:      a.Height,
+	}
+	err := r.data.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&User{}).
+			Where("id = ? AND attachments_bytes + ? <= ?", a.UserID, a.Size, quota).
+			UpdateColumn("attachments_bytes", gorm.Expr("attachments_bytes + ?", a.Size))
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return biz.ErrAttachmentQuota
+		}
+		return tx.Create(&po).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return convertAttachment(po), nil
+}
+
+func (r *attachmentRepo) GetAttachment(ctx context.Context, id uint) (*biz.Attachment, error) {
+	a := Attachment{}
+	if err := r.data.DB(ctx).Where("id = ?", id).First(&a).Error; err != nil {
+		return nil, translateNotFound(err, biz.ErrAttachmentNotFound)
+	}
+	return convertAttachment(a), nil
+}
+
+func (r *attachmentRepo) GetAttachmentByKey(ctx context.Context, key string) (*biz.Attachment, error) {
+	a := Attachment{}
+	if err := r.data.DB(ctx).Where("blob_key = ?", key).First(&a).Error; err != nil {
+		return nil, translateNotFound(err, biz.ErrAttachmentNotFound)
+	}
+	return convertAttachment(a), nil
+}
+
+func (r *attachmentRepo) ListPendingAttachments(ctx context.Context, uid uint) ([]*biz.Attachment, error) {
+	var attachments []Attachment
+	if err := r.data.DB(ctx).Where("user_id = ? AND article_id = 0", uid).Order("id DESC").Find(&attachments).Error; err != nil {
+		return nil, err
+	}
+	return convertAttachments(attachments), nil
+}
+
+func (r *attachmentRepo) ListArticleAttachments(ctx context.Context, aid uint) ([]*biz.Attachment, error) {
+	var attachments []Attachment
+	if err := r.data.DB(ctx).Where("article_id = ?", aid).Order("id DESC").Find(&attachments).Error; err != nil {
+		return nil, err
+	}
+	return convertAttachments(attachments), nil
+}
+
+// 只关联还没有被引用的附件, 只解除这篇文章自己的附件
+func (r *attachmentRepo) LinkAttachments(ctx context.Context, aid uint, link []uint, unlink []uint) error {
+	return r.data.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		if len(link) > 0 {
+			err := tx.Model(&Attachment{}).Where("id IN ? AND article_id = 0", link).Update("article_id", aid).Error
+			if err != nil {
+				return err
+			}
+		}
+		if len(unlink) > 0 {
+			err := tx.Model(&Attachment{}).Where("id IN ? AND article_id = ?", unlink, aid).Update("article_id", 0).Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (r *attachmentRepo) DeleteAttachment(ctx context.Context, id uint) error {
+	a := Attachment{}
+	err := r.data.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("id = ?", id).First(&a).Error; err != nil {
+			return translateNotFound(err, biz.ErrAttachmentNotFound)
+		}
+		if err := tx.Unscoped().Delete(&a).Error; err != nil {
+			return err
+		}
+		err := tx.Model(&User{}).Where("id = ?", a.UserID).
+			UpdateColumn("attachments_bytes", gorm.Expr("CASE WHEN attachments_bytes > ? THEN attachments_bytes - ? ELSE 0 END", a.Size, a.Size)).Error
+		if err != nil {
+			return err
+		}
+		if a.ArticleID == 0 {
+			return nil
+		}
+		return tx.Model(&Article{}).Where("id = ? AND cover_image = ?", a.ArticleID, a.URL).UpdateColumn("cover_image", "").Error
+	})
+	if err != nil {
+		return err
+	}
+	// 封面可能被清空
+	if a.ArticleID != 0 {
+		r.data.cache.invalidate(ctx, articleCacheKey(a.ArticleID))
+	}
+	return nil
+}
+
+func (r *attachmentRepo) ListOrphanedAttachments(ctx context.Context, before time.Time, limit int) ([]*biz.Attachment, error) {
+	var attachments []Attachment
+	err := r.data.DB(ctx).
+		Where("(article_id = 0 AND updated_at < ?) OR (article_id <> 0 AND article_id NOT IN (?))",
+			before, r.data.DB(ctx).Model(&Article{}).Select("id")).
+		Order("id").Limit(limit).Find(&attachments).Error
+	if err != nil {
+		return nil, err
+	}
+	return convertAttachments(attachments), nil
+}
+
+func (r *attachmentRepo) GetAttachmentBytes(ctx context.Context, uid uint) (int64, error) {
+	var used int64
+	if err := r.data.DB(ctx).Model(&User{}).Where("id = ?", uid).Pluck("attachments_bytes", &used).Error; err != nil {
+		return 0, err
+	}
+	return used, nil
+}
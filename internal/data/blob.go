@@ -0,0 +1,186 @@
+package data
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"mime"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"kratos-realworld/internal/biz"
+	"kratos-realworld/internal/conf"
+)
+
+const (
+	defaultLocalStorageDir = "./data/media"
+	defaultLocalBaseURL    = "/media/"
+	// 签名url由服务的/media/路由校验
+	signedURLPrefix = "/media/"
+)
+
+// 根据配置选择文件存储, 默认使用本地文件系统
+// 签名url的密钥必须配置 - 随机生成的密钥在重启后和其他实例上都无法校验
+func NewBlobStore(c *conf.Data) (biz.BlobStore, error) {
+	sc := c.GetStorage()
+	if sc.GetSigningKey() == "" {
+		return nil, errSigningKeyRequired
+	}
+	switch sc.GetDriver() {
+	case "", "local":
+		dir := sc.GetLocal().GetDir()
+		if dir == "" {
+			dir = defaultLocalStorageDir
+		}
+		baseURL := sc.GetBaseUrl()
+		if baseURL == "" {
+			baseURL = defaultLocalBaseURL
+		}
+		return newLocalBlobStore(dir, baseURL, sc.GetSigningKey())
+	case "s3":
+		return newS3BlobStore(sc.GetS3(), sc.GetBaseUrl(), sc.GetSigningKey(), nil)
+	default:
+		return nil, fmt.Errorf("unknown storage driver %q", sc.GetDriver())
+	}
+}
+
+// 校验key - 不能是绝对路径, 也不能通过..跳出存储目录
+func cleanKey(key string) (string, error) {
+	cleaned := path.Clean(key)
+	if key == "" || cleaned != key || path.IsAbs(key) || cleaned == "." || cleaned == ".." || strings.HasPrefix(cleaned, "../") {
+		return "", biz.ErrMediaKeyInvalid
+	}
+	return cleaned, nil
+}
+
+var errSigningKeyRequired = errors.New("storage signing_key is required")
+
+// 签名url - {prefix}{key}?expires=unix秒&signature=hmac, 本地存储和s3共用
+type urlSigner []byte
+
+func (k urlSigner) signedURL(prefix string, key string, expires time.Time) (string, error) {
+	key, err := cleanKey(key)
+	if err != nil {
+		return "", err
+	}
+	query := url.Values{}
+	query.Set("expires", strconv.FormatInt(expires.Unix(), 10))
+	query.Set("signature", k.sign(key, expires))
+	return strings.TrimSuffix(prefix, "/") + "/" + key + "?" + query.Encode(), nil
+}
+
+// 只校验签名, 是否过期由调用方判断
+func (k urlSigner) verify(key string, expires time.Time, signature string) bool {
+	return hmac.Equal([]byte(k.sign(key, expires)), []byte(signature))
+}
+
+func (k urlSigner) sign(key string, expires time.Time) string {
+	h := hmac.New(sha256.New, k)
+	h.Write([]byte(key + "\n" + strconv.FormatInt(expires.Unix(), 10)))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// 本地文件系统存储 - content type由扩展名决定
+type localBlobStore struct {
+	dir     string
+	baseURL string
+	signer  urlSigner
+}
+
+func newLocalBlobStore(dir string, baseURL string, signingKey string) (*localBlobStore, error) {
+	if signingKey == "" {
+		return nil, errSigningKeyRequired
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &localBlobStore{dir: dir, baseURL: baseURL, signer: urlSigner(signingKey)}, nil
+}
+
+func (s *localBlobStore) path(key string) (string, error) {
+	key, err := cleanKey(key)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(s.dir, filepath.FromSlash(key)), nil
+}
+
+// 先写临时文件再rename, 读取时不会看到写了一半的文件
+func (s *localBlobStore) Put(ctx context.Context, key string, contentType string, data []byte) error {
+	p, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(p), ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), p)
+}
+
+func (s *localBlobStore) Get(ctx context.Context, key string) (*biz.Blob, error) {
+	p, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(p)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, biz.ErrMediaNotFound
+		}
+		return nil, err
+	}
+	contentType := mime.TypeByExtension(path.Ext(key))
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	return &biz.Blob{ContentType: contentType, Data: data}, nil
+}
+
+func (s *localBlobStore) Delete(ctx context.Context, key string) error {
+	p, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(p); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+func (s *localBlobStore) URL(key string) string {
+	return strings.TrimSuffix(s.baseURL, "/") + "/" + key
+}
+
+// 签名url - 由服务校验签名后直接返回文件
+func (s *localBlobStore) SignedURL(key string, expires time.Time) (string, error) {
+	return s.signer.signedURL(s.baseURL, key, expires)
+}
+
+func (s *localBlobStore) VerifySignature(key string, expires time.Time, signature string) bool {
+	return s.signer.verify(key, expires, signature)
+}
+
+// 本地文件由服务直接返回, 不需要跳转
+func (s *localBlobStore) RedirectURL(key string, expires time.Time) (string, error) {
+	return "", nil
+}
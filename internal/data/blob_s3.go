@@ -0,0 +1,337 @@
+package data
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"kratos-realworld/internal/biz"
+	"kratos-realworld/internal/conf"
+)
+
+const (
+	defaultS3Region  = "us-east-1"
+	s3RequestTimeout = 30 * time.Second
+	// 预签名url的有效期最长7天
+	s3MaxPresignExpires = 7 * 24 * time.Hour
+)
+
+// S3兼容的对象存储 - 只用到put/get/delete, 手写SigV4签名, 不引入aws sdk
+type s3BlobStore struct {
+	client    *http.Client
+	endpoint  *url.URL
+	region    string
+	bucket    string
+	accessKey string
+	secretKey string
+	pathStyle bool
+	baseURL   string
+	signer    urlSigner
+	now       func() time.Time
+}
+
+func newS3BlobStore(c *conf.Data_Storage_S3, baseURL string, signingKey string, client *http.Client) (*s3BlobStore, error) {
+	if signingKey == "" {
+		return nil, errSigningKeyRequired
+	}
+	if c.GetEndpoint() == "" || c.GetBucket() == "" {
+		return nil, fmt.Errorf("s3 storage requires endpoint and bucket")
+	}
+	endpoint, err := url.Parse(c.GetEndpoint())
+	if err != nil || endpoint.Scheme == "" || endpoint.Host == "" {
+		return nil, fmt.Errorf("invalid s3 endpoint %q", c.GetEndpoint())
+	}
+	region := c.GetRegion()
+	if region == "" {
+		region = defaultS3Region
+	}
+	if client == nil {
+		client = &http.Client{Timeout: s3RequestTimeout}
+	}
+	s := &s3BlobStore{
+		client:    client,
+		endpoint:  endpoint,
+		region:    region,
+		bucket:    c.GetBucket(),
+		accessKey: c.GetAccessKey(),
+		secretKey: c.GetSecretKey(),
+		pathStyle: c.GetPathStyle(),
+		baseURL:   baseURL,
+		signer:    urlSigner(signingKey),
+		now:       time.Now,
+	}
+	// 没有配置CDN等地址时直接使用bucket的地址
+	if s.baseURL == "" {
+		s.baseURL = s.objectURL("").String()
+	}
+	return s, nil
+}
+
+func (s *s3BlobStore) objectURL(key string) *url.URL {
+	u := *s.endpoint
+	prefix := strings.TrimSuffix(u.Path, "/")
+	if s.pathStyle {
+		u.Path = prefix + "/" + s.bucket + "/" + key
+	} else {
+		u.Host = s.bucket + "." + u.Host
+		u.Path = prefix + "/" + key
+	}
+	// 签名使用的是编码后的path, 统一按S3的规则编码
+	u.RawPath = s3EscapePath(u.Path)
+	return &u
+}
+
+func (s *s3BlobStore) do(ctx context.Context, method string, key string, contentType string, body []byte) (*http.Response, error) {
+	key, err := cleanKey(key)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, method, s.objectURL(key).String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	signV4(req, body, s.accessKey, s.secretKey, s.region, s.now())
+	return s.client.Do(req)
+}
+
+func (s *s3BlobStore) Put(ctx context.Context, key string, contentType string, data []byte) error {
+	resp, err := s.do(ctx, http.MethodPut, key, contentType, data)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return s3Error(resp, http.MethodPut, key)
+	}
+	return nil
+}
+
+func (s *s3BlobStore) Get(ctx context.Context, key string) (*biz.Blob, error) {
+	resp, err := s.do(ctx, http.MethodGet, key, "", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, biz.ErrMediaNotFound
+	default:
+		return nil, s3Error(resp, http.MethodGet, key)
+	}
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	return &biz.Blob{ContentType: resp.Header.Get("Content-Type"), Data: data}, nil
+}
+
+// 删除不存在的对象不算错误
+func (s *s3BlobStore) Delete(ctx context.Context, key string) error {
+	resp, err := s.do(ctx, http.MethodDelete, key, "", nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusNoContent, http.StatusNotFound:
+		return nil
+	default:
+		return s3Error(resp, http.MethodDelete, key)
+	}
+}
+
+func (s *s3BlobStore) URL(key string) string {
+	return strings.TrimSuffix(s.baseURL, "/") + "/" + s3EscapePath(key)
+}
+
+// 签名url指向服务的/media/路由, 校验签名后跳转到s3的预签名url
+// bucket保持私有, 预签名url的有效期只到签名url过期为止
+func (s *s3BlobStore) SignedURL(key string, expires time.Time) (string, error) {
+	return s.signer.signedURL(signedURLPrefix, key, expires)
+}
+
+func (s *s3BlobStore) VerifySignature(key string, expires time.Time, signature string) bool {
+	return s.signer.verify(key, expires, signature)
+}
+
+// 预签名的GET url, 直接访问bucket, 不经过base_url
+func (s *s3BlobStore) RedirectURL(key string, expires time.Time) (string, error) {
+	key, err := cleanKey(key)
+	if err != nil {
+		return "", err
+	}
+	now := s.now()
+	ttl := expires.Sub(now)
+	if ttl <= 0 {
+		return "", fmt.Errorf("s3 presign %s: expiry is in the past", key)
+	}
+	if ttl > s3MaxPresignExpires {
+		ttl = s3MaxPresignExpires
+	}
+	return presignV4(s.objectURL(key), s.accessKey, s.secretKey, s.region, now, ttl), nil
+}
+
+// 错误信息带上响应体的前一部分, 里面有S3的错误码
+func s3Error(resp *http.Response, method string, key string) error {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	return fmt.Errorf("s3 %s %s: %s: %s", method, key, resp.Status, strings.TrimSpace(string(body)))
+}
+
+// AWS Signature Version 4
+// https://docs.aws.amazon.com/AmazonS3/latest/API/sig-v4-header-based-auth.html
+func signV4(req *http.Request, payload []byte, accessKey string, secretKey string, region string, t time.Time) {
+	t = t.UTC()
+	amzDate := t.Format("20060102T150405Z")
+	date := t.Format("20060102")
+	payloadHash := sha256Hex(payload)
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	// 签名host和请求上已经设置的所有header
+	host := req.Host
+	if host == "" {
+		host = req.URL.Host
+	}
+	headers := map[string]string{"host": host}
+	for name, values := range req.Header {
+		if strings.EqualFold(name, "Authorization") {
+			continue
+		}
+		trimmed := make([]string, len(values))
+		for i, v := range values {
+			trimmed[i] = strings.Join(strings.Fields(v), " ")
+		}
+		headers[strings.ToLower(name)] = strings.Join(trimmed, ",")
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		s3CanonicalQuery(req.URL.Query()),
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := s3Scope(date, region)
+	signature := s3Signature(secretKey, date, region, amzDate, scope, canonicalRequest)
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		accessKey, scope, signedHeaders, signature))
+}
+
+// 签名放在query中的GET请求, 只签名host, payload不参与签名
+// https://docs.aws.amazon.com/AmazonS3/latest/API/sigv4-query-string-auth.html
+func presignV4(u *url.URL, accessKey string, secretKey string, region string, t time.Time, ttl time.Duration) string {
+	t = t.UTC()
+	amzDate := t.Format("20060102T150405Z")
+	date := t.Format("20060102")
+	scope := s3Scope(date, region)
+
+	query := u.Query()
+	query.Set("X-Amz-Algorithm", "AWS4-HMAC-SHA256")
+	query.Set("X-Amz-Credential", accessKey+"/"+scope)
+	query.Set("X-Amz-Date", amzDate)
+	query.Set("X-Amz-Expires", strconv.Itoa(int(ttl/time.Second)))
+	query.Set("X-Amz-SignedHeaders", "host")
+
+	canonicalQuery := s3CanonicalQuery(query)
+	canonicalRequest := strings.Join([]string{
+		http.MethodGet,
+		u.EscapedPath(),
+		canonicalQuery,
+		"host:" + u.Host + "\n",
+		"host",
+		"UNSIGNED-PAYLOAD",
+	}, "\n")
+	signature := s3Signature(secretKey, date, region, amzDate, scope, canonicalRequest)
+
+	signed := *u
+	signed.RawQuery = canonicalQuery + "&X-Amz-Signature=" + signature
+	return signed.String()
+}
+
+func s3Scope(date string, region string) string {
+	return date + "/" + region + "/s3/aws4_request"
+}
+
+func s3Signature(secretKey string, date string, region string, amzDate string, scope string, canonicalRequest string) string {
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + sha256Hex([]byte(canonicalRequest))
+
+	key := hmacSHA256([]byte("AWS4"+secretKey), date)
+	key = hmacSHA256(key, region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	return hex.EncodeToString(hmacSHA256(key, stringToSign))
+}
+
+func s3CanonicalQuery(query url.Values) string {
+	keys := make([]string, 0, len(query))
+	for k := range query {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var parts []string
+	for _, k := range keys {
+		values := append([]string{}, query[k]...)
+		sort.Strings(values)
+		for _, v := range values {
+			parts = append(parts, s3Escape(k, true)+"="+s3Escape(v, true))
+		}
+	}
+	return strings.Join(parts, "&")
+}
+
+func s3EscapePath(p string) string {
+	return s3Escape(p, false)
+}
+
+// 除了A-Z a-z 0-9 - _ . ~以外都需要编码, path中的/保留
+func s3Escape(s string, encodeSlash bool) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if (c >= 'A' && c <= 'Z') || (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9') ||
+			c == '-' || c == '_' || c == '.' || c == '~' || (c == '/' && !encodeSlash) {
+			b.WriteByte(c)
+			continue
+		}
+		fmt.Fprintf(&b, "%%%02X", c)
+	}
+	return b.String()
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
@@ -0,0 +1,200 @@
+package data
+
+import (
+	"context"
+
+	"kratos-realworld/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 书签表 - 和article_favorites分开, 不参与收藏数和favorited过滤
+type Bookmark struct {
+	gorm.Model
+	UserID    uint `gorm:"index:idx_bookmark_user_article,unique"`
+	ArticleID uint `gorm:"index:idx_bookmark_user_article,unique"`
+	// 0表示不在任何收藏夹中
+	CollectionID uint `gorm:"index"`
+}
+
+// 书签收藏夹 - 同一用户下名称唯一
+type BookmarkCollection struct {
+	gorm.Model
+	UserID uint   `gorm:"index:idx_collection_user_name,unique"`
+	Name   string `gorm:"size:200;index:idx_collection_user_name,unique"`
+}
+
+func convertCollection(c BookmarkCollection, count uint32) *biz.BookmarkCollection {
+	return &biz.BookmarkCollection{
+		ID:             c.ID,
+		UserID:         c.UserID,
+		Name:           c.Name,
+		BookmarksCount: count,
+		CreatedAt:      c.CreatedAt,
+	}
+}
+
+type bookmarkRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewBookmarkRepo(data *Data, logger log.Logger) biz.BookmarkRepo {
+	if data.mem != nil {
+		return &memoryBookmarkRepo{mem: data.mem}
+	}
+	return &bookmarkRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *bookmarkRepo) CreateBookmark(ctx context.Context, uid uint, aid uint, collectionID uint) error {
+	b := Bookmark{UserID: uid, ArticleID: aid, CollectionID: collectionID}
+	return r.data.DB(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}, {Name: "article_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"collection_id", "updated_at"}),
+	}).Create(&b).Error
+}
+
+// 没有加入书签时也不报错
+func (r *bookmarkRepo) DeleteBookmark(ctx context.Context, uid uint, aid uint) error {
+	return r.data.DB(ctx).Unscoped().Where("user_id = ? AND article_id = ?", uid, aid).Delete(&Bookmark{}).Error
+}
+
+// 按书签id倒序, 多查一条来判断是否有下一页
+func (r *bookmarkRepo) ListBookmarks(ctx context.Context, uid uint, collectionID uint, cursor uint, limit int) ([]*biz.Article, uint, error) {
+	type bookmark struct {
+		ID        uint
+		ArticleID uint
+	}
+
+	db := r.data.DB(ctx).Model(&Bookmark{}).Select("bookmarks.id, bookmarks.article_id").
+		Joins("JOIN articles ON articles.id = bookmarks.article_id AND articles.deleted_at IS NULL").
+		Where("bookmarks.user_id = ?", uid)
+	db = excludeBlocked(db, "articles.author_id", uid)
+	db = excludePrivate(db, "articles.author_id", uid)
+	if collectionID > 0 {
+		db = db.Where("bookmarks.collection_id = ?", collectionID)
+	}
+	if cursor > 0 {
+		db = db.Where("bookmarks.id < ?", cursor)
+	}
+	var bookmarks []bookmark
+	if err := db.Order("bookmarks.id DESC").Limit(limit + 1).Scan(&bookmarks).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var next uint
+	if len(bookmarks) > limit {
+		bookmarks = bookmarks[:limit]
+		next = bookmarks[limit-1].ID
+	}
+	if len(bookmarks) == 0 {
+		return []*biz.Article{}, 0, nil
+	}
+
+	aids := make([]uint, len(bookmarks))
+	for i, b := range bookmarks {
+		aids[i] = b.ArticleID
+	}
+	articles, err := findArticles(ctx, r.data, r.data.DB(ctx).Where("articles.id IN ?", aids))
+	if err != nil {
+		return nil, 0, err
+	}
+	articleMap := make(map[uint]Article, len(articles))
+	for _, a := range articles {
+		articleMap[a.ID] = a
+	}
+	// 保持书签的顺序
+	list := make([]*biz.Article, 0, len(aids))
+	for _, aid := range aids {
+		if a, ok := articleMap[aid]; ok {
+			list = append(list, convertArticle(a))
+		}
+	}
+	return list, next, nil
+}
+
+func (r *bookmarkRepo) CreateCollection(ctx context.Context, uid uint, name string) (*biz.BookmarkCollection, error) {
+	c := BookmarkCollection{UserID: uid, Name: name}
+	if err := r.data.DB(ctx).Create(&c).Error; err != nil {
+		if isUniqueViolation(err, "name") {
+			return nil, biz.TakenError("name")
+		}
+		return nil, err
+	}
+	return convertCollection(c, 0), nil
+}
+
+func (r *bookmarkRepo) GetCollection(ctx context.Context, id uint) (*biz.BookmarkCollection, error) {
+	c := BookmarkCollection{}
+	if err := r.data.DB(ctx).Where("id = ?", id).First(&c).Error; err != nil {
+		return nil, translateNotFound(err, biz.ErrCollectionNotFound)
+	}
+	count, err := r.countBookmarks(ctx, c.ID)
+	if err != nil {
+		return nil, err
+	}
+	return convertCollection(c, count), nil
+}
+
+func (r *bookmarkRepo) countBookmarks(ctx context.Context, collectionID uint) (uint32, error) {
+	var count int64
+	if err := r.data.DB(ctx).Model(&Bookmark{}).Where("collection_id = ?", collectionID).Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return uint32(count), nil
+}
+
+func (r *bookmarkRepo) ListCollections(ctx context.Context, uid uint) ([]*biz.BookmarkCollection, error) {
+	var collections []BookmarkCollection
+	if err := r.data.DB(ctx).Where("user_id = ?", uid).Order("name").Find(&collections).Error; err != nil {
+		return nil, err
+	}
+	list := make([]*biz.BookmarkCollection, 0, len(collections))
+	if len(collections) == 0 {
+		return list, nil
+	}
+
+	// 一次查出所有收藏夹的书签数
+	type collectionCount struct {
+		CollectionID uint
+		Count        uint32
+	}
+	var counts []collectionCount
+	err := r.data.DB(ctx).Model(&Bookmark{}).Select("collection_id, COUNT(*) AS count").
+		Where("user_id = ? AND collection_id > 0", uid).Group("collection_id").Scan(&counts).Error
+	if err != nil {
+		return nil, err
+	}
+	countMap := make(map[uint]uint32, len(counts))
+	for _, c := range counts {
+		countMap[c.CollectionID] = c.Count
+	}
+	for _, c := range collections {
+		list = append(list, convertCollection(c, countMap[c.ID]))
+	}
+	return list, nil
+}
+
+func (r *bookmarkRepo) RenameCollection(ctx context.Context, id uint, name string) (*biz.BookmarkCollection, error) {
+	if err := r.data.DB(ctx).Model(&BookmarkCollection{}).Where("id = ?", id).Update("name", name).Error; err != nil {
+		if isUniqueViolation(err, "name") {
+			return nil, biz.TakenError("name")
+		}
+		return nil, err
+	}
+	return r.GetCollection(ctx, id)
+}
+
+func (r *bookmarkRepo) DeleteCollection(ctx context.Context, id uint) error {
+	return r.data.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&Bookmark{}).Where("collection_id = ?", id).Update("collection_id", 0).Error; err != nil {
+			return err
+		}
+		return tx.Unscoped().Delete(&BookmarkCollection{}, "id = ?", id).Error
+	})
+}
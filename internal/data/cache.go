@@ -0,0 +1,257 @@
+package data
+
+import (
+	"container/list"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"kratos-realworld/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"golang.org/x/sync/singleflight"
+	"gorm.io/gorm"
+)
+
+const (
+	defaultCacheTTL        = 60 * time.Second
+	defaultCacheMaxEntries = 10000
+	// 失效后再删除一次的延迟, 至少覆盖一次加载的时间
+	defaultCacheDeleteDelay = time.Second
+
+	// 缓存的key, 只缓存和当前用户无关的内容
+	tagsCacheKey = "tags"
+)
+
+func articleCacheKey(aid uint) string {
+	return fmt.Sprintf("article:%d", aid)
+}
+
+// slug -> 文章id
+func articleSlugCacheKey(slug string) string {
+	return "article:slug:" + slug
+}
+
+func profileCacheKey(uid uint) string {
+	return fmt.Sprintf("profile:%d", uid)
+}
+
+// username -> 用户id
+func profileNameCacheKey(username string) string {
+	return "profile:name:" + username
+}
+
+// 缓存 - 值是编码后的字节, 由调用方编码
+type Cache interface {
+	// 不存在或已经过期时ok为false
+	Get(ctx context.Context, key string) (value []byte, ok bool, err error)
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	Delete(ctx context.Context, keys ...string) error
+}
+
+// 根据配置选择缓存, driver为空时不缓存, 返回nil
+func NewCache(c *conf.Data) (Cache, func(), error) {
+	cc := c.GetCache()
+	switch cc.GetDriver() {
+	case "":
+		return nil, func() {}, nil
+	case "local":
+		n := int(cc.GetMaxEntries())
+		if n <= 0 {
+			n = defaultCacheMaxEntries
+		}
+		return newLRUCache(n), func() {}, nil
+	case "redis":
+		rc := newRedisCache(cc.GetRedis())
+		return rc, func() { rc.Close() }, nil
+	default:
+		return nil, nil, fmt.Errorf("unknown cache driver %q", cc.GetDriver())
+	}
+}
+
+// 进程内的LRU缓存, 超过maxEntries时淘汰最久没有访问的条目
+type lruCache struct {
+	mu         sync.Mutex
+	maxEntries int
+	ll         *list.List
+	items      map[string]*list.Element
+	now        func() time.Time
+}
+
+type lruEntry struct {
+	key       string
+	value     []byte
+	expiresAt time.Time
+}
+
+func newLRUCache(maxEntries int) *lruCache {
+	return &lruCache{
+		maxEntries: maxEntries,
+		ll:         list.New(),
+		items:      make(map[string]*list.Element),
+		now:        time.Now,
+	}
+}
+
+func (c *lruCache) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.items[key]
+	if !ok {
+		return nil, false, nil
+	}
+	entry := e.Value.(*lruEntry)
+	if !c.now().Before(entry.expiresAt) {
+		c.remove(e)
+		return nil, false, nil
+	}
+	c.ll.MoveToFront(e)
+	return entry.value, true, nil
+}
+
+func (c *lruCache) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	expiresAt := c.now().Add(ttl)
+	if e, ok := c.items[key]; ok {
+		entry := e.Value.(*lruEntry)
+		entry.value = value
+		entry.expiresAt = expiresAt
+		c.ll.MoveToFront(e)
+		return nil
+	}
+	c.items[key] = c.ll.PushFront(&lruEntry{key: key, value: value, expiresAt: expiresAt})
+	for c.ll.Len() > c.maxEntries {
+		c.remove(c.ll.Back())
+	}
+	return nil
+}
+
+func (c *lruCache) Delete(ctx context.Context, keys ...string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, key := range keys {
+		if e, ok := c.items[key]; ok {
+			c.remove(e)
+		}
+	}
+	return nil
+}
+
+func (c *lruCache) remove(e *list.Element) {
+	c.ll.Remove(e)
+	delete(c.items, e.Value.(*lruEntry).key)
+}
+
+// repo使用的缓存 - 未命中时同一个key的并发加载合并为一次, 值用json编码, 每个调用方得到自己的副本
+// 为nil时不缓存
+type repoCache struct {
+	cache  Cache
+	prefix string
+	ttl    time.Duration
+	// 失效后经过delay再删除一次: 提交前开始的加载可能在第一次删除之后才写入旧数据,
+	// 有只读副本时从副本读到的旧数据也一样; 延迟取加载超时和副本的粘滞窗口中较大的
+	delay time.Duration
+	group singleflight.Group
+	log   *log.Helper
+}
+
+func newRepoCache(cache Cache, c *conf.Data, logger log.Logger) *repoCache {
+	if cache == nil {
+		return nil
+	}
+	ttl := c.GetCache().GetTtl().AsDuration()
+	if ttl <= 0 {
+		ttl = defaultCacheTTL
+	}
+	delay := defaultCacheDeleteDelay
+	if d := c.GetDatabase().GetReadTimeout().AsDuration(); d > delay {
+		delay = d
+	}
+	if d := c.GetDatabase().GetReplicaStickyWindow().AsDuration(); len(c.GetDatabase().GetReplicaDsns()) > 0 && d > delay {
+		delay = d
+	}
+	return &repoCache{
+		cache:  cache,
+		prefix: c.GetCache().GetPrefix(),
+		ttl:    ttl,
+		delay:  delay,
+		log:    log.NewHelper(logger),
+	}
+}
+
+// 事务中不读也不写缓存, 事务里的写入在提交前对其他请求不可见
+func (rc *repoCache) usable(ctx context.Context) bool {
+	if rc == nil {
+		return false
+	}
+	_, inTx := ctx.Value(contextTxKey{}).(*gorm.DB)
+	return !inTx
+}
+
+// 读取key对应的值, 未命中时调用load并写入缓存; 缓存出错时只记录日志, 直接使用load的结果
+// 错误不缓存
+func cached[T any](ctx context.Context, rc *repoCache, key string, load func(ctx context.Context) (T, error)) (T, error) {
+	if !rc.usable(ctx) {
+		return load(ctx)
+	}
+	var value T
+	key = rc.prefix + key
+	if b, ok, err := rc.cache.Get(ctx, key); err != nil {
+		rc.log.WithContext(ctx).Warnf("cache get %s: %v", key, err)
+	} else if ok {
+		if err := json.Unmarshal(b, &value); err == nil {
+			return value, nil
+		}
+		rc.log.WithContext(ctx).Warnf("cache decode %s: %v", key, err)
+	}
+
+	// 合并的加载不随第一个请求取消, 仍然受SQL超时的限制
+	loadCtx := context.WithoutCancel(ctx)
+	v, err, _ := rc.group.Do(key, func() (interface{}, error) {
+		value, err := load(loadCtx)
+		if err != nil {
+			return nil, err
+		}
+		b, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		if err := rc.cache.Set(loadCtx, key, b, rc.ttl); err != nil {
+			rc.log.WithContext(ctx).Warnf("cache set %s: %v", key, err)
+		}
+		return b, nil
+	})
+	if err != nil {
+		return value, err
+	}
+	if err := json.Unmarshal(v.([]byte), &value); err != nil {
+		return value, err
+	}
+	return value, nil
+}
+
+// 数据变化后删除缓存; 在Data.Transaction中时等最外层事务提交后再删除
+func (rc *repoCache) invalidate(ctx context.Context, keys ...string) {
+	if rc == nil || len(keys) == 0 {
+		return
+	}
+	prefixed := make([]string, len(keys))
+	for i, key := range keys {
+		prefixed[i] = rc.prefix + key
+	}
+	afterCommit(ctx, func() {
+		rc.delete(context.WithoutCancel(ctx), prefixed)
+		time.AfterFunc(rc.delay, func() {
+			rc.delete(context.Background(), prefixed)
+		})
+	})
+}
+
+func (rc *repoCache) delete(ctx context.Context, keys []string) {
+	if err := rc.cache.Delete(ctx, keys...); err != nil {
+		rc.log.WithContext(ctx).Warnf("cache delete %v: %v", keys, err)
+	}
+}
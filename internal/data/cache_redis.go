@@ -0,0 +1,283 @@
+package data
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	"kratos-realworld/internal/conf"
+)
+
+const (
+	defaultRedisPoolSize = 10
+	defaultRedisTimeout  = 200 * time.Millisecond
+)
+
+var errRedisClosed = errors.New("redis: cache closed")
+
+// redis缓存 - 只用到GET/SET/DEL, 手写RESP协议, 不引入redis客户端
+// 连接出错后丢弃, 空闲连接最多保留poolSize个
+type redisCache struct {
+	addr     string
+	password string
+	db       int
+	timeout  time.Duration
+	poolSize int
+
+	mu     sync.Mutex
+	idle   []*redisConn
+	closed bool
+}
+
+type redisConn struct {
+	conn net.Conn
+	r    *bufio.Reader
+	w    *bufio.Writer
+}
+
+// redis返回的错误回复, 连接仍然可用
+type redisError string
+
+func (e redisError) Error() string {
+	return "redis: " + string(e)
+}
+
+func newRedisCache(c *conf.Data_Cache_Redis) *redisCache {
+	poolSize := int(c.GetPoolSize())
+	if poolSize <= 0 {
+		poolSize = defaultRedisPoolSize
+	}
+	timeout := c.GetTimeout().AsDuration()
+	if timeout <= 0 {
+		timeout = defaultRedisTimeout
+	}
+	return &redisCache{
+		addr:     c.GetAddr(),
+		password: c.GetPassword(),
+		db:       int(c.GetDb()),
+		timeout:  timeout,
+		poolSize: poolSize,
+	}
+}
+
+func (c *redisCache) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	reply, err := c.do(ctx, "GET", key)
+	if err != nil {
+		return nil, false, err
+	}
+	if reply == nil {
+		return nil, false, nil
+	}
+	b, ok := reply.([]byte)
+	if !ok {
+		return nil, false, fmt.Errorf("redis: unexpected GET reply %T", reply)
+	}
+	return b, true, nil
+}
+
+func (c *redisCache) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	// PX的单位是毫秒, 不足1ms的按1ms
+	ms := ttl.Milliseconds()
+	if ms <= 0 {
+		ms = 1
+	}
+	_, err := c.do(ctx, "SET", key, value, "PX", strconv.FormatInt(ms, 10))
+	return err
+}
+
+func (c *redisCache) Delete(ctx context.Context, keys ...string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	args := make([]interface{}, len(keys))
+	for i, key := range keys {
+		args[i] = key
+	}
+	_, err := c.do(ctx, "DEL", args...)
+	return err
+}
+
+// 关闭空闲连接, 之后的命令都返回错误
+func (c *redisCache) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.closed = true
+	for _, rc := range c.idle {
+		rc.conn.Close()
+	}
+	c.idle = nil
+	return nil
+}
+
+// 执行一条命令, 超时取ctx的deadline和timeout中较早的一个
+func (c *redisCache) do(ctx context.Context, cmd string, args ...interface{}) (interface{}, error) {
+	rc, err := c.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	deadline := time.Now().Add(c.timeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	reply, err := rc.do(deadline, cmd, args...)
+	var replyErr redisError
+	if err != nil && !errors.As(err, &replyErr) {
+		rc.conn.Close()
+		return nil, err
+	}
+	c.put(rc)
+	return reply, err
+}
+
+func (c *redisCache) get(ctx context.Context) (*redisConn, error) {
+	c.mu.Lock()
+	if c.closed {
+		c.mu.Unlock()
+		return nil, errRedisClosed
+	}
+	if n := len(c.idle); n > 0 {
+		rc := c.idle[n-1]
+		c.idle = c.idle[:n-1]
+		c.mu.Unlock()
+		return rc, nil
+	}
+	c.mu.Unlock()
+	return c.dial(ctx)
+}
+
+func (c *redisCache) put(rc *redisConn) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.closed || len(c.idle) >= c.poolSize {
+		rc.conn.Close()
+		return
+	}
+	c.idle = append(c.idle, rc)
+}
+
+// 新连接先认证和选择db
+func (c *redisCache) dial(ctx context.Context) (*redisConn, error) {
+	dialer := net.Dialer{Timeout: c.timeout}
+	conn, err := dialer.DialContext(ctx, "tcp", c.addr)
+	if err != nil {
+		return nil, err
+	}
+	rc := &redisConn{conn: conn, r: bufio.NewReader(conn), w: bufio.NewWriter(conn)}
+	deadline := time.Now().Add(c.timeout)
+	if c.password != "" {
+		if _, err := rc.do(deadline, "AUTH", c.password); err != nil {
+			conn.Close()
+			return nil, err
+		}
+	}
+	if c.db != 0 {
+		if _, err := rc.do(deadline, "SELECT", strconv.Itoa(c.db)); err != nil {
+			conn.Close()
+			return nil, err
+		}
+	}
+	return rc, nil
+}
+
+func (rc *redisConn) do(deadline time.Time, cmd string, args ...interface{}) (interface{}, error) {
+	if err := rc.conn.SetDeadline(deadline); err != nil {
+		return nil, err
+	}
+	if err := writeRedisCommand(rc.w, cmd, args...); err != nil {
+		return nil, err
+	}
+	if err := rc.w.Flush(); err != nil {
+		return nil, err
+	}
+	return readRedisReply(rc.r)
+}
+
+// 命令编码为bulk string的数组, 参数只支持string和[]byte
+func writeRedisCommand(w *bufio.Writer, cmd string, args ...interface{}) error {
+	fmt.Fprintf(w, "*%d\r\n", len(args)+1)
+	writeRedisBulk(w, []byte(cmd))
+	for _, arg := range args {
+		switch v := arg.(type) {
+		case string:
+			writeRedisBulk(w, []byte(v))
+		case []byte:
+			writeRedisBulk(w, v)
+		default:
+			return fmt.Errorf("redis: unsupported argument type %T", arg)
+		}
+	}
+	return nil
+}
+
+func writeRedisBulk(w *bufio.Writer, b []byte) {
+	fmt.Fprintf(w, "$%d\r\n", len(b))
+	w.Write(b)
+	w.WriteString("\r\n")
+}
+
+// 读取一个回复 - 简单字符串返回string, 整数返回int64, bulk string返回[]byte, nil返回nil
+// 错误回复返回redisError
+func readRedisReply(r *bufio.Reader) (interface{}, error) {
+	line, err := readRedisLine(r)
+	if err != nil {
+		return nil, err
+	}
+	if len(line) == 0 {
+		return nil, errors.New("redis: empty reply")
+	}
+	switch line[0] {
+	case '+':
+		return line[1:], nil
+	case '-':
+		return nil, redisError(line[1:])
+	case ':':
+		return strconv.ParseInt(line[1:], 10, 64)
+	case '$':
+		n, err := strconv.Atoi(line[1:])
+		if err != nil {
+			return nil, fmt.Errorf("redis: invalid bulk length %q", line)
+		}
+		if n < 0 {
+			return nil, nil
+		}
+		b := make([]byte, n+2)
+		if _, err := io.ReadFull(r, b); err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	case '*':
+		n, err := strconv.Atoi(line[1:])
+		if err != nil {
+			return nil, fmt.Errorf("redis: invalid array length %q", line)
+		}
+		if n < 0 {
+			return nil, nil
+		}
+		items := make([]interface{}, n)
+		for i := range items {
+			if items[i], err = readRedisReply(r); err != nil {
+				return nil, err
+			}
+		}
+		return items, nil
+	default:
+		return nil, fmt.Errorf("redis: unexpected reply %q", line)
+	}
+}
+
+func readRedisLine(r *bufio.Reader) (string, error) {
+	line, err := r.ReadString('\n')
+	if err != nil {
+		return "", err
+	}
+	if len(line) < 2 || line[len(line)-2] != '\r' {
+		return "", fmt.Errorf("redis: invalid line %q", line)
+	}
+	return line[:len(line)-2], nil
+}
@@ -0,0 +1,359 @@
+package data
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"kratos-realworld/internal/biz"
+	"kratos-realworld/internal/conf"
+	"kratos-realworld/internal/pkg/middleware/auth"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-playground/assert/v2"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestLRUCache(t *testing.T) {
+	ctx := context.Background()
+	c := newLRUCache(2)
+	now := time.Now()
+	c.now = func() time.Time { return now }
+
+	assert.Equal(t, nil, c.Set(ctx, "a", []byte("1"), time.Minute))
+	assert.Equal(t, nil, c.Set(ctx, "b", []byte("2"), time.Minute))
+	v, ok, _ := c.Get(ctx, "a")
+	assert.Equal(t, true, ok)
+	assert.Equal(t, "1", string(v))
+
+	// b最久没有访问, 被淘汰
+	assert.Equal(t, nil, c.Set(ctx, "c", []byte("3"), time.Second))
+	_, ok, _ = c.Get(ctx, "b")
+	assert.Equal(t, false, ok)
+
+	now = now.Add(time.Second)
+	_, ok, _ = c.Get(ctx, "c")
+	assert.Equal(t, false, ok)
+	assert.Equal(t, nil, c.Delete(ctx, "a", "missing"))
+	_, ok, _ = c.Get(ctx, "a")
+	assert.Equal(t, false, ok)
+	assert.Equal(t, 0, c.ll.Len())
+}
+
+// 本地的redis替身 - 支持AUTH, SELECT, GET, SET PX和DEL
+type fakeRedis struct {
+	mu       sync.Mutex
+	password string
+	values   map[string]string
+	expires  map[string]time.Time
+	conns    int
+}
+
+func newFakeRedis(t *testing.T, password string) (*fakeRedis, string) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { ln.Close() })
+	f := &fakeRedis{password: password, values: map[string]string{}, expires: map[string]time.Time{}}
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			f.mu.Lock()
+			f.conns++
+			f.mu.Unlock()
+			go f.serve(conn)
+		}
+	}()
+	return f, ln.Addr().String()
+}
+
+func (f *fakeRedis) connCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.conns
+}
+
+func (f *fakeRedis) serve(conn net.Conn) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	authed := f.password == ""
+	for {
+		reply, err := readRedisReply(r)
+		if err != nil {
+			return
+		}
+		items := reply.([]interface{})
+		args := make([]string, len(items))
+		for i, item := range items {
+			args[i] = string(item.([]byte))
+		}
+		if _, err := conn.Write([]byte(f.handle(args, &authed))); err != nil {
+			return
+		}
+	}
+}
+
+func (f *fakeRedis) handle(args []string, authed *bool) string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	cmd := strings.ToUpper(args[0])
+	if cmd == "AUTH" {
+		if args[1] != f.password {
+			return "-WRONGPASS invalid password\r\n"
+		}
+		*authed = true
+		return "+OK\r\n"
+	}
+	if !*authed {
+		return "-NOAUTH Authentication required.\r\n"
+	}
+	switch cmd {
+	case "SELECT":
+		return "+OK\r\n"
+	case "GET":
+		v, ok := f.values[args[1]]
+		if !ok || !time.Now().Before(f.expires[args[1]]) {
+			return "$-1\r\n"
+		}
+		return "$" + strconv.Itoa(len(v)) + "\r\n" + v + "\r\n"
+	case "SET":
+		ms, _ := strconv.Atoi(args[4])
+		f.values[args[1]] = args[2]
+		f.expires[args[1]] = time.Now().Add(time.Duration(ms) * time.Millisecond)
+		return "+OK\r\n"
+	case "DEL":
+		n := 0
+		for _, key := range args[1:] {
+			if _, ok := f.values[key]; ok {
+				delete(f.values, key)
+				n++
+			}
+		}
+		return ":" + strconv.Itoa(n) + "\r\n"
+	default:
+		return "-ERR unknown command '" + args[0] + "'\r\n"
+	}
+}
+
+func TestRedisCache(t *testing.T) {
+	f, addr := newFakeRedis(t, "secret")
+	ctx := context.Background()
+	c := newRedisCache(&conf.Data_Cache_Redis{Addr: addr, Password: "secret", Db: 1})
+	defer c.Close()
+
+	_, ok, err := c.Get(ctx, "a")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, false, ok)
+	// 值可以包含\r\n
+	assert.Equal(t, nil, c.Set(ctx, "a", []byte("1\r\n2"), time.Minute))
+	v, ok, err := c.Get(ctx, "a")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, "1\r\n2", string(v))
+
+	assert.Equal(t, nil, c.Set(ctx, "b", []byte("x"), time.Millisecond))
+	time.Sleep(5 * time.Millisecond)
+	_, ok, _ = c.Get(ctx, "b")
+	assert.Equal(t, false, ok)
+
+	assert.Equal(t, nil, c.Delete(ctx, "a", "b"))
+	_, ok, _ = c.Get(ctx, "a")
+	assert.Equal(t, false, ok)
+	// 连接复用
+	assert.Equal(t, 1, f.connCount())
+
+	// 错误回复不影响连接
+	_, err = c.do(ctx, "PING")
+	assert.Equal(t, "redis: ERR unknown command 'PING'", err.Error())
+	assert.Equal(t, nil, c.Set(ctx, "a", []byte("1"), time.Minute))
+	assert.Equal(t, 1, f.connCount())
+
+	wrong := newRedisCache(&conf.Data_Cache_Redis{Addr: addr, Password: "wrong"})
+	_, _, err = wrong.Get(ctx, "a")
+	var replyErr redisError
+	assert.Equal(t, true, errors.As(err, &replyErr))
+
+	c.Close()
+	_, _, err = c.Get(ctx, "a")
+	assert.Equal(t, errRedisClosed, err)
+}
+
+func TestCachedCoalescesLoads(t *testing.T) {
+	rc := newRepoCache(newLRUCache(10), &conf.Data{}, log.DefaultLogger)
+	var loads atomic.Int32
+	release := make(chan struct{})
+	load := func(ctx context.Context) (*biz.ProfileResp, error) {
+		loads.Add(1)
+		<-release
+		return &biz.ProfileResp{ID: 1, Username: "a"}, nil
+	}
+
+	const n = 10
+	results := make([]*biz.ProfileResp, n)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i], _ = cached(context.Background(), rc, "k", load)
+		}(i)
+	}
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	assert.Equal(t, int32(1), loads.Load())
+	// 每个调用方得到自己的副本
+	results[0].Following = true
+	for _, p := range results[1:] {
+		assert.Equal(t, "a", p.Username)
+		assert.Equal(t, false, p.Following)
+	}
+
+	// 加载出错时不缓存
+	_, err := cached(context.Background(), rc, "missing", func(ctx context.Context) (*biz.ProfileResp, error) {
+		return nil, biz.ErrUserNotFound
+	})
+	assert.Equal(t, biz.ErrUserNotFound, err)
+	_, ok, _ := rc.cache.Get(context.Background(), "missing")
+	assert.Equal(t, false, ok)
+}
+
+func TestCachedReads(t *testing.T) {
+	d := newCachedTestData(t)
+	uids := createTestUsers(t, d, 2)
+	ar := NewArticleRepo(d, log.DefaultLogger)
+	pr := NewProfileRepo(d, log.DefaultLogger)
+	tr := NewTagRepo(d, log.DefaultLogger)
+	ctx := context.Background()
+	a, err := ar.CreateArticle(ctx, &biz.Article{Slug: "cached", Title: "cached", AuthorID: uids[0], TagList: []string{"go"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	author, _ := pr.GetProfilesByIDs(ctx, uids[:1])
+
+	// 第二次读取不查数据库
+	_, err = ar.GetArticleBySlug(ctx, "cached")
+	assert.Equal(t, nil, err)
+	_, err = tr.GetTags(ctx, "", 0)
+	assert.Equal(t, nil, err)
+	n := countQueries(t, d, func() {
+		article, err := ar.GetArticleBySlug(ctx, "cached")
+		assert.Equal(t, nil, err)
+		assert.Equal(t, author[0].Username, article.Author.Username)
+		assert.Equal(t, []string{"go"}, article.TagList)
+		tags, err := tr.GetTags(ctx, "", 1)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, 1, len(tags))
+	})
+	assert.Equal(t, 0, n)
+
+	// 收藏, 关注和修改资料后失效
+	_, err = ar.FavoriteArticle(ctx, a.ID, uids[1])
+	assert.Equal(t, nil, err)
+	article, _ := ar.GetArticleBySlug(ctx, "cached")
+	assert.Equal(t, uint32(1), article.FavoritesCount)
+	assert.Equal(t, nil, pr.FollowUserByUsername(ctx, uids[1], uids[0]))
+	article, _ = ar.GetArticleBySlug(ctx, "cached")
+	assert.Equal(t, uint32(1), article.Author.FollowersCount)
+	ur := NewUserRepo(d, log.DefaultLogger)
+	_, err = ur.UpdateUser(ctx, &biz.User{ID: uids[0], Username: "renamed", Bio: "bio"})
+	assert.Equal(t, nil, err)
+	article, _ = ar.GetArticleBySlug(ctx, "cached")
+	assert.Equal(t, "renamed", article.Author.Username)
+	_, err = pr.GetProfileByUsername(ctx, author[0].Username)
+	assert.Equal(t, biz.ErrUserNotFound, err)
+
+	// 当前用户相关的字段不进入缓存
+	viewer := auth.WithContext(ctx, &auth.CurrentUser{UserID: uids[1]})
+	p, err := pr.GetProfileByUsername(viewer, "renamed")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, true, p.Following)
+	p, err = pr.GetProfileByUsername(ctx, "renamed")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, false, p.Following)
+
+	// 改名后旧的slug不再命中
+	_, err = ar.UpdateArticle(ctx, &biz.Article{Slug: "cached", Title: "Renamed Article"})
+	assert.Equal(t, nil, err)
+	_, err = ar.GetArticleBySlug(ctx, "cached")
+	assert.Equal(t, biz.ErrArticleNotFound, err)
+	assert.Equal(t, nil, ar.DeleteArticleBySlug(ctx, "renamed-article"))
+	tags, _ := tr.GetTags(ctx, "", 0)
+	assert.Equal(t, 0, len(tags))
+}
+
+// 事务中的失效在提交后执行, 回滚时不执行
+func TestInvalidateAfterCommit(t *testing.T) {
+	d := newCachedTestData(t)
+	ctx := context.Background()
+	set := func() {
+		d.cache.cache.Set(ctx, "k", []byte("1"), time.Minute)
+	}
+	exists := func() bool {
+		_, ok, _ := d.cache.cache.Get(ctx, "k")
+		return ok
+	}
+
+	set()
+	err := d.Transaction(ctx, func(ctx context.Context) error {
+		return d.Transaction(ctx, func(ctx context.Context) error {
+			d.cache.invalidate(ctx, "k")
+			assert.Equal(t, true, exists())
+			return nil
+		})
+	})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, false, exists())
+
+	set()
+	err = d.Transaction(ctx, func(ctx context.Context) error {
+		d.cache.invalidate(ctx, "k")
+		return errors.New("rollback")
+	})
+	assert.Equal(t, "rollback", err.Error())
+	assert.Equal(t, true, exists())
+}
+
+// 没有副本时也延迟再删除一次, 第一次删除之后写回的旧数据同样被清除
+func TestInvalidateDeletesAgain(t *testing.T) {
+	rc := newRepoCache(newLRUCache(10), &conf.Data{}, log.DefaultLogger)
+	assert.Equal(t, defaultCacheDeleteDelay, rc.delay)
+	rc = newRepoCache(newLRUCache(10), &conf.Data{Database: &conf.Data_Database{
+		ReadTimeout:         durationpb.New(2 * time.Second),
+		ReplicaDsns:         []string{"replica"},
+		ReplicaStickyWindow: durationpb.New(5 * time.Second),
+	}}, log.DefaultLogger)
+	assert.Equal(t, 5*time.Second, rc.delay)
+
+	ctx := context.Background()
+	rc.delay = 10 * time.Millisecond
+	rc.cache.Set(ctx, "k", []byte("1"), time.Minute)
+	rc.invalidate(ctx, "k")
+	rc.cache.Set(ctx, "k", []byte("stale"), time.Minute)
+	time.Sleep(50 * time.Millisecond)
+	_, ok, _ := rc.cache.Get(ctx, "k")
+	assert.Equal(t, false, ok)
+}
+
+func TestNewCache(t *testing.T) {
+	c, _, err := NewCache(&conf.Data{})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, nil, c)
+	c, cleanup, err := NewCache(&conf.Data{Cache: &conf.Data_Cache{Driver: "redis", Redis: &conf.Data_Cache_Redis{Timeout: durationpb.New(time.Second)}}})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, time.Second, c.(*redisCache).timeout)
+	cleanup()
+	_, _, err = NewCache(&conf.Data{Cache: &conf.Data_Cache{Driver: "memcached"}})
+	assert.NotEqual(t, nil, err)
+}
@@ -0,0 +1,1066 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"kratos-realworld/internal/biz"
+	"kratos-realworld/internal/conf"
+	e "kratos-realworld/internal/errors"
+	"kratos-realworld/internal/pkg/middleware/auth"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-playground/assert/v2"
+)
+
+// 同一组用例分别在gorm和内存实现上运行, 保证两者行为一致
+type conformanceRepos struct {
+	tx            biz.Transaction
+	users         biz.UserRepo
+	profiles      biz.ProfileRepo
+	articles      biz.ArticleRepo
+	comments      biz.CommentRepo
+	tags          biz.TagRepo
+	bookmarks     biz.BookmarkRepo
+	reactions     biz.ReactionRepo
+	attachments   biz.AttachmentRepo
+	outbox        biz.OutboxRepo
+	notifications biz.NotificationRepo
+}
+
+func newConformanceRepos(d *Data) *conformanceRepos {
+	return &conformanceRepos{
+		tx:            NewTransaction(d),
+		users:         NewUserRepo(d, log.DefaultLogger),
+		profiles:      NewProfileRepo(d, log.DefaultLogger),
+		articles:      NewArticleRepo(d, log.DefaultLogger),
+		comments:      NewCommentRepo(d, log.DefaultLogger),
+		tags:          NewTagRepo(d, log.DefaultLogger),
+		bookmarks:     NewBookmarkRepo(d, log.DefaultLogger),
+		reactions:     NewReactionRepo(d, log.DefaultLogger),
+		attachments:   NewAttachmentRepo(d, log.DefaultLogger),
+		outbox:        NewOutboxRepo(d, log.DefaultLogger),
+		notifications: NewNotificationRepo(d, log.DefaultLogger),
+	}
+}
+
+func newMemoryTestData(t *testing.T) *Data {
+	c := &conf.Data{Database: &conf.Data_Database{Driver: "memory"}}
+	d, cleanup, err := NewData(c, log.DefaultLogger, NewDB(c, log.DefaultLogger), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(cleanup)
+	return d
+}
+
+var conformanceCases = []struct {
+	name string
+	run  func(t *testing.T, r *conformanceRepos)
+}{
+	{"Users", testConformanceUsers},
+	{"Follows", testConformanceFollows},
+	{"BlocksAndMutes", testConformanceBlocksAndMutes},
+	{"FollowRequests", testConformanceFollowRequests},
+	{"Articles", testConformanceArticles},
+	{"Feed", testConformanceFeed},
+	{"Comments", testConformanceComments},
+	{"Tags", testConformanceTags},
+	{"Search", testConformanceSearch},
+	{"Suggestions", testConformanceSuggestions},
+	{"DeleteUser", testConformanceDeleteUser},
+	{"ExportUser", testConformanceExportUser},
+	{"Transaction", testConformanceTransaction},
+	{"Bookmarks", testConformanceBookmarks},
+	{"Reactions", testConformanceReactions},
+	{"Attachments", testConformanceAttachments},
+	{"Outbox", testConformanceOutbox},
+	{"Notifications", testConformanceNotifications},
+}
+
+func TestConformance(t *testing.T) {
+	backends := []struct {
+		name    string
+		newData func(t *testing.T) *Data
+	}{
+		{"gorm", newTestData},
+		{"cached", newCachedTestData},
+		{"memory", newMemoryTestData},
+	}
+	for _, b := range backends {
+		t.Run(b.name, func(t *testing.T) {
+			for _, c := range conformanceCases {
+				t.Run(c.name, func(t *testing.T) {
+					c.run(t, newConformanceRepos(b.newData(t)))
+				})
+			}
+		})
+	}
+}
+
+// 按顺序创建用户, 返回uid
+func conformanceUsers(t *testing.T, r *conformanceRepos, names ...string) []uint {
+	uids := make([]uint, len(names))
+	for i, name := range names {
+		u := &biz.User{Username: name, Email: name + "@example.com", PasswordHash: "hash"}
+		if err := r.users.CreateUser(context.Background(), u); err != nil {
+			t.Fatal(err)
+		}
+		uids[i] = u.ID
+	}
+	return uids
+}
+
+func conformanceArticle(t *testing.T, r *conformanceRepos, uid uint, slug string, tags ...string) *biz.Article {
+	a, err := r.articles.CreateArticle(context.Background(), &biz.Article{Slug: slug, Title: slug, AuthorID: uid, TagList: tags})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return a
+}
+
+func usernames(profiles []*biz.ProfileResp) []string {
+	names := make([]string, len(profiles))
+	for i, p := range profiles {
+		names[i] = p.Username
+	}
+	return names
+}
+
+func slugs(articles []*biz.Article) []string {
+	list := make([]string, len(articles))
+	for i, a := range articles {
+		list[i] = a.Slug
+	}
+	return list
+}
+
+func testConformanceUsers(t *testing.T, r *conformanceRepos) {
+	ctx := context.Background()
+	uids := conformanceUsers(t, r, "jake", "jane")
+
+	err := r.users.CreateUser(ctx, &biz.User{Username: "jake", Email: "other@example.com"})
+	assert.Equal(t, []string{"has already been taken"}, e.FromError(err).Errors["username"])
+	err = r.users.CreateUser(ctx, &biz.User{Username: "other", Email: "jake@example.com"})
+	assert.Equal(t, []string{"has already been taken"}, e.FromError(err).Errors["email"])
+
+	u, err := r.users.GetUserByEmail(ctx, "jake@example.com")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, uids[0], u.ID)
+	assert.Equal(t, "hash", u.PasswordHash)
+	_, err = r.users.GetUserByEmail(ctx, "nobody@example.com")
+	assert.Equal(t, true, errors.Is(err, biz.ErrUserNotFound))
+	_, err = r.users.GetUserByID(ctx, 12345)
+	assert.Equal(t, true, errors.Is(err, biz.ErrUserNotFound))
+	u, err = r.users.GetUserByUsername(ctx, "jane")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, uids[1], u.ID)
+	_, err = r.users.GetUserByUsername(ctx, "nobody")
+	assert.Equal(t, true, errors.Is(err, biz.ErrUserNotFound))
+
+	// 零值不更新, private总是更新
+	u, err = r.users.UpdateUser(ctx, &biz.User{ID: uids[0], Bio: "hello", Private: true})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "jake", u.Username)
+	assert.Equal(t, "hello", u.Bio)
+	assert.Equal(t, true, u.Private)
+	_, err = r.users.UpdateUser(ctx, &biz.User{ID: uids[0], Username: "jane"})
+	assert.Equal(t, []string{"has already been taken"}, e.FromError(err).Errors["username"])
+
+	assert.Equal(t, nil, r.users.UpdatePasswordHash(ctx, uids[1], "rehashed"))
+	u, err = r.users.GetUserByEmail(ctx, "jane@example.com")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "rehashed", u.PasswordHash)
+
+	// 匿名化后不能再通过id和username查到, 先读一次资料让缓存中有username的映射
+	_, err = r.profiles.GetProfileByUsername(ctx, "jane")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, nil, r.users.AnonymizeUser(ctx, uids[1]))
+	_, err = r.users.GetUserByID(ctx, uids[1])
+	assert.Equal(t, true, errors.Is(err, biz.ErrUserNotFound))
+	_, err = r.users.GetUserByUsername(ctx, "jane")
+	assert.Equal(t, true, errors.Is(err, biz.ErrUserNotFound))
+	for _, name := range []string{"jane", fmt.Sprintf("deleted-user-%d", uids[1])} {
+		_, err = r.profiles.GetProfileByUsername(ctx, name)
+		assert.Equal(t, true, errors.Is(err, biz.ErrUserNotFound))
+	}
+	assert.Equal(t, true, errors.Is(r.users.AnonymizeUser(ctx, uids[1]), biz.ErrUserNotFound))
+}
+
+func testConformanceFollows(t *testing.T, r *conformanceRepos) {
+	ctx := context.Background()
+	uids := conformanceUsers(t, r, "a", "b", "c", "d")
+	for _, follower := range uids[1:] {
+		assert.Equal(t, nil, r.profiles.FollowUserByUsername(ctx, follower, uids[0]))
+	}
+	assert.Equal(t, true, errors.Is(r.profiles.FollowUserByUsername(ctx, uids[1], uids[0]), biz.ErrFollowExists))
+
+	p, err := r.profiles.GetProfileByUsername(auth.WithContext(ctx, &auth.CurrentUser{UserID: uids[1]}), "a")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, true, p.Following)
+	assert.Equal(t, uint32(3), p.FollowersCount)
+	p, err = r.profiles.GetProfileByUsername(ctx, "b")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, false, p.Following)
+	assert.Equal(t, uint32(1), p.FollowingCount)
+
+	// 按关注时间倒序分页
+	page, next, err := r.profiles.ListFollowers(ctx, uids[0], 0, 2)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, []string{"d", "c"}, usernames(page))
+	assert.NotEqual(t, uint(0), next)
+	page, next, err = r.profiles.ListFollowers(ctx, uids[0], next, 2)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, []string{"b"}, usernames(page))
+	assert.Equal(t, uint(0), next)
+	page, _, err = r.profiles.ListFollowing(ctx, uids[1], 0, 10)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, []string{"a"}, usernames(page))
+
+	following, err := r.profiles.GetFollowingMap(ctx, uids[1], []uint{uids[0], uids[2]})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, map[uint]bool{uids[0]: true, uids[2]: false}, following)
+
+	assert.Equal(t, nil, r.profiles.UnfollowUserByUsername(ctx, uids[1], uids[0]))
+	assert.Equal(t, true, errors.Is(r.profiles.UnfollowUserByUsername(ctx, uids[1], uids[0]), biz.ErrFollowNotFound))
+	profiles, err := r.profiles.GetProfilesByIDs(ctx, []uint{uids[1], uids[0], 12345})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, []string{"b", "a"}, usernames(profiles))
+	assert.Equal(t, uint32(0), profiles[0].FollowingCount)
+	assert.Equal(t, uint32(2), profiles[1].FollowersCount)
+
+	// 取关后可以再次关注
+	assert.Equal(t, nil, r.profiles.FollowUserByUsername(ctx, uids[1], uids[0]))
+	profiles, err = r.profiles.GetProfilesByIDs(ctx, []uint{uids[0]})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, uint32(3), profiles[0].FollowersCount)
+}
+
+func testConformanceBlocksAndMutes(t *testing.T, r *conformanceRepos) {
+	ctx := context.Background()
+	uids := conformanceUsers(t, r, "a", "b", "c")
+	assert.Equal(t, nil, r.profiles.FollowUserByUsername(ctx, uids[0], uids[1]))
+	assert.Equal(t, nil, r.profiles.FollowUserByUsername(ctx, uids[1], uids[0]))
+	a := conformanceArticle(t, r, uids[1], "by-b")
+	_, err := r.comments.AddComment(ctx, &biz.Comment{ArticleID: a.ID, AuthorID: uids[2], Body: "from c"})
+	assert.Equal(t, nil, err)
+
+	// 拉黑同时删除双向的关注
+	assert.Equal(t, nil, r.profiles.BlockUser(ctx, uids[0], uids[1]))
+	assert.Equal(t, true, errors.Is(r.profiles.BlockUser(ctx, uids[0], uids[1]), biz.ErrBlockExists))
+	blocked, err := r.profiles.IsBlocked(ctx, uids[1], uids[0])
+	assert.Equal(t, nil, err)
+	assert.Equal(t, true, blocked)
+	profiles, err := r.profiles.GetProfilesByIDs(ctx, uids[:2])
+	assert.Equal(t, nil, err)
+	for _, p := range profiles {
+		assert.Equal(t, uint32(0), p.FollowersCount)
+		assert.Equal(t, uint32(0), p.FollowingCount)
+	}
+	page, _, err := r.profiles.ListBlockedUsers(ctx, uids[0], 0, 10)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, []string{"b"}, usernames(page))
+
+	// 拉黑的作者的文章不可见
+	list, err := r.articles.ListArticlesByOptions(ctx, &biz.ListOptions{Author: "b", CurrentUid: uids[0]})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 0, len(list))
+	list, err = r.articles.ListArticlesByOptions(ctx, &biz.ListOptions{Author: "b"})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, []string{"by-b"}, slugs(list))
+
+	// 静音的用户的评论不可见
+	assert.Equal(t, nil, r.profiles.MuteUser(ctx, uids[0], uids[2]))
+	assert.Equal(t, true, errors.Is(r.profiles.MuteUser(ctx, uids[0], uids[2]), biz.ErrMuteExists))
+	comments, err := r.comments.GetCommentsByID(ctx, a.ID, uids[0])
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 0, len(comments))
+	comments, err = r.comments.GetCommentsByID(ctx, a.ID, uids[1])
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 1, len(comments))
+	page, _, err = r.profiles.ListMutedUsers(ctx, uids[0], 0, 10)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, []string{"c"}, usernames(page))
+
+	assert.Equal(t, nil, r.profiles.UnmuteUser(ctx, uids[0], uids[2]))
+	assert.Equal(t, true, errors.Is(r.profiles.UnmuteUser(ctx, uids[0], uids[2]), biz.ErrMuteNotFound))
+	assert.Equal(t, nil, r.profiles.UnblockUser(ctx, uids[0], uids[1]))
+	assert.Equal(t, true, errors.Is(r.profiles.UnblockUser(ctx, uids[0], uids[1]), biz.ErrBlockNotFound))
+	blocked, err = r.profiles.IsBlocked(ctx, uids[0], uids[1])
+	assert.Equal(t, nil, err)
+	assert.Equal(t, false, blocked)
+}
+
+func testConformanceFollowRequests(t *testing.T, r *conformanceRepos) {
+	ctx := context.Background()
+	uids := conformanceUsers(t, r, "private", "b", "c")
+	_, err := r.users.UpdateUser(ctx, &biz.User{ID: uids[0], Private: true})
+	assert.Equal(t, nil, err)
+	conformanceArticle(t, r, uids[0], "private-article")
+
+	assert.Equal(t, nil, r.profiles.CreateFollowRequest(ctx, uids[1], uids[0]))
+	assert.Equal(t, nil, r.profiles.CreateFollowRequest(ctx, uids[2], uids[0]))
+	assert.Equal(t, true, errors.Is(r.profiles.CreateFollowRequest(ctx, uids[1], uids[0]), biz.ErrFollowRequestExists))
+	p, err := r.profiles.GetProfileByUsername(auth.WithContext(ctx, &auth.CurrentUser{UserID: uids[1]}), "private")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, true, p.FollowRequested)
+
+	page, _, err := r.profiles.ListIncomingFollowRequests(ctx, uids[0], 0, 10)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, []string{"c", "b"}, usernames(page))
+	page, _, err = r.profiles.ListOutgoingFollowRequests(ctx, uids[1], 0, 10)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, []string{"private"}, usernames(page))
+
+	// 私密账号的文章只对粉丝可见
+	list, err := r.articles.ListArticlesByOptions(ctx, &biz.ListOptions{Author: "private", CurrentUid: uids[1]})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 0, len(list))
+
+	assert.Equal(t, nil, r.profiles.ApproveFollowRequest(ctx, uids[1], uids[0]))
+	assert.Equal(t, true, errors.Is(r.profiles.ApproveFollowRequest(ctx, uids[1], uids[0]), biz.ErrFollowRequestNotFound))
+	assert.Equal(t, true, errors.Is(r.profiles.CreateFollowRequest(ctx, uids[1], uids[0]), biz.ErrFollowExists))
+	list, err = r.articles.ListArticlesByOptions(ctx, &biz.ListOptions{Author: "private", CurrentUid: uids[1]})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, []string{"private-article"}, slugs(list))
+
+	approved, err := r.profiles.ApproveAllFollowRequests(ctx, uids[0])
+	assert.Equal(t, nil, err)
+	assert.Equal(t, []uint{uids[2]}, approved)
+	p, err = r.profiles.GetProfileByUsername(ctx, "private")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, uint32(2), p.FollowersCount)
+	assert.Equal(t, true, errors.Is(r.profiles.DeleteFollowRequest(ctx, uids[2], uids[0]), biz.ErrFollowRequestNotFound))
+}
+
+func testConformanceArticles(t *testing.T, r *conformanceRepos) {
+	ctx := context.Background()
+	uids := conformanceUsers(t, r, "author", "reader")
+	a := conformanceArticle(t, r, uids[0], "first", "go", "kratos")
+	assert.Equal(t, []string{"go", "kratos"}, a.TagList)
+	conformanceArticle(t, r, uids[0], "second", "go")
+	_, err := r.articles.CreateArticle(ctx, &biz.Article{Slug: "first", Title: "first", AuthorID: uids[0]})
+	assert.Equal(t, []string{"has already been taken"}, e.FromError(err).Errors["slug"])
+
+	got, err := r.articles.GetArticleBySlug(ctx, "first")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "author", got.Author.Username)
+	assert.Equal(t, uint32(2), got.Author.ArticlesCount)
+	assert.Equal(t, []string{"go", "kratos"}, got.TagList)
+
+	// 改标题同时改slug, tag整体替换
+	updated, err := r.articles.UpdateArticle(ctx, &biz.Article{Slug: "first", Title: "First Post", TagList: []string{"grpc"}})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "first-post", updated.Slug)
+	assert.Equal(t, []string{"grpc"}, updated.TagList)
+	_, err = r.articles.UpdateArticle(ctx, &biz.Article{Slug: "first-post", Title: "second"})
+	assert.Equal(t, []string{"has already been taken"}, e.FromError(err).Errors["title"])
+	_, err = r.articles.GetArticleBySlug(ctx, "first")
+	assert.Equal(t, true, errors.Is(err, biz.ErrArticleNotFound))
+
+	// 收藏是幂等的, 只有第一次返回新增
+	added, err := r.articles.FavoriteArticle(ctx, a.ID, uids[1])
+	assert.Equal(t, nil, err)
+	assert.Equal(t, true, added)
+	added, err = r.articles.FavoriteArticle(ctx, a.ID, uids[1])
+	assert.Equal(t, nil, err)
+	assert.Equal(t, false, added)
+	got, err = r.articles.GetArticleByAid(ctx, a.ID)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, uint32(1), got.FavoritesCount)
+	states, err := r.articles.GetArticleViewerStates(ctx, []uint{a.ID, a.ID + 1, 12345}, uids[1])
+	assert.Equal(t, nil, err)
+	assert.Equal(t, map[uint]biz.ArticleViewerState{a.ID: {Favorited: true}, a.ID + 1: {}}, states)
+
+	list, err := r.articles.ListArticlesByOptions(ctx, &biz.ListOptions{Tag: "go"})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, []string{"second"}, slugs(list))
+	list, err = r.articles.ListArticlesByOptions(ctx, &biz.ListOptions{FavoritedBy: "reader"})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, []string{"first-post"}, slugs(list))
+	list, err = r.articles.ListArticlesByOptions(ctx, &biz.ListOptions{Author: "author", Limit: 1, Offset: 1})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 1, len(list))
+
+	// 登录且没有过滤条件时只有关注的作者的文章
+	list, err = r.articles.ListArticlesByOptions(ctx, &biz.ListOptions{CurrentUid: uids[1]})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 0, len(list))
+	assert.Equal(t, nil, r.profiles.FollowUserByUsername(ctx, uids[1], uids[0]))
+	list, err = r.articles.ListArticlesByOptions(ctx, &biz.ListOptions{CurrentUid: uids[1]})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 2, len(list))
+
+	assert.Equal(t, nil, r.articles.UnfavoriteArticle(ctx, a.ID, uids[1]))
+	assert.Equal(t, nil, r.articles.UnfavoriteArticle(ctx, a.ID, uids[1]))
+	got, err = r.articles.GetArticleByAid(ctx, a.ID)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, uint32(0), got.FavoritesCount)
+	fixed, last, err := r.articles.ReconcileFavoritesCounts(ctx, 0, 10)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 0, fixed)
+	assert.NotEqual(t, uint(0), last)
+
+	assert.Equal(t, nil, r.articles.DeleteArticleBySlug(ctx, "first-post"))
+	assert.Equal(t, true, errors.Is(r.articles.DeleteArticleBySlug(ctx, "first-post"), biz.ErrArticleNotFound))
+	p, err := r.profiles.GetProfileByUsername(ctx, "author")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, uint32(1), p.ArticlesCount)
+}
+
+func testConformanceFeed(t *testing.T, r *conformanceRepos) {
+	ctx := context.Background()
+	uids := conformanceUsers(t, r, "reader", "followed", "tagged", "muted")
+	assert.Equal(t, nil, r.profiles.FollowUserByUsername(ctx, uids[0], uids[1]))
+	assert.Equal(t, nil, r.profiles.FollowUserByUsername(ctx, uids[0], uids[3]))
+	assert.Equal(t, nil, r.profiles.MuteUser(ctx, uids[0], uids[3]))
+	conformanceArticle(t, r, uids[1], "followed-1")
+	conformanceArticle(t, r, uids[2], "tagged-1", "go")
+	conformanceArticle(t, r, uids[2], "untagged")
+	conformanceArticle(t, r, uids[3], "muted-1", "go")
+	conformanceArticle(t, r, uids[1], "followed-2", "go")
+	conformanceArticle(t, r, uids[0], "own", "go")
+	tag, err := r.tags.GetTag(ctx, "go")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, nil, r.tags.FollowTag(ctx, uids[0], tag.ID))
+	assert.Equal(t, nil, r.tags.FollowTag(ctx, uids[0], tag.ID))
+
+	// 关注的作者和关注的标签去重, 按id倒序
+	page, next, err := r.articles.ListFeedArticles(ctx, uids[0], 0, 0, 2)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, []string{"followed-2", "tagged-1"}, slugs(page))
+	page, next, err = r.articles.ListFeedArticles(ctx, uids[0], next, 0, 2)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, []string{"followed-1"}, slugs(page))
+	assert.Equal(t, uint(0), next)
+	page, _, err = r.articles.ListFeedArticles(ctx, uids[0], 0, 1, 10)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, []string{"tagged-1", "followed-1"}, slugs(page))
+}
+
+func testConformanceComments(t *testing.T, r *conformanceRepos) {
+	ctx := context.Background()
+	uids := conformanceUsers(t, r, "author", "commenter")
+	a := conformanceArticle(t, r, uids[0], "commented")
+	c, err := r.comments.AddComment(ctx, &biz.Comment{ArticleID: a.ID, AuthorID: uids[1], Body: "first"})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "commenter", c.Author.Username)
+	_, err = r.comments.AddComment(ctx, &biz.Comment{ArticleID: a.ID, AuthorID: uids[0], Body: "second"})
+	assert.Equal(t, nil, err)
+
+	got, err := r.comments.GetCommentByID(ctx, c.ID)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "first", got.Body)
+	assert.Equal(t, uids[1], got.AuthorID)
+	assert.Equal(t, a.ID, got.ArticleID)
+	comments, err := r.comments.GetCommentsByID(ctx, a.ID, 0)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 2, len(comments))
+	assert.Equal(t, "first", comments[0].Body)
+
+	assert.Equal(t, nil, r.comments.DeleteCommentByID(ctx, c.ID))
+	assert.Equal(t, true, errors.Is(r.comments.DeleteCommentByID(ctx, c.ID), biz.ErrCommentNotFound))
+	_, err = r.comments.GetCommentByID(ctx, c.ID)
+	assert.Equal(t, true, errors.Is(err, biz.ErrCommentNotFound))
+}
+
+func testConformanceTags(t *testing.T, r *conformanceRepos) {
+	ctx := context.Background()
+	uids := conformanceUsers(t, r, "author", "reader")
+	conformanceArticle(t, r, uids[0], "a1", "golang", "grpc")
+	conformanceArticle(t, r, uids[0], "a2", "golang", "go")
+	conformanceArticle(t, r, uids[0], "a3", "go")
+	assert.Equal(t, nil, r.articles.DeleteArticleBySlug(ctx, "a3"))
+
+	// 按文章数倒序, 删除的文章不计数
+	tags, err := r.tags.GetTags(ctx, "", 0)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 3, len(tags))
+	assert.Equal(t, biz.Tag("golang"), tags[0].Name)
+	assert.Equal(t, uint32(2), tags[0].ArticlesCount)
+	assert.Equal(t, biz.Tag("go"), tags[1].Name)
+	tags, err = r.tags.GetTags(ctx, "GR", 10)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 1, len(tags))
+	assert.Equal(t, biz.Tag("grpc"), tags[0].Name)
+	_, err = r.tags.GetTag(ctx, "rust")
+	assert.Equal(t, true, errors.Is(err, biz.ErrTagNotFound))
+	uses, err := r.tags.ListTagUses(ctx, time.Now().Add(-time.Hour))
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 4, len(uses))
+
+	golang, err := r.tags.GetTag(ctx, "golang")
+	assert.Equal(t, nil, err)
+	goTag, err := r.tags.GetTag(ctx, "go")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, nil, r.tags.FollowTag(ctx, uids[1], golang.ID))
+	assert.Equal(t, nil, r.tags.AddAlias(ctx, golang.ID, "golang-lang"))
+	assert.Equal(t, nil, r.tags.AddAlias(ctx, golang.ID, "golang-lang"))
+	err = r.tags.AddAlias(ctx, goTag.ID, "golang-lang")
+	assert.Equal(t, []string{"has already been taken"}, e.FromError(err).Errors["alias"])
+	assert.Equal(t, true, errors.Is(r.tags.RemoveAlias(ctx, golang.ID, "missing"), biz.ErrAliasNotFound))
+
+	// 合并后source的文章, 关注和别名都转到target
+	assert.Equal(t, nil, r.tags.MergeTags(ctx, golang.ID, goTag.ID))
+	_, err = r.tags.GetTag(ctx, "golang")
+	assert.Equal(t, true, errors.Is(err, biz.ErrTagNotFound))
+	goTag, err = r.tags.GetTag(ctx, "go")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, uint32(2), goTag.ArticlesCount)
+	followed, err := r.tags.GetFollowedTags(ctx, uids[1])
+	assert.Equal(t, nil, err)
+	assert.Equal(t, []biz.Tag{"go"}, followed)
+	aliases, err := r.tags.ListAliases(ctx, goTag.ID)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, []string{"golang", "golang-lang"}, aliases)
+	resolved, err := r.tags.ResolveAliases(ctx, []string{"golang", "rust"})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, map[string]string{"golang": "go"}, resolved)
+
+	// 改名后旧名称成为别名, 新名称不再是别名
+	assert.Equal(t, nil, r.tags.RenameTag(ctx, goTag.ID, "golang"))
+	aliases, err = r.tags.ListAliases(ctx, goTag.ID)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, []string{"go", "golang-lang"}, aliases)
+	a, err := r.articles.GetArticleBySlug(ctx, "a1")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, []string{"grpc", "golang"}, a.TagList)
+	// 名称已经被其他标签使用 - 唯一索引冲突返回TakenError
+	err = r.tags.RenameTag(ctx, goTag.ID, "grpc")
+	assert.Equal(t, []string{"has already been taken"}, e.FromError(err).Errors["name"])
+
+	assert.Equal(t, nil, r.tags.UnfollowTag(ctx, uids[1], goTag.ID))
+	assert.Equal(t, nil, r.tags.DeleteTag(ctx, goTag.ID))
+	tags, err = r.tags.GetTags(ctx, "", 0)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 1, len(tags))
+	resolved, err = r.tags.ResolveAliases(ctx, []string{"go"})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 0, len(resolved))
+}
+
+func testConformanceSearch(t *testing.T, r *conformanceRepos) {
+	ctx := context.Background()
+	uids := conformanceUsers(t, r, "viewer", "gopher", "go", "old_gopher", "writer", "blocker")
+	_, err := r.users.UpdateUser(ctx, &biz.User{ID: uids[4], Bio: "writes GO code"})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, nil, r.profiles.FollowUserByUsername(ctx, uids[0], uids[3]))
+	assert.Equal(t, nil, r.profiles.BlockUser(ctx, uids[5], uids[0]))
+	_, err = r.users.UpdateUser(ctx, &biz.User{ID: uids[5], Bio: "go"})
+	assert.Equal(t, nil, err)
+
+	// 完全匹配 > 前缀 > 包含 > bio, 拉黑的用户不出现
+	page, next, err := r.profiles.SearchProfiles(ctx, uids[0], "Go", 0, 3)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, []string{"go", "gopher", "old_gopher"}, usernames(page))
+	assert.Equal(t, uint(3), next)
+	page, next, err = r.profiles.SearchProfiles(ctx, uids[0], "Go", next, 3)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, []string{"writer"}, usernames(page))
+	assert.Equal(t, uint(0), next)
+	page, _, err = r.profiles.SearchProfiles(ctx, 0, "go", 0, 10)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 5, len(page))
+	page, _, err = r.profiles.SearchProfiles(ctx, 0, "%", 0, 10)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 0, len(page))
+}
+
+func testConformanceSuggestions(t *testing.T, r *conformanceRepos) {
+	ctx := context.Background()
+	uids := conformanceUsers(t, r, "me", "friend", "mutual", "tagged", "recent")
+	assert.Equal(t, nil, r.profiles.FollowUserByUsername(ctx, uids[0], uids[1]))
+	assert.Equal(t, nil, r.profiles.FollowUserByUsername(ctx, uids[1], uids[2]))
+	liked := conformanceArticle(t, r, uids[1], "liked", "go", "grpc")
+	_, err := r.articles.FavoriteArticle(ctx, liked.ID, uids[0])
+	assert.Equal(t, nil, err)
+	conformanceArticle(t, r, uids[3], "tagged-1", "go")
+	conformanceArticle(t, r, uids[3], "tagged-2", "grpc")
+	conformanceArticle(t, r, uids[4], "recent")
+
+	signals, err := r.profiles.GetSuggestionSignals(ctx, uids[0], time.Now().Add(-time.Hour), 10)
+	assert.Equal(t, nil, err)
+	byUser := make(map[uint]biz.SuggestionSignal, len(signals))
+	for _, s := range signals {
+		byUser[s.UserID] = *s
+	}
+	// 已经关注的人和自己不推荐
+	assert.Equal(t, 3, len(byUser))
+	assert.Equal(t, 1, byUser[uids[2]].MutualFollows)
+	assert.Equal(t, 2, byUser[uids[3]].SharedTags)
+	assert.Equal(t, 2, byUser[uids[3]].RecentArticles)
+	assert.Equal(t, 1, byUser[uids[4]].RecentArticles)
+}
+
+func testConformanceDeleteUser(t *testing.T, r *conformanceRepos) {
+	ctx := context.Background()
+	uids := conformanceUsers(t, r, "leaving", "fan")
+	a := conformanceArticle(t, r, uids[0], "kept", "go")
+	assert.Equal(t, nil, r.profiles.FollowUserByUsername(ctx, uids[1], uids[0]))
+	_, err := r.articles.FavoriteArticle(ctx, a.ID, uids[0])
+	assert.Equal(t, nil, err)
+	_, err = r.comments.AddComment(ctx, &biz.Comment{ArticleID: a.ID, AuthorID: uids[0], Body: "mine"})
+	assert.Equal(t, nil, err)
+
+	export, err := r.users.ExportUser(ctx, uids[0])
+	assert.Equal(t, nil, err)
+	assert.Equal(t, []string{"kept"}, slugs(export.Articles))
+	assert.Equal(t, "kept", export.Comments[0].ArticleSlug)
+	assert.Equal(t, "kept", export.Favorites[0].Slug)
+	assert.Equal(t, "fan", export.Followers[0].Username)
+	assert.Equal(t, 0, len(export.Following))
+
+	// 文章和评论转移到占位用户, 关系和收藏删除
+	assert.Equal(t, nil, r.users.DeleteUser(ctx, uids[0], "deleted-user"))
+	assert.Equal(t, true, errors.Is(r.users.DeleteUser(ctx, uids[0], "deleted-user"), biz.ErrUserNotFound))
+	got, err := r.articles.GetArticleBySlug(ctx, "kept")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "deleted-user", got.Author.Username)
+	assert.Equal(t, uint32(1), got.Author.ArticlesCount)
+	assert.Equal(t, uint32(0), got.FavoritesCount)
+	comments, err := r.comments.GetCommentsByID(ctx, a.ID, 0)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "deleted-user", comments[0].Author.Username)
+	p, err := r.profiles.GetProfileByUsername(ctx, "fan")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, uint32(0), p.FollowingCount)
+
+	// 占位用户不能删除自己
+	placeholder, err := r.profiles.GetProfileByUsername(ctx, "deleted-user")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, true, errors.Is(r.users.DeleteUser(ctx, placeholder.ID, "deleted-user"), biz.ErrPlaceholderUser))
+	// 没有密码的占位用户不出现在搜索中
+	page, _, err := r.profiles.SearchProfiles(ctx, 0, "deleted", 0, 10)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 0, len(page))
+
+	// 占位用户名被可以登录的用户占用时不转移内容
+	taken := conformanceUsers(t, r, "taken", "another")
+	assert.Equal(t, true, errors.Is(r.users.DeleteUser(ctx, taken[1], "taken"), biz.ErrPlaceholderTaken))
+	_, err = r.users.GetUserByID(ctx, taken[1])
+	assert.Equal(t, nil, err)
+}
+
+// 在删除账号会删除的每张表中为第一个用户写入数据, 返回第一个用户的id
+func seedUserData(t *testing.T, r *conformanceRepos) uint {
+	ctx := context.Background()
+	uids := conformanceUsers(t, r, "me", "friend", "private", "pending", "blocked", "muted")
+	me := uids[0]
+	assert.Equal(t, nil, r.profiles.FollowUserByUsername(ctx, me, uids[1]))
+	assert.Equal(t, nil, r.profiles.FollowUserByUsername(ctx, uids[1], me))
+	assert.Equal(t, nil, r.profiles.CreateFollowRequest(ctx, me, uids[2]))
+	assert.Equal(t, nil, r.profiles.CreateFollowRequest(ctx, uids[3], me))
+	assert.Equal(t, nil, r.profiles.BlockUser(ctx, me, uids[4]))
+	assert.Equal(t, nil, r.profiles.MuteUser(ctx, me, uids[5]))
+
+	a := conformanceArticle(t, r, uids[1], "theirs", "go")
+	_, err := r.articles.FavoriteArticle(ctx, a.ID, me)
+	assert.Equal(t, nil, err)
+	_, err = r.comments.AddComment(ctx, &biz.Comment{ArticleID: a.ID, AuthorID: me, Body: "nice"})
+	assert.Equal(t, nil, err)
+	_, err = r.attachments.CreateAttachment(ctx, &biz.Attachment{UserID: me, Key: "mine", URL: "/media/mine", Size: 10}, 100)
+	assert.Equal(t, nil, err)
+	c, err := r.bookmarks.CreateCollection(ctx, me, "later")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, nil, r.bookmarks.CreateBookmark(ctx, me, a.ID, c.ID))
+	assert.Equal(t, nil, r.reactions.AddReaction(ctx, me, biz.ReactionTargetArticle, a.ID, "👍"))
+	tag, err := r.tags.GetTag(ctx, "go")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, nil, r.tags.FollowTag(ctx, me, tag.ID))
+	n := &biz.Notification{UserID: me, Type: biz.NotificationFavorite, GroupKey: "favorite:1", ArticleID: a.ID, ActorID: uids[1]}
+	assert.Equal(t, nil, r.notifications.AddNotification(ctx, n))
+	assert.Equal(t, nil, r.notifications.UpdateNotificationPreferences(ctx, me, map[string]bool{biz.NotificationFollow: false}))
+	return me
+}
+
+func testConformanceExportUser(t *testing.T, r *conformanceRepos) {
+	me := seedUserData(t, r)
+	export, err := r.users.ExportUser(context.Background(), me)
+	assert.Equal(t, nil, err)
+
+	relation := func(list []*biz.ExportFollow) []string {
+		names := make([]string, len(list))
+		for i, f := range list {
+			names[i] = f.Username
+		}
+		return names
+	}
+	assert.Equal(t, []string{"friend"}, relation(export.Following))
+	assert.Equal(t, []string{"friend"}, relation(export.Followers))
+	assert.Equal(t, []string{"private"}, relation(export.FollowRequestsSent))
+	assert.Equal(t, []string{"pending"}, relation(export.FollowRequestsReceived))
+	assert.Equal(t, []string{"blocked"}, relation(export.Blocked))
+	assert.Equal(t, []string{"muted"}, relation(export.Muted))
+	assert.Equal(t, "theirs", export.Favorites[0].Slug)
+	assert.Equal(t, "theirs", export.Comments[0].ArticleSlug)
+	assert.Equal(t, 1, len(export.Attachments))
+	assert.Equal(t, "/media/mine", export.Attachments[0].URL)
+	assert.Equal(t, 1, len(export.BookmarkCollections))
+	assert.Equal(t, uint32(1), export.BookmarkCollections[0].BookmarksCount)
+	assert.Equal(t, 1, len(export.Bookmarks))
+	assert.Equal(t, "theirs", export.Bookmarks[0].Slug)
+	assert.Equal(t, "later", export.Bookmarks[0].Collection)
+	assert.Equal(t, 1, len(export.Reactions))
+	assert.Equal(t, "👍", export.Reactions[0].Reaction)
+	assert.Equal(t, 1, len(export.FollowedTags))
+	assert.Equal(t, "go", export.FollowedTags[0].Name)
+	assert.Equal(t, 1, len(export.Notifications))
+	assert.Equal(t, []string{"friend"}, export.Notifications[0].Actors)
+	assert.Equal(t, "theirs", export.Notifications[0].ArticleSlug)
+	assert.Equal(t, map[string]bool{biz.NotificationFollow: false}, export.NotificationPreferences)
+}
+
+func testConformanceTransaction(t *testing.T, r *conformanceRepos) {
+	ctx := context.Background()
+	uids := conformanceUsers(t, r, "author")
+	failed := errors.New("failed")
+
+	// fn返回错误时所有repo的修改都回滚
+	err := r.tx.Transaction(ctx, func(ctx context.Context) error {
+		if _, err := r.articles.CreateArticle(ctx, &biz.Article{Slug: "rolled-back", Title: "rolled-back", AuthorID: uids[0], TagList: []string{"tx"}}); err != nil {
+			return err
+		}
+		if err := r.users.CreateUser(ctx, &biz.User{Username: "ghost", Email: "ghost@example.com"}); err != nil {
+			return err
+		}
+		return failed
+	})
+	assert.Equal(t, failed, err)
+	_, err = r.articles.GetArticleBySlug(ctx, "rolled-back")
+	assert.Equal(t, true, errors.Is(err, biz.ErrArticleNotFound))
+	_, err = r.users.GetUserByEmail(ctx, "ghost@example.com")
+	assert.Equal(t, true, errors.Is(err, biz.ErrUserNotFound))
+	_, err = r.tags.GetTag(ctx, "tx")
+	assert.Equal(t, true, errors.Is(err, biz.ErrTagNotFound))
+	p, err := r.profiles.GetProfileByUsername(ctx, "author")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, uint32(0), p.ArticlesCount)
+
+	// 嵌套的事务出错只回滚内层
+	err = r.tx.Transaction(ctx, func(ctx context.Context) error {
+		if err := r.users.CreateUser(ctx, &biz.User{Username: "outer", Email: "outer@example.com"}); err != nil {
+			return err
+		}
+		inner := r.tx.Transaction(ctx, func(ctx context.Context) error {
+			if err := r.users.CreateUser(ctx, &biz.User{Username: "inner", Email: "inner@example.com"}); err != nil {
+				return err
+			}
+			return failed
+		})
+		assert.Equal(t, failed, inner)
+		return nil
+	})
+	assert.Equal(t, nil, err)
+	_, err = r.users.GetUserByEmail(ctx, "outer@example.com")
+	assert.Equal(t, nil, err)
+	_, err = r.users.GetUserByEmail(ctx, "inner@example.com")
+	assert.Equal(t, true, errors.Is(err, biz.ErrUserNotFound))
+}
+
+func testConformanceBookmarks(t *testing.T, r *conformanceRepos) {
+	ctx := context.Background()
+	uids := conformanceUsers(t, r, "reader", "author")
+	a1 := conformanceArticle(t, r, uids[1], "b1")
+	a2 := conformanceArticle(t, r, uids[1], "b2")
+	c, err := r.bookmarks.CreateCollection(ctx, uids[0], "later")
+	assert.Equal(t, nil, err)
+	_, err = r.bookmarks.CreateCollection(ctx, uids[0], "later")
+	assert.Equal(t, []string{"has already been taken"}, e.FromError(err).Errors["name"])
+
+	assert.Equal(t, nil, r.bookmarks.CreateBookmark(ctx, uids[0], a1.ID, 0))
+	assert.Equal(t, nil, r.bookmarks.CreateBookmark(ctx, uids[0], a2.ID, 0))
+	// 重复加入书签只移动收藏夹
+	assert.Equal(t, nil, r.bookmarks.CreateBookmark(ctx, uids[0], a1.ID, c.ID))
+	assert.Equal(t, nil, r.profiles.FollowUserByUsername(ctx, uids[0], uids[1]))
+	states, err := r.articles.GetArticleViewerStates(ctx, []uint{a1.ID, a2.ID}, uids[0])
+	assert.Equal(t, nil, err)
+	assert.Equal(t, map[uint]biz.ArticleViewerState{
+		a1.ID: {Bookmarked: true, Following: true},
+		a2.ID: {Bookmarked: true, Following: true},
+	}, states)
+
+	page, next, err := r.bookmarks.ListBookmarks(ctx, uids[0], 0, 0, 1)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, []string{"b2"}, slugs(page))
+	page, _, err = r.bookmarks.ListBookmarks(ctx, uids[0], 0, next, 1)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, []string{"b1"}, slugs(page))
+	page, _, err = r.bookmarks.ListBookmarks(ctx, uids[0], c.ID, 0, 10)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, []string{"b1"}, slugs(page))
+
+	c, err = r.bookmarks.RenameCollection(ctx, c.ID, "someday")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, uint32(1), c.BookmarksCount)
+	list, err := r.bookmarks.ListCollections(ctx, uids[0])
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 1, len(list))
+	assert.Equal(t, "someday", list[0].Name)
+
+	// 删除收藏夹保留书签
+	assert.Equal(t, nil, r.bookmarks.DeleteCollection(ctx, c.ID))
+	_, err = r.bookmarks.GetCollection(ctx, c.ID)
+	assert.Equal(t, true, errors.Is(err, biz.ErrCollectionNotFound))
+	assert.Equal(t, nil, r.bookmarks.DeleteBookmark(ctx, uids[0], a2.ID))
+	page, _, err = r.bookmarks.ListBookmarks(ctx, uids[0], 0, 0, 10)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, []string{"b1"}, slugs(page))
+}
+
+func testConformanceReactions(t *testing.T, r *conformanceRepos) {
+	ctx := context.Background()
+	uids := conformanceUsers(t, r, "a", "b")
+	for _, uid := range uids {
+		assert.Equal(t, nil, r.reactions.AddReaction(ctx, uid, "article", 1, "heart"))
+		assert.Equal(t, nil, r.reactions.AddReaction(ctx, uid, "article", 1, "heart"))
+	}
+	assert.Equal(t, nil, r.reactions.AddReaction(ctx, uids[0], "article", 1, "rocket"))
+	counts, err := r.reactions.GetReactionCounts(ctx, "article", []uint{1, 2})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, []biz.ReactionCount{{Reaction: "heart", Count: 2}, {Reaction: "rocket", Count: 1}}, counts[1])
+	mine, err := r.reactions.GetUserReactions(ctx, uids[0], "article", []uint{1})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 2, len(mine[1]))
+
+	// 删除用户时回应数同步减少
+	assert.Equal(t, nil, r.reactions.RemoveReaction(ctx, uids[0], "article", 1, "rocket"))
+	assert.Equal(t, nil, r.reactions.RemoveReaction(ctx, uids[0], "article", 1, "rocket"))
+	assert.Equal(t, nil, r.users.AnonymizeUser(ctx, uids[1]))
+	counts, err = r.reactions.GetReactionCounts(ctx, "article", []uint{1})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, []biz.ReactionCount{{Reaction: "heart", Count: 1}}, counts[1])
+}
+
+func testConformanceAttachments(t *testing.T, r *conformanceRepos) {
+	ctx := context.Background()
+	uids := conformanceUsers(t, r, "uploader")
+	a := conformanceArticle(t, r, uids[0], "with-cover")
+
+	first, err := r.attachments.CreateAttachment(ctx, &biz.Attachment{UserID: uids[0], Key: "k1", URL: "/media/k1", Size: 60}, 100)
+	assert.Equal(t, nil, err)
+	_, err = r.attachments.CreateAttachment(ctx, &biz.Attachment{UserID: uids[0], Key: "k2", URL: "/media/k2", Size: 60}, 100)
+	assert.Equal(t, true, errors.Is(err, biz.ErrAttachmentQuota))
+	second, err := r.attachments.CreateAttachment(ctx, &biz.Attachment{UserID: uids[0], Key: "k3", URL: "/media/k3", Size: 40}, 100)
+	assert.Equal(t, nil, err)
+	used, err := r.attachments.GetAttachmentBytes(ctx, uids[0])
+	assert.Equal(t, nil, err)
+	assert.Equal(t, int64(100), used)
+
+	got, err := r.attachments.GetAttachmentByKey(ctx, "k3")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, second.ID, got.ID)
+	_, err = r.attachments.GetAttachment(ctx, 12345)
+	assert.Equal(t, true, errors.Is(err, biz.ErrAttachmentNotFound))
+	pending, err := r.attachments.ListPendingAttachments(ctx, uids[0])
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 2, len(pending))
+	assert.Equal(t, second.ID, pending[0].ID)
+
+	assert.Equal(t, nil, r.attachments.LinkAttachments(ctx, a.ID, []uint{first.ID, second.ID}, nil))
+	assert.Equal(t, nil, r.attachments.LinkAttachments(ctx, a.ID, nil, []uint{second.ID}))
+	linked, err := r.attachments.ListArticleAttachments(ctx, a.ID)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 1, len(linked))
+	assert.Equal(t, first.ID, linked[0].ID)
+	orphaned, err := r.attachments.ListOrphanedAttachments(ctx, time.Now().Add(time.Hour), 10)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 1, len(orphaned))
+	assert.Equal(t, second.ID, orphaned[0].ID)
+
+	// 删除封面图片的附件时清空封面
+	cover := "/media/k1"
+	_, err = r.articles.UpdateArticle(ctx, &biz.Article{Slug: "with-cover", CoverImageUpdate: &cover})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, nil, r.attachments.DeleteAttachment(ctx, first.ID))
+	assert.Equal(t, true, errors.Is(r.attachments.DeleteAttachment(ctx, first.ID), biz.ErrAttachmentNotFound))
+	article, err := r.articles.GetArticleBySlug(ctx, "with-cover")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "", article.CoverImage)
+	used, err = r.attachments.GetAttachmentBytes(ctx, uids[0])
+	assert.Equal(t, nil, err)
+	assert.Equal(t, int64(40), used)
+}
+
+func testConformanceOutbox(t *testing.T, r *conformanceRepos) {
+	ctx := context.Background()
+	now := time.Now().Truncate(time.Millisecond)
+	newEvent := func(eventType string) *biz.OutboxEvent {
+		return &biz.OutboxEvent{Type: eventType, Payload: []byte(`{"id":1}`), Status: biz.EventPending, NextAttemptAt: now, CreatedAt: now}
+	}
+	notified := func() bool {
+		select {
+		case <-r.outbox.Notify():
+			return true
+		default:
+			return false
+		}
+	}
+
+	// 回滚的事务中写入的事件不存在, 也不通知
+	failed := errors.New("failed")
+	err := r.tx.Transaction(ctx, func(ctx context.Context) error {
+		if err := r.outbox.AddEvents(ctx, []*biz.OutboxEvent{newEvent("rolled.back")}); err != nil {
+			return err
+		}
+		return failed
+	})
+	assert.Equal(t, failed, err)
+	assert.Equal(t, false, notified())
+
+	// 提交后通知, 多次提交只保留一个通知
+	for _, eventType := range []string{"first", "second"} {
+		err = r.tx.Transaction(ctx, func(ctx context.Context) error {
+			return r.outbox.AddEvents(ctx, []*biz.OutboxEvent{newEvent(eventType)})
+		})
+		assert.Equal(t, nil, err)
+	}
+	assert.Equal(t, true, notified())
+	assert.Equal(t, false, notified())
+
+	// 按id顺序领取, 租期内不会再被领取
+	events, err := r.outbox.ClaimEvents(ctx, now, 1, time.Minute)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 1, len(events))
+	assert.Equal(t, "first", events[0].Type)
+	assert.Equal(t, `{"id":1}`, string(events[0].Payload))
+	first := events[0]
+	events, err = r.outbox.ClaimEvents(ctx, now, 10, time.Minute)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 1, len(events))
+	assert.Equal(t, "second", events[0].Type)
+	events, err = r.outbox.ClaimEvents(ctx, now.Add(time.Second), 10, time.Minute)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 0, len(events))
+
+	// 部分失败的事件到重试时间后重新领取, 保留已经投递的订阅者
+	first.Attempts = 1
+	first.Delivered = []string{"search", "webhooks"}
+	first.LastError = "notifications: failed"
+	first.NextAttemptAt = now.Add(10 * time.Second)
+	assert.Equal(t, nil, r.outbox.UpdateEvent(ctx, first))
+	events, err = r.outbox.ClaimEvents(ctx, now.Add(5*time.Second), 10, time.Minute)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 0, len(events))
+	events, err = r.outbox.ClaimEvents(ctx, now.Add(10*time.Second), 10, time.Minute)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 1, len(events))
+	assert.Equal(t, first.ID, events[0].ID)
+	assert.Equal(t, 1, events[0].Attempts)
+	assert.Equal(t, []string{"search", "webhooks"}, events[0].Delivered)
+	assert.Equal(t, "notifications: failed", events[0].LastError)
+
+	// 投递完成和dead的事件不再领取
+	events[0].Status = biz.EventDelivered
+	assert.Equal(t, nil, r.outbox.UpdateEvent(ctx, events[0]))
+	events, err = r.outbox.ClaimEvents(ctx, now.Add(time.Hour), 10, time.Minute)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 1, len(events))
+	assert.Equal(t, "second", events[0].Type)
+	events[0].Status = biz.EventDead
+	assert.Equal(t, nil, r.outbox.UpdateEvent(ctx, events[0]))
+	events, err = r.outbox.ClaimEvents(ctx, now.Add(2*time.Hour), 10, time.Minute)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 0, len(events))
+}
+
+func testConformanceNotifications(t *testing.T, r *conformanceRepos) {
+	ctx := context.Background()
+	uids := conformanceUsers(t, r, "author", "a", "b", "c")
+	a := conformanceArticle(t, r, uids[0], "liked")
+	add := func(actorID uint, typ string, groupKey string, articleID uint) {
+		n := &biz.Notification{UserID: uids[0], Type: typ, GroupKey: groupKey, ArticleID: articleID, ActorID: actorID}
+		assert.Equal(t, nil, r.notifications.AddNotification(ctx, n))
+	}
+	list := func() []*biz.Notification {
+		page, next, err := r.notifications.ListNotifications(ctx, uids[0], 0, 10)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, uint(0), next)
+		return page
+	}
+	unread := func() int {
+		count, err := r.notifications.CountUnreadNotifications(ctx, uids[0])
+		assert.Equal(t, nil, err)
+		return count
+	}
+
+	// 未读的同组通知合并并移到最前, 重复的操作者不计数
+	add(uids[1], biz.NotificationFavorite, "favorite:1", a.ID)
+	add(uids[1], biz.NotificationFollow, "follow", 0)
+	add(uids[2], biz.NotificationFavorite, "favorite:1", a.ID)
+	add(uids[2], biz.NotificationFavorite, "favorite:1", a.ID)
+	page := list()
+	assert.Equal(t, 2, len(page))
+	assert.Equal(t, biz.NotificationFavorite, page[0].Type)
+	assert.Equal(t, uids[2], page[0].ActorID)
+	assert.Equal(t, uint32(2), page[0].ActorsCount)
+	assert.Equal(t, "liked", page[0].ArticleSlug)
+	assert.Equal(t, "liked", page[0].ArticleTitle)
+	assert.Equal(t, biz.NotificationFollow, page[1].Type)
+	assert.Equal(t, "", page[1].ArticleSlug)
+	assert.Equal(t, 2, unread())
+
+	// 分页
+	first, next, err := r.notifications.ListNotifications(ctx, uids[0], 0, 1)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 1, len(first))
+	assert.Equal(t, first[0].ID, next)
+	rest, next, err := r.notifications.ListNotifications(ctx, uids[0], next, 1)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, page[1].ID, rest[0].ID)
+	assert.Equal(t, uint(0), next)
+
+	// 已读的通知不再合并, 新的操作者新建一条
+	assert.Equal(t, nil, r.notifications.MarkNotificationRead(ctx, uids[0], page[0].ID))
+	assert.Equal(t, nil, r.notifications.MarkNotificationRead(ctx, uids[0], page[0].ID))
+	assert.Equal(t, true, errors.Is(r.notifications.MarkNotificationRead(ctx, uids[1], page[0].ID), biz.ErrNotificationNotFound))
+	assert.Equal(t, 1, unread())
+	add(uids[2], biz.NotificationFavorite, "favorite:1", a.ID)
+	assert.Equal(t, 2, len(list()))
+	add(uids[3], biz.NotificationFavorite, "favorite:1", a.ID)
+	page = list()
+	assert.Equal(t, 3, len(page))
+	assert.Equal(t, uint32(1), page[0].ActorsCount)
+	assert.Equal(t, false, page[0].Read)
+	assert.Equal(t, true, page[1].Read)
+
+	assert.Equal(t, nil, r.notifications.MarkAllNotificationsRead(ctx, uids[0]))
+	assert.Equal(t, 0, unread())
+
+	// 文章删除后通知不可见
+	add(uids[1], biz.NotificationComment, "comment:1", a.ID)
+	assert.Equal(t, 1, unread())
+	assert.Equal(t, nil, r.articles.DeleteArticleBySlug(ctx, "liked"))
+	assert.Equal(t, 1, len(list()))
+	assert.Equal(t, 0, unread())
+
+	// 只返回保存过的设置, 重复保存时更新
+	preferences, err := r.notifications.GetNotificationPreferences(ctx, uids[0])
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 0, len(preferences))
+	assert.Equal(t, nil, r.notifications.UpdateNotificationPreferences(ctx, uids[0], map[string]bool{"follow": false, "mention": false}))
+	assert.Equal(t, nil, r.notifications.UpdateNotificationPreferences(ctx, uids[0], map[string]bool{"mention": true}))
+	preferences, err = r.notifications.GetNotificationPreferences(ctx, uids[0])
+	assert.Equal(t, nil, err)
+	assert.Equal(t, map[string]bool{"follow": false, "mention": true}, preferences)
+
+	// 静音是单向的
+	assert.Equal(t, nil, r.profiles.MuteUser(ctx, uids[0], uids[1]))
+	muted, err := r.profiles.IsMuted(ctx, uids[0], uids[1])
+	assert.Equal(t, nil, err)
+	assert.Equal(t, true, muted)
+	muted, err = r.profiles.IsMuted(ctx, uids[1], uids[0])
+	assert.Equal(t, nil, err)
+	assert.Equal(t, false, muted)
+
+	// 删除用户时删除收到的通知和设置
+	assert.Equal(t, nil, r.users.DeleteUser(ctx, uids[0], "deleted-user"))
+	page, _, err = r.notifications.ListNotifications(ctx, uids[0], 0, 10)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 0, len(page))
+	preferences, err = r.notifications.GetNotificationPreferences(ctx, uids[0])
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 0, len(preferences))
+}
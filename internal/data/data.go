@@ -1,49 +1,223 @@
 package data
 
 import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sync"
+
+	"kratos-realworld/internal/biz"
 	"kratos-realworld/internal/conf"
 
+	"github.com/glebarez/sqlite"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/google/wire"
+	"google.golang.org/protobuf/proto"
 	"gorm.io/driver/mysql"
+	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 )
 
 // ProviderSet is data providers.
-var ProviderSet = wire.NewSet(NewData, NewDB, NewUserRepo, NewProfileRepo, NewArticleRepo, NewCommentRepo, NewTagRepo)
+var ProviderSet = wire.NewSet(NewData, NewDB, NewCache, NewTransaction, NewUserRepo, NewProfileRepo, NewArticleRepo, NewCommentRepo, NewTagRepo, NewAttachmentRepo, NewBookmarkRepo, NewReactionRepo, NewOutboxRepo, NewNotificationRepo, NewBlobStore)
 
 // Data .
 type Data struct {
 	db *gorm.DB
+	// driver为memory时不连接数据库, repo使用内存实现
+	mem *memoryStore
+	// 没有配置缓存时为nil
+	cache *repoCache
 }
 
 // NewData .
-func NewData(c *conf.Data, logger log.Logger, db *gorm.DB) (*Data, func(), error) {
+func NewData(c *conf.Data, logger log.Logger, db *gorm.DB, cache Cache) (*Data, func(), error) {
 	cleanup := func() {
 		log.NewHelper(logger).Info("closing the data resources")
 	}
-	return &Data{db: db}, cleanup, nil
+	if c.GetDatabase().GetDriver() == "memory" {
+		log.NewHelper(logger).Warn("using the in-memory database, all data will be lost on exit")
+		return &Data{mem: newMemoryStore()}, cleanup, nil
+	}
+	// 配置了只读副本时定期检查副本
+	if rs, ok := db.Config.Plugins[replicaPluginName].(*replicaSet); ok {
+		stop := rs.start(c.GetDatabase().GetReplicaCheckInterval().AsDuration())
+		cleanup = func() {
+			log.NewHelper(logger).Info("closing the data resources")
+			stop()
+		}
+	}
+	return &Data{db: db, cache: newRepoCache(cache, c, logger)}, cleanup, nil
+}
+
+type contextTxKey struct{}
+
+type contextAfterCommitKey struct{}
+
+// 最外层事务提交后执行的函数
+type afterCommitHooks struct {
+	mu  sync.Mutex
+	fns []func()
+}
+
+// 在Data.Transaction中时等最外层事务提交后执行fn, 回滚时不执行; 不在事务中时直接执行
+func afterCommit(ctx context.Context, fn func()) {
+	hooks, ok := ctx.Value(contextAfterCommitKey{}).(*afterCommitHooks)
+	if !ok {
+		fn()
+		return
+	}
+	hooks.mu.Lock()
+	defer hooks.mu.Unlock()
+	hooks.fns = append(hooks.fns, fn)
+}
+
+// 在一个事务中执行fn, fn中用同一个ctx调用的repo都使用这个事务
+// 已经在事务中时嵌套为savepoint
+func (d *Data) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, nested := ctx.Value(contextAfterCommitKey{}).(*afterCommitHooks); nested {
+		return d.transaction(ctx, fn)
+	}
+	hooks := &afterCommitHooks{}
+	if err := d.transaction(context.WithValue(ctx, contextAfterCommitKey{}, hooks), fn); err != nil {
+		return err
+	}
+	for _, fn := range hooks.fns {
+		fn()
+	}
+	return nil
+}
+
+func (d *Data) transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if d.mem != nil {
+		return d.mem.transaction(ctx, fn)
+	}
+	return d.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(context.WithValue(ctx, contextTxKey{}, tx))
+	})
+}
+
+// ctx中有事务时返回事务, 否则返回db
+// 都绑定ctx, 请求取消或超时时SQL也会被取消
+func (d *Data) DB(ctx context.Context) *gorm.DB {
+	if tx, ok := ctx.Value(contextTxKey{}).(*gorm.DB); ok {
+		return tx.WithContext(ctx)
+	}
+	return d.db.WithContext(ctx)
+}
+
+func NewTransaction(d *Data) biz.Transaction {
+	return d
 }
 
 // 参数 - db的配置文件
-// 数据库连接
-func NewDB(c *conf.Data) *gorm.DB {
-	db, err := gorm.Open(mysql.Open(c.Database.Dsn), &gorm.Config{
+// 数据库连接, 启动时执行未执行的迁移
+// 配置了skip_migrations时不迁移, 由migrate子命令单独执行; driver为memory时没有数据库连接
+func NewDB(c *conf.Data, logger log.Logger) *gorm.DB {
+	if c.GetDatabase().GetDriver() == "memory" {
+		return nil
+	}
+	db, err := OpenDB(c, logger)
+	if err != nil {
+		panic(err)
+	}
+	if !c.GetDatabase().GetSkipMigrations() {
+		m, err := NewMigrator(db, logger)
+		if err != nil {
+			panic(err)
+		}
+		if _, err := m.Up(context.Background()); err != nil {
+			panic(err)
+		}
+	}
+	// 迁移不受单条SQL超时的限制, 只在主库执行
+	database := c.GetDatabase()
+	if err := registerQueryTimeouts(db, database.GetReadTimeout().AsDuration(), database.GetWriteTimeout().AsDuration()); err != nil {
+		panic(err)
+	}
+	if len(database.GetReplicaDsns()) > 0 {
+		pools, err := openReplicas(c, logger)
+		if err != nil {
+			panic(err)
+		}
+		if err := db.Use(newReplicaSet(pools, database.GetReplicaStickyWindow().AsDuration(), logger)); err != nil {
+			panic(err)
+		}
+	}
+	return db
+}
+
+// 副本使用和主库相同的驱动和连接池配置
+func openReplicas(c *conf.Data, logger log.Logger) ([]*sql.DB, error) {
+	pools := make([]*sql.DB, 0, len(c.GetDatabase().GetReplicaDsns()))
+	for i, dsn := range c.GetDatabase().GetReplicaDsns() {
+		database := proto.Clone(c.GetDatabase()).(*conf.Data_Database)
+		database.Dsn = dsn
+		database.ReplicaDsns = nil
+		db, err := OpenDB(&conf.Data{Database: database}, logger)
+		if err != nil {
+			return nil, fmt.Errorf("replica %d: %w", i, err)
+		}
+		pool, err := db.DB()
+		if err != nil {
+			return nil, err
+		}
+		pools = append(pools, pool)
+	}
+	return pools, nil
+}
+
+// 只建立连接, 不迁移
+func OpenDB(c *conf.Data, logger log.Logger) (*gorm.DB, error) {
+	database := c.GetDatabase()
+	dialector, err := openDialector(database)
+	if err != nil {
+		return nil, err
+	}
+	db, err := gorm.Open(dialector, &gorm.Config{
 		DisableForeignKeyConstraintWhenMigrating: true,
+		Logger:                                   newGormLogger(logger, database.GetSlowThreshold().AsDuration()),
 	})
 	if err != nil {
-		panic("failed to connect database")
+		return nil, fmt.Errorf("failed to connect database: %w", err)
 	}
 
-	// create tables - 创建表格的地方
-	InitDB(db)
-
-	return db
+	// 连接池, 没有配置的使用database/sql的默认值
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, err
+	}
+	if n := database.GetMaxOpenConns(); n > 0 {
+		sqlDB.SetMaxOpenConns(int(n))
+	}
+	if n := database.GetMaxIdleConns(); n > 0 {
+		sqlDB.SetMaxIdleConns(int(n))
+	}
+	if d := database.GetConnMaxLifetime().AsDuration(); d > 0 {
+		sqlDB.SetConnMaxLifetime(d)
+	}
+	if d := database.GetConnMaxIdleTime().AsDuration(); d > 0 {
+		sqlDB.SetConnMaxIdleTime(d)
+	}
+	if dialector.Name() == "sqlite" {
+		// sqlite同时只能有一个写入, 内存数据库的每个连接都是独立的库
+		sqlDB.SetMaxOpenConns(1)
+	}
+	return db, nil
 }
 
-// 单独指令开创建表格
-func InitDB(db *gorm.DB) {
-	if err := db.AutoMigrate(&User{}, &Follow{}, &Article{}, &Tag{}, &ArticleFavorite{}, &Comment{}); err != nil {
-		panic(err)
+// 根据配置选择数据库驱动, 默认mysql
+func openDialector(c *conf.Data_Database) (gorm.Dialector, error) {
+	switch c.GetDriver() {
+	case "", "mysql":
+		return mysql.Open(c.GetDsn()), nil
+	case "postgres":
+		return postgres.Open(c.GetDsn()), nil
+	case "sqlite":
+		return sqlite.Open(c.GetDsn()), nil
+	case "memory":
+		return nil, fmt.Errorf("database driver %q has no database to connect to", c.GetDriver())
+	default:
+		return nil, fmt.Errorf("unknown database driver %q", c.GetDriver())
 	}
 }
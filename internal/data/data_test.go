@@ -1,7 +1,85 @@
 package data
 
-import "testing"
+import (
+	"os"
+	"testing"
+
+	"kratos-realworld/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-playground/assert/v2"
+	"gorm.io/gorm"
+)
+
+// 默认使用内存中的sqlite, 设置REALWORLD_TEST_DRIVER和REALWORLD_TEST_DSN时使用对应的数据库
+func newTestData(t *testing.T) *Data {
+	c := &conf.Data_Database{Driver: "sqlite", Dsn: "file:" + t.Name() + "?mode=memory&cache=shared"}
+	if driver := os.Getenv("REALWORLD_TEST_DRIVER"); driver != "" {
+		c = &conf.Data_Database{Driver: driver, Dsn: os.Getenv("REALWORLD_TEST_DSN")}
+	}
+	db := NewDB(&conf.Data{Database: c}, log.DefaultLogger)
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { sqlDB.Close() })
+	return &Data{db: db}
+}
+
+// gorm的repo加上进程内缓存
+func newCachedTestData(t *testing.T) *Data {
+	d := newTestData(t)
+	d.cache = newRepoCache(newLRUCache(1000), &conf.Data{}, log.DefaultLogger)
+	return d
+}
+
+// 统计fn执行的SQL数量, 用来防止列表查询退化成N+1
+func countQueries(t *testing.T, d *Data, fn func()) int {
+	t.Helper()
+	const name = "test:count_queries"
+	var n int
+	// 子查询构建SQL时也会在DryRun模式下执行回调, 不计数
+	count := func(db *gorm.DB) {
+		if !db.DryRun {
+			n++
+		}
+	}
+	cb := d.db.Callback()
+	register := []error{
+		cb.Create().After("gorm:create").Register(name, count),
+		cb.Query().After("gorm:query").Register(name, count),
+		cb.Update().After("gorm:update").Register(name, count),
+		cb.Delete().After("gorm:delete").Register(name, count),
+		cb.Row().After("gorm:row").Register(name, count),
+		cb.Raw().After("gorm:raw").Register(name, count),
+	}
+	for _, err := range register {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	defer func() {
+		cb.Create().Remove(name)
+		cb.Query().Remove(name)
+		cb.Update().Remove(name)
+		cb.Delete().Remove(name)
+		cb.Row().Remove(name)
+		cb.Raw().Remove(name)
+	}()
+	fn()
+	return n
+}
 
 func TestNewDB(t *testing.T) {
-	NewDB(nil)
+	d := newTestData(t)
+	assert.Equal(t, true, d.db.Migrator().HasTable(&Article{}))
+}
+
+func TestOpenDialector(t *testing.T) {
+	for _, driver := range []string{"", "mysql", "postgres", "sqlite"} {
+		_, err := openDialector(&conf.Data_Database{Driver: driver})
+		assert.Equal(t, nil, err)
+	}
+	_, err := openDialector(&conf.Data_Database{Driver: "oracle"})
+	assert.NotEqual(t, nil, err)
 }
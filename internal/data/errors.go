@@ -0,0 +1,108 @@
+package data
+
+import (
+	"errors"
+	"strings"
+
+	gosqlite "github.com/glebarez/go-sqlite"
+	"github.com/go-sql-driver/mysql"
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+	sqlite3 "modernc.org/sqlite/lib"
+)
+
+// 各驱动的约束错误统一翻译成下面两种, 调用方不需要关心具体的驱动
+
+// 违反唯一约束, Key包含冲突的约束名或列名, 格式随驱动不同
+type uniqueViolation struct {
+	Key string
+	Err error
+}
+
+func (e *uniqueViolation) Error() string { return e.Err.Error() }
+func (e *uniqueViolation) Unwrap() error { return e.Err }
+
+// 违反外键约束
+type foreignKeyViolation struct {
+	Err error
+}
+
+func (e *foreignKeyViolation) Error() string { return e.Err.Error() }
+func (e *foreignKeyViolation) Unwrap() error { return e.Err }
+
+// 把驱动返回的约束错误翻译成uniqueViolation或foreignKeyViolation, 其他错误原样返回
+func translateError(err error) error {
+	var mysqlErr *mysql.MySQLError
+	var pgErr *pgconn.PgError
+	var sqliteErr *gosqlite.Error
+	switch {
+	case errors.As(err, &mysqlErr):
+		switch mysqlErr.Number {
+		case 1062:
+			// Duplicate entry 'x' for key 'users.uni_users_email', 只保留key的部分, 避免匹配到冲突的值
+			key := mysqlErr.Message
+			if i := strings.LastIndex(key, " for key "); i >= 0 {
+				key = key[i+len(" for key "):]
+			}
+			return &uniqueViolation{Key: key, Err: err}
+		case 1451, 1452:
+			return &foreignKeyViolation{Err: err}
+		}
+	case errors.As(err, &pgErr):
+		switch pgErr.Code {
+		case "23505":
+			// uni_users_email
+			return &uniqueViolation{Key: pgErr.ConstraintName, Err: err}
+		case "23503":
+			return &foreignKeyViolation{Err: err}
+		}
+	case errors.As(err, &sqliteErr):
+		switch sqliteErr.Code() {
+		case sqlite3.SQLITE_CONSTRAINT_UNIQUE, sqlite3.SQLITE_CONSTRAINT_PRIMARYKEY:
+			// UNIQUE constraint failed: users.email
+			return &uniqueViolation{Key: sqliteErr.Error(), Err: err}
+		case sqlite3.SQLITE_CONSTRAINT_FOREIGNKEY:
+			return &foreignKeyViolation{Err: err}
+		}
+	}
+	return err
+}
+
+// 是否违反唯一约束, column不为空时还要求冲突的约束包含这一列
+func isUniqueViolation(err error, column string) bool {
+	var e *uniqueViolation
+	if !errors.As(translateError(err), &e) {
+		return false
+	}
+	return column == "" || containsWord(e.Key, column)
+}
+
+func isForeignKeyViolation(err error) bool {
+	var e *foreignKeyViolation
+	return errors.As(translateError(err), &e)
+}
+
+// 记录不存在时返回biz中对应的领域错误, 其他错误原样返回
+func translateNotFound(err error, notFound error) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return notFound
+	}
+	return err
+}
+
+// s中是否有完整的word, 前后不能是字母数字, 避免name匹配到username
+func containsWord(s string, word string) bool {
+	for i := 0; i+len(word) <= len(s); i++ {
+		if s[i:i+len(word)] != word {
+			continue
+		}
+		if (i == 0 || !isWordChar(s[i-1])) && (i+len(word) == len(s) || !isWordChar(s[i+len(word)])) {
+			return true
+		}
+	}
+	return false
+}
+
+func isWordChar(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9'
+}
@@ -0,0 +1,59 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"kratos-realworld/internal/biz"
+	e "kratos-realworld/internal/errors"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-playground/assert/v2"
+	"github.com/go-sql-driver/mysql"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+func TestTranslateError(t *testing.T) {
+	// 冲突的值中出现的列名不算
+	mysqlErr := fmt.Errorf("create user: %w", &mysql.MySQLError{Number: 1062, Message: "Duplicate entry 'email' for key 'users.uni_users_username'"})
+	assert.Equal(t, true, isUniqueViolation(mysqlErr, ""))
+	assert.Equal(t, true, isUniqueViolation(mysqlErr, "username"))
+	assert.Equal(t, false, isUniqueViolation(mysqlErr, "email"))
+	assert.Equal(t, true, isForeignKeyViolation(&mysql.MySQLError{Number: 1452}))
+
+	pgErr := &pgconn.PgError{Code: "23505", ConstraintName: "idx_collection_user_name"}
+	assert.Equal(t, true, isUniqueViolation(pgErr, "name"))
+	assert.Equal(t, false, isUniqueViolation(pgErr, "username"))
+	assert.Equal(t, true, isForeignKeyViolation(&pgconn.PgError{Code: "23503"}))
+
+	other := errors.New("Duplicate entry")
+	assert.Equal(t, false, isUniqueViolation(other, ""))
+	assert.Equal(t, other, translateError(other))
+}
+
+func TestCreateUserDuplicate(t *testing.T) {
+	d := newTestData(t)
+	ctx := context.Background()
+	ur := NewUserRepo(d, log.DefaultLogger)
+	assert.Equal(t, nil, ur.CreateUser(ctx, &biz.User{Username: "jake", Email: "jake@example.com"}))
+
+	err := ur.CreateUser(ctx, &biz.User{Username: "jake", Email: "jacob@example.com"})
+	assert.Equal(t, []string{"has already been taken"}, e.FromError(err).Errors["username"])
+	err = ur.CreateUser(ctx, &biz.User{Username: "jacob", Email: "jake@example.com"})
+	assert.Equal(t, []string{"has already been taken"}, e.FromError(err).Errors["email"])
+}
+
+// 记录不存在时返回领域错误, 而不是gorm的错误
+func TestNotFound(t *testing.T) {
+	d := newTestData(t)
+	ctx := context.Background()
+	_, err := NewProfileRepo(d, log.DefaultLogger).GetProfileByUsername(ctx, "nobody")
+	assert.Equal(t, true, errors.Is(err, biz.ErrUserNotFound))
+	_, err = NewUserRepo(d, log.DefaultLogger).GetUserByEmail(ctx, "nobody@example.com")
+	assert.Equal(t, true, errors.Is(err, biz.ErrUserNotFound))
+	_, err = NewArticleRepo(d, log.DefaultLogger).GetArticleBySlug(ctx, "nothing")
+	assert.Equal(t, true, errors.Is(err, biz.ErrArticleNotFound))
+	assert.Equal(t, 404, e.FromError(err).Code)
+}
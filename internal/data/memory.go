@@ -0,0 +1,451 @@
+package data
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"kratos-realworld/internal/biz"
+
+	"gorm.io/gorm"
+)
+
+// 内存中的一张表 - 按自增id保存记录, 查询时按id升序
+type memoryTable[T any] struct {
+	lastID uint
+	rows   map[uint]T
+}
+
+func newMemoryTable[T any]() *memoryTable[T] {
+	return &memoryTable[T]{rows: make(map[uint]T)}
+}
+
+func (t *memoryTable[T]) clone() *memoryTable[T] {
+	return &memoryTable[T]{lastID: t.lastID, rows: maps.Clone(t.rows)}
+}
+
+func (t *memoryTable[T]) nextID() uint {
+	t.lastID++
+	return t.lastID
+}
+
+func (t *memoryTable[T]) get(id uint) (T, bool) {
+	row, ok := t.rows[id]
+	return row, ok
+}
+
+func (t *memoryTable[T]) put(id uint, row T) {
+	t.rows[id] = row
+}
+
+func (t *memoryTable[T]) ids() []uint {
+	ids := slices.Collect(maps.Keys(t.rows))
+	slices.Sort(ids)
+	return ids
+}
+
+func (t *memoryTable[T]) find(match func(T) bool) []T {
+	var rows []T
+	for _, id := range t.ids() {
+		if row := t.rows[id]; match(row) {
+			rows = append(rows, row)
+		}
+	}
+	return rows
+}
+
+func (t *memoryTable[T]) first(match func(T) bool) (T, bool) {
+	for _, id := range t.ids() {
+		if row := t.rows[id]; match(row) {
+			return row, true
+		}
+	}
+	var zero T
+	return zero, false
+}
+
+func (t *memoryTable[T]) exists(match func(T) bool) bool {
+	_, ok := t.first(match)
+	return ok
+}
+
+// 修改满足条件的记录, 返回影响的行数
+func (t *memoryTable[T]) update(match func(T) bool, fn func(*T)) int {
+	n := 0
+	for id, row := range t.rows {
+		if match(row) {
+			fn(&row)
+			t.rows[id] = row
+			n++
+		}
+	}
+	return n
+}
+
+// 删除满足条件的记录, 返回影响的行数
+func (t *memoryTable[T]) delete(match func(T) bool) int {
+	n := 0
+	for id, row := range t.rows {
+		if match(row) {
+			delete(t.rows, id)
+			n++
+		}
+	}
+	return n
+}
+
+// 新记录的id和创建时间
+func newModel[T any](t *memoryTable[T]) gorm.Model {
+	now := time.Now()
+	return gorm.Model{ID: t.nextID(), CreatedAt: now, UpdatedAt: now}
+}
+
+// 文章和tag的关联, 对应article_tags表
+type articleTag struct {
+	ArticleID uint
+	TagID     uint
+}
+
+// 内存中的所有表 - 文章软删除, 其他表的删除都是物理删除
+type memoryDB struct {
+	users                   *memoryTable[User]
+	follows                 *memoryTable[Follow]
+	followRequests          *memoryTable[FollowRequest]
+	blocks                  *memoryTable[Block]
+	mutes                   *memoryTable[Mute]
+	articles                *memoryTable[Article]
+	articleTags             *memoryTable[articleTag]
+	comments                *memoryTable[Comment]
+	tags                    *memoryTable[Tag]
+	favorites               *memoryTable[ArticleFavorite]
+	tagFollows              *memoryTable[TagFollow]
+	tagAliases              *memoryTable[TagAlias]
+	bookmarks               *memoryTable[Bookmark]
+	collections             *memoryTable[BookmarkCollection]
+	reactions               *memoryTable[Reaction]
+	reactionCounts          *memoryTable[ReactionCount]
+	attachments             *memoryTable[Attachment]
+	outboxEvents            *memoryTable[OutboxEvent]
+	notifications           *memoryTable[Notification]
+	notificationActors      *memoryTable[NotificationActor]
+	notificationPreferences *memoryTable[NotificationPreference]
+}
+
+func newMemoryDB() *memoryDB {
+	return &memoryDB{
+		users:                   newMemoryTable[User](),
+		follows:                 newMemoryTable[Follow](),
+		followRequests:          newMemoryTable[FollowRequest](),
+		blocks:                  newMemoryTable[Block](),
+		mutes:                   newMemoryTable[Mute](),
+		articles:                newMemoryTable[Article](),
+		articleTags:             newMemoryTable[articleTag](),
+		comments:                newMemoryTable[Comment](),
+		tags:                    newMemoryTable[Tag](),
+		favorites:               newMemoryTable[ArticleFavorite](),
+		tagFollows:              newMemoryTable[TagFollow](),
+		tagAliases:              newMemoryTable[TagAlias](),
+		bookmarks:               newMemoryTable[Bookmark](),
+		collections:             newMemoryTable[BookmarkCollection](),
+		reactions:               newMemoryTable[Reaction](),
+		reactionCounts:          newMemoryTable[ReactionCount](),
+		attachments:             newMemoryTable[Attachment](),
+		outboxEvents:            newMemoryTable[OutboxEvent](),
+		notifications:           newMemoryTable[Notification](),
+		notificationActors:      newMemoryTable[NotificationActor](),
+		notificationPreferences: newMemoryTable[NotificationPreference](),
+	}
+}
+
+func (db *memoryDB) clone() *memoryDB {
+	return &memoryDB{
+		users:                   db.users.clone(),
+		follows:                 db.follows.clone(),
+		followRequests:          db.followRequests.clone(),
+		blocks:                  db.blocks.clone(),
+		mutes:                   db.mutes.clone(),
+		articles:                db.articles.clone(),
+		articleTags:             db.articleTags.clone(),
+		comments:                db.comments.clone(),
+		tags:                    db.tags.clone(),
+		favorites:               db.favorites.clone(),
+		tagFollows:              db.tagFollows.clone(),
+		tagAliases:              db.tagAliases.clone(),
+		bookmarks:               db.bookmarks.clone(),
+		collections:             db.collections.clone(),
+		reactions:               db.reactions.clone(),
+		reactionCounts:          db.reactionCounts.clone(),
+		attachments:             db.attachments.clone(),
+		outboxEvents:            db.outboxEvents.clone(),
+		notifications:           db.notifications.clone(),
+		notificationActors:      db.notificationActors.clone(),
+		notificationPreferences: db.notificationPreferences.clone(),
+	}
+}
+
+// driver为memory时代替数据库, 进程退出后数据丢失
+// 所有读写串行执行, 事务持有锁直到结束, 出错时恢复到事务开始时的快照
+type memoryStore struct {
+	mu sync.Mutex
+	db *memoryDB
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{db: newMemoryDB()}
+}
+
+type memoryTxKey struct{}
+
+func (s *memoryStore) inTransaction(ctx context.Context) bool {
+	return ctx.Value(memoryTxKey{}) == s
+}
+
+// 在锁内执行fn, 事务中已经持有锁
+func (s *memoryStore) run(ctx context.Context, fn func(db *memoryDB) error) error {
+	if !s.inTransaction(ctx) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+	}
+	return fn(s.db)
+}
+
+// 和Data.Transaction相同, 嵌套时只回滚内层的修改
+func (s *memoryStore) transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if !s.inTransaction(ctx) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		ctx = context.WithValue(ctx, memoryTxKey{}, s)
+	}
+	saved := s.db.clone()
+	defer func() {
+		if r := recover(); r != nil {
+			s.db = saved
+			panic(r)
+		}
+	}()
+	if err := fn(ctx); err != nil {
+		s.db = saved
+		return err
+	}
+	return nil
+}
+
+// 计数器减一, 不会小于0
+func decrCount(n uint32) uint32 {
+	if n > 0 {
+		return n - 1
+	}
+	return 0
+}
+
+// 按创建时间排序, 时间相同时保持id的顺序
+func sortByCreatedAt[T any](rows []T, createdAt func(T) time.Time) {
+	sort.SliceStable(rows, func(i, j int) bool {
+		return createdAt(rows[i]).Before(createdAt(rows[j]))
+	})
+}
+
+func (db *memoryDB) isFollowing(followerID uint, followingID uint) bool {
+	return db.follows.exists(func(f Follow) bool {
+		return f.FollowerID == followerID && f.FollowingID == followingID
+	})
+}
+
+// uid和other之间是否存在拉黑关系, 对应excludeBlocked
+func (db *memoryDB) isBlocked(uid uint, other uint) bool {
+	return db.blocks.exists(func(b Block) bool {
+		return (b.BlockerID == uid && b.BlockedID == other) || (b.BlockerID == other && b.BlockedID == uid)
+	})
+}
+
+func (db *memoryDB) isMuted(uid uint, other uint) bool {
+	return db.mutes.exists(func(m Mute) bool {
+		return m.MuterID == uid && m.MutedID == other
+	})
+}
+
+// 对应excludePrivate, uid为0表示未登录
+func (db *memoryDB) isHidden(authorID uint, uid uint) bool {
+	u, ok := db.users.get(authorID)
+	if !ok || !u.Private {
+		return false
+	}
+	return uid == 0 || (uid != authorID && !db.isFollowing(uid, authorID))
+}
+
+// 对应inactiveUsers
+func (db *memoryDB) isInactive(id uint) bool {
+	u, ok := db.users.get(id)
+	return ok && (u.AnonymizedAt != nil || u.PasswordHash == "")
+}
+
+// 对应excludeForSuggestion
+func (db *memoryDB) excludedForSuggestion(id uint, uid uint) bool {
+	requested := db.followRequests.exists(func(r FollowRequest) bool {
+		return r.RequesterID == uid && r.TargetID == id
+	})
+	return id == uid || db.isBlocked(uid, id) || db.isFollowing(uid, id) || requested || db.isInactive(id)
+}
+
+// 关注/取关时同时更新双方的计数
+func (db *memoryDB) adjustFollowCounts(followerID uint, followingID uint, incr bool) {
+	db.users.update(func(u User) bool { return u.ID == followerID }, func(u *User) {
+		if incr {
+			u.FollowingCount++
+		} else {
+			u.FollowingCount = decrCount(u.FollowingCount)
+		}
+	})
+	db.users.update(func(u User) bool { return u.ID == followingID }, func(u *User) {
+		if incr {
+			u.FollowersCount++
+		} else {
+			u.FollowersCount = decrCount(u.FollowersCount)
+		}
+	})
+}
+
+func (db *memoryDB) followingMap(uid uint, uids []uint) map[uint]bool {
+	result := make(map[uint]bool, len(uids))
+	for _, id := range uids {
+		result[id] = db.isFollowing(uid, id)
+	}
+	return result
+}
+
+// 按uids的顺序返回, 不存在的用户跳过
+func (db *memoryDB) profilesByIDs(uids []uint) []*biz.ProfileResp {
+	profiles := make([]*biz.ProfileResp, 0, len(uids))
+	for _, id := range uids {
+		if u, ok := db.users.get(id); ok {
+			profiles = append(profiles, convertProfile(u))
+		}
+	}
+	return profiles
+}
+
+// 关系记录的id和要列出的用户id
+type memoryRelation struct {
+	ID       uint
+	TargetID uint
+}
+
+// 对应profileRepo.listRelations, 按关系记录id倒序
+func (db *memoryDB) listRelations(relations []memoryRelation, cursor uint, limit int) ([]*biz.ProfileResp, uint) {
+	slices.Reverse(relations)
+	if cursor > 0 {
+		relations = slices.DeleteFunc(relations, func(r memoryRelation) bool { return r.ID >= cursor })
+	}
+	var next uint
+	if len(relations) > limit {
+		relations = relations[:limit]
+		next = relations[limit-1].ID
+	}
+	uids := make([]uint, len(relations))
+	for i, r := range relations {
+		uids[i] = r.TargetID
+	}
+	return db.profilesByIDs(uids), next
+}
+
+// 未删除的文章
+func (db *memoryDB) findArticles(match func(Article) bool) []Article {
+	return db.articles.find(func(a Article) bool {
+		return !a.DeletedAt.Valid && match(a)
+	})
+}
+
+func (db *memoryDB) firstArticle(match func(Article) bool) (Article, bool) {
+	return db.articles.first(func(a Article) bool {
+		return !a.DeletedAt.Valid && match(a)
+	})
+}
+
+// 文章的tag, 按tag的id排序
+func (db *memoryDB) articleTagsOf(aid uint) []Tag {
+	var tags []Tag
+	for _, at := range db.articleTags.find(func(at articleTag) bool { return at.ArticleID == aid }) {
+		if tag, ok := db.tags.get(at.TagID); ok {
+			tags = append(tags, tag)
+		}
+	}
+	sort.Slice(tags, func(i, j int) bool { return tags[i].ID < tags[j].ID })
+	return tags
+}
+
+func (db *memoryDB) hasTag(aid uint, match func(Tag) bool) bool {
+	return slices.ContainsFunc(db.articleTagsOf(aid), match)
+}
+
+// 带上作者和tag, 对应Preload("Author").Preload("Tags")
+func (db *memoryDB) convertArticle(a Article) *biz.Article {
+	a.Author, _ = db.users.get(a.AuthorID)
+	a.Tags = db.articleTagsOf(a.ID)
+	return convertArticle(a)
+}
+
+func (db *memoryDB) convertArticles(articles []Article) []*biz.Article {
+	list := make([]*biz.Article, len(articles))
+	for i, a := range articles {
+		list[i] = db.convertArticle(a)
+	}
+	return list
+}
+
+// 评论只带作者的username, bio和image
+func (db *memoryDB) convertComment(c Comment) *biz.Comment {
+	author, _ := db.users.get(c.AuthorID)
+	return &biz.Comment{
+		ID:        c.ID,
+		Body:      c.Body,
+		CreatedAt: c.CreatedAt,
+		UpdatedAt: c.UpdatedAt,
+		Author: &biz.ProfileResp{
+			Username: author.Username,
+			Bio:      author.Bio,
+			Image:    author.Image,
+		},
+		AuthorID:  c.AuthorID,
+		ArticleID: c.ArticleID,
+	}
+}
+
+// 对应deleteUserRelations
+func (db *memoryDB) deleteUserRelations(uid uint) {
+	for _, f := range db.follows.find(func(f Follow) bool { return f.FollowerID == uid || f.FollowingID == uid }) {
+		db.adjustFollowCounts(f.FollowerID, f.FollowingID, false)
+	}
+	db.follows.delete(func(f Follow) bool { return f.FollowerID == uid || f.FollowingID == uid })
+	db.blocks.delete(func(b Block) bool { return b.BlockerID == uid || b.BlockedID == uid })
+	db.mutes.delete(func(m Mute) bool { return m.MuterID == uid || m.MutedID == uid })
+	db.followRequests.delete(func(r FollowRequest) bool { return r.RequesterID == uid || r.TargetID == uid })
+	db.bookmarks.delete(func(b Bookmark) bool { return b.UserID == uid })
+	db.collections.delete(func(c BookmarkCollection) bool { return c.UserID == uid })
+	for _, r := range db.reactions.find(func(r Reaction) bool { return r.UserID == uid }) {
+		db.decrReactionCount(r.TargetType, r.TargetID, r.Reaction)
+	}
+	db.reactions.delete(func(r Reaction) bool { return r.UserID == uid })
+	db.tagFollows.delete(func(f TagFollow) bool { return f.UserID == uid })
+	for _, n := range db.notifications.find(func(n Notification) bool { return n.UserID == uid }) {
+		db.notificationActors.delete(func(a NotificationActor) bool { return a.NotificationID == n.ID })
+	}
+	db.notifications.delete(func(n Notification) bool { return n.UserID == uid })
+	db.notificationPreferences.delete(func(p NotificationPreference) bool { return p.UserID == uid })
+	for _, f := range db.favorites.find(func(f ArticleFavorite) bool { return f.UserID == uid }) {
+		db.articles.update(func(a Article) bool { return a.ID == f.ArticleID }, func(a *Article) {
+			a.FavoritesCount = decrCount(a.FavoritesCount)
+		})
+	}
+	db.favorites.delete(func(f ArticleFavorite) bool { return f.UserID == uid })
+}
+
+func (db *memoryDB) decrReactionCount(targetType string, targetID uint, reaction string) {
+	db.reactionCounts.update(func(c ReactionCount) bool {
+		return c.TargetType == targetType && c.TargetID == targetID && c.Reaction == reaction
+	}, func(c *ReactionCount) {
+		c.ReactionsCount = decrCount(c.ReactionsCount)
+	})
+}
@@ -0,0 +1,153 @@
+package data
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"kratos-realworld/internal/biz"
+)
+
+// AttachmentRepo的内存实现
+type memoryAttachmentRepo struct {
+	mem *memoryStore
+}
+
+// 已用空间加上新附件不能超过配额
+func (r *memoryAttachmentRepo) CreateAttachment(ctx context.Context, a *biz.Attachment, quota int64) (*biz.Attachment, error) {
+	var created *biz.Attachment
+	err := r.mem.run(ctx, func(db *memoryDB) error {
+		u, ok := db.users.get(a.UserID)
+		if !ok || u.AttachmentsBytes+a.Size > quota {
+			return biz.ErrAttachmentQuota
+		}
+		if db.attachments.exists(func(o Attachment) bool { return o.BlobKey == a.Key }) {
+			return biz.TakenError("key")
+		}
+		u.AttachmentsBytes += a.Size
+		db.users.put(u.ID, u)
+
+		po := Attachment{
+			Model:       newModel(db.attachments),
+			UserID:      a.UserID,
+			ArticleID:   a.ArticleID,
+			BlobKey:     a.Key,
+			URL:         a.URL,
+			ContentType: a.ContentType,
+			Size:        a.Size,
+			Width:       a.Width,
+			Height:      a.Height,
+		}
+		db.attachments.put(po.ID, po)
+		created = convertAttachment(po)
+		return nil
+	})
+	return created, err
+}
+
+func (r *memoryAttachmentRepo) getAttachment(ctx context.Context, match func(Attachment) bool) (*biz.Attachment, error) {
+	var attachment *biz.Attachment
+	err := r.mem.run(ctx, func(db *memoryDB) error {
+		a, ok := db.attachments.first(match)
+		if !ok {
+			return biz.ErrAttachmentNotFound
+		}
+		attachment = convertAttachment(a)
+		return nil
+	})
+	return attachment, err
+}
+
+func (r *memoryAttachmentRepo) GetAttachment(ctx context.Context, id uint) (*biz.Attachment, error) {
+	return r.getAttachment(ctx, func(a Attachment) bool { return a.ID == id })
+}
+
+func (r *memoryAttachmentRepo) GetAttachmentByKey(ctx context.Context, key string) (*biz.Attachment, error) {
+	return r.getAttachment(ctx, func(a Attachment) bool { return a.BlobKey == key })
+}
+
+// 按id倒序
+func (r *memoryAttachmentRepo) listAttachments(ctx context.Context, match func(Attachment) bool) ([]*biz.Attachment, error) {
+	var attachments []Attachment
+	err := r.mem.run(ctx, func(db *memoryDB) error {
+		attachments = db.attachments.find(match)
+		return nil
+	})
+	slices.Reverse(attachments)
+	return convertAttachments(attachments), err
+}
+
+func (r *memoryAttachmentRepo) ListPendingAttachments(ctx context.Context, uid uint) ([]*biz.Attachment, error) {
+	return r.listAttachments(ctx, func(a Attachment) bool { return a.UserID == uid && a.ArticleID == 0 })
+}
+
+func (r *memoryAttachmentRepo) ListArticleAttachments(ctx context.Context, aid uint) ([]*biz.Attachment, error) {
+	return r.listAttachments(ctx, func(a Attachment) bool { return a.ArticleID == aid })
+}
+
+// 只关联还没有被引用的附件, 只解除这篇文章自己的附件
+func (r *memoryAttachmentRepo) LinkAttachments(ctx context.Context, aid uint, link []uint, unlink []uint) error {
+	return r.mem.run(ctx, func(db *memoryDB) error {
+		now := time.Now()
+		db.attachments.update(func(a Attachment) bool { return a.ArticleID == 0 && slices.Contains(link, a.ID) }, func(a *Attachment) {
+			a.ArticleID = aid
+			a.UpdatedAt = now
+		})
+		db.attachments.update(func(a Attachment) bool { return a.ArticleID == aid && slices.Contains(unlink, a.ID) }, func(a *Attachment) {
+			a.ArticleID = 0
+			a.UpdatedAt = now
+		})
+		return nil
+	})
+}
+
+func (r *memoryAttachmentRepo) DeleteAttachment(ctx context.Context, id uint) error {
+	return r.mem.run(ctx, func(db *memoryDB) error {
+		a, ok := db.attachments.get(id)
+		if !ok {
+			return biz.ErrAttachmentNotFound
+		}
+		db.attachments.delete(func(o Attachment) bool { return o.ID == id })
+		db.users.update(func(u User) bool { return u.ID == a.UserID }, func(u *User) {
+			u.AttachmentsBytes = max(u.AttachmentsBytes-a.Size, 0)
+		})
+		if a.ArticleID == 0 {
+			return nil
+		}
+		db.articles.update(func(o Article) bool {
+			return o.ID == a.ArticleID && !o.DeletedAt.Valid && o.CoverImage == a.URL
+		}, func(o *Article) {
+			o.CoverImage = ""
+		})
+		return nil
+	})
+}
+
+// 没有被引用并且超过before的附件, 以及文章已经被删除的附件
+func (r *memoryAttachmentRepo) ListOrphanedAttachments(ctx context.Context, before time.Time, limit int) ([]*biz.Attachment, error) {
+	var attachments []Attachment
+	err := r.mem.run(ctx, func(db *memoryDB) error {
+		attachments = db.attachments.find(func(a Attachment) bool {
+			if a.ArticleID == 0 {
+				return a.UpdatedAt.Before(before)
+			}
+			_, ok := db.firstArticle(func(o Article) bool { return o.ID == a.ArticleID })
+			return !ok
+		})
+		return nil
+	})
+	if limit >= 0 && len(attachments) > limit {
+		attachments = attachments[:limit]
+	}
+	return convertAttachments(attachments), err
+}
+
+func (r *memoryAttachmentRepo) GetAttachmentBytes(ctx context.Context, uid uint) (int64, error) {
+	var used int64
+	err := r.mem.run(ctx, func(db *memoryDB) error {
+		u, _ := db.users.get(uid)
+		used = u.AttachmentsBytes
+		return nil
+	})
+	return used, err
+}
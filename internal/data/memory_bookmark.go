@@ -0,0 +1,144 @@
+package data
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"kratos-realworld/internal/biz"
+)
+
+// BookmarkRepo的内存实现
+type memoryBookmarkRepo struct {
+	mem *memoryStore
+}
+
+func (r *memoryBookmarkRepo) CreateBookmark(ctx context.Context, uid uint, aid uint, collectionID uint) error {
+	return r.mem.run(ctx, func(db *memoryDB) error {
+		n := db.bookmarks.update(func(b Bookmark) bool { return b.UserID == uid && b.ArticleID == aid }, func(b *Bookmark) {
+			b.CollectionID = collectionID
+			b.UpdatedAt = time.Now()
+		})
+		if n == 0 {
+			b := Bookmark{Model: newModel(db.bookmarks), UserID: uid, ArticleID: aid, CollectionID: collectionID}
+			db.bookmarks.put(b.ID, b)
+		}
+		return nil
+	})
+}
+
+func (r *memoryBookmarkRepo) DeleteBookmark(ctx context.Context, uid uint, aid uint) error {
+	return r.mem.run(ctx, func(db *memoryDB) error {
+		db.bookmarks.delete(func(b Bookmark) bool { return b.UserID == uid && b.ArticleID == aid })
+		return nil
+	})
+}
+
+// 按书签id倒序
+func (r *memoryBookmarkRepo) ListBookmarks(ctx context.Context, uid uint, collectionID uint, cursor uint, limit int) ([]*biz.Article, uint, error) {
+	list := make([]*biz.Article, 0)
+	var next uint
+	err := r.mem.run(ctx, func(db *memoryDB) error {
+		bookmarks := db.bookmarks.find(func(b Bookmark) bool {
+			if b.UserID != uid || (collectionID > 0 && b.CollectionID != collectionID) || (cursor > 0 && b.ID >= cursor) {
+				return false
+			}
+			a, ok := db.firstArticle(func(a Article) bool { return a.ID == b.ArticleID })
+			return ok && !db.isBlocked(uid, a.AuthorID) && !db.isHidden(a.AuthorID, uid)
+		})
+		slices.Reverse(bookmarks)
+		if len(bookmarks) > limit {
+			bookmarks = bookmarks[:limit]
+			next = bookmarks[limit-1].ID
+		}
+		for _, b := range bookmarks {
+			a, _ := db.firstArticle(func(a Article) bool { return a.ID == b.ArticleID })
+			list = append(list, db.convertArticle(a))
+		}
+		return nil
+	})
+	return list, next, err
+}
+
+// 同一用户下收藏夹名称唯一
+func (db *memoryDB) collectionNameTaken(uid uint, name string, exceptID uint) bool {
+	return db.collections.exists(func(c BookmarkCollection) bool {
+		return c.UserID == uid && c.Name == name && c.ID != exceptID
+	})
+}
+
+func (db *memoryDB) countBookmarks(collectionID uint) uint32 {
+	return uint32(len(db.bookmarks.find(func(b Bookmark) bool { return b.CollectionID == collectionID })))
+}
+
+func (r *memoryBookmarkRepo) CreateCollection(ctx context.Context, uid uint, name string) (*biz.BookmarkCollection, error) {
+	var collection *biz.BookmarkCollection
+	err := r.mem.run(ctx, func(db *memoryDB) error {
+		if db.collectionNameTaken(uid, name, 0) {
+			return biz.TakenError("name")
+		}
+		c := BookmarkCollection{Model: newModel(db.collections), UserID: uid, Name: name}
+		db.collections.put(c.ID, c)
+		collection = convertCollection(c, 0)
+		return nil
+	})
+	return collection, err
+}
+
+func (r *memoryBookmarkRepo) GetCollection(ctx context.Context, id uint) (*biz.BookmarkCollection, error) {
+	var collection *biz.BookmarkCollection
+	err := r.mem.run(ctx, func(db *memoryDB) error {
+		c, ok := db.collections.get(id)
+		if !ok {
+			return biz.ErrCollectionNotFound
+		}
+		collection = convertCollection(c, db.countBookmarks(c.ID))
+		return nil
+	})
+	return collection, err
+}
+
+func (r *memoryBookmarkRepo) ListCollections(ctx context.Context, uid uint) ([]*biz.BookmarkCollection, error) {
+	list := make([]*biz.BookmarkCollection, 0)
+	err := r.mem.run(ctx, func(db *memoryDB) error {
+		collections := db.collections.find(func(c BookmarkCollection) bool { return c.UserID == uid })
+		slices.SortStableFunc(collections, func(a, b BookmarkCollection) int { return cmp.Compare(a.Name, b.Name) })
+		for _, c := range collections {
+			list = append(list, convertCollection(c, db.countBookmarks(c.ID)))
+		}
+		return nil
+	})
+	return list, err
+}
+
+func (r *memoryBookmarkRepo) RenameCollection(ctx context.Context, id uint, name string) (*biz.BookmarkCollection, error) {
+	var collection *biz.BookmarkCollection
+	err := r.mem.run(ctx, func(db *memoryDB) error {
+		c, ok := db.collections.get(id)
+		if !ok {
+			return biz.ErrCollectionNotFound
+		}
+		if db.collectionNameTaken(c.UserID, name, c.ID) {
+			return biz.TakenError("name")
+		}
+		c.Name = name
+		c.UpdatedAt = time.Now()
+		db.collections.put(c.ID, c)
+		collection = convertCollection(c, db.countBookmarks(c.ID))
+		return nil
+	})
+	return collection, err
+}
+
+// 收藏夹中的书签移出收藏夹, 不删除
+func (r *memoryBookmarkRepo) DeleteCollection(ctx context.Context, id uint) error {
+	return r.mem.run(ctx, func(db *memoryDB) error {
+		db.bookmarks.update(func(b Bookmark) bool { return b.CollectionID == id }, func(b *Bookmark) {
+			b.CollectionID = 0
+			b.UpdatedAt = time.Now()
+		})
+		db.collections.delete(func(c BookmarkCollection) bool { return c.ID == id })
+		return nil
+	})
+}
@@ -0,0 +1,171 @@
+package data
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"kratos-realworld/internal/biz"
+
+	"gorm.io/gorm"
+)
+
+// NotificationRepo的内存实现
+type memoryNotificationRepo struct {
+	mem *memoryStore
+}
+
+func (r *memoryNotificationRepo) AddNotification(ctx context.Context, n *biz.Notification) error {
+	return r.mem.run(ctx, func(db *memoryDB) error {
+		var latest Notification
+		found := false
+		for _, row := range db.notifications.find(func(row Notification) bool { return row.UserID == n.UserID && row.GroupKey == n.GroupKey }) {
+			if !found || row.ID > latest.ID {
+				latest, found = row, true
+			}
+		}
+		if found && db.notificationActors.exists(func(a NotificationActor) bool { return a.NotificationID == latest.ID && a.ActorID == n.ActorID }) {
+			return nil
+		}
+
+		now := time.Now()
+		row := Notification{
+			Model:       gorm.Model{ID: db.notifications.nextID(), CreatedAt: now, UpdatedAt: now},
+			UserID:      n.UserID,
+			Type:        n.Type,
+			GroupKey:    n.GroupKey,
+			ArticleID:   n.ArticleID,
+			CommentID:   n.CommentID,
+			ActorID:     n.ActorID,
+			ActorsCount: 1,
+		}
+		if found && latest.ReadAt == nil {
+			row.ActorsCount = latest.ActorsCount + 1
+			db.notifications.delete(func(old Notification) bool { return old.ID == latest.ID })
+			db.notificationActors.update(func(a NotificationActor) bool { return a.NotificationID == latest.ID }, func(a *NotificationActor) {
+				a.NotificationID = row.ID
+			})
+		}
+		db.notifications.put(row.ID, row)
+		actor := NotificationActor{
+			Model:          gorm.Model{ID: db.notificationActors.nextID(), CreatedAt: now, UpdatedAt: now},
+			NotificationID: row.ID,
+			ActorID:        n.ActorID,
+		}
+		db.notificationActors.put(actor.ID, actor)
+		return nil
+	})
+}
+
+// 对应visibleNotifications
+func (db *memoryDB) visibleNotifications(uid uint) []Notification {
+	return db.notifications.find(func(n Notification) bool {
+		if n.UserID != uid {
+			return false
+		}
+		if n.ArticleID == 0 {
+			return true
+		}
+		_, ok := db.firstArticle(func(a Article) bool { return a.ID == n.ArticleID })
+		return ok
+	})
+}
+
+func (r *memoryNotificationRepo) ListNotifications(ctx context.Context, uid uint, cursor uint, limit int) ([]*biz.Notification, uint, error) {
+	list := make([]*biz.Notification, 0)
+	var next uint
+	err := r.mem.run(ctx, func(db *memoryDB) error {
+		rows := db.visibleNotifications(uid)
+		sort.Slice(rows, func(i, j int) bool { return rows[i].ID > rows[j].ID })
+		for _, row := range rows {
+			if cursor > 0 && row.ID >= cursor {
+				continue
+			}
+			if len(list) == limit {
+				next = list[limit-1].ID
+				break
+			}
+			n := convertNotification(row)
+			if a, ok := db.firstArticle(func(a Article) bool { return a.ID == row.ArticleID }); ok {
+				n.ArticleSlug = a.Slug
+				n.ArticleTitle = a.Title
+			}
+			list = append(list, n)
+		}
+		return nil
+	})
+	return list, next, err
+}
+
+func (r *memoryNotificationRepo) CountUnreadNotifications(ctx context.Context, uid uint) (int, error) {
+	count := 0
+	err := r.mem.run(ctx, func(db *memoryDB) error {
+		for _, n := range db.visibleNotifications(uid) {
+			if n.ReadAt == nil {
+				count++
+			}
+		}
+		return nil
+	})
+	return count, err
+}
+
+func (r *memoryNotificationRepo) MarkNotificationRead(ctx context.Context, uid uint, id uint) error {
+	return r.mem.run(ctx, func(db *memoryDB) error {
+		n, ok := db.notifications.get(id)
+		if !ok || n.UserID != uid {
+			return biz.ErrNotificationNotFound
+		}
+		if n.ReadAt == nil {
+			now := time.Now()
+			n.ReadAt = &now
+			n.UpdatedAt = now
+			db.notifications.put(n.ID, n)
+		}
+		return nil
+	})
+}
+
+func (r *memoryNotificationRepo) MarkAllNotificationsRead(ctx context.Context, uid uint) error {
+	return r.mem.run(ctx, func(db *memoryDB) error {
+		now := time.Now()
+		db.notifications.update(func(n Notification) bool { return n.UserID == uid && n.ReadAt == nil }, func(n *Notification) {
+			n.ReadAt = &now
+			n.UpdatedAt = now
+		})
+		return nil
+	})
+}
+
+func (r *memoryNotificationRepo) GetNotificationPreferences(ctx context.Context, uid uint) (map[string]bool, error) {
+	preferences := make(map[string]bool)
+	err := r.mem.run(ctx, func(db *memoryDB) error {
+		for _, p := range db.notificationPreferences.find(func(p NotificationPreference) bool { return p.UserID == uid }) {
+			preferences[p.Type] = p.Enabled
+		}
+		return nil
+	})
+	return preferences, err
+}
+
+func (r *memoryNotificationRepo) UpdateNotificationPreferences(ctx context.Context, uid uint, preferences map[string]bool) error {
+	return r.mem.run(ctx, func(db *memoryDB) error {
+		now := time.Now()
+		for t, enabled := range preferences {
+			updated := db.notificationPreferences.update(func(p NotificationPreference) bool { return p.UserID == uid && p.Type == t }, func(p *NotificationPreference) {
+				p.Enabled = enabled
+				p.UpdatedAt = now
+			})
+			if updated == 0 {
+				p := NotificationPreference{
+					Model:   gorm.Model{ID: db.notificationPreferences.nextID(), CreatedAt: now, UpdatedAt: now},
+					UserID:  uid,
+					Type:    t,
+					Enabled: enabled,
+				}
+				db.notificationPreferences.put(p.ID, p)
+			}
+		}
+		return nil
+	})
+}
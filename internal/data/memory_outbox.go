@@ -0,0 +1,78 @@
+package data
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"kratos-realworld/internal/biz"
+)
+
+// OutboxRepo的内存实现
+type memoryOutboxRepo struct {
+	mem    *memoryStore
+	notify outboxNotifier
+}
+
+func (r *memoryOutboxRepo) AddEvents(ctx context.Context, events []*biz.OutboxEvent) error {
+	err := r.mem.run(ctx, func(db *memoryDB) error {
+		for _, e := range events {
+			row := OutboxEvent{
+				ID:            db.outboxEvents.nextID(),
+				CreatedAt:     e.CreatedAt,
+				UpdatedAt:     time.Now(),
+				Type:          e.Type,
+				Payload:       string(e.Payload),
+				Status:        e.Status,
+				NextAttemptAt: e.NextAttemptAt,
+			}
+			db.outboxEvents.put(row.ID, row)
+			e.ID = row.ID
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	afterCommit(ctx, r.notify.notify)
+	return nil
+}
+
+func (r *memoryOutboxRepo) ClaimEvents(ctx context.Context, now time.Time, limit int, lease time.Duration) ([]*biz.OutboxEvent, error) {
+	events := make([]*biz.OutboxEvent, 0)
+	err := r.mem.run(ctx, func(db *memoryDB) error {
+		rows := db.outboxEvents.find(func(e OutboxEvent) bool {
+			return e.Status == biz.EventPending && !e.NextAttemptAt.After(now)
+		})
+		if len(rows) > limit {
+			rows = rows[:limit]
+		}
+		until := now.Add(lease)
+		for _, row := range rows {
+			row.NextAttemptAt = until
+			row.UpdatedAt = time.Now()
+			db.outboxEvents.put(row.ID, row)
+			events = append(events, convertOutboxEvent(row))
+		}
+		return nil
+	})
+	return events, err
+}
+
+func (r *memoryOutboxRepo) UpdateEvent(ctx context.Context, e *biz.OutboxEvent) error {
+	return r.mem.run(ctx, func(db *memoryDB) error {
+		db.outboxEvents.update(func(row OutboxEvent) bool { return row.ID == e.ID }, func(row *OutboxEvent) {
+			row.Status = e.Status
+			row.Attempts = e.Attempts
+			row.NextAttemptAt = e.NextAttemptAt
+			row.Delivered = strings.Join(e.Delivered, ",")
+			row.LastError = e.LastError
+			row.UpdatedAt = time.Now()
+		})
+		return nil
+	})
+}
+
+func (r *memoryOutboxRepo) Notify() <-chan struct{} {
+	return r.notify
+}
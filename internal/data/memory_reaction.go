@@ -0,0 +1,78 @@
+package data
+
+import (
+	"context"
+	"slices"
+
+	"kratos-realworld/internal/biz"
+)
+
+// ReactionRepo的内存实现
+type memoryReactionRepo struct {
+	mem *memoryStore
+}
+
+func (r *memoryReactionRepo) AddReaction(ctx context.Context, uid uint, targetType string, targetID uint, reaction string) error {
+	return r.mem.run(ctx, func(db *memoryDB) error {
+		// 已经回应过时不重复计数
+		exists := db.reactions.exists(func(re Reaction) bool {
+			return re.UserID == uid && re.TargetType == targetType && re.TargetID == targetID && re.Reaction == reaction
+		})
+		if exists {
+			return nil
+		}
+		re := Reaction{Model: newModel(db.reactions), UserID: uid, TargetType: targetType, TargetID: targetID, Reaction: reaction}
+		db.reactions.put(re.ID, re)
+
+		n := db.reactionCounts.update(func(c ReactionCount) bool {
+			return c.TargetType == targetType && c.TargetID == targetID && c.Reaction == reaction
+		}, func(c *ReactionCount) {
+			c.ReactionsCount++
+		})
+		if n == 0 {
+			id := db.reactionCounts.nextID()
+			db.reactionCounts.put(id, ReactionCount{ID: id, TargetType: targetType, TargetID: targetID, Reaction: reaction, ReactionsCount: 1})
+		}
+		return nil
+	})
+}
+
+func (r *memoryReactionRepo) RemoveReaction(ctx context.Context, uid uint, targetType string, targetID uint, reaction string) error {
+	return r.mem.run(ctx, func(db *memoryDB) error {
+		n := db.reactions.delete(func(re Reaction) bool {
+			return re.UserID == uid && re.TargetType == targetType && re.TargetID == targetID && re.Reaction == reaction
+		})
+		if n > 0 {
+			db.decrReactionCount(targetType, targetID, reaction)
+		}
+		return nil
+	})
+}
+
+func (r *memoryReactionRepo) GetReactionCounts(ctx context.Context, targetType string, targetIDs []uint) (map[uint][]biz.ReactionCount, error) {
+	result := make(map[uint][]biz.ReactionCount, len(targetIDs))
+	err := r.mem.run(ctx, func(db *memoryDB) error {
+		counts := db.reactionCounts.find(func(c ReactionCount) bool {
+			return c.TargetType == targetType && slices.Contains(targetIDs, c.TargetID) && c.ReactionsCount > 0
+		})
+		for _, c := range counts {
+			result[c.TargetID] = append(result[c.TargetID], biz.ReactionCount{Reaction: c.Reaction, Count: c.ReactionsCount})
+		}
+		return nil
+	})
+	return result, err
+}
+
+func (r *memoryReactionRepo) GetUserReactions(ctx context.Context, uid uint, targetType string, targetIDs []uint) (map[uint][]string, error) {
+	result := make(map[uint][]string, len(targetIDs))
+	err := r.mem.run(ctx, func(db *memoryDB) error {
+		reactions := db.reactions.find(func(re Reaction) bool {
+			return re.UserID == uid && re.TargetType == targetType && slices.Contains(targetIDs, re.TargetID)
+		})
+		for _, re := range reactions {
+			result[re.TargetID] = append(result[re.TargetID], re.Reaction)
+		}
+		return nil
+	})
+	return result, err
+}
@@ -0,0 +1,368 @@
+package data
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"time"
+
+	"kratos-realworld/internal/biz"
+	"kratos-realworld/internal/pkg/utils"
+
+	"gorm.io/gorm"
+)
+
+// ArticleRepo的内存实现
+type memoryArticleRepo struct {
+	mem *memoryStore
+}
+
+// 对应ensureTags, 不存在的tag先创建
+func (db *memoryDB) ensureTags(names []string) []Tag {
+	if len(names) == 0 {
+		return nil
+	}
+	for _, name := range names {
+		if !db.tags.exists(func(t Tag) bool { return t.Name == name }) {
+			tag := Tag{Model: newModel(db.tags), Name: name}
+			db.tags.put(tag.ID, tag)
+		}
+	}
+	return db.tags.find(func(t Tag) bool { return slices.Contains(names, t.Name) })
+}
+
+// 替换文章的tag关联
+func (db *memoryDB) setArticleTags(aid uint, tags []Tag) {
+	db.articleTags.delete(func(at articleTag) bool { return at.ArticleID == aid })
+	for _, tag := range tags {
+		db.articleTags.put(db.articleTags.nextID(), articleTag{ArticleID: aid, TagID: tag.ID})
+	}
+}
+
+// slug的唯一索引也包含已经软删除的文章
+func (db *memoryDB) slugTaken(slug string, exceptID uint) bool {
+	return db.articles.exists(func(a Article) bool { return a.Slug == slug && a.ID != exceptID })
+}
+
+func (ar *memoryArticleRepo) CreateArticle(ctx context.Context, article *biz.Article) (*biz.Article, error) {
+	var created *biz.Article
+	err := ar.mem.transaction(ctx, func(ctx context.Context) error {
+		return ar.mem.run(ctx, func(db *memoryDB) error {
+			tags := db.ensureTags(article.TagList)
+			if db.slugTaken(article.Slug, 0) {
+				return biz.TakenError("slug")
+			}
+			a := Article{
+				Model:       newModel(db.articles),
+				Slug:        article.Slug,
+				Title:       article.Title,
+				Description: article.Description,
+				Body:        article.Body,
+				CoverImage:  article.CoverImage,
+				AuthorID:    article.AuthorID,
+			}
+			db.articles.put(a.ID, a)
+			db.setArticleTags(a.ID, tags)
+			db.users.update(func(u User) bool { return u.ID == article.AuthorID }, func(u *User) { u.ArticlesCount++ })
+
+			// 和gorm实现一样, 作者只带id
+			a.Tags = tags
+			a.Author = User{Model: gorm.Model{ID: article.AuthorID}}
+			created = convertArticle(a)
+			return nil
+		})
+	})
+	return created, err
+}
+
+func (ar *memoryArticleRepo) getArticle(ctx context.Context, match func(Article) bool) (*biz.Article, error) {
+	var article *biz.Article
+	err := ar.mem.run(ctx, func(db *memoryDB) error {
+		a, ok := db.firstArticle(match)
+		if !ok {
+			return biz.ErrArticleNotFound
+		}
+		article = db.convertArticle(a)
+		return nil
+	})
+	return article, err
+}
+
+func (ar *memoryArticleRepo) GetArticleBySlug(ctx context.Context, slug string) (*biz.Article, error) {
+	return ar.getArticle(ctx, func(a Article) bool { return a.Slug == slug })
+}
+
+func (ar *memoryArticleRepo) GetArticleByAid(ctx context.Context, aid uint) (*biz.Article, error) {
+	return ar.getArticle(ctx, func(a Article) bool { return a.ID == aid })
+}
+
+// 软删除, tag关联和收藏保留
+func (ar *memoryArticleRepo) DeleteArticleBySlug(ctx context.Context, slug string) error {
+	return ar.mem.run(ctx, func(db *memoryDB) error {
+		a, ok := db.firstArticle(func(a Article) bool { return a.Slug == slug })
+		if !ok {
+			return biz.ErrArticleNotFound
+		}
+		a.DeletedAt = gorm.DeletedAt{Time: time.Now(), Valid: true}
+		db.articles.put(a.ID, a)
+		db.users.update(func(u User) bool { return u.ID == a.AuthorID }, func(u *User) {
+			u.ArticlesCount = decrCount(u.ArticlesCount)
+		})
+		return nil
+	})
+}
+
+// 和gorm实现一样, 返回的文章不带作者, 只在更新了tag时带tag
+func (ar *memoryArticleRepo) UpdateArticle(ctx context.Context, article *biz.Article) (*biz.Article, error) {
+	var updated *biz.Article
+	err := ar.mem.transaction(ctx, func(ctx context.Context) error {
+		return ar.mem.run(ctx, func(db *memoryDB) error {
+			a, ok := db.firstArticle(func(a Article) bool { return a.Slug == article.Slug })
+			if !ok {
+				return biz.ErrArticleNotFound
+			}
+			if article.Title != "" {
+				a.Title = article.Title
+				a.Slug = utils.Slugify(article.Title)
+			}
+			if article.Description != "" {
+				a.Description = article.Description
+			}
+			if article.Body != "" {
+				a.Body = article.Body
+			}
+			if article.CoverImageUpdate != nil {
+				a.CoverImage = *article.CoverImageUpdate
+			}
+			if db.slugTaken(a.Slug, a.ID) {
+				return biz.TakenError("title")
+			}
+			a.UpdatedAt = time.Now()
+			db.articles.put(a.ID, a)
+
+			if len(article.TagList) > 0 {
+				a.Tags = db.ensureTags(article.TagList)
+				db.setArticleTags(a.ID, a.Tags)
+			}
+			updated = convertArticle(a)
+			return nil
+		})
+	})
+	return updated, err
+}
+
+// 收藏 - 已经收藏过时不报错, 收藏数只在新增时加一
+func (ar *memoryArticleRepo) FavoriteArticle(ctx context.Context, aid uint, uid uint) (bool, error) {
+	added := false
+	err := ar.mem.run(ctx, func(db *memoryDB) error {
+		// 和外键约束一样, 软删除的文章仍然可以收藏
+		if _, ok := db.articles.get(aid); !ok {
+			return biz.ErrArticleNotFound
+		}
+		if db.favorites.exists(func(f ArticleFavorite) bool { return f.UserID == uid && f.ArticleID == aid }) {
+			return nil
+		}
+		f := ArticleFavorite{Model: newModel(db.favorites), UserID: uid, ArticleID: aid}
+		db.favorites.put(f.ID, f)
+		db.articles.update(func(a Article) bool { return a.ID == aid && !a.DeletedAt.Valid }, func(a *Article) { a.FavoritesCount++ })
+		added = true
+		return nil
+	})
+	return added && err == nil, err
+}
+
+// 取消收藏 - 没有收藏过时不报错, 收藏数只在删除时减一
+func (ar *memoryArticleRepo) UnfavoriteArticle(ctx context.Context, aid uint, uid uint) error {
+	return ar.mem.run(ctx, func(db *memoryDB) error {
+		if db.favorites.delete(func(f ArticleFavorite) bool { return f.UserID == uid && f.ArticleID == aid }) == 0 {
+			return nil
+		}
+		db.articles.update(func(a Article) bool { return a.ID == aid && !a.DeletedAt.Valid }, func(a *Article) {
+			a.FavoritesCount = decrCount(a.FavoritesCount)
+		})
+		return nil
+	})
+}
+
+func (ar *memoryArticleRepo) ReconcileFavoritesCounts(ctx context.Context, afterID uint, limit int) (int, uint, error) {
+	fixed := 0
+	var last uint
+	err := ar.mem.run(ctx, func(db *memoryDB) error {
+		articles := db.findArticles(func(a Article) bool { return a.ID > afterID })
+		if len(articles) > limit {
+			articles = articles[:limit]
+		}
+		for _, a := range articles {
+			count := uint32(len(db.favorites.find(func(f ArticleFavorite) bool { return f.ArticleID == a.ID })))
+			if a.FavoritesCount != count {
+				a.FavoritesCount = count
+				db.articles.put(a.ID, a)
+				fixed++
+			}
+			last = a.ID
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+	return fixed, last, nil
+}
+
+func (ar *memoryArticleRepo) GetArticleViewerStates(ctx context.Context, aids []uint, uid uint) (map[uint]biz.ArticleViewerState, error) {
+	result := make(map[uint]biz.ArticleViewerState, len(aids))
+	err := ar.mem.run(ctx, func(db *memoryDB) error {
+		for _, a := range db.findArticles(func(a Article) bool { return slices.Contains(aids, a.ID) }) {
+			result[a.ID] = biz.ArticleViewerState{
+				Favorited:  db.favorites.exists(func(f ArticleFavorite) bool { return f.UserID == uid && f.ArticleID == a.ID }),
+				Bookmarked: db.bookmarks.exists(func(b Bookmark) bool { return b.UserID == uid && b.ArticleID == a.ID }),
+				Following:  db.isFollowing(uid, a.AuthorID),
+			}
+		}
+		return nil
+	})
+	return result, err
+}
+
+// 过滤条件和gorm实现相同, 按创建时间倒序
+func (ar *memoryArticleRepo) ListArticlesByOptions(ctx context.Context, options *biz.ListOptions) ([]*biz.Article, error) {
+	uid := options.CurrentUid
+	feed := uid > 0 && options.Tag == "" && options.Author == "" && options.FavoritedBy == ""
+
+	var list []*biz.Article
+	err := ar.mem.run(ctx, func(db *memoryDB) error {
+		articles := db.findArticles(func(a Article) bool {
+			if (uid > 0 && db.isBlocked(uid, a.AuthorID)) || db.isHidden(a.AuthorID, uid) {
+				return false
+			}
+			// 返回当前用户关注的用户文章, 静音的用户不出现在feed中
+			if feed {
+				return db.isFollowing(uid, a.AuthorID) && !db.isMuted(uid, a.AuthorID)
+			}
+			if options.Tag != "" && !db.hasTag(a.ID, func(t Tag) bool { return t.Name == options.Tag }) {
+				return false
+			}
+			if options.Author != "" {
+				if author, ok := db.users.get(a.AuthorID); !ok || author.Username != options.Author {
+					return false
+				}
+			}
+			if options.FavoritedBy != "" {
+				favorited := db.favorites.exists(func(f ArticleFavorite) bool {
+					u, ok := db.users.get(f.UserID)
+					return f.ArticleID == a.ID && ok && u.Username == options.FavoritedBy
+				})
+				if !favorited {
+					return false
+				}
+			}
+			return true
+		})
+
+		// 创建时间相同时新的文章在前
+		slices.Reverse(articles)
+		sort.SliceStable(articles, func(i, j int) bool { return articles[i].CreatedAt.After(articles[j].CreatedAt) })
+		if options.Offset > 0 {
+			articles = articles[min(int(options.Offset), len(articles)):]
+		}
+		if options.Limit > 0 && len(articles) > int(options.Limit) {
+			articles = articles[:options.Limit]
+		}
+		list = db.convertArticles(articles)
+		return nil
+	})
+	return list, err
+}
+
+// feed - 关注的作者的文章和关注的标签下的文章, 按id倒序
+func (ar *memoryArticleRepo) ListFeedArticles(ctx context.Context, uid uint, cursor uint, offset int, limit int) ([]*biz.Article, uint, error) {
+	var list []*biz.Article
+	var next uint
+	err := ar.mem.run(ctx, func(db *memoryDB) error {
+		articles := db.findArticles(func(a Article) bool {
+			if a.AuthorID == uid || db.isMuted(uid, a.AuthorID) || db.isBlocked(uid, a.AuthorID) || db.isHidden(a.AuthorID, uid) {
+				return false
+			}
+			if cursor > 0 && a.ID >= cursor {
+				return false
+			}
+			return db.isFollowing(uid, a.AuthorID) || db.hasTag(a.ID, func(t Tag) bool {
+				return db.tagFollows.exists(func(f TagFollow) bool { return f.UserID == uid && f.TagID == t.ID })
+			})
+		})
+		slices.Reverse(articles)
+		if offset > 0 {
+			articles = articles[min(offset, len(articles)):]
+		}
+		if len(articles) > limit {
+			articles = articles[:limit]
+			next = articles[limit-1].ID
+		}
+		list = db.convertArticles(articles)
+		return nil
+	})
+	return list, next, err
+}
+
+// CommentRepo的内存实现
+type memoryCommentRepo struct {
+	mem *memoryStore
+}
+
+func (cr *memoryCommentRepo) AddComment(ctx context.Context, c *biz.Comment) (*biz.Comment, error) {
+	var comment *biz.Comment
+	err := cr.mem.run(ctx, func(db *memoryDB) error {
+		if _, ok := db.articles.get(c.ArticleID); !ok {
+			return biz.ErrArticleNotFound
+		}
+		if _, ok := db.users.get(c.AuthorID); !ok {
+			return gorm.ErrRecordNotFound
+		}
+		po := Comment{Model: newModel(db.comments), ArticleID: c.ArticleID, AuthorID: c.AuthorID, Body: c.Body}
+		db.comments.put(po.ID, po)
+		comment = db.convertComment(po)
+		return nil
+	})
+	return comment, err
+}
+
+func (cr *memoryCommentRepo) DeleteCommentByID(ctx context.Context, id uint) error {
+	return cr.mem.run(ctx, func(db *memoryDB) error {
+		if db.comments.delete(func(c Comment) bool { return c.ID == id }) == 0 {
+			return biz.ErrCommentNotFound
+		}
+		return nil
+	})
+}
+
+func (cr *memoryCommentRepo) GetCommentByID(ctx context.Context, id uint) (*biz.Comment, error) {
+	var comment *biz.Comment
+	err := cr.mem.run(ctx, func(db *memoryDB) error {
+		c, ok := db.comments.get(id)
+		if !ok {
+			return biz.ErrCommentNotFound
+		}
+		comment = db.convertComment(c)
+		return nil
+	})
+	return comment, err
+}
+
+func (cr *memoryCommentRepo) GetCommentsByID(ctx context.Context, cid uint, viewerUid uint) ([]*biz.Comment, error) {
+	var list []*biz.Comment
+	err := cr.mem.run(ctx, func(db *memoryDB) error {
+		comments := db.comments.find(func(c Comment) bool {
+			if c.ArticleID != cid {
+				return false
+			}
+			// 拉黑和静音的用户的评论不可见
+			return viewerUid == 0 || (!db.isBlocked(viewerUid, c.AuthorID) && !db.isMuted(viewerUid, c.AuthorID))
+		})
+		list = make([]*biz.Comment, len(comments))
+		for i, c := range comments {
+			list[i] = db.convertComment(c)
+		}
+		return nil
+	})
+	return list, err
+}
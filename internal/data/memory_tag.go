@@ -0,0 +1,246 @@
+package data
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"kratos-realworld/internal/biz"
+)
+
+// TagRepo的内存实现
+type memoryTagRepo struct {
+	mem *memoryStore
+}
+
+// 对应tagsWithCount, 只统计未删除的文章
+func (db *memoryDB) tagArticlesCount(tagID uint) uint32 {
+	var count uint32
+	for _, at := range db.articleTags.find(func(at articleTag) bool { return at.TagID == tagID }) {
+		if _, ok := db.firstArticle(func(a Article) bool { return a.ID == at.ArticleID }); ok {
+			count++
+		}
+	}
+	return count
+}
+
+func (tr *memoryTagRepo) GetTags(ctx context.Context, prefix string, limit int) ([]*biz.TagInfo, error) {
+	prefix = strings.ToLower(prefix)
+	var list []*biz.TagInfo
+	err := tr.mem.run(ctx, func(db *memoryDB) error {
+		for _, tag := range db.tags.find(func(t Tag) bool { return strings.HasPrefix(strings.ToLower(t.Name), prefix) }) {
+			// 没有文章使用的标签不会出现
+			if count := db.tagArticlesCount(tag.ID); count > 0 {
+				list = append(list, &biz.TagInfo{ID: tag.ID, Name: biz.Tag(tag.Name), ArticlesCount: count})
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].ArticlesCount != list[j].ArticlesCount {
+			return list[i].ArticlesCount > list[j].ArticlesCount
+		}
+		return list[i].Name < list[j].Name
+	})
+	if limit > 0 && len(list) > limit {
+		list = list[:limit]
+	}
+	if list == nil {
+		list = []*biz.TagInfo{}
+	}
+	return list, nil
+}
+
+func (tr *memoryTagRepo) GetTag(ctx context.Context, name string) (*biz.TagInfo, error) {
+	var info *biz.TagInfo
+	err := tr.mem.run(ctx, func(db *memoryDB) error {
+		tag, ok := db.tags.first(func(t Tag) bool { return t.Name == name })
+		if !ok {
+			return biz.ErrTagNotFound
+		}
+		info = &biz.TagInfo{ID: tag.ID, Name: biz.Tag(tag.Name), ArticlesCount: db.tagArticlesCount(tag.ID)}
+		return nil
+	})
+	return info, err
+}
+
+func (tr *memoryTagRepo) ListTagUses(ctx context.Context, since time.Time) ([]*biz.TagUse, error) {
+	list := make([]*biz.TagUse, 0)
+	err := tr.mem.run(ctx, func(db *memoryDB) error {
+		for _, at := range db.articleTags.find(func(articleTag) bool { return true }) {
+			tag, ok := db.tags.get(at.TagID)
+			if !ok {
+				continue
+			}
+			a, ok := db.firstArticle(func(a Article) bool { return a.ID == at.ArticleID })
+			if ok && !a.CreatedAt.Before(since) {
+				list = append(list, &biz.TagUse{Name: biz.Tag(tag.Name), CreatedAt: a.CreatedAt})
+			}
+		}
+		return nil
+	})
+	return list, err
+}
+
+func (tr *memoryTagRepo) FollowTag(ctx context.Context, uid uint, tagID uint) error {
+	return tr.mem.run(ctx, func(db *memoryDB) error {
+		if db.tagFollows.exists(func(f TagFollow) bool { return f.UserID == uid && f.TagID == tagID }) {
+			return nil
+		}
+		f := TagFollow{Model: newModel(db.tagFollows), UserID: uid, TagID: tagID}
+		db.tagFollows.put(f.ID, f)
+		return nil
+	})
+}
+
+func (tr *memoryTagRepo) UnfollowTag(ctx context.Context, uid uint, tagID uint) error {
+	return tr.mem.run(ctx, func(db *memoryDB) error {
+		db.tagFollows.delete(func(f TagFollow) bool { return f.UserID == uid && f.TagID == tagID })
+		return nil
+	})
+}
+
+func (tr *memoryTagRepo) GetFollowedTags(ctx context.Context, uid uint) ([]biz.Tag, error) {
+	tags := make([]biz.Tag, 0)
+	err := tr.mem.run(ctx, func(db *memoryDB) error {
+		for _, f := range db.tagFollows.find(func(f TagFollow) bool { return f.UserID == uid }) {
+			if tag, ok := db.tags.get(f.TagID); ok {
+				tags = append(tags, biz.Tag(tag.Name))
+			}
+		}
+		return nil
+	})
+	sort.Slice(tags, func(i, j int) bool { return tags[i] < tags[j] })
+	return tags, err
+}
+
+func (tr *memoryTagRepo) ResolveAliases(ctx context.Context, names []string) (map[string]string, error) {
+	result := make(map[string]string)
+	err := tr.mem.run(ctx, func(db *memoryDB) error {
+		for _, name := range names {
+			alias, ok := db.tagAliases.first(func(a TagAlias) bool { return a.Alias == name })
+			if !ok {
+				continue
+			}
+			if tag, ok := db.tags.get(alias.TagID); ok {
+				result[alias.Alias] = tag.Name
+			}
+		}
+		return nil
+	})
+	return result, err
+}
+
+func (tr *memoryTagRepo) ListAliases(ctx context.Context, tagID uint) ([]string, error) {
+	aliases := make([]string, 0)
+	err := tr.mem.run(ctx, func(db *memoryDB) error {
+		for _, a := range db.tagAliases.find(func(a TagAlias) bool { return a.TagID == tagID }) {
+			aliases = append(aliases, a.Alias)
+		}
+		return nil
+	})
+	sort.Strings(aliases)
+	return aliases, err
+}
+
+// 别名全局唯一, 已经存在时不报错
+func (db *memoryDB) addAlias(tagID uint, alias string) {
+	if db.tagAliases.exists(func(a TagAlias) bool { return a.Alias == alias }) {
+		return
+	}
+	a := TagAlias{Model: newModel(db.tagAliases), Alias: alias, TagID: tagID}
+	db.tagAliases.put(a.ID, a)
+}
+
+func (tr *memoryTagRepo) AddAlias(ctx context.Context, tagID uint, alias string) error {
+	return tr.mem.run(ctx, func(db *memoryDB) error {
+		if db.tagAliases.exists(func(a TagAlias) bool { return a.Alias == alias && a.TagID != tagID }) {
+			return biz.TakenError("alias")
+		}
+		db.addAlias(tagID, alias)
+		return nil
+	})
+}
+
+func (tr *memoryTagRepo) RemoveAlias(ctx context.Context, tagID uint, alias string) error {
+	return tr.mem.run(ctx, func(db *memoryDB) error {
+		if db.tagAliases.delete(func(a TagAlias) bool { return a.TagID == tagID && a.Alias == alias }) == 0 {
+			return biz.ErrAliasNotFound
+		}
+		return nil
+	})
+}
+
+func (tr *memoryTagRepo) RenameTag(ctx context.Context, tagID uint, name string) error {
+	return tr.mem.run(ctx, func(db *memoryDB) error {
+		tag, ok := db.tags.get(tagID)
+		if !ok {
+			return biz.ErrTagNotFound
+		}
+		if db.tags.exists(func(t Tag) bool { return t.ID != tagID && t.Name == name }) {
+			return biz.TakenError("name")
+		}
+		// 新名称原来是这个标签的别名
+		db.tagAliases.delete(func(a TagAlias) bool { return a.Alias == name })
+		oldName := tag.Name
+		tag.Name = name
+		tag.UpdatedAt = time.Now()
+		db.tags.put(tag.ID, tag)
+		db.addAlias(tagID, oldName)
+		return nil
+	})
+}
+
+func (tr *memoryTagRepo) MergeTags(ctx context.Context, sourceID uint, targetID uint) error {
+	return tr.mem.run(ctx, func(db *memoryDB) error {
+		source, ok := db.tags.get(sourceID)
+		if !ok {
+			return biz.ErrTagNotFound
+		}
+		if db.tagAliases.exists(func(a TagAlias) bool { return a.Alias == source.Name }) {
+			return biz.TakenError("alias")
+		}
+
+		// 文章 - 已经同时使用两个标签的文章只保留target
+		for _, at := range db.articleTags.find(func(at articleTag) bool { return at.TagID == sourceID }) {
+			tagged := db.articleTags.exists(func(t articleTag) bool { return t.ArticleID == at.ArticleID && t.TagID == targetID })
+			if !tagged {
+				db.articleTags.put(db.articleTags.nextID(), articleTag{ArticleID: at.ArticleID, TagID: targetID})
+			}
+		}
+
+		// 关注 - 已经关注target的用户删除对source的关注
+		for _, f := range db.tagFollows.find(func(f TagFollow) bool { return f.TagID == sourceID }) {
+			following := db.tagFollows.exists(func(t TagFollow) bool { return t.UserID == f.UserID && t.TagID == targetID })
+			if !following {
+				f.TagID = targetID
+				db.tagFollows.put(f.ID, f)
+			}
+		}
+
+		// 别名 - source的别名和名称都指向target
+		db.tagAliases.update(func(a TagAlias) bool { return a.TagID == sourceID }, func(a *TagAlias) { a.TagID = targetID })
+		db.addAlias(targetID, source.Name)
+		db.deleteTag(sourceID)
+		return nil
+	})
+}
+
+func (tr *memoryTagRepo) DeleteTag(ctx context.Context, tagID uint) error {
+	return tr.mem.run(ctx, func(db *memoryDB) error {
+		db.deleteTag(tagID)
+		return nil
+	})
+}
+
+// 对应deleteTag, 删除标签和它的文章关联, 关注和别名
+func (db *memoryDB) deleteTag(tagID uint) {
+	db.articleTags.delete(func(at articleTag) bool { return at.TagID == tagID })
+	db.tagFollows.delete(func(f TagFollow) bool { return f.TagID == tagID })
+	db.tagAliases.delete(func(a TagAlias) bool { return a.TagID == tagID })
+	db.tags.delete(func(t Tag) bool { return t.ID == tagID })
+}
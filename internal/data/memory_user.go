@@ -0,0 +1,788 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"kratos-realworld/internal/biz"
+	"kratos-realworld/internal/pkg/middleware/auth"
+)
+
+// UserRepo的内存实现
+type memoryUserRepo struct {
+	mem *memoryStore
+}
+
+func (r *memoryUserRepo) CreateUser(ctx context.Context, user *biz.User) error {
+	return r.mem.run(ctx, func(db *memoryDB) error {
+		if db.users.exists(func(u User) bool { return u.Username == user.Username }) {
+			return biz.TakenError("username")
+		}
+		if db.users.exists(func(u User) bool { return u.Email == user.Email }) {
+			return biz.TakenError("email")
+		}
+		u := User{
+			Model:        newModel(db.users),
+			Email:        user.Email,
+			Username:     user.Username,
+			Bio:          user.Bio,
+			Image:        user.Image,
+			PasswordHash: user.PasswordHash,
+		}
+		db.users.put(u.ID, u)
+		user.ID = u.ID
+		return nil
+	})
+}
+
+func (r *memoryUserRepo) GetUserByEmail(ctx context.Context, email string) (*biz.User, error) {
+	var user *biz.User
+	err := r.mem.run(ctx, func(db *memoryDB) error {
+		u, ok := db.users.first(func(u User) bool { return u.Email == email })
+		if !ok {
+			return biz.ErrUserNotFound
+		}
+		user = &biz.User{
+			ID:           u.ID,
+			Email:        u.Email,
+			Username:     u.Username,
+			Bio:          u.Bio,
+			Image:        u.Image,
+			PasswordHash: u.PasswordHash,
+			Private:      u.Private,
+		}
+		return nil
+	})
+	return user, err
+}
+
+func (r *memoryUserRepo) GetUserByUsername(ctx context.Context, username string) (*biz.User, error) {
+	var user *biz.User
+	err := r.mem.run(ctx, func(db *memoryDB) error {
+		u, ok := db.users.first(func(u User) bool { return u.Username == username && u.AnonymizedAt == nil })
+		if !ok {
+			return biz.ErrUserNotFound
+		}
+		user = &biz.User{
+			ID:       u.ID,
+			Email:    u.Email,
+			Username: u.Username,
+			Bio:      u.Bio,
+			Image:    u.Image,
+			Private:  u.Private,
+		}
+		return nil
+	})
+	return user, err
+}
+
+func (r *memoryUserRepo) GetUserByID(ctx context.Context, uid uint) (*biz.User, error) {
+	var user *biz.User
+	err := r.mem.run(ctx, func(db *memoryDB) error {
+		u, ok := db.users.get(uid)
+		if !ok || u.AnonymizedAt != nil {
+			return biz.ErrUserNotFound
+		}
+		user = &biz.User{
+			ID:       u.ID,
+			Email:    u.Email,
+			Username: u.Username,
+			Bio:      u.Bio,
+			Image:    u.Image,
+			Private:  u.Private,
+		}
+		return nil
+	})
+	return user, err
+}
+
+// 和gorm的Updates一样忽略零值, private总是更新
+func (r *memoryUserRepo) UpdateUser(ctx context.Context, user *biz.User) (*biz.User, error) {
+	var updated *biz.User
+	err := r.mem.run(ctx, func(db *memoryDB) error {
+		u, ok := db.users.get(user.ID)
+		if !ok {
+			return biz.ErrUserNotFound
+		}
+		if user.Username != "" && db.users.exists(func(o User) bool { return o.ID != u.ID && o.Username == user.Username }) {
+			return biz.TakenError("username")
+		}
+		if user.Email != "" && db.users.exists(func(o User) bool { return o.ID != u.ID && o.Email == user.Email }) {
+			return biz.TakenError("email")
+		}
+		if user.Email != "" {
+			u.Email = user.Email
+		}
+		if user.Username != "" {
+			u.Username = user.Username
+		}
+		if user.Bio != "" {
+			u.Bio = user.Bio
+		}
+		if user.Image != "" {
+			u.Image = user.Image
+		}
+		if user.PasswordHash != "" {
+			u.PasswordHash = user.PasswordHash
+		}
+		u.Private = user.Private
+		u.UpdatedAt = time.Now()
+		db.users.put(u.ID, u)
+		updated = &biz.User{
+			ID:           u.ID,
+			Email:        u.Email,
+			Username:     u.Username,
+			Bio:          u.Bio,
+			Image:        u.Image,
+			PasswordHash: u.PasswordHash,
+			Private:      u.Private,
+		}
+		return nil
+	})
+	return updated, err
+}
+
+func (r *memoryUserRepo) UpdatePasswordHash(ctx context.Context, uid uint, hash string) error {
+	return r.mem.run(ctx, func(db *memoryDB) error {
+		db.users.update(func(u User) bool { return u.ID == uid }, func(u *User) { u.PasswordHash = hash })
+		return nil
+	})
+}
+
+func (r *memoryUserRepo) AnonymizeUser(ctx context.Context, uid uint) error {
+	return r.mem.transaction(ctx, func(ctx context.Context) error {
+		return r.mem.run(ctx, func(db *memoryDB) error {
+			db.deleteUserRelations(uid)
+			now := time.Now()
+			n := db.users.update(func(u User) bool { return u.ID == uid && u.AnonymizedAt == nil }, func(u *User) {
+				u.Email = fmt.Sprintf("deleted-%d@deleted.invalid", uid)
+				u.Username = fmt.Sprintf("deleted-user-%d", uid)
+				u.Bio = ""
+				u.Image = ""
+				u.PasswordHash = ""
+				u.AnonymizedAt = &now
+				u.Private = false
+				u.FollowersCount = 0
+				u.FollowingCount = 0
+				u.UpdatedAt = now
+			})
+			if n == 0 {
+				return biz.ErrUserNotFound
+			}
+			return nil
+		})
+	})
+}
+
+func (r *memoryUserRepo) DeleteUser(ctx context.Context, uid uint, placeholderUsername string) error {
+	return r.mem.transaction(ctx, func(ctx context.Context) error {
+		return r.mem.run(ctx, func(db *memoryDB) error {
+			db.deleteUserRelations(uid)
+
+			placeholder, ok := db.users.first(func(u User) bool { return u.Username == placeholderUsername })
+			if !ok {
+				placeholder = User{
+					Model:    newModel(db.users),
+					Username: placeholderUsername,
+					Email:    placeholderUsername + "@deleted.invalid",
+				}
+				db.users.put(placeholder.ID, placeholder)
+			}
+			if placeholder.ID == uid {
+				return biz.ErrPlaceholderUser
+			}
+			if placeholder.PasswordHash != "" || placeholder.AnonymizedAt != nil {
+				return biz.ErrPlaceholderTaken
+			}
+
+			moved := db.articles.update(func(a Article) bool { return !a.DeletedAt.Valid && a.AuthorID == uid }, func(a *Article) {
+				a.AuthorID = placeholder.ID
+			})
+			db.users.update(func(u User) bool { return u.ID == placeholder.ID }, func(u *User) {
+				u.ArticlesCount += uint32(moved)
+			})
+			db.comments.update(func(c Comment) bool { return c.AuthorID == uid }, func(c *Comment) {
+				c.AuthorID = placeholder.ID
+			})
+
+			if db.users.delete(func(u User) bool { return u.ID == uid }) == 0 {
+				return biz.ErrUserNotFound
+			}
+			return nil
+		})
+	})
+}
+
+func (r *memoryUserRepo) ExportUser(ctx context.Context, uid uint) (*biz.UserExport, error) {
+	var export *biz.UserExport
+	err := r.mem.run(ctx, func(db *memoryDB) error {
+		u, ok := db.users.get(uid)
+		if !ok || u.AnonymizedAt != nil {
+			return biz.ErrUserNotFound
+		}
+
+		articles := db.findArticles(func(a Article) bool { return a.AuthorID == uid })
+		sortByCreatedAt(articles, func(a Article) time.Time { return a.CreatedAt })
+
+		comments := db.comments.find(func(c Comment) bool { return c.AuthorID == uid })
+		sortByCreatedAt(comments, func(c Comment) time.Time { return c.CreatedAt })
+		commentList := make([]*biz.Comment, len(comments))
+		for i, c := range comments {
+			a, _ := db.firstArticle(func(a Article) bool { return a.ID == c.ArticleID })
+			commentList[i] = &biz.Comment{
+				ID:          c.ID,
+				Body:        c.Body,
+				CreatedAt:   c.CreatedAt,
+				UpdatedAt:   c.UpdatedAt,
+				AuthorID:    c.AuthorID,
+				ArticleID:   c.ArticleID,
+				ArticleSlug: a.Slug,
+			}
+		}
+
+		// 和gorm的JOIN一样, 收藏的文章被删除后仍然导出
+		var favorites []*biz.ExportFavorite
+		favoriteRows := db.favorites.find(func(f ArticleFavorite) bool { return f.UserID == uid })
+		sortByCreatedAt(favoriteRows, func(f ArticleFavorite) time.Time { return f.CreatedAt })
+		for _, f := range favoriteRows {
+			if a, ok := db.articles.get(f.ArticleID); ok {
+				favorites = append(favorites, &biz.ExportFavorite{Slug: a.Slug, CreatedAt: f.CreatedAt})
+			}
+		}
+
+		export = &biz.UserExport{
+			User: &biz.User{
+				ID:       u.ID,
+				Email:    u.Email,
+				Username: u.Username,
+				Bio:      u.Bio,
+				Image:    u.Image,
+			},
+			CreatedAt: u.CreatedAt,
+			Articles:  db.convertArticles(articles),
+			Comments:  commentList,
+			Favorites: favorites,
+		}
+		// 对方用户按关系建立的时间排序
+		relation := func(list *[]*biz.ExportFollow, otherID uint, createdAt time.Time) {
+			if other, ok := db.users.get(otherID); ok {
+				*list = append(*list, &biz.ExportFollow{Username: other.Username, CreatedAt: createdAt})
+			}
+		}
+		follows := db.follows.find(func(f Follow) bool { return f.FollowerID == uid || f.FollowingID == uid })
+		sortByCreatedAt(follows, func(f Follow) time.Time { return f.CreatedAt })
+		for _, f := range follows {
+			if f.FollowerID == uid {
+				relation(&export.Following, f.FollowingID, f.CreatedAt)
+			}
+			if f.FollowingID == uid {
+				relation(&export.Followers, f.FollowerID, f.CreatedAt)
+			}
+		}
+		requests := db.followRequests.find(func(r FollowRequest) bool { return r.RequesterID == uid || r.TargetID == uid })
+		sortByCreatedAt(requests, func(r FollowRequest) time.Time { return r.CreatedAt })
+		for _, r := range requests {
+			if r.RequesterID == uid {
+				relation(&export.FollowRequestsSent, r.TargetID, r.CreatedAt)
+			} else {
+				relation(&export.FollowRequestsReceived, r.RequesterID, r.CreatedAt)
+			}
+		}
+		blocks := db.blocks.find(func(b Block) bool { return b.BlockerID == uid })
+		sortByCreatedAt(blocks, func(b Block) time.Time { return b.CreatedAt })
+		for _, b := range blocks {
+			relation(&export.Blocked, b.BlockedID, b.CreatedAt)
+		}
+		mutes := db.mutes.find(func(m Mute) bool { return m.MuterID == uid })
+		sortByCreatedAt(mutes, func(m Mute) time.Time { return m.CreatedAt })
+		for _, m := range mutes {
+			relation(&export.Muted, m.MutedID, m.CreatedAt)
+		}
+
+		attachments := db.attachments.find(func(a Attachment) bool { return a.UserID == uid })
+		sortByCreatedAt(attachments, func(a Attachment) time.Time { return a.CreatedAt })
+		export.Attachments = convertAttachments(attachments)
+
+		collections := db.collections.find(func(c BookmarkCollection) bool { return c.UserID == uid })
+		sortByCreatedAt(collections, func(c BookmarkCollection) time.Time { return c.CreatedAt })
+		names := make(map[uint]string, len(collections))
+		counts := make(map[uint]uint32, len(collections))
+		for _, c := range collections {
+			names[c.ID] = c.Name
+		}
+		bookmarks := db.bookmarks.find(func(b Bookmark) bool { return b.UserID == uid })
+		sortByCreatedAt(bookmarks, func(b Bookmark) time.Time { return b.CreatedAt })
+		for _, b := range bookmarks {
+			if a, ok := db.articles.get(b.ArticleID); ok {
+				counts[b.CollectionID]++
+				export.Bookmarks = append(export.Bookmarks, &biz.ExportBookmark{Slug: a.Slug, Collection: names[b.CollectionID], CreatedAt: b.CreatedAt})
+			}
+		}
+		for _, c := range collections {
+			export.BookmarkCollections = append(export.BookmarkCollections, convertCollection(c, counts[c.ID]))
+		}
+
+		reactions := db.reactions.find(func(r Reaction) bool { return r.UserID == uid })
+		sortByCreatedAt(reactions, func(r Reaction) time.Time { return r.CreatedAt })
+		for _, r := range reactions {
+			export.Reactions = append(export.Reactions, &biz.ExportReaction{TargetType: r.TargetType, TargetID: r.TargetID, Reaction: r.Reaction, CreatedAt: r.CreatedAt})
+		}
+
+		tagFollows := db.tagFollows.find(func(f TagFollow) bool { return f.UserID == uid })
+		sortByCreatedAt(tagFollows, func(f TagFollow) time.Time { return f.CreatedAt })
+		for _, f := range tagFollows {
+			if tag, ok := db.tags.get(f.TagID); ok {
+				export.FollowedTags = append(export.FollowedTags, &biz.ExportTag{Name: tag.Name, CreatedAt: f.CreatedAt})
+			}
+		}
+
+		notifications := db.notifications.find(func(n Notification) bool { return n.UserID == uid })
+		sort.Slice(notifications, func(i, j int) bool { return notifications[i].ID < notifications[j].ID })
+		for _, n := range notifications {
+			actors := db.notificationActors.find(func(a NotificationActor) bool { return a.NotificationID == n.ID })
+			sort.Slice(actors, func(i, j int) bool { return actors[i].ID < actors[j].ID })
+			var usernames []string
+			for _, a := range actors {
+				if actor, ok := db.users.get(a.ActorID); ok {
+					usernames = append(usernames, actor.Username)
+				}
+			}
+			var slug string
+			if a, ok := db.articles.get(n.ArticleID); ok {
+				slug = a.Slug
+			}
+			export.Notifications = append(export.Notifications, &biz.ExportNotification{
+				Type:        n.Type,
+				ArticleSlug: slug,
+				CommentID:   n.CommentID,
+				Actors:      usernames,
+				Read:        n.ReadAt != nil,
+				CreatedAt:   n.CreatedAt,
+			})
+		}
+		export.NotificationPreferences = make(map[string]bool)
+		for _, p := range db.notificationPreferences.find(func(p NotificationPreference) bool { return p.UserID == uid }) {
+			export.NotificationPreferences[p.Type] = p.Enabled
+		}
+		return nil
+	})
+	return export, err
+}
+
+// ProfileRepo的内存实现
+type memoryProfileRepo struct {
+	mem *memoryStore
+}
+
+func (p *memoryProfileRepo) GetProfileByUsername(ctx context.Context, username string) (*biz.ProfileResp, error) {
+	var profile *biz.ProfileResp
+	err := p.mem.run(ctx, func(db *memoryDB) error {
+		u, ok := db.users.first(func(u User) bool { return u.Username == username && u.AnonymizedAt == nil })
+		if !ok {
+			return biz.ErrUserNotFound
+		}
+		profile = convertProfile(u)
+		if currentUser, ok := auth.FromContext(ctx); ok {
+			profile.Following = db.isFollowing(currentUser.UserID, u.ID)
+			profile.FollowRequested = u.Private && !profile.Following && db.followRequests.exists(func(r FollowRequest) bool {
+				return r.RequesterID == currentUser.UserID && r.TargetID == u.ID
+			})
+		}
+		return nil
+	})
+	return profile, err
+}
+
+func (p *memoryProfileRepo) FollowUserByUsername(ctx context.Context, currentUserID uint, followingUserID uint) error {
+	return p.mem.run(ctx, func(db *memoryDB) error {
+		if db.isFollowing(currentUserID, followingUserID) {
+			return biz.ErrFollowExists
+		}
+		f := Follow{Model: newModel(db.follows), FollowerID: currentUserID, FollowingID: followingUserID}
+		db.follows.put(f.ID, f)
+		db.adjustFollowCounts(currentUserID, followingUserID, true)
+		return nil
+	})
+}
+
+func (p *memoryProfileRepo) UnfollowUserByUsername(ctx context.Context, currentUserID uint, followingUserID uint) error {
+	return p.mem.run(ctx, func(db *memoryDB) error {
+		n := db.follows.delete(func(f Follow) bool { return f.FollowerID == currentUserID && f.FollowingID == followingUserID })
+		if n == 0 {
+			return biz.ErrFollowNotFound
+		}
+		db.adjustFollowCounts(currentUserID, followingUserID, false)
+		return nil
+	})
+}
+
+func (p *memoryProfileRepo) ListFollowers(ctx context.Context, uid uint, cursor uint, limit int) ([]*biz.ProfileResp, uint, error) {
+	return p.listRelations(ctx, cursor, limit, func(db *memoryDB) []memoryRelation {
+		var relations []memoryRelation
+		for _, f := range db.follows.find(func(f Follow) bool { return f.FollowingID == uid }) {
+			relations = append(relations, memoryRelation{f.ID, f.FollowerID})
+		}
+		return relations
+	})
+}
+
+func (p *memoryProfileRepo) ListFollowing(ctx context.Context, uid uint, cursor uint, limit int) ([]*biz.ProfileResp, uint, error) {
+	return p.listRelations(ctx, cursor, limit, func(db *memoryDB) []memoryRelation {
+		var relations []memoryRelation
+		for _, f := range db.follows.find(func(f Follow) bool { return f.FollowerID == uid }) {
+			relations = append(relations, memoryRelation{f.ID, f.FollowingID})
+		}
+		return relations
+	})
+}
+
+func (p *memoryProfileRepo) listRelations(ctx context.Context, cursor uint, limit int, relations func(db *memoryDB) []memoryRelation) ([]*biz.ProfileResp, uint, error) {
+	var profiles []*biz.ProfileResp
+	var next uint
+	err := p.mem.run(ctx, func(db *memoryDB) error {
+		profiles, next = db.listRelations(relations(db), cursor, limit)
+		return nil
+	})
+	return profiles, next, err
+}
+
+func (p *memoryProfileRepo) GetFollowingMap(ctx context.Context, uid uint, uids []uint) (map[uint]bool, error) {
+	var result map[uint]bool
+	err := p.mem.run(ctx, func(db *memoryDB) error {
+		result = db.followingMap(uid, uids)
+		return nil
+	})
+	return result, err
+}
+
+func (p *memoryProfileRepo) BlockUser(ctx context.Context, uid uint, targetID uint) error {
+	return p.mem.run(ctx, func(db *memoryDB) error {
+		if db.blocks.exists(func(b Block) bool { return b.BlockerID == uid && b.BlockedID == targetID }) {
+			return biz.ErrBlockExists
+		}
+		b := Block{Model: newModel(db.blocks), BlockerID: uid, BlockedID: targetID}
+		db.blocks.put(b.ID, b)
+		db.followRequests.delete(func(r FollowRequest) bool {
+			return (r.RequesterID == uid && r.TargetID == targetID) || (r.RequesterID == targetID && r.TargetID == uid)
+		})
+		for _, pair := range [][2]uint{{uid, targetID}, {targetID, uid}} {
+			n := db.follows.delete(func(f Follow) bool { return f.FollowerID == pair[0] && f.FollowingID == pair[1] })
+			if n > 0 {
+				db.adjustFollowCounts(pair[0], pair[1], false)
+			}
+		}
+		return nil
+	})
+}
+
+func (p *memoryProfileRepo) UnblockUser(ctx context.Context, uid uint, targetID uint) error {
+	return p.mem.run(ctx, func(db *memoryDB) error {
+		if db.blocks.delete(func(b Block) bool { return b.BlockerID == uid && b.BlockedID == targetID }) == 0 {
+			return biz.ErrBlockNotFound
+		}
+		return nil
+	})
+}
+
+func (p *memoryProfileRepo) MuteUser(ctx context.Context, uid uint, targetID uint) error {
+	return p.mem.run(ctx, func(db *memoryDB) error {
+		if db.isMuted(uid, targetID) {
+			return biz.ErrMuteExists
+		}
+		m := Mute{Model: newModel(db.mutes), MuterID: uid, MutedID: targetID}
+		db.mutes.put(m.ID, m)
+		return nil
+	})
+}
+
+func (p *memoryProfileRepo) UnmuteUser(ctx context.Context, uid uint, targetID uint) error {
+	return p.mem.run(ctx, func(db *memoryDB) error {
+		if db.mutes.delete(func(m Mute) bool { return m.MuterID == uid && m.MutedID == targetID }) == 0 {
+			return biz.ErrMuteNotFound
+		}
+		return nil
+	})
+}
+
+func (p *memoryProfileRepo) ListBlockedUsers(ctx context.Context, uid uint, cursor uint, limit int) ([]*biz.ProfileResp, uint, error) {
+	return p.listRelations(ctx, cursor, limit, func(db *memoryDB) []memoryRelation {
+		var relations []memoryRelation
+		for _, b := range db.blocks.find(func(b Block) bool { return b.BlockerID == uid }) {
+			relations = append(relations, memoryRelation{b.ID, b.BlockedID})
+		}
+		return relations
+	})
+}
+
+func (p *memoryProfileRepo) ListMutedUsers(ctx context.Context, uid uint, cursor uint, limit int) ([]*biz.ProfileResp, uint, error) {
+	return p.listRelations(ctx, cursor, limit, func(db *memoryDB) []memoryRelation {
+		var relations []memoryRelation
+		for _, m := range db.mutes.find(func(m Mute) bool { return m.MuterID == uid }) {
+			relations = append(relations, memoryRelation{m.ID, m.MutedID})
+		}
+		return relations
+	})
+}
+
+func (p *memoryProfileRepo) IsBlocked(ctx context.Context, uid uint, otherID uint) (bool, error) {
+	if uid == 0 || otherID == 0 || uid == otherID {
+		return false, nil
+	}
+	var blocked bool
+	err := p.mem.run(ctx, func(db *memoryDB) error {
+		blocked = db.isBlocked(uid, otherID)
+		return nil
+	})
+	return blocked, err
+}
+
+func (p *memoryProfileRepo) IsMuted(ctx context.Context, uid uint, targetID uint) (bool, error) {
+	var muted bool
+	err := p.mem.run(ctx, func(db *memoryDB) error {
+		muted = db.isMuted(uid, targetID)
+		return nil
+	})
+	return muted, err
+}
+
+func (p *memoryProfileRepo) CreateFollowRequest(ctx context.Context, uid uint, targetID uint) error {
+	return p.mem.run(ctx, func(db *memoryDB) error {
+		if db.isFollowing(uid, targetID) {
+			return biz.ErrFollowExists
+		}
+		if db.followRequests.exists(func(r FollowRequest) bool { return r.RequesterID == uid && r.TargetID == targetID }) {
+			return biz.ErrFollowRequestExists
+		}
+		fr := FollowRequest{Model: newModel(db.followRequests), RequesterID: uid, TargetID: targetID}
+		db.followRequests.put(fr.ID, fr)
+		return nil
+	})
+}
+
+func (p *memoryProfileRepo) ApproveFollowRequest(ctx context.Context, requesterID uint, targetID uint) error {
+	return p.mem.run(ctx, func(db *memoryDB) error {
+		n := db.followRequests.delete(func(r FollowRequest) bool { return r.RequesterID == requesterID && r.TargetID == targetID })
+		if n == 0 {
+			return biz.ErrFollowRequestNotFound
+		}
+		db.approveFollow(requesterID, targetID)
+		return nil
+	})
+}
+
+func (p *memoryProfileRepo) DeleteFollowRequest(ctx context.Context, requesterID uint, targetID uint) error {
+	return p.mem.run(ctx, func(db *memoryDB) error {
+		n := db.followRequests.delete(func(r FollowRequest) bool { return r.RequesterID == requesterID && r.TargetID == targetID })
+		if n == 0 {
+			return biz.ErrFollowRequestNotFound
+		}
+		return nil
+	})
+}
+
+func (p *memoryProfileRepo) ApproveAllFollowRequests(ctx context.Context, targetID uint) ([]uint, error) {
+	var approved []uint
+	err := p.mem.run(ctx, func(db *memoryDB) error {
+		requests := db.followRequests.find(func(r FollowRequest) bool { return r.TargetID == targetID })
+		db.followRequests.delete(func(r FollowRequest) bool { return r.TargetID == targetID })
+		for _, r := range requests {
+			if db.approveFollow(r.RequesterID, targetID) {
+				approved = append(approved, r.RequesterID)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return approved, nil
+}
+
+// 对应approveFollow, 已经关注的情况直接跳过 - 返回是否新建了关注
+func (db *memoryDB) approveFollow(followerID uint, followingID uint) bool {
+	if db.isFollowing(followerID, followingID) {
+		return false
+	}
+	f := Follow{Model: newModel(db.follows), FollowerID: followerID, FollowingID: followingID}
+	db.follows.put(f.ID, f)
+	db.adjustFollowCounts(followerID, followingID, true)
+	return true
+}
+
+func (p *memoryProfileRepo) ListIncomingFollowRequests(ctx context.Context, uid uint, cursor uint, limit int) ([]*biz.ProfileResp, uint, error) {
+	return p.listRelations(ctx, cursor, limit, func(db *memoryDB) []memoryRelation {
+		var relations []memoryRelation
+		for _, r := range db.followRequests.find(func(r FollowRequest) bool { return r.TargetID == uid }) {
+			relations = append(relations, memoryRelation{r.ID, r.RequesterID})
+		}
+		return relations
+	})
+}
+
+func (p *memoryProfileRepo) ListOutgoingFollowRequests(ctx context.Context, uid uint, cursor uint, limit int) ([]*biz.ProfileResp, uint, error) {
+	return p.listRelations(ctx, cursor, limit, func(db *memoryDB) []memoryRelation {
+		var relations []memoryRelation
+		for _, r := range db.followRequests.find(func(r FollowRequest) bool { return r.RequesterID == uid }) {
+			relations = append(relations, memoryRelation{r.ID, r.TargetID})
+		}
+		return relations
+	})
+}
+
+// 排序和gorm实现相同: 完全匹配 > username前缀 > username包含 > 只有bio包含, 同一档按粉丝数排序
+func (p *memoryProfileRepo) SearchProfiles(ctx context.Context, viewerUid uint, query string, offset uint, limit int) ([]*biz.ProfileResp, uint, error) {
+	q := strings.ToLower(query)
+	rank := func(u User) int {
+		username := strings.ToLower(u.Username)
+		switch {
+		case username == q:
+			return 0
+		case strings.HasPrefix(username, q):
+			return 1
+		case strings.Contains(username, q):
+			return 2
+		default:
+			return 3
+		}
+	}
+
+	var users []User
+	err := p.mem.run(ctx, func(db *memoryDB) error {
+		users = db.users.find(func(u User) bool {
+			if !strings.Contains(strings.ToLower(u.Username), q) && !strings.Contains(strings.ToLower(u.Bio), q) {
+				return false
+			}
+			if u.AnonymizedAt != nil || u.PasswordHash == "" {
+				return false
+			}
+			return viewerUid == 0 || !db.isBlocked(viewerUid, u.ID)
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	sort.SliceStable(users, func(i, j int) bool {
+		if ri, rj := rank(users[i]), rank(users[j]); ri != rj {
+			return ri < rj
+		}
+		return users[i].FollowersCount > users[j].FollowersCount
+	})
+
+	if int(offset) >= len(users) {
+		return []*biz.ProfileResp{}, 0, nil
+	}
+	users = users[offset:]
+	var next uint
+	if len(users) > limit {
+		users = users[:limit]
+		next = offset + uint(limit)
+	}
+	profiles := make([]*biz.ProfileResp, len(users))
+	for i, u := range users {
+		profiles[i] = convertProfile(u)
+	}
+	return profiles, next, nil
+}
+
+// 三项信号分别计算, 每项按分数取前limit个候选人后合并
+func (p *memoryProfileRepo) GetSuggestionSignals(ctx context.Context, uid uint, since time.Time, limit int) ([]*biz.SuggestionSignal, error) {
+	signals := make(map[uint]*biz.SuggestionSignal)
+	signal := func(id uint) *biz.SuggestionSignal {
+		s, ok := signals[id]
+		if !ok {
+			s = &biz.SuggestionSignal{UserID: id}
+			signals[id] = s
+		}
+		return s
+	}
+	// 按分数倒序取前limit个, 分数相同时按id
+	top := func(scores map[uint]int, set func(s *biz.SuggestionSignal, score int)) {
+		ids := make([]uint, 0, len(scores))
+		for id := range scores {
+			ids = append(ids, id)
+		}
+		sort.Slice(ids, func(i, j int) bool {
+			if scores[ids[i]] != scores[ids[j]] {
+				return scores[ids[i]] > scores[ids[j]]
+			}
+			return ids[i] < ids[j]
+		})
+		if len(ids) > limit {
+			ids = ids[:limit]
+		}
+		for _, id := range ids {
+			set(signal(id), scores[id])
+		}
+	}
+
+	err := p.mem.run(ctx, func(db *memoryDB) error {
+		// 关注的人的关注
+		mutual := make(map[uint]int)
+		for _, f1 := range db.follows.find(func(f Follow) bool { return f.FollowerID == uid }) {
+			for _, f2 := range db.follows.find(func(f Follow) bool { return f.FollowerID == f1.FollowingID }) {
+				if !db.excludedForSuggestion(f2.FollowingID, uid) {
+					mutual[f2.FollowingID]++
+				}
+			}
+		}
+		top(mutual, func(s *biz.SuggestionSignal, score int) { s.MutualFollows = score })
+
+		// 文章的tag和自己收藏过的文章的tag相同
+		favoriteTags := make(map[uint]bool)
+		for _, f := range db.favorites.find(func(f ArticleFavorite) bool { return f.UserID == uid }) {
+			for _, at := range db.articleTags.find(func(at articleTag) bool { return at.ArticleID == f.ArticleID }) {
+				favoriteTags[at.TagID] = true
+			}
+		}
+		authorTags := make(map[uint]map[uint]bool)
+		for _, a := range db.findArticles(func(a Article) bool { return !db.excludedForSuggestion(a.AuthorID, uid) }) {
+			for _, at := range db.articleTags.find(func(at articleTag) bool { return at.ArticleID == a.ID }) {
+				if !favoriteTags[at.TagID] {
+					continue
+				}
+				if authorTags[a.AuthorID] == nil {
+					authorTags[a.AuthorID] = make(map[uint]bool)
+				}
+				authorTags[a.AuthorID][at.TagID] = true
+			}
+		}
+		shared := make(map[uint]int, len(authorTags))
+		for id, tags := range authorTags {
+			shared[id] = len(tags)
+		}
+		top(shared, func(s *biz.SuggestionSignal, score int) { s.SharedTags = score })
+
+		// 最近活跃 - since之后发布的文章数
+		recent := make(map[uint]int)
+		for _, a := range db.findArticles(func(a Article) bool {
+			return !a.CreatedAt.Before(since) && !db.excludedForSuggestion(a.AuthorID, uid)
+		}) {
+			recent[a.AuthorID]++
+		}
+		top(recent, func(s *biz.SuggestionSignal, score int) { s.RecentArticles = score })
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*biz.SuggestionSignal, 0, len(signals))
+	for _, s := range signals {
+		result = append(result, s)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].UserID < result[j].UserID })
+	return result, nil
+}
+
+func (p *memoryProfileRepo) GetProfilesByIDs(ctx context.Context, uids []uint) ([]*biz.ProfileResp, error) {
+	var profiles []*biz.ProfileResp
+	err := p.mem.run(ctx, func(db *memoryDB) error {
+		profiles = db.profilesByIDs(uids)
+		return nil
+	})
+	return profiles, err
+}
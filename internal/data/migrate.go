@@ -0,0 +1,400 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+// 数据库迁移 - 每个驱动一个目录, 文件名为<版本>_<名称>.up.sql和<版本>_<名称>.down.sql
+// 每条语句以行尾的分号结束, --开头的行是注释
+
+//go:embed migrations
+var migrationFS embed.FS
+
+const (
+	migrationsDir = "migrations"
+	// mysql的GET_LOCK和postgres的pg_advisory_lock使用的锁
+	migrationLockName = "realworld_schema_migrations"
+	migrationLockID   = 7239461805
+	migrationLockWait = time.Minute
+)
+
+var migrationFileRe = regexp.MustCompile(`^(\d+)_([a-z0-9_]+)\.(up|down)\.sql$`)
+
+// 已执行的迁移
+type SchemaMigration struct {
+	Version   uint   `gorm:"primaryKey;autoIncrement:false"`
+	Name      string `gorm:"size:255"`
+	AppliedAt time.Time
+}
+
+type migration struct {
+	Version uint
+	Name    string
+	Up      string
+	Down    string
+}
+
+// 迁移的执行状态, AppliedAt为nil表示还没有执行
+// Baseline表示库由旧版本AutoMigrate创建, 下次migrate up时补齐表结构后直接记为已执行
+type MigrationStatus struct {
+	Version   uint
+	Name      string
+	AppliedAt *time.Time
+	Baseline  bool
+}
+
+type Migrator struct {
+	db         *gorm.DB
+	migrations []*migration
+	log        *log.Helper
+}
+
+// 加载当前驱动的迁移文件
+func NewMigrator(db *gorm.DB, logger log.Logger) (*Migrator, error) {
+	migrations, err := loadMigrations(migrationFS, path.Join(migrationsDir, db.Dialector.Name()))
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{db: db, migrations: migrations, log: log.NewHelper(logger)}, nil
+}
+
+func loadMigrations(fsys fs.FS, dir string) ([]*migration, error) {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return nil, err
+	}
+	byVersion := make(map[uint]*migration)
+	for _, entry := range entries {
+		m := migrationFileRe.FindStringSubmatch(entry.Name())
+		if m == nil {
+			continue
+		}
+		version, _ := strconv.ParseUint(m[1], 10, 64)
+		content, err := fs.ReadFile(fsys, path.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		mg, ok := byVersion[uint(version)]
+		if !ok {
+			mg = &migration{Version: uint(version), Name: m[2]}
+			byVersion[mg.Version] = mg
+		}
+		if mg.Name != m[2] {
+			return nil, fmt.Errorf("migration %d has different names: %s, %s", version, mg.Name, m[2])
+		}
+		if m[3] == "up" {
+			mg.Up = string(content)
+		} else {
+			mg.Down = string(content)
+		}
+	}
+
+	migrations := make([]*migration, 0, len(byVersion))
+	for _, mg := range byVersion {
+		if mg.Up == "" {
+			return nil, fmt.Errorf("migration %04d_%s has no up file", mg.Version, mg.Name)
+		}
+		migrations = append(migrations, mg)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	return migrations, nil
+}
+
+// 执行所有未执行的迁移, 返回本次执行的迁移
+func (m *Migrator) Up(ctx context.Context) ([]*MigrationStatus, error) {
+	var done []*MigrationStatus
+	err := m.withLock(ctx, func(db *gorm.DB) error {
+		applied, err := m.applied(db)
+		if err != nil {
+			return err
+		}
+		for _, mg := range m.migrations {
+			if _, ok := applied[mg.Version]; ok {
+				continue
+			}
+			m.log.Infof("applying migration %04d_%s", mg.Version, mg.Name)
+			now := time.Now()
+			err := runMigration(db, mg, mg.Up, "up", func(tx *gorm.DB) error {
+				return tx.Create(&SchemaMigration{Version: mg.Version, Name: mg.Name, AppliedAt: now}).Error
+			})
+			if err != nil {
+				return err
+			}
+			done = append(done, &MigrationStatus{Version: mg.Version, Name: mg.Name, AppliedAt: &now})
+		}
+		return nil
+	})
+	return done, err
+}
+
+// 按版本倒序回滚最近执行的steps个迁移, 返回回滚的迁移
+func (m *Migrator) Down(ctx context.Context, steps int) ([]*MigrationStatus, error) {
+	var done []*MigrationStatus
+	err := m.withLock(ctx, func(db *gorm.DB) error {
+		var records []SchemaMigration
+		if err := db.Order("version DESC").Limit(steps).Find(&records).Error; err != nil {
+			return err
+		}
+		for _, record := range records {
+			mg := m.find(record.Version)
+			if mg == nil || mg.Down == "" {
+				return fmt.Errorf("migration %04d_%s has no down file", record.Version, record.Name)
+			}
+			m.log.Infof("reverting migration %04d_%s", mg.Version, mg.Name)
+			err := runMigration(db, mg, mg.Down, "down", func(tx *gorm.DB) error {
+				return tx.Where("version = ?", mg.Version).Delete(&SchemaMigration{}).Error
+			})
+			if err != nil {
+				return err
+			}
+			done = append(done, &MigrationStatus{Version: mg.Version, Name: mg.Name})
+		}
+		return nil
+	})
+	return done, err
+}
+
+// 所有迁移文件的执行状态, 按版本排序
+// 只读: 不加锁, 也不创建schema_migrations或升级旧库, 这些留给migrate up
+func (m *Migrator) Status(ctx context.Context) ([]*MigrationStatus, error) {
+	db := m.db.WithContext(ctx)
+	applied := make(map[uint]time.Time)
+	legacy := false
+	if db.Migrator().HasTable(&SchemaMigration{}) {
+		var err error
+		if applied, err = m.records(db); err != nil {
+			return nil, err
+		}
+	} else {
+		legacy = db.Migrator().HasTable(&User{})
+	}
+
+	status := make([]*MigrationStatus, 0, len(m.migrations))
+	for i, mg := range m.migrations {
+		s := &MigrationStatus{Version: mg.Version, Name: mg.Name, Baseline: legacy && i == 0}
+		if at, ok := applied[mg.Version]; ok {
+			s.AppliedAt = &at
+		}
+		status = append(status, s)
+	}
+	return status, nil
+}
+
+func (m *Migrator) find(version uint) *migration {
+	for _, mg := range m.migrations {
+		if mg.Version == version {
+			return mg
+		}
+	}
+	return nil
+}
+
+// 执行一个迁移文件, 成功后由record更新schema_migrations
+// postgres和sqlite在同一个事务中执行, 失败时整体回滚
+// mysql的DDL会隐式提交, 事务无法回滚已经执行的语句, 所以逐条执行:
+// 失败时之前的语句已经生效且没有记录版本, 需要按对应的down文件手动撤销这些语句后再执行migrate up
+func runMigration(db *gorm.DB, mg *migration, content string, direction string, record func(tx *gorm.DB) error) error {
+	stmts := splitStatements(content)
+	if db.Dialector.Name() != "mysql" {
+		return db.Transaction(func(tx *gorm.DB) error {
+			for i, stmt := range stmts {
+				if err := tx.Exec(stmt).Error; err != nil {
+					return fmt.Errorf("migration %04d_%s (%s) statement %d: %w", mg.Version, mg.Name, direction, i+1, err)
+				}
+			}
+			return record(tx)
+		})
+	}
+	for i, stmt := range stmts {
+		if err := db.Exec(stmt).Error; err != nil {
+			return fmt.Errorf("migration %04d_%s (%s) statement %d of %d: %w; "+
+				"mysql DDL is not transactional, the first %d statements were committed and must be reverted by hand before retrying",
+				mg.Version, mg.Name, direction, i+1, len(stmts), err, i)
+		}
+	}
+	return record(db)
+}
+
+// 0001时的follows表, 唯一索引由0004在清理重复数据后添加
+type follow0001 struct {
+	gorm.Model
+	FollowerID  uint `gorm:"index"`
+	FollowingID uint `gorm:"index"`
+}
+
+func (follow0001) TableName() string {
+	return "follows"
+}
+
+// 版本化迁移之前启动时AutoMigrate的模型, 对应0001的表结构
+// 这些模型以后增加的列由新的迁移添加, 届时需要在这里换成0001时的结构
+func legacyModels() []interface{} {
+	return []interface{}{&User{}, &follow0001{}, &FollowRequest{}, &Block{}, &Mute{}, &Article{}, &Tag{}, &ArticleFavorite{}, &Comment{},
+		&Attachment{}, &Bookmark{}, &BookmarkCollection{}, &Reaction{}, &ReactionCount{}, &TagFollow{}, &TagAlias{}}
+}
+
+// 由AutoMigrate建表的旧库可能早于0001的表结构, 缺少后来加的表和列
+// 先补齐表结构, 计数列是新加的时根据现有数据回填, 然后才能记为已执行0001
+func (m *Migrator) upgradeLegacy(db *gorm.DB) error {
+	backfill := !db.Migrator().HasColumn(&User{}, "FollowersCount")
+	if err := db.AutoMigrate(legacyModels()...); err != nil {
+		return err
+	}
+	if backfill {
+		m.log.Infof("backfill user counters")
+		return recountUserStats(db)
+	}
+	return nil
+}
+
+// 根据follows和articles表重新计算用户的计数
+func recountUserStats(db *gorm.DB) error {
+	return db.Exec(`UPDATE users SET
+		followers_count = (SELECT COUNT(*) FROM follows WHERE follows.following_id = users.id AND follows.deleted_at IS NULL),
+		following_count = (SELECT COUNT(*) FROM follows WHERE follows.follower_id = users.id AND follows.deleted_at IS NULL),
+		articles_count = (SELECT COUNT(*) FROM articles WHERE articles.author_id = users.id AND articles.deleted_at IS NULL)`).Error
+}
+
+// 已执行的迁移和执行时间, 第一次执行时创建schema_migrations表
+func (m *Migrator) applied(db *gorm.DB) (map[uint]time.Time, error) {
+	if !db.Migrator().HasTable(&SchemaMigration{}) {
+		// 之前启动时由AutoMigrate建表的库, 补齐到0001的表结构后记为已执行
+		legacy := db.Migrator().HasTable(&User{})
+		if legacy {
+			if err := m.upgradeLegacy(db); err != nil {
+				return nil, fmt.Errorf("upgrade existing database: %w", err)
+			}
+		}
+		if err := db.Migrator().CreateTable(&SchemaMigration{}); err != nil {
+			return nil, err
+		}
+		if legacy && len(m.migrations) > 0 {
+			baseline := m.migrations[0]
+			m.log.Infof("baseline existing database at migration %04d_%s", baseline.Version, baseline.Name)
+			if err := db.Create(&SchemaMigration{Version: baseline.Version, Name: baseline.Name, AppliedAt: time.Now()}).Error; err != nil {
+				return nil, err
+			}
+		}
+	}
+	return m.records(db)
+}
+
+// schema_migrations中的版本和执行时间
+func (m *Migrator) records(db *gorm.DB) (map[uint]time.Time, error) {
+	var records []SchemaMigration
+	if err := db.Find(&records).Error; err != nil {
+		return nil, err
+	}
+	applied := make(map[uint]time.Time, len(records))
+	for _, record := range records {
+		applied[record.Version] = record.AppliedAt
+	}
+	return applied, nil
+}
+
+// 持有锁执行fn, 同时启动的多个实例中只有一个在迁移
+// 锁是会话级别的, fn中的语句都在加锁的连接上执行
+func (m *Migrator) withLock(ctx context.Context, fn func(db *gorm.DB) error) error {
+	return m.db.WithContext(ctx).Connection(func(conn *gorm.DB) error {
+		switch conn.Dialector.Name() {
+		case "mysql":
+			var locked sql.NullInt64
+			if err := conn.Raw("SELECT GET_LOCK(?, ?)", migrationLockName, int(migrationLockWait.Seconds())).Row().Scan(&locked); err != nil {
+				return err
+			}
+			if locked.Int64 != 1 {
+				return fmt.Errorf("timeout waiting for migration lock")
+			}
+			defer conn.Exec("SELECT RELEASE_LOCK(?)", migrationLockName)
+		case "postgres":
+			if err := conn.Exec("SELECT pg_advisory_lock(?)", migrationLockID).Error; err != nil {
+				return err
+			}
+			defer conn.Exec("SELECT pg_advisory_unlock(?)", migrationLockID)
+		}
+		// sqlite的写入本身是串行的, 不需要锁
+		return fn(conn.Session(&gorm.Session{}))
+	})
+}
+
+// 按行尾的分号拆分语句, 跳过空行和注释
+func splitStatements(content string) []string {
+	var stmts []string
+	var b strings.Builder
+	for _, line := range strings.Split(content, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "--") {
+			continue
+		}
+		b.WriteString(line)
+		b.WriteString("\n")
+		if strings.HasSuffix(trimmed, ";") {
+			stmts = append(stmts, strings.TrimSpace(b.String()))
+			b.Reset()
+		}
+	}
+	if rest := strings.TrimSpace(b.String()); rest != "" {
+		stmts = append(stmts, rest)
+	}
+	return stmts
+}
+
+var migrationNameRe = regexp.MustCompile(`[^a-z0-9]+`)
+
+// 在dir下每个驱动的目录中创建一对空的迁移文件, 版本号为已有的最大版本加一
+func CreateMigration(dir string, name string) ([]string, error) {
+	name = strings.Trim(migrationNameRe.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if name == "" {
+		return nil, fmt.Errorf("migration name is required")
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var drivers []string
+	var version uint
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		drivers = append(drivers, entry.Name())
+		migrations, err := loadMigrations(os.DirFS(dir), entry.Name())
+		if err != nil {
+			return nil, err
+		}
+		if n := len(migrations); n > 0 && migrations[n-1].Version > version {
+			version = migrations[n-1].Version
+		}
+	}
+	if len(drivers) == 0 {
+		return nil, fmt.Errorf("no driver directories in %s", dir)
+	}
+
+	var files []string
+	for _, driver := range drivers {
+		for _, direction := range []string{"up", "down"} {
+			file := filepath.Join(dir, driver, fmt.Sprintf("%04d_%s.%s.sql", version+1, name, direction))
+			if err := os.WriteFile(file, []byte("-- "+name+" ("+direction+")\n"), 0o644); err != nil {
+				return files, err
+			}
+			files = append(files, file)
+		}
+	}
+	return files, nil
+}
@@ -0,0 +1,177 @@
+package data
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"kratos-realworld/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-playground/assert/v2"
+	"gorm.io/gorm"
+)
+
+func openTestSqlite(t *testing.T) *gorm.DB {
+	db, err := OpenDB(&conf.Data{Database: &conf.Data_Database{Driver: "sqlite", Dsn: "file:" + t.Name() + "?mode=memory&cache=shared"}}, log.DefaultLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sqlDB, _ := db.DB()
+	t.Cleanup(func() { sqlDB.Close() })
+	return db
+}
+
+// 每个驱动的迁移版本必须一致
+func TestMigrationsForAllDrivers(t *testing.T) {
+	var versions []uint
+	for _, driver := range []string{"mysql", "postgres", "sqlite"} {
+		migrations, err := loadMigrations(migrationFS, "migrations/"+driver)
+		assert.Equal(t, nil, err)
+		var vs []uint
+		for _, mg := range migrations {
+			assert.NotEqual(t, "", mg.Down)
+			vs = append(vs, mg.Version)
+		}
+		if versions == nil {
+			versions = vs
+		}
+		assert.Equal(t, versions, vs)
+	}
+}
+
+func TestMigrateUpDown(t *testing.T) {
+	ctx := context.Background()
+	db := openTestSqlite(t)
+	m, err := NewMigrator(db, log.DefaultLogger)
+	assert.Equal(t, nil, err)
+
+	done, err := m.Up(ctx)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, len(m.migrations), len(done))
+	assert.Equal(t, true, db.Migrator().HasTable(&Article{}))
+	assert.Equal(t, true, db.Migrator().HasTable("article_tags"))
+	// 已经执行过的不再执行
+	done, err = m.Up(ctx)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 0, len(done))
+
+	done, err = m.Down(ctx, len(m.migrations))
+	assert.Equal(t, nil, err)
+	assert.Equal(t, len(m.migrations), len(done))
+	assert.Equal(t, false, db.Migrator().HasTable(&User{}))
+	status, err := m.Status(ctx)
+	assert.Equal(t, nil, err)
+	for _, s := range status {
+		assert.Equal(t, true, s.AppliedAt == nil)
+	}
+}
+
+// AutoMigrate建表的旧库补齐表结构和计数后记为初始版本
+func TestMigrateBaseline(t *testing.T) {
+	ctx := context.Background()
+	db := openTestSqlite(t)
+	// 加入计数和收藏夹之前的表结构
+	for _, stmt := range []string{
+		"CREATE TABLE users (id integer PRIMARY KEY AUTOINCREMENT, created_at datetime, updated_at datetime, deleted_at datetime, email text, username text, bio text, image text, password_hash text)",
+		"CREATE TABLE follows (id integer PRIMARY KEY AUTOINCREMENT, created_at datetime, updated_at datetime, deleted_at datetime, follower_id integer, following_id integer)",
+		"CREATE TABLE articles (id integer PRIMARY KEY AUTOINCREMENT, created_at datetime, updated_at datetime, deleted_at datetime, slug text, title text, description text, body text, author_id integer, favorites_count integer)",
+		"INSERT INTO users (email, username, password_hash) VALUES ('a@example.com', 'a', 'x'), ('b@example.com', 'b', 'x')",
+		// 重复和软删除的关注在加唯一索引时清理
+		"INSERT INTO follows (follower_id, following_id) VALUES (1, 2), (1, 2)",
+		"INSERT INTO follows (follower_id, following_id, deleted_at) VALUES (2, 1, '2020-01-01')",
+		"INSERT INTO articles (slug, title, author_id, favorites_count) VALUES ('a-1', 'A', 1, 0), ('a-2', 'A', 1, 0)",
+	} {
+		assert.Equal(t, nil, db.Exec(stmt).Error)
+	}
+	m, err := NewMigrator(db, log.DefaultLogger)
+	assert.Equal(t, nil, err)
+
+	// status只读, 不升级旧库
+	status, err := m.Status(ctx)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, true, status[0].AppliedAt == nil)
+	assert.Equal(t, true, status[0].Baseline)
+	assert.Equal(t, false, status[1].Baseline)
+	assert.Equal(t, false, db.Migrator().HasTable(&SchemaMigration{}))
+	assert.Equal(t, false, db.Migrator().HasTable(&Bookmark{}))
+
+	done, err := m.Up(ctx)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, len(m.migrations)-1, len(done))
+	status, err = m.Status(ctx)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, false, status[0].AppliedAt == nil)
+	assert.Equal(t, false, status[0].Baseline)
+
+	assert.Equal(t, true, db.Migrator().HasTable(&Bookmark{}))
+	var users []User
+	assert.Equal(t, nil, db.Order("id").Find(&users).Error)
+	assert.Equal(t, [][3]uint32{{0, 1, 2}, {1, 0, 0}}, [][3]uint32{
+		{users[0].FollowersCount, users[0].FollowingCount, users[0].ArticlesCount},
+		{users[1].FollowersCount, users[1].FollowingCount, users[1].ArticlesCount},
+	})
+}
+
+func TestMigrateNormalizeTags(t *testing.T) {
+	ctx := context.Background()
+	db := openTestSqlite(t)
+	m, err := NewMigrator(db, log.DefaultLogger)
+	assert.Equal(t, nil, err)
+	_, err = m.Up(ctx)
+	assert.Equal(t, nil, err)
+	// 0005回滚不做修改, 插入规范化之前的数据后重新执行
+	_, err = m.Down(ctx, 1)
+	assert.Equal(t, nil, err)
+	for _, stmt := range []string{
+		"INSERT INTO tags (id, name) VALUES (1, 'Go'), (2, 'go'), (3, ' Go  Lang '), (4, 'kratos')",
+		"INSERT INTO article_tags (article_id, tag_id) VALUES (1, 1), (1, 2), (2, 1), (3, 3)",
+		"INSERT INTO tag_follows (user_id, tag_id) VALUES (1, 1), (1, 2), (2, 2)",
+		"INSERT INTO tag_aliases (alias, tag_id) VALUES ('golang', 2), ('Kratos Framework', 4), ('go-lang', 4)",
+	} {
+		assert.Equal(t, nil, db.Exec(stmt).Error)
+	}
+	_, err = m.Up(ctx)
+	assert.Equal(t, nil, err)
+
+	var tags []Tag
+	assert.Equal(t, nil, db.Order("id").Find(&tags).Error)
+	names := make([]string, len(tags))
+	for i, tag := range tags {
+		names[i] = tag.Name
+	}
+	assert.Equal(t, []string{"go", "go-lang", "kratos"}, names)
+	var count int64
+	assert.Equal(t, nil, db.Table("article_tags").Where("tag_id = ?", 1).Count(&count).Error)
+	assert.Equal(t, int64(2), count)
+	assert.Equal(t, nil, db.Model(&TagFollow{}).Where("tag_id = ?", 1).Count(&count).Error)
+	assert.Equal(t, int64(2), count)
+	var aliases []TagAlias
+	assert.Equal(t, nil, db.Find(&aliases).Error)
+	assert.Equal(t, 1, len(aliases))
+	assert.Equal(t, "golang", aliases[0].Alias)
+	assert.Equal(t, uint(1), aliases[0].TagID)
+}
+
+func TestSplitStatements(t *testing.T) {
+	stmts := splitStatements("-- comment\nCREATE TABLE a (\n  id int\n);\n\nCREATE INDEX i ON a (id);\nDROP TABLE b")
+	assert.Equal(t, []string{"CREATE TABLE a (\n  id int\n);", "CREATE INDEX i ON a (id);", "DROP TABLE b"}, stmts)
+}
+
+func TestCreateMigration(t *testing.T) {
+	dir := t.TempDir()
+	for _, driver := range []string{"mysql", "sqlite"} {
+		assert.Equal(t, nil, os.Mkdir(filepath.Join(dir, driver), 0o755))
+	}
+	assert.Equal(t, nil, os.WriteFile(filepath.Join(dir, "mysql", "0003_init.up.sql"), []byte("SELECT 1;"), 0o644))
+
+	files, err := CreateMigration(dir, "Add Outbox")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, []string{
+		filepath.Join(dir, "mysql", "0004_add_outbox.up.sql"),
+		filepath.Join(dir, "mysql", "0004_add_outbox.down.sql"),
+		filepath.Join(dir, "sqlite", "0004_add_outbox.up.sql"),
+		filepath.Join(dir, "sqlite", "0004_add_outbox.down.sql"),
+	}, files)
+}
@@ -0,0 +1,229 @@
+-- 初始表结构, 和之前AutoMigrate创建的表一致
+
+CREATE TABLE `users` (
+  `id` bigint unsigned AUTO_INCREMENT,
+  `created_at` datetime(3) NULL,
+  `updated_at` datetime(3) NULL,
+  `deleted_at` datetime(3) NULL,
+  `email` varchar(500),
+  `username` varchar(500),
+  `bio` varchar(1000),
+  `image` varchar(1000),
+  `password_hash` varchar(500),
+  `anonymized_at` datetime(3) NULL,
+  `private` boolean NOT NULL DEFAULT false,
+  `followers_count` int unsigned NOT NULL DEFAULT 0,
+  `following_count` int unsigned NOT NULL DEFAULT 0,
+  `articles_count` int unsigned NOT NULL DEFAULT 0,
+  `attachments_bytes` bigint NOT NULL DEFAULT 0,
+  PRIMARY KEY (`id`),
+  INDEX `idx_users_deleted_at` (`deleted_at`),
+  CONSTRAINT `uni_users_email` UNIQUE (`email`),
+  CONSTRAINT `uni_users_username` UNIQUE (`username`)
+);
+
+CREATE TABLE `follows` (
+  `id` bigint unsigned AUTO_INCREMENT,
+  `created_at` datetime(3) NULL,
+  `updated_at` datetime(3) NULL,
+  `deleted_at` datetime(3) NULL,
+  `follower_id` bigint unsigned,
+  `following_id` bigint unsigned,
+  PRIMARY KEY (`id`),
+  INDEX `idx_follows_deleted_at` (`deleted_at`),
+  INDEX `idx_follows_follower_id` (`follower_id`),
+  INDEX `idx_follows_following_id` (`following_id`)
+);
+
+CREATE TABLE `follow_requests` (
+  `id` bigint unsigned AUTO_INCREMENT,
+  `created_at` datetime(3) NULL,
+  `updated_at` datetime(3) NULL,
+  `deleted_at` datetime(3) NULL,
+  `requester_id` bigint unsigned,
+  `target_id` bigint unsigned,
+  PRIMARY KEY (`id`),
+  INDEX `idx_follow_requests_deleted_at` (`deleted_at`),
+  UNIQUE INDEX `idx_requester_target` (`requester_id`,`target_id`),
+  INDEX `idx_follow_requests_target_id` (`target_id`)
+);
+
+CREATE TABLE `blocks` (
+  `id` bigint unsigned AUTO_INCREMENT,
+  `created_at` datetime(3) NULL,
+  `updated_at` datetime(3) NULL,
+  `deleted_at` datetime(3) NULL,
+  `blocker_id` bigint unsigned,
+  `blocked_id` bigint unsigned,
+  PRIMARY KEY (`id`),
+  INDEX `idx_blocks_deleted_at` (`deleted_at`),
+  UNIQUE INDEX `idx_blocker_blocked` (`blocker_id`,`blocked_id`),
+  INDEX `idx_blocks_blocked_id` (`blocked_id`)
+);
+
+CREATE TABLE `mutes` (
+  `id` bigint unsigned AUTO_INCREMENT,
+  `created_at` datetime(3) NULL,
+  `updated_at` datetime(3) NULL,
+  `deleted_at` datetime(3) NULL,
+  `muter_id` bigint unsigned,
+  `muted_id` bigint unsigned,
+  PRIMARY KEY (`id`),
+  INDEX `idx_mutes_deleted_at` (`deleted_at`),
+  UNIQUE INDEX `idx_muter_muted` (`muter_id`,`muted_id`)
+);
+
+CREATE TABLE `articles` (
+  `id` bigint unsigned AUTO_INCREMENT,
+  `created_at` datetime(3) NULL,
+  `updated_at` datetime(3) NULL,
+  `deleted_at` datetime(3) NULL,
+  `slug` varchar(500),
+  `title` varchar(500),
+  `description` varchar(1000),
+  `body` varchar(10000),
+  `cover_image` varchar(1000),
+  `author_id` bigint unsigned,
+  `favorites_count` int unsigned,
+  PRIMARY KEY (`id`),
+  INDEX `idx_articles_deleted_at` (`deleted_at`),
+  CONSTRAINT `uni_articles_slug` UNIQUE (`slug`)
+);
+
+CREATE TABLE `tags` (
+  `id` bigint unsigned AUTO_INCREMENT,
+  `created_at` datetime(3) NULL,
+  `updated_at` datetime(3) NULL,
+  `deleted_at` datetime(3) NULL,
+  `name` varchar(500),
+  PRIMARY KEY (`id`),
+  INDEX `idx_tags_deleted_at` (`deleted_at`),
+  UNIQUE INDEX `idx_tags_name` (`name`)
+);
+
+CREATE TABLE `article_favorites` (
+  `id` bigint unsigned AUTO_INCREMENT,
+  `created_at` datetime(3) NULL,
+  `updated_at` datetime(3) NULL,
+  `deleted_at` datetime(3) NULL,
+  `user_id` bigint unsigned,
+  `article_id` bigint unsigned,
+  PRIMARY KEY (`id`),
+  INDEX `idx_article_favorites_deleted_at` (`deleted_at`),
+  UNIQUE INDEX `idx_user_article` (`user_id`,`article_id`)
+);
+
+CREATE TABLE `comments` (
+  `id` bigint unsigned AUTO_INCREMENT,
+  `created_at` datetime(3) NULL,
+  `updated_at` datetime(3) NULL,
+  `deleted_at` datetime(3) NULL,
+  `article_id` bigint unsigned,
+  `body` longtext,
+  `author_id` bigint unsigned,
+  PRIMARY KEY (`id`),
+  INDEX `idx_comments_deleted_at` (`deleted_at`)
+);
+
+CREATE TABLE `attachments` (
+  `id` bigint unsigned AUTO_INCREMENT,
+  `created_at` datetime(3) NULL,
+  `updated_at` datetime(3) NULL,
+  `deleted_at` datetime(3) NULL,
+  `user_id` bigint unsigned,
+  `article_id` bigint unsigned,
+  `blob_key` varchar(500),
+  `url` varchar(1000),
+  `content_type` varchar(100),
+  `size` bigint,
+  `width` bigint,
+  `height` bigint,
+  PRIMARY KEY (`id`),
+  INDEX `idx_attachments_deleted_at` (`deleted_at`),
+  INDEX `idx_attachments_user_id` (`user_id`),
+  INDEX `idx_attachments_article_id` (`article_id`),
+  UNIQUE INDEX `idx_attachments_blob_key` (`blob_key`)
+);
+
+CREATE TABLE `bookmarks` (
+  `id` bigint unsigned AUTO_INCREMENT,
+  `created_at` datetime(3) NULL,
+  `updated_at` datetime(3) NULL,
+  `deleted_at` datetime(3) NULL,
+  `user_id` bigint unsigned,
+  `article_id` bigint unsigned,
+  `collection_id` bigint unsigned,
+  PRIMARY KEY (`id`),
+  INDEX `idx_bookmarks_deleted_at` (`deleted_at`),
+  UNIQUE INDEX `idx_bookmark_user_article` (`user_id`,`article_id`),
+  INDEX `idx_bookmarks_collection_id` (`collection_id`)
+);
+
+CREATE TABLE `bookmark_collections` (
+  `id` bigint unsigned AUTO_INCREMENT,
+  `created_at` datetime(3) NULL,
+  `updated_at` datetime(3) NULL,
+  `deleted_at` datetime(3) NULL,
+  `user_id` bigint unsigned,
+  `name` varchar(200),
+  PRIMARY KEY (`id`),
+  INDEX `idx_bookmark_collections_deleted_at` (`deleted_at`),
+  UNIQUE INDEX `idx_collection_user_name` (`user_id`,`name`)
+);
+
+CREATE TABLE `reactions` (
+  `id` bigint unsigned AUTO_INCREMENT,
+  `created_at` datetime(3) NULL,
+  `updated_at` datetime(3) NULL,
+  `deleted_at` datetime(3) NULL,
+  `user_id` bigint unsigned,
+  `target_type` varchar(16),
+  `target_id` bigint unsigned,
+  `reaction` varchar(32),
+  PRIMARY KEY (`id`),
+  INDEX `idx_reactions_deleted_at` (`deleted_at`),
+  UNIQUE INDEX `idx_reaction_user_target` (`user_id`,`target_type`,`target_id`,`reaction`),
+  INDEX `idx_reaction_target` (`target_type`,`target_id`)
+);
+
+CREATE TABLE `reaction_counts` (
+  `id` bigint unsigned AUTO_INCREMENT,
+  `target_type` varchar(16),
+  `target_id` bigint unsigned,
+  `reaction` varchar(32),
+  `reactions_count` int unsigned,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `idx_reaction_count_target` (`target_type`,`target_id`,`reaction`)
+);
+
+CREATE TABLE `tag_follows` (
+  `id` bigint unsigned AUTO_INCREMENT,
+  `created_at` datetime(3) NULL,
+  `updated_at` datetime(3) NULL,
+  `deleted_at` datetime(3) NULL,
+  `user_id` bigint unsigned,
+  `tag_id` bigint unsigned,
+  PRIMARY KEY (`id`),
+  INDEX `idx_tag_follows_deleted_at` (`deleted_at`),
+  UNIQUE INDEX `idx_tag_follow_user_tag` (`user_id`,`tag_id`),
+  INDEX `idx_tag_follows_tag_id` (`tag_id`)
+);
+
+CREATE TABLE `tag_aliases` (
+  `id` bigint unsigned AUTO_INCREMENT,
+  `created_at` datetime(3) NULL,
+  `updated_at` datetime(3) NULL,
+  `deleted_at` datetime(3) NULL,
+  `alias` varchar(500),
+  `tag_id` bigint unsigned,
+  PRIMARY KEY (`id`),
+  INDEX `idx_tag_aliases_deleted_at` (`deleted_at`),
+  UNIQUE INDEX `idx_tag_aliases_alias` (`alias`),
+  INDEX `idx_tag_aliases_tag_id` (`tag_id`)
+);
+
+CREATE TABLE `article_tags` (
+  `article_id` bigint unsigned,
+  `tag_id` bigint unsigned,
+  PRIMARY KEY (`article_id`,`tag_id`)
+);
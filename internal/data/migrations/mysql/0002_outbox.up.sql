@@ -0,0 +1,16 @@
+-- 领域事件的outbox表
+
+CREATE TABLE `outbox_events` (
+  `id` bigint unsigned AUTO_INCREMENT,
+  `created_at` datetime(3) NULL,
+  `updated_at` datetime(3) NULL,
+  `type` varchar(100),
+  `payload` text,
+  `status` varchar(20),
+  `attempts` bigint,
+  `next_attempt_at` datetime(3) NULL,
+  `delivered` varchar(1000),
+  `last_error` text,
+  PRIMARY KEY (`id`),
+  INDEX `idx_outbox_status_next_attempt` (`status`,`next_attempt_at`)
+);
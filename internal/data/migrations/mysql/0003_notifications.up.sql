@@ -0,0 +1,45 @@
+-- 通知中心: 通知, 通知的操作者和通知设置
+
+CREATE TABLE `notifications` (
+  `id` bigint unsigned AUTO_INCREMENT,
+  `created_at` datetime(3) NULL,
+  `updated_at` datetime(3) NULL,
+  `deleted_at` datetime(3) NULL,
+  `user_id` bigint unsigned,
+  `type` varchar(20),
+  `group_key` varchar(100),
+  `article_id` bigint unsigned,
+  `comment_id` bigint unsigned,
+  `actor_id` bigint unsigned,
+  `actors_count` int unsigned NOT NULL DEFAULT 0,
+  `read_at` datetime(3) NULL,
+  PRIMARY KEY (`id`),
+  INDEX `idx_notifications_deleted_at` (`deleted_at`),
+  INDEX `idx_notification_user_group` (`user_id`,`group_key`),
+  INDEX `idx_notifications_article_id` (`article_id`)
+);
+
+CREATE TABLE `notification_actors` (
+  `id` bigint unsigned AUTO_INCREMENT,
+  `created_at` datetime(3) NULL,
+  `updated_at` datetime(3) NULL,
+  `deleted_at` datetime(3) NULL,
+  `notification_id` bigint unsigned,
+  `actor_id` bigint unsigned,
+  PRIMARY KEY (`id`),
+  INDEX `idx_notification_actors_deleted_at` (`deleted_at`),
+  UNIQUE INDEX `idx_notification_actor` (`notification_id`,`actor_id`)
+);
+
+CREATE TABLE `notification_preferences` (
+  `id` bigint unsigned AUTO_INCREMENT,
+  `created_at` datetime(3) NULL,
+  `updated_at` datetime(3) NULL,
+  `deleted_at` datetime(3) NULL,
+  `user_id` bigint unsigned,
+  `type` varchar(20),
+  `enabled` boolean,
+  PRIMARY KEY (`id`),
+  INDEX `idx_notification_preferences_deleted_at` (`deleted_at`),
+  UNIQUE INDEX `idx_notification_preference` (`user_id`,`type`)
+);
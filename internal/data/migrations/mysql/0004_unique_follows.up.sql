@@ -0,0 +1,9 @@
+-- 关注关系加上唯一索引: 先清理软删除和重复的关注, 再按清理后的数据重新计算计数
+-- 清理是幂等的, 创建索引失败时可以直接重新执行
+
+DELETE FROM `follows` WHERE `deleted_at` IS NOT NULL;
+DELETE `f` FROM `follows` `f` JOIN `follows` `d` ON `d`.`follower_id` = `f`.`follower_id` AND `d`.`following_id` = `f`.`following_id` AND `d`.`id` < `f`.`id`;
+CREATE UNIQUE INDEX `idx_follower_following` ON `follows` (`follower_id`,`following_id`);
+UPDATE `users` SET
+  `followers_count` = (SELECT COUNT(*) FROM `follows` WHERE `follows`.`following_id` = `users`.`id`),
+  `following_count` = (SELECT COUNT(*) FROM `follows` WHERE `follows`.`follower_id` = `users`.`id`);
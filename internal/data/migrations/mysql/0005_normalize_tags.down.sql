@@ -0,0 +1 @@
+-- 合并的标签无法拆分, 回滚不做修改
@@ -0,0 +1,17 @@
+DROP TABLE "article_tags";
+DROP TABLE "tag_aliases";
+DROP TABLE "tag_follows";
+DROP TABLE "reaction_counts";
+DROP TABLE "reactions";
+DROP TABLE "bookmark_collections";
+DROP TABLE "bookmarks";
+DROP TABLE "attachments";
+DROP TABLE "comments";
+DROP TABLE "article_favorites";
+DROP TABLE "tags";
+DROP TABLE "articles";
+DROP TABLE "mutes";
+DROP TABLE "blocks";
+DROP TABLE "follow_requests";
+DROP TABLE "follows";
+DROP TABLE "users";
@@ -0,0 +1,229 @@
+-- 初始表结构, 和之前AutoMigrate创建的表一致
+
+CREATE TABLE "users" (
+  "id" bigserial,
+  "created_at" timestamptz,
+  "updated_at" timestamptz,
+  "deleted_at" timestamptz,
+  "email" varchar(500),
+  "username" varchar(500),
+  "bio" varchar(1000),
+  "image" varchar(1000),
+  "password_hash" varchar(500),
+  "anonymized_at" timestamptz,
+  "private" boolean NOT NULL DEFAULT false,
+  "followers_count" bigint NOT NULL DEFAULT 0,
+  "following_count" bigint NOT NULL DEFAULT 0,
+  "articles_count" bigint NOT NULL DEFAULT 0,
+  "attachments_bytes" bigint NOT NULL DEFAULT 0,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "uni_users_email" UNIQUE ("email"),
+  CONSTRAINT "uni_users_username" UNIQUE ("username")
+);
+CREATE INDEX "idx_users_deleted_at" ON "users" ("deleted_at");
+
+CREATE TABLE "follows" (
+  "id" bigserial,
+  "created_at" timestamptz,
+  "updated_at" timestamptz,
+  "deleted_at" timestamptz,
+  "follower_id" bigint,
+  "following_id" bigint,
+  PRIMARY KEY ("id")
+);
+CREATE INDEX "idx_follows_following_id" ON "follows" ("following_id");
+CREATE INDEX "idx_follows_follower_id" ON "follows" ("follower_id");
+CREATE INDEX "idx_follows_deleted_at" ON "follows" ("deleted_at");
+
+CREATE TABLE "follow_requests" (
+  "id" bigserial,
+  "created_at" timestamptz,
+  "updated_at" timestamptz,
+  "deleted_at" timestamptz,
+  "requester_id" bigint,
+  "target_id" bigint,
+  PRIMARY KEY ("id")
+);
+CREATE INDEX "idx_follow_requests_target_id" ON "follow_requests" ("target_id");
+CREATE UNIQUE INDEX "idx_requester_target" ON "follow_requests" ("requester_id","target_id");
+CREATE INDEX "idx_follow_requests_deleted_at" ON "follow_requests" ("deleted_at");
+
+CREATE TABLE "blocks" (
+  "id" bigserial,
+  "created_at" timestamptz,
+  "updated_at" timestamptz,
+  "deleted_at" timestamptz,
+  "blocker_id" bigint,
+  "blocked_id" bigint,
+  PRIMARY KEY ("id")
+);
+CREATE INDEX "idx_blocks_blocked_id" ON "blocks" ("blocked_id");
+CREATE UNIQUE INDEX "idx_blocker_blocked" ON "blocks" ("blocker_id","blocked_id");
+CREATE INDEX "idx_blocks_deleted_at" ON "blocks" ("deleted_at");
+
+CREATE TABLE "mutes" (
+  "id" bigserial,
+  "created_at" timestamptz,
+  "updated_at" timestamptz,
+  "deleted_at" timestamptz,
+  "muter_id" bigint,
+  "muted_id" bigint,
+  PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX "idx_muter_muted" ON "mutes" ("muter_id","muted_id");
+CREATE INDEX "idx_mutes_deleted_at" ON "mutes" ("deleted_at");
+
+CREATE TABLE "articles" (
+  "id" bigserial,
+  "created_at" timestamptz,
+  "updated_at" timestamptz,
+  "deleted_at" timestamptz,
+  "slug" varchar(500),
+  "title" varchar(500),
+  "description" varchar(1000),
+  "body" varchar(10000),
+  "cover_image" varchar(1000),
+  "author_id" bigint,
+  "favorites_count" bigint,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "uni_articles_slug" UNIQUE ("slug")
+);
+CREATE INDEX "idx_articles_deleted_at" ON "articles" ("deleted_at");
+
+CREATE TABLE "tags" (
+  "id" bigserial,
+  "created_at" timestamptz,
+  "updated_at" timestamptz,
+  "deleted_at" timestamptz,
+  "name" varchar(500),
+  PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX "idx_tags_name" ON "tags" ("name");
+CREATE INDEX "idx_tags_deleted_at" ON "tags" ("deleted_at");
+
+CREATE TABLE "article_favorites" (
+  "id" bigserial,
+  "created_at" timestamptz,
+  "updated_at" timestamptz,
+  "deleted_at" timestamptz,
+  "user_id" bigint,
+  "article_id" bigint,
+  PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX "idx_user_article" ON "article_favorites" ("user_id","article_id");
+CREATE INDEX "idx_article_favorites_deleted_at" ON "article_favorites" ("deleted_at");
+
+CREATE TABLE "comments" (
+  "id" bigserial,
+  "created_at" timestamptz,
+  "updated_at" timestamptz,
+  "deleted_at" timestamptz,
+  "article_id" bigint,
+  "body" text,
+  "author_id" bigint,
+  PRIMARY KEY ("id")
+);
+CREATE INDEX "idx_comments_deleted_at" ON "comments" ("deleted_at");
+
+CREATE TABLE "attachments" (
+  "id" bigserial,
+  "created_at" timestamptz,
+  "updated_at" timestamptz,
+  "deleted_at" timestamptz,
+  "user_id" bigint,
+  "article_id" bigint,
+  "blob_key" varchar(500),
+  "url" varchar(1000),
+  "content_type" varchar(100),
+  "size" bigint,
+  "width" bigint,
+  "height" bigint,
+  PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX "idx_attachments_blob_key" ON "attachments" ("blob_key");
+CREATE INDEX "idx_attachments_article_id" ON "attachments" ("article_id");
+CREATE INDEX "idx_attachments_user_id" ON "attachments" ("user_id");
+CREATE INDEX "idx_attachments_deleted_at" ON "attachments" ("deleted_at");
+
+CREATE TABLE "bookmarks" (
+  "id" bigserial,
+  "created_at" timestamptz,
+  "updated_at" timestamptz,
+  "deleted_at" timestamptz,
+  "user_id" bigint,
+  "article_id" bigint,
+  "collection_id" bigint,
+  PRIMARY KEY ("id")
+);
+CREATE INDEX "idx_bookmarks_collection_id" ON "bookmarks" ("collection_id");
+CREATE UNIQUE INDEX "idx_bookmark_user_article" ON "bookmarks" ("user_id","article_id");
+CREATE INDEX "idx_bookmarks_deleted_at" ON "bookmarks" ("deleted_at");
+
+CREATE TABLE "bookmark_collections" (
+  "id" bigserial,
+  "created_at" timestamptz,
+  "updated_at" timestamptz,
+  "deleted_at" timestamptz,
+  "user_id" bigint,
+  "name" varchar(200),
+  PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX "idx_collection_user_name" ON "bookmark_collections" ("user_id","name");
+CREATE INDEX "idx_bookmark_collections_deleted_at" ON "bookmark_collections" ("deleted_at");
+
+CREATE TABLE "reactions" (
+  "id" bigserial,
+  "created_at" timestamptz,
+  "updated_at" timestamptz,
+  "deleted_at" timestamptz,
+  "user_id" bigint,
+  "target_type" varchar(16),
+  "target_id" bigint,
+  "reaction" varchar(32),
+  PRIMARY KEY ("id")
+);
+CREATE INDEX "idx_reaction_target" ON "reactions" ("target_type","target_id");
+CREATE UNIQUE INDEX "idx_reaction_user_target" ON "reactions" ("user_id","target_type","target_id","reaction");
+CREATE INDEX "idx_reactions_deleted_at" ON "reactions" ("deleted_at");
+
+CREATE TABLE "reaction_counts" (
+  "id" bigserial,
+  "target_type" varchar(16),
+  "target_id" bigint,
+  "reaction" varchar(32),
+  "reactions_count" bigint,
+  PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX "idx_reaction_count_target" ON "reaction_counts" ("target_type","target_id","reaction");
+
+CREATE TABLE "tag_follows" (
+  "id" bigserial,
+  "created_at" timestamptz,
+  "updated_at" timestamptz,
+  "deleted_at" timestamptz,
+  "user_id" bigint,
+  "tag_id" bigint,
+  PRIMARY KEY ("id")
+);
+CREATE INDEX "idx_tag_follows_tag_id" ON "tag_follows" ("tag_id");
+CREATE UNIQUE INDEX "idx_tag_follow_user_tag" ON "tag_follows" ("user_id","tag_id");
+CREATE INDEX "idx_tag_follows_deleted_at" ON "tag_follows" ("deleted_at");
+
+CREATE TABLE "tag_aliases" (
+  "id" bigserial,
+  "created_at" timestamptz,
+  "updated_at" timestamptz,
+  "deleted_at" timestamptz,
+  "alias" varchar(500),
+  "tag_id" bigint,
+  PRIMARY KEY ("id")
+);
+CREATE INDEX "idx_tag_aliases_tag_id" ON "tag_aliases" ("tag_id");
+CREATE UNIQUE INDEX "idx_tag_aliases_alias" ON "tag_aliases" ("alias");
+CREATE INDEX "idx_tag_aliases_deleted_at" ON "tag_aliases" ("deleted_at");
+
+CREATE TABLE "article_tags" (
+  "article_id" bigint,
+  "tag_id" bigint,
+  PRIMARY KEY ("article_id","tag_id")
+);
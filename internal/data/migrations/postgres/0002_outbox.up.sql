@@ -0,0 +1,16 @@
+-- 领域事件的outbox表
+
+CREATE TABLE "outbox_events" (
+  "id" bigserial,
+  "created_at" timestamptz,
+  "updated_at" timestamptz,
+  "type" varchar(100),
+  "payload" text,
+  "status" varchar(20),
+  "attempts" bigint,
+  "next_attempt_at" timestamptz,
+  "delivered" varchar(1000),
+  "last_error" text,
+  PRIMARY KEY ("id")
+);
+CREATE INDEX "idx_outbox_status_next_attempt" ON "outbox_events" ("status","next_attempt_at");
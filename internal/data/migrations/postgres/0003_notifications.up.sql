@@ -0,0 +1,45 @@
+-- 通知中心: 通知, 通知的操作者和通知设置
+
+CREATE TABLE "notifications" (
+  "id" bigserial,
+  "created_at" timestamptz,
+  "updated_at" timestamptz,
+  "deleted_at" timestamptz,
+  "user_id" bigint,
+  "type" varchar(20),
+  "group_key" varchar(100),
+  "article_id" bigint,
+  "comment_id" bigint,
+  "actor_id" bigint,
+  "actors_count" bigint NOT NULL DEFAULT 0,
+  "read_at" timestamptz,
+  PRIMARY KEY ("id")
+);
+CREATE INDEX "idx_notifications_deleted_at" ON "notifications" ("deleted_at");
+CREATE INDEX "idx_notification_user_group" ON "notifications" ("user_id","group_key");
+CREATE INDEX "idx_notifications_article_id" ON "notifications" ("article_id");
+
+CREATE TABLE "notification_actors" (
+  "id" bigserial,
+  "created_at" timestamptz,
+  "updated_at" timestamptz,
+  "deleted_at" timestamptz,
+  "notification_id" bigint,
+  "actor_id" bigint,
+  PRIMARY KEY ("id")
+);
+CREATE INDEX "idx_notification_actors_deleted_at" ON "notification_actors" ("deleted_at");
+CREATE UNIQUE INDEX "idx_notification_actor" ON "notification_actors" ("notification_id","actor_id");
+
+CREATE TABLE "notification_preferences" (
+  "id" bigserial,
+  "created_at" timestamptz,
+  "updated_at" timestamptz,
+  "deleted_at" timestamptz,
+  "user_id" bigint,
+  "type" varchar(20),
+  "enabled" boolean,
+  PRIMARY KEY ("id")
+);
+CREATE INDEX "idx_notification_preferences_deleted_at" ON "notification_preferences" ("deleted_at");
+CREATE UNIQUE INDEX "idx_notification_preference" ON "notification_preferences" ("user_id","type");
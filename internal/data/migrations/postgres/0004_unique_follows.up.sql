@@ -0,0 +1,8 @@
+-- 关注关系加上唯一索引: 先清理软删除和重复的关注, 再按清理后的数据重新计算计数
+
+DELETE FROM "follows" WHERE "deleted_at" IS NOT NULL;
+DELETE FROM "follows" WHERE "id" NOT IN (SELECT MIN("id") FROM "follows" GROUP BY "follower_id", "following_id");
+CREATE UNIQUE INDEX "idx_follower_following" ON "follows" ("follower_id","following_id");
+UPDATE "users" SET
+  "followers_count" = (SELECT COUNT(*) FROM "follows" WHERE "follows"."following_id" = "users"."id"),
+  "following_count" = (SELECT COUNT(*) FROM "follows" WHERE "follows"."follower_id" = "users"."id");
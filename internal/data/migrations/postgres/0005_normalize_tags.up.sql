@@ -0,0 +1,31 @@
+-- 按normalizeTag规范化已有的标签: 小写, 去掉首尾空格, 连续的空格替换为-
+-- 规范化后同名的标签合并到id最小的一个, 文章, 关注和别名转到保留的标签
+-- 查询前会先规范化, 不规范的别名永远匹配不到, 和标签同名的别名会遮住标签, 都直接删除
+
+CREATE TABLE "tag_normalize" ("id" bigint PRIMARY KEY, "norm" varchar(500), "target_id" bigint);
+INSERT INTO "tag_normalize" ("id", "norm")
+  SELECT "id", LOWER(REPLACE(REPLACE(REPLACE(REPLACE(TRIM("name"), '  ', ' '), '  ', ' '), '  ', ' '), ' ', '-')) FROM "tags";
+UPDATE "tag_normalize" SET "target_id" = (SELECT MIN("n"."id") FROM "tag_normalize" "n" WHERE "n"."norm" = "tag_normalize"."norm");
+
+INSERT INTO "article_tags" ("article_id", "tag_id")
+  SELECT DISTINCT "at"."article_id", "n"."target_id" FROM "article_tags" "at" JOIN "tag_normalize" "n" ON "n"."id" = "at"."tag_id"
+  WHERE "n"."id" <> "n"."target_id"
+    AND NOT EXISTS (SELECT 1 FROM "article_tags" "x" WHERE "x"."article_id" = "at"."article_id" AND "x"."tag_id" = "n"."target_id");
+DELETE FROM "article_tags" WHERE "tag_id" IN (SELECT "id" FROM "tag_normalize" WHERE "id" <> "target_id");
+
+INSERT INTO "tag_follows" ("created_at", "updated_at", "user_id", "tag_id")
+  SELECT MIN("f"."created_at"), MIN("f"."created_at"), "f"."user_id", "n"."target_id" FROM "tag_follows" "f" JOIN "tag_normalize" "n" ON "n"."id" = "f"."tag_id"
+  WHERE "n"."id" <> "n"."target_id"
+    AND NOT EXISTS (SELECT 1 FROM "tag_follows" "x" WHERE "x"."user_id" = "f"."user_id" AND "x"."tag_id" = "n"."target_id")
+  GROUP BY "f"."user_id", "n"."target_id";
+DELETE FROM "tag_follows" WHERE "tag_id" IN (SELECT "id" FROM "tag_normalize" WHERE "id" <> "target_id");
+
+UPDATE "tag_aliases" SET "tag_id" = (SELECT "target_id" FROM "tag_normalize" WHERE "tag_normalize"."id" = "tag_aliases"."tag_id")
+  WHERE "tag_id" IN (SELECT "id" FROM "tag_normalize" WHERE "id" <> "target_id");
+DELETE FROM "tag_aliases" WHERE "alias" IN (SELECT "norm" FROM "tag_normalize");
+DELETE FROM "tag_aliases" WHERE "alias" <> LOWER(REPLACE(REPLACE(REPLACE(REPLACE(TRIM("alias"), '  ', ' '), '  ', ' '), '  ', ' '), ' ', '-'));
+
+DELETE FROM "tags" WHERE "id" IN (SELECT "id" FROM "tag_normalize" WHERE "id" <> "target_id");
+UPDATE "tags" SET "name" = (SELECT "norm" FROM "tag_normalize" WHERE "tag_normalize"."id" = "tags"."id")
+  WHERE "id" IN (SELECT "id" FROM "tag_normalize" WHERE "norm" <> "tags"."name");
+DROP TABLE "tag_normalize";
@@ -0,0 +1,213 @@
+-- 初始表结构, 和之前AutoMigrate创建的表一致
+
+CREATE TABLE `users` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `created_at` datetime,
+  `updated_at` datetime,
+  `deleted_at` datetime,
+  `email` text,
+  `username` text,
+  `bio` text,
+  `image` text,
+  `password_hash` text,
+  `anonymized_at` datetime,
+  `private` numeric NOT NULL DEFAULT false,
+  `followers_count` integer NOT NULL DEFAULT 0,
+  `following_count` integer NOT NULL DEFAULT 0,
+  `articles_count` integer NOT NULL DEFAULT 0,
+  `attachments_bytes` integer NOT NULL DEFAULT 0,
+  CONSTRAINT `uni_users_email` UNIQUE (`email`),
+  CONSTRAINT `uni_users_username` UNIQUE (`username`)
+);
+CREATE INDEX `idx_users_deleted_at` ON `users`(`deleted_at`);
+
+CREATE TABLE `follows` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `created_at` datetime,
+  `updated_at` datetime,
+  `deleted_at` datetime,
+  `follower_id` integer,
+  `following_id` integer
+);
+CREATE INDEX `idx_follows_following_id` ON `follows`(`following_id`);
+CREATE INDEX `idx_follows_follower_id` ON `follows`(`follower_id`);
+CREATE INDEX `idx_follows_deleted_at` ON `follows`(`deleted_at`);
+
+CREATE TABLE `follow_requests` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `created_at` datetime,
+  `updated_at` datetime,
+  `deleted_at` datetime,
+  `requester_id` integer,
+  `target_id` integer
+);
+CREATE INDEX `idx_follow_requests_target_id` ON `follow_requests`(`target_id`);
+CREATE UNIQUE INDEX `idx_requester_target` ON `follow_requests`(`requester_id`,`target_id`);
+CREATE INDEX `idx_follow_requests_deleted_at` ON `follow_requests`(`deleted_at`);
+
+CREATE TABLE `blocks` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `created_at` datetime,
+  `updated_at` datetime,
+  `deleted_at` datetime,
+  `blocker_id` integer,
+  `blocked_id` integer
+);
+CREATE INDEX `idx_blocks_blocked_id` ON `blocks`(`blocked_id`);
+CREATE UNIQUE INDEX `idx_blocker_blocked` ON `blocks`(`blocker_id`,`blocked_id`);
+CREATE INDEX `idx_blocks_deleted_at` ON `blocks`(`deleted_at`);
+
+CREATE TABLE `mutes` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `created_at` datetime,
+  `updated_at` datetime,
+  `deleted_at` datetime,
+  `muter_id` integer,
+  `muted_id` integer
+);
+CREATE UNIQUE INDEX `idx_muter_muted` ON `mutes`(`muter_id`,`muted_id`);
+CREATE INDEX `idx_mutes_deleted_at` ON `mutes`(`deleted_at`);
+
+CREATE TABLE `articles` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `created_at` datetime,
+  `updated_at` datetime,
+  `deleted_at` datetime,
+  `slug` text,
+  `title` text,
+  `description` text,
+  `body` text,
+  `cover_image` text,
+  `author_id` integer,
+  `favorites_count` integer,
+  CONSTRAINT `uni_articles_slug` UNIQUE (`slug`)
+);
+CREATE INDEX `idx_articles_deleted_at` ON `articles`(`deleted_at`);
+
+CREATE TABLE `tags` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `created_at` datetime,
+  `updated_at` datetime,
+  `deleted_at` datetime,
+  `name` text
+);
+CREATE UNIQUE INDEX `idx_tags_name` ON `tags`(`name`);
+CREATE INDEX `idx_tags_deleted_at` ON `tags`(`deleted_at`);
+
+CREATE TABLE `article_favorites` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `created_at` datetime,
+  `updated_at` datetime,
+  `deleted_at` datetime,
+  `user_id` integer,
+  `article_id` integer
+);
+CREATE UNIQUE INDEX `idx_user_article` ON `article_favorites`(`user_id`,`article_id`);
+CREATE INDEX `idx_article_favorites_deleted_at` ON `article_favorites`(`deleted_at`);
+
+CREATE TABLE `comments` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `created_at` datetime,
+  `updated_at` datetime,
+  `deleted_at` datetime,
+  `article_id` integer,
+  `body` text,
+  `author_id` integer
+);
+CREATE INDEX `idx_comments_deleted_at` ON `comments`(`deleted_at`);
+
+CREATE TABLE `attachments` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `created_at` datetime,
+  `updated_at` datetime,
+  `deleted_at` datetime,
+  `user_id` integer,
+  `article_id` integer,
+  `blob_key` text,
+  `url` text,
+  `content_type` text,
+  `size` integer,
+  `width` integer,
+  `height` integer
+);
+CREATE UNIQUE INDEX `idx_attachments_blob_key` ON `attachments`(`blob_key`);
+CREATE INDEX `idx_attachments_article_id` ON `attachments`(`article_id`);
+CREATE INDEX `idx_attachments_user_id` ON `attachments`(`user_id`);
+CREATE INDEX `idx_attachments_deleted_at` ON `attachments`(`deleted_at`);
+
+CREATE TABLE `bookmarks` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `created_at` datetime,
+  `updated_at` datetime,
+  `deleted_at` datetime,
+  `user_id` integer,
+  `article_id` integer,
+  `collection_id` integer
+);
+CREATE INDEX `idx_bookmarks_collection_id` ON `bookmarks`(`collection_id`);
+CREATE UNIQUE INDEX `idx_bookmark_user_article` ON `bookmarks`(`user_id`,`article_id`);
+CREATE INDEX `idx_bookmarks_deleted_at` ON `bookmarks`(`deleted_at`);
+
+CREATE TABLE `bookmark_collections` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `created_at` datetime,
+  `updated_at` datetime,
+  `deleted_at` datetime,
+  `user_id` integer,
+  `name` text
+);
+CREATE UNIQUE INDEX `idx_collection_user_name` ON `bookmark_collections`(`user_id`,`name`);
+CREATE INDEX `idx_bookmark_collections_deleted_at` ON `bookmark_collections`(`deleted_at`);
+
+CREATE TABLE `reactions` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `created_at` datetime,
+  `updated_at` datetime,
+  `deleted_at` datetime,
+  `user_id` integer,
+  `target_type` text,
+  `target_id` integer,
+  `reaction` text
+);
+CREATE INDEX `idx_reaction_target` ON `reactions`(`target_type`,`target_id`);
+CREATE UNIQUE INDEX `idx_reaction_user_target` ON `reactions`(`user_id`,`target_type`,`target_id`,`reaction`);
+CREATE INDEX `idx_reactions_deleted_at` ON `reactions`(`deleted_at`);
+
+CREATE TABLE `reaction_counts` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `target_type` text,
+  `target_id` integer,
+  `reaction` text,
+  `reactions_count` integer
+);
+CREATE UNIQUE INDEX `idx_reaction_count_target` ON `reaction_counts`(`target_type`,`target_id`,`reaction`);
+
+CREATE TABLE `tag_follows` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `created_at` datetime,
+  `updated_at` datetime,
+  `deleted_at` datetime,
+  `user_id` integer,
+  `tag_id` integer
+);
+CREATE INDEX `idx_tag_follows_tag_id` ON `tag_follows`(`tag_id`);
+CREATE UNIQUE INDEX `idx_tag_follow_user_tag` ON `tag_follows`(`user_id`,`tag_id`);
+CREATE INDEX `idx_tag_follows_deleted_at` ON `tag_follows`(`deleted_at`);
+
+CREATE TABLE `tag_aliases` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `created_at` datetime,
+  `updated_at` datetime,
+  `deleted_at` datetime,
+  `alias` text,
+  `tag_id` integer
+);
+CREATE INDEX `idx_tag_aliases_tag_id` ON `tag_aliases`(`tag_id`);
+CREATE UNIQUE INDEX `idx_tag_aliases_alias` ON `tag_aliases`(`alias`);
+CREATE INDEX `idx_tag_aliases_deleted_at` ON `tag_aliases`(`deleted_at`);
+
+CREATE TABLE `article_tags` (
+  `article_id` integer,
+  `tag_id` integer,
+  PRIMARY KEY (`article_id`,`tag_id`)
+);
@@ -0,0 +1,15 @@
+-- 领域事件的outbox表
+
+CREATE TABLE `outbox_events` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `created_at` datetime,
+  `updated_at` datetime,
+  `type` text,
+  `payload` text,
+  `status` text,
+  `attempts` integer,
+  `next_attempt_at` datetime,
+  `delivered` text,
+  `last_error` text
+);
+CREATE INDEX `idx_outbox_status_next_attempt` ON `outbox_events`(`status`,`next_attempt_at`);
@@ -0,0 +1,42 @@
+-- 通知中心: 通知, 通知的操作者和通知设置
+
+CREATE TABLE `notifications` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `created_at` datetime,
+  `updated_at` datetime,
+  `deleted_at` datetime,
+  `user_id` integer,
+  `type` text,
+  `group_key` text,
+  `article_id` integer,
+  `comment_id` integer,
+  `actor_id` integer,
+  `actors_count` integer NOT NULL DEFAULT 0,
+  `read_at` datetime
+);
+CREATE INDEX `idx_notifications_deleted_at` ON `notifications`(`deleted_at`);
+CREATE INDEX `idx_notification_user_group` ON `notifications`(`user_id`,`group_key`);
+CREATE INDEX `idx_notifications_article_id` ON `notifications`(`article_id`);
+
+CREATE TABLE `notification_actors` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `created_at` datetime,
+  `updated_at` datetime,
+  `deleted_at` datetime,
+  `notification_id` integer,
+  `actor_id` integer
+);
+CREATE INDEX `idx_notification_actors_deleted_at` ON `notification_actors`(`deleted_at`);
+CREATE UNIQUE INDEX `idx_notification_actor` ON `notification_actors`(`notification_id`,`actor_id`);
+
+CREATE TABLE `notification_preferences` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `created_at` datetime,
+  `updated_at` datetime,
+  `deleted_at` datetime,
+  `user_id` integer,
+  `type` text,
+  `enabled` numeric
+);
+CREATE INDEX `idx_notification_preferences_deleted_at` ON `notification_preferences`(`deleted_at`);
+CREATE UNIQUE INDEX `idx_notification_preference` ON `notification_preferences`(`user_id`,`type`);
@@ -0,0 +1 @@
+DROP INDEX `idx_follower_following`;
@@ -0,0 +1,275 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"kratos-realworld/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 通知 - 同一分组的操作者合并为一条, 未读的通知合并新的操作者时删除后重新插入, 按id排序即按最近的操作排序
+type Notification struct {
+	gorm.Model
+	UserID    uint   `gorm:"index:idx_notification_user_group"`
+	Type      string `gorm:"size:20"`
+	GroupKey  string `gorm:"size:100;index:idx_notification_user_group"`
+	ArticleID uint   `gorm:"index"`
+	CommentID uint
+	// 最近的操作者
+	ActorID     uint
+	ActorsCount uint32 `gorm:"not null;default:0"`
+	// 为空表示未读
+	ReadAt *time.Time
+}
+
+// 通知中的操作者, 用来去重和计数
+type NotificationActor struct {
+	gorm.Model
+	NotificationID uint `gorm:"index:idx_notification_actor,unique"`
+	ActorID        uint `gorm:"index:idx_notification_actor,unique"`
+}
+
+// 通知类型的开关, 没有记录时为开启
+type NotificationPreference struct {
+	gorm.Model
+	UserID  uint   `gorm:"index:idx_notification_preference,unique"`
+	Type    string `gorm:"size:20;index:idx_notification_preference,unique"`
+	Enabled bool
+}
+
+func convertNotification(n Notification) *biz.Notification {
+	return &biz.Notification{
+		ID:          n.ID,
+		UserID:      n.UserID,
+		Type:        n.Type,
+		GroupKey:    n.GroupKey,
+		ArticleID:   n.ArticleID,
+		CommentID:   n.CommentID,
+		ActorID:     n.ActorID,
+		ActorsCount: n.ActorsCount,
+		Read:        n.ReadAt != nil,
+		CreatedAt:   n.CreatedAt,
+	}
+}
+
+type notificationRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewNotificationRepo(data *Data, logger log.Logger) biz.NotificationRepo {
+	if data.mem != nil {
+		return &memoryNotificationRepo{mem: data.mem}
+	}
+	return &notificationRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *notificationRepo) AddNotification(ctx context.Context, n *biz.Notification) error {
+	return r.data.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		// 锁住接收者, 同一个用户的通知依次合并; 分组的最新一行会被删除后重新插入, 不能只锁这一行
+		// sqlite只有一个写入者, 不需要加锁
+		var recipient User
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").Where("id = ?", n.UserID).Take(&recipient).Error; err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+		var latest Notification
+		err := tx.Where("user_id = ? AND group_key = ?", n.UserID, n.GroupKey).Order("id DESC").Take(&latest).Error
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+		found := err == nil
+		if found {
+			var count int64
+			if err := tx.Model(&NotificationActor{}).Where("notification_id = ? AND actor_id = ?", latest.ID, n.ActorID).Count(&count).Error; err != nil {
+				return err
+			}
+			if count > 0 {
+				return nil
+			}
+		}
+
+		row := Notification{
+			UserID:      n.UserID,
+			Type:        n.Type,
+			GroupKey:    n.GroupKey,
+			ArticleID:   n.ArticleID,
+			CommentID:   n.CommentID,
+			ActorID:     n.ActorID,
+			ActorsCount: 1,
+		}
+		merge := found && latest.ReadAt == nil
+		if merge {
+			row.ActorsCount = latest.ActorsCount + 1
+			if err := tx.Unscoped().Delete(&latest).Error; err != nil {
+				return err
+			}
+		}
+		if err := tx.Create(&row).Error; err != nil {
+			return err
+		}
+		if merge {
+			if err := tx.Model(&NotificationActor{}).Where("notification_id = ?", latest.ID).Update("notification_id", row.ID).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Create(&NotificationActor{NotificationID: row.ID, ActorID: n.ActorID}).Error
+	})
+}
+
+// 文章已删除的通知不可见
+func visibleNotifications(db *gorm.DB, uid uint) *gorm.DB {
+	return db.Model(&Notification{}).
+		Joins("LEFT JOIN articles ON articles.id = notifications.article_id AND articles.deleted_at IS NULL").
+		Where("notifications.user_id = ? AND (notifications.article_id = 0 OR articles.id IS NOT NULL)", uid)
+}
+
+// 按id倒序, 多查一条来判断是否有下一页
+func (r *notificationRepo) ListNotifications(ctx context.Context, uid uint, cursor uint, limit int) ([]*biz.Notification, uint, error) {
+	type notificationRow struct {
+		Notification
+		ArticleSlug  string
+		ArticleTitle string
+	}
+
+	db := visibleNotifications(r.data.DB(ctx), uid).
+		Select("notifications.*, articles.slug AS article_slug, articles.title AS article_title")
+	if cursor > 0 {
+		db = db.Where("notifications.id < ?", cursor)
+	}
+	var rows []notificationRow
+	if err := db.Order("notifications.id DESC").Limit(limit + 1).Scan(&rows).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var next uint
+	if len(rows) > limit {
+		rows = rows[:limit]
+		next = rows[limit-1].ID
+	}
+	list := make([]*biz.Notification, len(rows))
+	for i, row := range rows {
+		list[i] = convertNotification(row.Notification)
+		list[i].ArticleSlug = row.ArticleSlug
+		list[i].ArticleTitle = row.ArticleTitle
+	}
+	return list, next, nil
+}
+
+func (r *notificationRepo) CountUnreadNotifications(ctx context.Context, uid uint) (int, error) {
+	var count int64
+	err := visibleNotifications(r.data.DB(ctx), uid).Where("notifications.read_at IS NULL").Count(&count).Error
+	return int(count), err
+}
+
+func (r *notificationRepo) MarkNotificationRead(ctx context.Context, uid uint, id uint) error {
+	var n Notification
+	if err := r.data.DB(ctx).Where("id = ? AND user_id = ?", id, uid).Take(&n).Error; err != nil {
+		return translateNotFound(err, biz.ErrNotificationNotFound)
+	}
+	if n.ReadAt != nil {
+		return nil
+	}
+	return r.data.DB(ctx).Model(&n).Update("read_at", time.Now()).Error
+}
+
+func (r *notificationRepo) MarkAllNotificationsRead(ctx context.Context, uid uint) error {
+	return r.data.DB(ctx).Model(&Notification{}).Where("user_id = ? AND read_at IS NULL", uid).Update("read_at", time.Now()).Error
+}
+
+func (r *notificationRepo) GetNotificationPreferences(ctx context.Context, uid uint) (map[string]bool, error) {
+	var rows []NotificationPreference
+	if err := r.data.DB(ctx).Where("user_id = ?", uid).Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	preferences := make(map[string]bool, len(rows))
+	for _, p := range rows {
+		preferences[p.Type] = p.Enabled
+	}
+	return preferences, nil
+}
+
+func (r *notificationRepo) UpdateNotificationPreferences(ctx context.Context, uid uint, preferences map[string]bool) error {
+	rows := make([]NotificationPreference, 0, len(preferences))
+	for t, enabled := range preferences {
+		rows = append(rows, NotificationPreference{UserID: uid, Type: t, Enabled: enabled})
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+	return r.data.DB(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}, {Name: "type"}},
+		DoUpdates: clause.AssignmentColumns([]string{"enabled", "updated_at"}),
+	}).Create(&rows).Error
+}
+
+// 删除用户收到的通知和通知设置, 用户作为操作者的记录保留在别人的通知中
+// 导出uid收到的所有通知和每条通知的操作者, 包括文章已删除的通知
+func exportNotifications(db *gorm.DB, uid uint) ([]*biz.ExportNotification, error) {
+	type notificationRow struct {
+		Notification
+		ArticleSlug string
+	}
+	var rows []notificationRow
+	err := db.Model(&Notification{}).
+		Select("notifications.*, articles.slug AS article_slug").
+		Joins("LEFT JOIN articles ON articles.id = notifications.article_id").
+		Where("notifications.user_id = ?", uid).
+		Order("notifications.id").
+		Scan(&rows).Error
+	if err != nil || len(rows) == 0 {
+		return nil, err
+	}
+	ids := make([]uint, len(rows))
+	for i, row := range rows {
+		ids[i] = row.ID
+	}
+	type actorRow struct {
+		NotificationID uint
+		Username       string
+	}
+	var actors []actorRow
+	err = db.Model(&NotificationActor{}).
+		Select("notification_actors.notification_id AS notification_id, users.username AS username").
+		Joins("JOIN users ON users.id = notification_actors.actor_id").
+		Where("notification_actors.notification_id IN ?", ids).
+		Order("notification_actors.id").
+		Scan(&actors).Error
+	if err != nil {
+		return nil, err
+	}
+	byNotification := make(map[uint][]string, len(rows))
+	for _, a := range actors {
+		byNotification[a.NotificationID] = append(byNotification[a.NotificationID], a.Username)
+	}
+	list := make([]*biz.ExportNotification, len(rows))
+	for i, row := range rows {
+		list[i] = &biz.ExportNotification{
+			Type:        row.Type,
+			ArticleSlug: row.ArticleSlug,
+			CommentID:   row.CommentID,
+			Actors:      byNotification[row.ID],
+			Read:        row.ReadAt != nil,
+			CreatedAt:   row.CreatedAt,
+		}
+	}
+	return list, nil
+}
+
+func deleteUserNotifications(tx *gorm.DB, uid uint) error {
+	ids := tx.Session(&gorm.Session{NewDB: true}).Unscoped().Model(&Notification{}).Select("id").Where("user_id = ?", uid)
+	if err := tx.Unscoped().Where("notification_id IN (?)", ids).Delete(&NotificationActor{}).Error; err != nil {
+		return err
+	}
+	if err := tx.Unscoped().Where("user_id = ?", uid).Delete(&Notification{}).Error; err != nil {
+		return err
+	}
+	return tx.Unscoped().Where("user_id = ?", uid).Delete(&NotificationPreference{}).Error
+}
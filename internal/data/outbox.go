@@ -0,0 +1,142 @@
+package data
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"kratos-realworld/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 领域事件的outbox表 - 和业务数据在同一个事务中写入, 由EventBus投递
+// 领取事件时把next_attempt_at推迟一个租期, 投递结果保存前不会被其他实例领取
+type OutboxEvent struct {
+	ID            uint `gorm:"primarykey"`
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	Type          string `gorm:"size:100"`
+	Payload       string `gorm:"type:text"`
+	Status        string `gorm:"size:20;index:idx_outbox_status_next_attempt"`
+	Attempts      int
+	NextAttemptAt time.Time `gorm:"index:idx_outbox_status_next_attempt"`
+	// 已经处理成功的订阅者, 逗号分隔
+	Delivered string `gorm:"size:1000"`
+	LastError string `gorm:"type:text"`
+}
+
+func convertOutboxEvent(e OutboxEvent) *biz.OutboxEvent {
+	var delivered []string
+	if e.Delivered != "" {
+		delivered = strings.Split(e.Delivered, ",")
+	}
+	return &biz.OutboxEvent{
+		ID:            e.ID,
+		Type:          e.Type,
+		Payload:       []byte(e.Payload),
+		Status:        e.Status,
+		Attempts:      e.Attempts,
+		NextAttemptAt: e.NextAttemptAt,
+		Delivered:     delivered,
+		LastError:     e.LastError,
+		CreatedAt:     e.CreatedAt,
+	}
+}
+
+// 新事件提交后通知EventBus, 缓冲为1, 已经有通知时不再发送
+type outboxNotifier chan struct{}
+
+func (n outboxNotifier) notify() {
+	select {
+	case n <- struct{}{}:
+	default:
+	}
+}
+
+type outboxRepo struct {
+	data   *Data
+	notify outboxNotifier
+	log    *log.Helper
+}
+
+func NewOutboxRepo(data *Data, logger log.Logger) biz.OutboxRepo {
+	if data.mem != nil {
+		return &memoryOutboxRepo{mem: data.mem, notify: make(outboxNotifier, 1)}
+	}
+	return &outboxRepo{
+		data:   data,
+		notify: make(outboxNotifier, 1),
+		log:    log.NewHelper(logger),
+	}
+}
+
+func (r *outboxRepo) AddEvents(ctx context.Context, events []*biz.OutboxEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+	rows := make([]OutboxEvent, len(events))
+	for i, e := range events {
+		rows[i] = OutboxEvent{
+			CreatedAt:     e.CreatedAt,
+			Type:          e.Type,
+			Payload:       string(e.Payload),
+			Status:        e.Status,
+			NextAttemptAt: e.NextAttemptAt,
+		}
+	}
+	if err := r.data.DB(ctx).Create(&rows).Error; err != nil {
+		return err
+	}
+	for i := range rows {
+		events[i].ID = rows[i].ID
+	}
+	afterCommit(ctx, r.notify.notify)
+	return nil
+}
+
+// 在事务中读取, 不会读到副本上的旧数据; 逐条条件更新, 多个实例同时领取时只有一个成功
+func (r *outboxRepo) ClaimEvents(ctx context.Context, now time.Time, limit int, lease time.Duration) ([]*biz.OutboxEvent, error) {
+	events := make([]*biz.OutboxEvent, 0)
+	err := r.data.Transaction(ctx, func(ctx context.Context) error {
+		var rows []OutboxEvent
+		err := r.data.DB(ctx).Where("status = ? AND next_attempt_at <= ?", biz.EventPending, now).
+			Order("id").Limit(limit).Find(&rows).Error
+		if err != nil {
+			return err
+		}
+		until := now.Add(lease)
+		for _, row := range rows {
+			res := r.data.DB(ctx).Model(&OutboxEvent{}).
+				Where("id = ? AND status = ? AND next_attempt_at <= ?", row.ID, biz.EventPending, now).
+				Update("next_attempt_at", until)
+			if res.Error != nil {
+				return res.Error
+			}
+			if res.RowsAffected == 0 {
+				continue
+			}
+			row.NextAttemptAt = until
+			events = append(events, convertOutboxEvent(row))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+func (r *outboxRepo) UpdateEvent(ctx context.Context, e *biz.OutboxEvent) error {
+	return r.data.DB(ctx).Model(&OutboxEvent{ID: e.ID}).Updates(map[string]interface{}{
+		"status":          e.Status,
+		"attempts":        e.Attempts,
+		"next_attempt_at": e.NextAttemptAt,
+		"delivered":       strings.Join(e.Delivered, ","),
+		"last_error":      e.LastError,
+	}).Error
+}
+
+func (r *outboxRepo) Notify() <-chan struct{} {
+	return r.notify
+}
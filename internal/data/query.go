@@ -0,0 +1,131 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	gormlogger "gorm.io/gorm/logger"
+)
+
+const (
+	queryTimeoutCallback = "realworld:query_timeout"
+	queryCancelCallback  = "realworld:query_cancel"
+	queryTimeoutKey      = "realworld:query_timeout_state"
+)
+
+// 执行前保存的原始ctx和取消超时的函数
+type queryTimeout struct {
+	parent context.Context
+	cancel context.CancelFunc
+}
+
+// 每条SQL单独设置超时, 查询使用read, 写入和Exec使用write
+// 超时的ctx从Statement的ctx派生, 所以仍然受请求ctx的取消和deadline限制
+func registerQueryTimeouts(db *gorm.DB, read time.Duration, write time.Duration) error {
+	cb := db.Callback()
+	return errors.Join(
+		cb.Query().Before("gorm:query").Register(queryTimeoutCallback, beforeQuery(read)),
+		cb.Query().After("gorm:query").Register(queryCancelCallback, afterQuery(true)),
+		// Rows在回调之后才读取, 不能提前取消, 超时到期后自动释放
+		cb.Row().Before("gorm:row").Register(queryTimeoutCallback, beforeQuery(read)),
+		cb.Row().After("gorm:row").Register(queryCancelCallback, afterQuery(false)),
+		cb.Raw().Before("gorm:raw").Register(queryTimeoutCallback, beforeQuery(write)),
+		cb.Raw().After("gorm:raw").Register(queryCancelCallback, afterQuery(true)),
+		cb.Create().Before("gorm:create").Register(queryTimeoutCallback, beforeQuery(write)),
+		cb.Create().After("gorm:create").Register(queryCancelCallback, afterQuery(true)),
+		cb.Update().Before("gorm:update").Register(queryTimeoutCallback, beforeQuery(write)),
+		cb.Update().After("gorm:update").Register(queryCancelCallback, afterQuery(true)),
+		cb.Delete().Before("gorm:delete").Register(queryTimeoutCallback, beforeQuery(write)),
+		cb.Delete().After("gorm:delete").Register(queryCancelCallback, afterQuery(true)),
+	)
+}
+
+func beforeQuery(timeout time.Duration) func(*gorm.DB) {
+	return func(db *gorm.DB) {
+		// 构建子查询时只生成SQL, 不执行
+		if timeout <= 0 || db.DryRun {
+			return
+		}
+		parent := db.Statement.Context
+		ctx, cancel := context.WithTimeout(parent, timeout)
+		db.Statement.Context = ctx
+		db.InstanceSet(queryTimeoutKey, queryTimeout{parent: parent, cancel: cancel})
+	}
+}
+
+// 恢复原始ctx, 同一个Statement执行下一条SQL时重新计时
+func afterQuery(cancel bool) func(*gorm.DB) {
+	return func(db *gorm.DB) {
+		v, _ := db.InstanceGet(queryTimeoutKey)
+		t, ok := v.(queryTimeout)
+		if !ok {
+			return
+		}
+		if cancel {
+			t.cancel()
+		}
+		db.Statement.Context = t.parent
+		db.InstanceSet(queryTimeoutKey, nil)
+	}
+}
+
+// gorm的日志输出到kratos的logger, 执行时间超过slowThreshold的SQL以warn级别记录
+type gormLogger struct {
+	log           *log.Helper
+	level         gormlogger.LogLevel
+	slowThreshold time.Duration
+}
+
+func newGormLogger(logger log.Logger, slowThreshold time.Duration) gormlogger.Interface {
+	return &gormLogger{
+		log:           log.NewHelper(logger),
+		level:         gormlogger.Warn,
+		slowThreshold: slowThreshold,
+	}
+}
+
+func (l *gormLogger) LogMode(level gormlogger.LogLevel) gormlogger.Interface {
+	n := *l
+	n.level = level
+	return &n
+}
+
+func (l *gormLogger) Info(ctx context.Context, msg string, data ...interface{}) {
+	if l.level >= gormlogger.Info {
+		l.log.WithContext(ctx).Infof(msg, data...)
+	}
+}
+
+func (l *gormLogger) Warn(ctx context.Context, msg string, data ...interface{}) {
+	if l.level >= gormlogger.Warn {
+		l.log.WithContext(ctx).Warnf(msg, data...)
+	}
+}
+
+func (l *gormLogger) Error(ctx context.Context, msg string, data ...interface{}) {
+	if l.level >= gormlogger.Error {
+		l.log.WithContext(ctx).Errorf(msg, data...)
+	}
+}
+
+// 查询不到记录由repo转换成业务错误, 不记录
+func (l *gormLogger) Trace(ctx context.Context, begin time.Time, fc func() (string, int64), err error) {
+	if l.level <= gormlogger.Silent {
+		return
+	}
+	elapsed := time.Since(begin)
+	switch {
+	case err != nil && l.level >= gormlogger.Error && !errors.Is(err, gorm.ErrRecordNotFound):
+		sql, rows := fc()
+		l.log.WithContext(ctx).Errorf("sql failed: %v [%s] [rows:%d] %s", err, elapsed, rows, sql)
+	case l.slowThreshold > 0 && elapsed > l.slowThreshold && l.level >= gormlogger.Warn:
+		sql, rows := fc()
+		l.log.WithContext(ctx).Warnf("slow sql >= %s [%s] [rows:%d] %s", l.slowThreshold, elapsed, rows, sql)
+	case l.level >= gormlogger.Info:
+		sql, rows := fc()
+		l.log.WithContext(ctx).Debugf("[%s] [rows:%d] %s", elapsed, rows, sql)
+	}
+}
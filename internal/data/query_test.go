@@ -0,0 +1,105 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-playground/assert/v2"
+	gormlogger "gorm.io/gorm/logger"
+)
+
+func TestRepoHonorsContext(t *testing.T) {
+	d := newTestData(t)
+	ur := NewUserRepo(d, log.DefaultLogger)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := ur.GetUserByEmail(ctx, "nobody@example.com")
+	assert.Equal(t, true, errors.Is(err, context.Canceled))
+
+	// 事务中的repo也使用调用时的ctx
+	err = d.Transaction(context.Background(), func(txCtx context.Context) error {
+		ctx, cancel := context.WithCancel(txCtx)
+		cancel()
+		_, err := ur.GetUserByEmail(ctx, "nobody@example.com")
+		return err
+	})
+	assert.Equal(t, true, errors.Is(err, context.Canceled))
+}
+
+func TestQueryTimeouts(t *testing.T) {
+	d := newTestData(t)
+	uids := createTestUsers(t, d, 1)
+	if err := registerQueryTimeouts(d.db, time.Nanosecond, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	var u User
+	err := d.DB(context.Background()).First(&u, uids[0]).Error
+	assert.Equal(t, true, errors.Is(err, context.DeadlineExceeded))
+	var ids []uint
+	err = d.DB(context.Background()).Model(&User{}).Select("id").Scan(&ids).Error
+	assert.Equal(t, true, errors.Is(err, context.DeadlineExceeded))
+
+	// 没有设置写超时
+	err = d.DB(context.Background()).Model(&User{}).Where("id = ?", uids[0]).Update("bio", "updated").Error
+	assert.Equal(t, nil, err)
+}
+
+// 同一个Statement执行多条SQL时每条单独计时, 执行后恢复原来的ctx
+func TestQueryTimeoutRestoresContext(t *testing.T) {
+	d := newTestData(t)
+	createTestUsers(t, d, 2)
+	if err := registerQueryTimeouts(d.db, time.Second, time.Second); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	db := d.DB(ctx).Model(&User{})
+	var count int64
+	assert.Equal(t, nil, db.Count(&count).Error)
+	assert.Equal(t, int64(2), count)
+	assert.Equal(t, ctx, db.Statement.Context)
+	var users []User
+	assert.Equal(t, nil, db.Find(&users).Error)
+	assert.Equal(t, 2, len(users))
+}
+
+// 记录日志级别和内容的logger
+type recordLogger struct {
+	levels []log.Level
+	lines  []string
+}
+
+func (l *recordLogger) Log(level log.Level, keyvals ...interface{}) error {
+	l.levels = append(l.levels, level)
+	l.lines = append(l.lines, fmt.Sprint(keyvals...))
+	return nil
+}
+
+func TestSlowQueryLog(t *testing.T) {
+	logger := &recordLogger{}
+	l := newGormLogger(logger, 100*time.Millisecond)
+	sql := func() (string, int64) { return "SELECT 1", 1 }
+	ctx := context.Background()
+
+	l.Trace(ctx, time.Now(), sql, nil)
+	assert.Equal(t, 0, len(logger.levels))
+	l.Trace(ctx, time.Now().Add(-time.Second), sql, nil)
+	assert.Equal(t, []log.Level{log.LevelWarn}, logger.levels)
+
+	// 查询不到记录不是错误
+	l.Trace(ctx, time.Now(), sql, errors.New("failed"))
+	l.Trace(ctx, time.Now(), sql, gormlogger.ErrRecordNotFound)
+	assert.Equal(t, []log.Level{log.LevelWarn, log.LevelError}, logger.levels)
+
+	// 没有配置阈值时不记录慢查询
+	logger = &recordLogger{}
+	l = newGormLogger(logger, 0)
+	l.Trace(ctx, time.Now().Add(-time.Hour), sql, nil)
+	l.LogMode(gormlogger.Silent).Trace(ctx, time.Now(), sql, errors.New("failed"))
+	assert.Equal(t, 0, len(logger.levels))
+}
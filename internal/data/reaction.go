@@ -0,0 +1,134 @@
+package data
+
+import (
+	"context"
+
+	"kratos-realworld/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 表情回应 - 同一用户对同一目标的同一表情只能有一条
+type Reaction struct {
+	gorm.Model
+	UserID     uint   `gorm:"index:idx_reaction_user_target,unique"`
+	TargetType string `gorm:"size:16;index:idx_reaction_user_target,unique;index:idx_reaction_target"`
+	TargetID   uint   `gorm:"index:idx_reaction_user_target,unique;index:idx_reaction_target"`
+	Reaction   string `gorm:"size:32;index:idx_reaction_user_target,unique"`
+}
+
+// 每个目标每种表情的回应数 - 和reactions表在同一个事务中原子更新
+type ReactionCount struct {
+	ID             uint   `gorm:"primarykey"`
+	TargetType     string `gorm:"size:16;index:idx_reaction_count_target,unique"`
+	TargetID       uint   `gorm:"index:idx_reaction_count_target,unique"`
+	Reaction       string `gorm:"size:32;index:idx_reaction_count_target,unique"`
+	ReactionsCount uint32
+}
+
+type reactionRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewReactionRepo(data *Data, logger log.Logger) biz.ReactionRepo {
+	if data.mem != nil {
+		return &memoryReactionRepo{mem: data.mem}
+	}
+	return &reactionRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *reactionRepo) AddReaction(ctx context.Context, uid uint, targetType string, targetID uint, reaction string) error {
+	return r.data.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		// 已经回应过时唯一索引冲突, 不重复计数
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&Reaction{
+			UserID:     uid,
+			TargetType: targetType,
+			TargetID:   targetID,
+			Reaction:   reaction,
+		})
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+		// 冲突时更新的列需要带表名, postgres中不带表名时和EXCLUDED有歧义
+		return tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "target_type"}, {Name: "target_id"}, {Name: "reaction"}},
+			DoUpdates: clause.Assignments(map[string]interface{}{"reactions_count": incrExpr("reaction_counts.reactions_count")}),
+		}).Create(&ReactionCount{
+			TargetType:     targetType,
+			TargetID:       targetID,
+			Reaction:       reaction,
+			ReactionsCount: 1,
+		}).Error
+	})
+}
+
+func (r *reactionRepo) RemoveReaction(ctx context.Context, uid uint, targetType string, targetID uint, reaction string) error {
+	return r.data.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Unscoped().
+			Where("user_id = ? AND target_type = ? AND target_id = ? AND reaction = ?", uid, targetType, targetID, reaction).
+			Delete(&Reaction{})
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+		return decrReactionCount(tx, targetType, targetID, reaction)
+	})
+}
+
+func decrReactionCount(tx *gorm.DB, targetType string, targetID uint, reaction string) error {
+	return tx.Model(&ReactionCount{}).
+		Where("target_type = ? AND target_id = ? AND reaction = ?", targetType, targetID, reaction).
+		UpdateColumn("reactions_count", decrExpr("reactions_count")).Error
+}
+
+func (r *reactionRepo) GetReactionCounts(ctx context.Context, targetType string, targetIDs []uint) (map[uint][]biz.ReactionCount, error) {
+	result := make(map[uint][]biz.ReactionCount, len(targetIDs))
+	if len(targetIDs) == 0 {
+		return result, nil
+	}
+	var counts []ReactionCount
+	err := r.data.DB(ctx).Where("target_type = ? AND target_id IN ? AND reactions_count > 0", targetType, targetIDs).Find(&counts).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range counts {
+		result[c.TargetID] = append(result[c.TargetID], biz.ReactionCount{Reaction: c.Reaction, Count: c.ReactionsCount})
+	}
+	return result, nil
+}
+
+func (r *reactionRepo) GetUserReactions(ctx context.Context, uid uint, targetType string, targetIDs []uint) (map[uint][]string, error) {
+	result := make(map[uint][]string, len(targetIDs))
+	if len(targetIDs) == 0 {
+		return result, nil
+	}
+	var reactions []Reaction
+	err := r.data.DB(ctx).Select("target_id, reaction").
+		Where("user_id = ? AND target_type = ? AND target_id IN ?", uid, targetType, targetIDs).Find(&reactions).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, reaction := range reactions {
+		result[reaction.TargetID] = append(result[reaction.TargetID], reaction.Reaction)
+	}
+	return result, nil
+}
+
+// 删除用户的所有回应, 同时减掉对应的回应数
+func deleteUserReactions(tx *gorm.DB, uid uint) error {
+	var reactions []Reaction
+	if err := tx.Where("user_id = ?", uid).Find(&reactions).Error; err != nil {
+		return err
+	}
+	for _, reaction := range reactions {
+		if err := decrReactionCount(tx, reaction.TargetType, reaction.TargetID, reaction.Reaction); err != nil {
+			return err
+		}
+	}
+	return tx.Unscoped().Where("user_id = ?", uid).Delete(&Reaction{}).Error
+}
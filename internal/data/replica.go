@@ -0,0 +1,204 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"kratos-realworld/internal/pkg/middleware/auth"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+const (
+	replicaPluginName    = "realworld:replicas"
+	replicaRouteName     = "realworld:replica_route"
+	replicaStickyName    = "realworld:replica_sticky"
+	defaultCheckInterval = 10 * time.Second
+)
+
+// 只读副本
+type replica struct {
+	id      int
+	pool    *sql.DB
+	healthy atomic.Bool
+	// 只在检查的goroutine中使用
+	checked bool
+}
+
+// 读写分离 - 作为gorm插件注册
+// Query和Row发到健康的副本, 写入, 事务和加锁的查询使用主库
+// 用户写入后stickyWindow内的查询也使用主库
+// 写入时间只记录在当前进程中, 多个实例时只有处理写入的实例会读主库,
+// 负载均衡需要按用户保持会话, 否则其他实例仍可能读到副本上的旧数据
+type replicaSet struct {
+	primary      gorm.ConnPool
+	replicas     []*replica
+	next         atomic.Uint64
+	stickyWindow time.Duration
+	// uid -> 最后一次写入的时间, 只在当前实例有效
+	writes sync.Map
+	log    *log.Helper
+}
+
+func newReplicaSet(pools []*sql.DB, stickyWindow time.Duration, logger log.Logger) *replicaSet {
+	rs := &replicaSet{
+		stickyWindow: stickyWindow,
+		log:          log.NewHelper(logger),
+	}
+	// 第一次检查通过之前查询都发到主库
+	for i, pool := range pools {
+		rs.replicas = append(rs.replicas, &replica{id: i, pool: pool})
+	}
+	return rs
+}
+
+func (rs *replicaSet) Name() string {
+	return replicaPluginName
+}
+
+func (rs *replicaSet) Initialize(db *gorm.DB) error {
+	rs.primary = db.Config.ConnPool
+	cb := db.Callback()
+	return errors.Join(
+		cb.Query().Before("gorm:query").Register(replicaRouteName, rs.routeRead),
+		cb.Row().Before("gorm:row").Register(replicaRouteName, rs.routeRead),
+		// 同一个Statement先查询后写入时需要切回主库
+		cb.Create().Before("gorm:create").Register(replicaRouteName, rs.routeWrite),
+		cb.Update().Before("gorm:update").Register(replicaRouteName, rs.routeWrite),
+		cb.Delete().Before("gorm:delete").Register(replicaRouteName, rs.routeWrite),
+		cb.Raw().Before("gorm:raw").Register(replicaRouteName, rs.routeWrite),
+		cb.Create().After("gorm:create").Register(replicaStickyName, rs.recordWrite),
+		cb.Update().After("gorm:update").Register(replicaStickyName, rs.recordWrite),
+		cb.Delete().After("gorm:delete").Register(replicaStickyName, rs.recordWrite),
+		cb.Raw().After("gorm:raw").Register(replicaStickyName, rs.recordWrite),
+	)
+}
+
+// 事务中的SQL都在事务的连接上执行
+func inTransaction(db *gorm.DB) bool {
+	_, ok := db.Statement.ConnPool.(gorm.TxCommitter)
+	return ok
+}
+
+func (rs *replicaSet) routeRead(db *gorm.DB) {
+	if db.DryRun || inTransaction(db) {
+		return
+	}
+	db.Statement.ConnPool = rs.primary
+	if _, locking := db.Statement.Clauses["FOR"]; locking || rs.sticky(db.Statement.Context) {
+		return
+	}
+	if r := rs.pick(); r != nil {
+		db.Statement.ConnPool = r.pool
+	}
+}
+
+func (rs *replicaSet) routeWrite(db *gorm.DB) {
+	if db.DryRun || inTransaction(db) {
+		return
+	}
+	db.Statement.ConnPool = rs.primary
+}
+
+// 记录登录用户的写入时间, 事务中的写入也需要记录
+func (rs *replicaSet) recordWrite(db *gorm.DB) {
+	if rs.stickyWindow <= 0 || db.DryRun || db.Error != nil {
+		return
+	}
+	if currentUser, ok := auth.FromContext(db.Statement.Context); ok {
+		rs.writes.Store(currentUser.UserID, time.Now())
+	}
+}
+
+// 用户最近有写入时读主库
+func (rs *replicaSet) sticky(ctx context.Context) bool {
+	if rs.stickyWindow <= 0 {
+		return false
+	}
+	currentUser, ok := auth.FromContext(ctx)
+	if !ok {
+		return false
+	}
+	v, ok := rs.writes.Load(currentUser.UserID)
+	if !ok {
+		return false
+	}
+	if time.Since(v.(time.Time)) < rs.stickyWindow {
+		return true
+	}
+	rs.writes.CompareAndDelete(currentUser.UserID, v)
+	return false
+}
+
+// 在健康的副本中轮询, 都不健康时返回nil
+func (rs *replicaSet) pick() *replica {
+	n := uint64(len(rs.replicas))
+	start := rs.next.Add(1)
+	for i := uint64(0); i < n; i++ {
+		if r := rs.replicas[(start+i)%n]; r.healthy.Load() {
+			return r
+		}
+	}
+	return nil
+}
+
+// 检查所有副本, 状态变化时记录日志
+func (rs *replicaSet) check(ctx context.Context, timeout time.Duration) {
+	for _, r := range rs.replicas {
+		pingCtx, cancel := context.WithTimeout(ctx, timeout)
+		err := r.pool.PingContext(pingCtx)
+		cancel()
+		healthy := err == nil
+		if r.healthy.Swap(healthy) == healthy && r.checked {
+			continue
+		}
+		r.checked = true
+		if healthy {
+			rs.log.Infof("replica %d is healthy", r.id)
+		} else {
+			rs.log.Warnf("replica %d is unhealthy, reads go to other replicas: %v", r.id, err)
+		}
+	}
+	// 清理已经过期的写入记录
+	rs.writes.Range(func(uid, v any) bool {
+		if time.Since(v.(time.Time)) >= rs.stickyWindow {
+			rs.writes.CompareAndDelete(uid, v)
+		}
+		return true
+	})
+}
+
+// 定期检查副本, 返回停止检查并关闭副本连接的函数
+func (rs *replicaSet) start(interval time.Duration) func() {
+	if interval <= 0 {
+		interval = defaultCheckInterval
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	rs.check(ctx, interval)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				rs.check(ctx, interval)
+			}
+		}
+	}()
+	return func() {
+		cancel()
+		<-done
+		for _, r := range rs.replicas {
+			r.pool.Close()
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"kratos-realworld/internal/conf"
+	"kratos-realworld/internal/pkg/middleware/auth"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-playground/assert/v2"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 主库和副本是两个独立的内存库, 通过数据是否存在判断查询发到了哪里
+func newReplicaTestData(t *testing.T, stickyWindow time.Duration) (*Data, *replicaSet) {
+	replica := NewDB(&conf.Data{Database: &conf.Data_Database{Driver: "sqlite", Dsn: "file:" + t.Name() + "-replica?mode=memory&cache=shared"}}, log.DefaultLogger)
+	pool, err := replica.DB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { pool.Close() })
+	d := newTestData(t)
+	rs := newReplicaSet([]*sql.DB{pool}, stickyWindow, log.DefaultLogger)
+	if err := d.db.Use(rs); err != nil {
+		t.Fatal(err)
+	}
+	rs.check(context.Background(), time.Second)
+	return d, rs
+}
+
+func findUser(ctx context.Context, d *Data, username string) error {
+	var u User
+	return d.DB(ctx).Where("username = ?", username).First(&u).Error
+}
+
+func TestReplicaRouting(t *testing.T) {
+	d, _ := newReplicaTestData(t, time.Minute)
+	ctx := context.Background()
+	assert.Equal(t, nil, d.DB(ctx).Create(&User{Username: "writer", Email: "writer@example.com"}).Error)
+
+	// 写入发到主库, 匿名的读发到副本
+	assert.Equal(t, true, errors.Is(findUser(ctx, d, "writer"), gorm.ErrRecordNotFound))
+	var n int64
+	assert.Equal(t, nil, d.DB(ctx).Model(&User{}).Count(&n).Error)
+	assert.Equal(t, int64(0), n)
+
+	// 加锁的查询和事务中的查询使用主库
+	var u User
+	assert.Equal(t, nil, d.DB(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).Where("username = ?", "writer").First(&u).Error)
+	err := d.Transaction(ctx, func(txCtx context.Context) error {
+		return findUser(txCtx, d, "writer")
+	})
+	assert.Equal(t, nil, err)
+}
+
+func TestReplicaReadYourWrites(t *testing.T) {
+	d, rs := newReplicaTestData(t, time.Minute)
+	writer := auth.WithContext(context.Background(), &auth.CurrentUser{UserID: 1})
+	other := auth.WithContext(context.Background(), &auth.CurrentUser{UserID: 2})
+	assert.Equal(t, nil, d.DB(writer).Create(&User{Username: "writer", Email: "writer@example.com"}).Error)
+
+	// 写入的用户在窗口内读主库, 其他用户仍然读副本
+	assert.Equal(t, nil, findUser(writer, d, "writer"))
+	assert.Equal(t, true, errors.Is(findUser(other, d, "writer"), gorm.ErrRecordNotFound))
+
+	// 窗口过期后回到副本
+	rs.writes.Store(uint(1), time.Now().Add(-time.Hour))
+	assert.Equal(t, true, errors.Is(findUser(writer, d, "writer"), gorm.ErrRecordNotFound))
+	_, ok := rs.writes.Load(uint(1))
+	assert.Equal(t, false, ok)
+}
+
+func TestReplicaHealthCheck(t *testing.T) {
+	d, rs := newReplicaTestData(t, 0)
+	ctx := context.Background()
+	// 第一次检查之前不使用副本
+	assert.Equal(t, true, newReplicaSet([]*sql.DB{rs.replicas[0].pool}, 0, log.DefaultLogger).pick() == nil)
+	assert.Equal(t, nil, d.DB(ctx).Create(&User{Username: "writer", Email: "writer@example.com"}).Error)
+	assert.Equal(t, true, errors.Is(findUser(ctx, d, "writer"), gorm.ErrRecordNotFound))
+
+	// 副本不可用后读主库
+	rs.replicas[0].pool.Close()
+	rs.check(ctx, time.Second)
+	assert.Equal(t, false, rs.replicas[0].healthy.Load())
+	assert.Equal(t, nil, findUser(ctx, d, "writer"))
+}
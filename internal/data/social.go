@@ -2,11 +2,11 @@ package data
 
 import (
 	"context"
+	"errors"
 	"kratos-realworld/internal/biz"
 	"kratos-realworld/internal/pkg/utils"
 	"strings"
 
-	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
@@ -23,6 +23,7 @@ func convertArticle(a Article) *biz.Article {
 		CreatedAt:      a.CreatedAt,
 		UpdatedAt:      a.UpdatedAt,
 		FavoritesCount: a.FavoritesCount,
+		CoverImage:     a.CoverImage,
 		TagList: func() []string {
 			tags := make([]string, len(a.Tags))
 			for i, tag := range a.Tags {
@@ -31,23 +32,8 @@ func convertArticle(a Article) *biz.Article {
 			return tags
 		}(),
 		AuthorID: a.AuthorID,
-		Author: &biz.ProfileResp{
-			ID:       a.Author.ID,
-			Username: a.Author.Username,
-			Bio:      a.Author.Bio,
-			Image:    a.Author.Image,
-		},
-	}
-}
-
-// 收藏数量
-func countFavorites(db *gorm.DB, aid uint) (uint32, error) {
-	var count int64
-	e := db.Model(&ArticleFavorite{}).Where("article_id = ?", aid).Count(&count).Error
-	if e != nil {
-		return 0, e
+		Author:   convertProfile(a.Author),
 	}
-	return uint32(count), nil
 }
 
 // 定义数据库表结构
@@ -59,6 +45,7 @@ type Article struct {
 	Title          string `gorm:"size:500"`
 	Description    string `gorm:"size:1000"`
 	Body           string `gorm:"size:10000"`
+	CoverImage     string `gorm:"size:1000"`
 	Tags           []Tag  `gorm:"many2many:article_tags;constraint:OnDelete:CASCADE;"`
 	AuthorID       uint
 	Author         User // 关联user表
@@ -100,6 +87,9 @@ type articleRepo struct {
 }
 
 func NewArticleRepo(data *Data, logger log.Logger) biz.ArticleRepo {
+	if data.mem != nil {
+		return &memoryArticleRepo{mem: data.mem}
+	}
 	return &articleRepo{
 		data: data,
 		log:  log.NewHelper(logger),
@@ -107,244 +97,361 @@ func NewArticleRepo(data *Data, logger log.Logger) biz.ArticleRepo {
 }
 
 func (ar *articleRepo) CreateArticle(ctx context.Context, article *biz.Article) (*biz.Article, error) {
-	// 组装tags, 并写入tag表
-	tags := make([]Tag, len(article.TagList))
-	for i, tagName := range article.TagList {
-		tags[i] = Tag{
-			Name: tagName,
-		}
-	}
-	if len(tags) > 0 {
-		err := ar.data.db.Clauses(clause.OnConflict{
-			DoNothing: true,
-		}).Create(&tags).Error
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	// 以防tag为0的情况（如果tag已经存在, 则不会创建, 所以需要查询） - fix
-	var dbTags []Tag
-	ar.data.db.Where("name IN ?", article.TagList).Find(&dbTags)
-
 	a := Article{
 		Slug:        article.Slug,
 		Title:       article.Title,
 		Description: article.Description,
 		Body:        article.Body,
-		Tags:        dbTags,
+		CoverImage:  article.CoverImage,
 		AuthorID:    article.AuthorID,
 		Author:      User{Model: gorm.Model{ID: article.AuthorID}},
 	}
 
-	err := ar.data.db.Create(&a).Error
+	// 写入tag, 创建文章, 更新作者的文章数在同一个事务中
+	err := ar.data.Transaction(ctx, func(ctx context.Context) error {
+		tags, err := ensureTags(ar.data.DB(ctx), article.TagList)
+		if err != nil {
+			return err
+		}
+		a.Tags = tags
+		if err := ar.data.DB(ctx).Create(&a).Error; err != nil {
+			return err
+		}
+		return ar.data.DB(ctx).Model(&User{}).Where("id = ?", article.AuthorID).UpdateColumn("articles_count", incrExpr("articles_count")).Error
+	})
 	if err != nil {
-		if strings.Contains(err.Error(), "Duplicate entry") {
-			if strings.Contains(err.Error(), "slug") {
-				return nil, errors.BadRequest("slug", "slug already exists")
-			}
+		if isUniqueViolation(err, "slug") {
+			return nil, biz.TakenError("slug")
 		}
 		return nil, err
 	}
+	ar.data.cache.invalidate(ctx, profileCacheKey(article.AuthorID), tagsCacheKey)
 
 	return convertArticle(a), nil
 }
 
-func (ar *articleRepo) GetArticleBySlug(ctx context.Context, slug string) (*biz.Article, error) {
-	a := Article{}
-	result := ar.data.db.Where("slug = ?", slug).Preload("Author").Preload("Tags").Preload("Favorites").First(&a)
-	if result.Error != nil {
-		if result.Error == gorm.ErrRecordNotFound {
-			return nil, errors.NotFound("ARTICLE_NOT_FOUND", "article not found")
-		}
-		return nil, result.Error
+// 不存在的tag先创建, 再按名字查出完整记录
+func ensureTags(db *gorm.DB, names []string) ([]Tag, error) {
+	if len(names) == 0 {
+		return nil, nil
 	}
-
-	article := convertArticle(a)
-	// favorited count
-	fc, err := countFavorites(ar.data.db, a.ID)
-	if err != nil {
+	tags := make([]Tag, len(names))
+	for i, name := range names {
+		tags[i] = Tag{Name: name}
+	}
+	if err := db.Clauses(clause.OnConflict{DoNothing: true}).Create(&tags).Error; err != nil {
 		return nil, err
 	}
-	article.FavoritesCount = fc
-	return article, nil
+	// tag已经存在时不会创建, 所以需要查询
+	var dbTags []Tag
+	if err := db.Where("name IN ?", names).Find(&dbTags).Error; err != nil {
+		return nil, err
+	}
+	return dbTags, nil
 }
 
-func (ar *articleRepo) GetArticleByAid(ctx context.Context, aid uint) (*biz.Article, error) {
-	a := Article{}
-	err := ar.data.db.Model(&Article{}).Where("id = ?", aid).Preload("Author").Preload("Tags").Preload("Favorites").First(&a).Error
-	if err != nil {
-		if err == gorm.ErrRecordNotFound {
-			return nil, errors.NotFound("ARTICLE_NOT_FOUND", "article not found")
-		}
+// 文章列表的查询 - 作者在同一条查询中JOIN, 标签再用一条查询批量加载, 查询数量和文章数量无关
+// 收藏数直接使用favorites_count列
+func findArticles(ctx context.Context, data *Data, db *gorm.DB) ([]Article, error) {
+	var articles []Article
+	if err := db.Joins("Author").Find(&articles).Error; err != nil {
 		return nil, err
 	}
-	// 收藏数量
-	fc, err := countFavorites(ar.data.db, a.ID)
-	if err != nil {
+	if err := loadArticleTags(data.DB(ctx), articles); err != nil {
 		return nil, err
 	}
-	a.FavoritesCount = fc
-
-	return convertArticle(a), nil
-}
-
-func (ar *articleRepo) DeleteArticleBySlug(ctx context.Context, slug string) error {
-	// 关联tag表和favorites表, 可以跟随删除
-	return ar.data.db.Delete(&Article{}, "slug = ?", slug).Error
+	return articles, nil
 }
 
-func (ar *articleRepo) UpdateArticle(ctx context.Context, article *biz.Article) (*biz.Article, error) {
-	var dbArticle Article
-	// 查到数据库中的文章内容
-	err := ar.data.db.Model(&Article{}).Where("slug = ?", article.Slug).First(&dbArticle).Error
+func (ar *articleRepo) firstArticle(ctx context.Context, db *gorm.DB) (*biz.Article, error) {
+	articles, err := findArticles(ctx, ar.data, db.Limit(1))
 	if err != nil {
 		return nil, err
 	}
+	if len(articles) == 0 {
+		return nil, biz.ErrArticleNotFound
+	}
+	return convertArticle(articles[0]), nil
+}
 
-	// 更新文章内容
-	if article.Title != "" {
-		dbArticle.Title = article.Title
-		dbArticle.Slug = utils.Slugify(article.Title)
+// 一条查询加载多篇文章的标签, 按标签id排序
+func loadArticleTags(db *gorm.DB, articles []Article) error {
+	if len(articles) == 0 {
+		return nil
 	}
-	if article.Description != "" {
-		dbArticle.Description = article.Description
+	aids := make([]uint, len(articles))
+	for i, a := range articles {
+		aids[i] = a.ID
 	}
-	if article.Body != "" {
-		dbArticle.Body = article.Body
+	var rows []struct {
+		ArticleID uint
+		TagID     uint
+		Name      string
 	}
-	if err := ar.data.db.Save(&dbArticle).Error; err != nil {
-		if strings.Contains(err.Error(), "Duplicate entry") && strings.Contains(err.Error(), "slug") {
-			return nil, errors.BadRequest("title", "title already exists")
-		}
-		return nil, err
+	err := db.Table("article_tags").Select("article_tags.article_id, tags.id AS tag_id, tags.name").
+		Joins("JOIN tags ON tags.id = article_tags.tag_id AND tags.deleted_at IS NULL").
+		Where("article_tags.article_id IN ?", aids).
+		Order("tags.id").Scan(&rows).Error
+	if err != nil {
+		return err
 	}
+	tags := make(map[uint][]Tag, len(articles))
+	for _, row := range rows {
+		tag := Tag{Name: row.Name}
+		tag.ID = row.TagID
+		tags[row.ArticleID] = append(tags[row.ArticleID], tag)
+	}
+	for i := range articles {
+		articles[i].Tags = tags[articles[i].ID]
+	}
+	return nil
+}
 
-	// 更新tag - 需要更新关联
-	if len(article.TagList) > 0 {
-		// 先确保所有 tag 都存在
-		tags := make([]Tag, len(article.TagList))
-		for i, tagName := range article.TagList {
-			tags[i] = Tag{Name: tagName}
+func (ar *articleRepo) GetArticleBySlug(ctx context.Context, slug string) (*biz.Article, error) {
+	if !ar.data.cache.usable(ctx) {
+		return ar.firstArticle(ctx, ar.data.DB(ctx).Where("articles.slug = ?", slug))
+	}
+	aid, err := cached(ctx, ar.data.cache, articleSlugCacheKey(slug), func(ctx context.Context) (uint, error) {
+		var aids []uint
+		if err := ar.data.DB(ctx).Model(&Article{}).Where("slug = ?", slug).Limit(1).Pluck("id", &aids).Error; err != nil {
+			return 0, err
 		}
-		ar.data.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&tags)
-		// 查出所有 tag 的完整记录
-		var dbTags []Tag
-		ar.data.db.Where("name IN ?", article.TagList).Find(&dbTags)
-		// 更新关联
-		if err := ar.data.db.Model(&dbArticle).Association("Tags").Replace(dbTags); err != nil {
-			return nil, err
+		if len(aids) == 0 {
+			return 0, biz.ErrArticleNotFound
 		}
+		return aids[0], nil
+	})
+	if err != nil {
+		return nil, err
 	}
+	article, err := ar.cachedArticle(ctx, aid)
+	// 文章改名或删除后slug可能已经指向其他文章, 映射不主动失效, 在这里校验
+	if errors.Is(err, biz.ErrArticleNotFound) || (err == nil && article.Slug != slug) {
+		ar.data.cache.invalidate(ctx, articleSlugCacheKey(slug))
+		return ar.firstArticle(ctx, ar.data.DB(ctx).Where("articles.slug = ?", slug))
+	}
+	return article, err
+}
 
-	return convertArticle(dbArticle), nil
+func (ar *articleRepo) GetArticleByAid(ctx context.Context, aid uint) (*biz.Article, error) {
+	if !ar.data.cache.usable(ctx) {
+		return ar.firstArticle(ctx, ar.data.DB(ctx).Where("articles.id = ?", aid))
+	}
+	return ar.cachedArticle(ctx, aid)
 }
 
-// mark 这个接口既能收藏又能取消收藏
-func (ar *articleRepo) FavoriteArticle(ctx context.Context, aid uint, uid uint) error {
-	af := ArticleFavorite{
-		UserID:    uid,
-		ArticleID: aid,
+// 文章和作者分开缓存, 作者资料变化时不需要失效文章
+func (ar *articleRepo) cachedArticle(ctx context.Context, aid uint) (*biz.Article, error) {
+	article, err := cached(ctx, ar.data.cache, articleCacheKey(aid), func(ctx context.Context) (*biz.Article, error) {
+		article, err := ar.firstArticle(ctx, ar.data.DB(ctx).Where("articles.id = ?", aid))
+		if err != nil {
+			return nil, err
+		}
+		article.Author = nil
+		return article, nil
+	})
+	if err != nil {
+		return nil, err
 	}
+	if article.Author, err = cachedProfile(ctx, ar.data, article.AuthorID); err != nil {
+		return nil, err
+	}
+	return article, nil
+}
 
-	// 获取文章
+func (ar *articleRepo) DeleteArticleBySlug(ctx context.Context, slug string) error {
+	// 关联tag表和favorites表, 可以跟随删除
 	a := Article{}
-	err := ar.data.db.Model(&Article{}).Where("id = ?", aid).First(&a).Error
+	err := ar.data.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("slug = ?", slug).First(&a).Error; err != nil {
+			return translateNotFound(err, biz.ErrArticleNotFound)
+		}
+		if err := tx.Delete(&a).Error; err != nil {
+			return err
+		}
+		return tx.Model(&User{}).Where("id = ?", a.AuthorID).UpdateColumn("articles_count", decrExpr("articles_count")).Error
+	})
 	if err != nil {
 		return err
 	}
+	ar.data.cache.invalidate(ctx, articleCacheKey(a.ID), articleSlugCacheKey(slug), profileCacheKey(a.AuthorID), tagsCacheKey)
+	return nil
+}
 
-	// 添加喜欢
-	// 先查询
-	result := ar.data.db.Where(&af).First(&ArticleFavorite{})
-	// 没收藏过则收藏
-	if result.RowsAffected == 0 {
-		ar.log.Infof("favorite article by article_id: %d", aid)
-		err := ar.data.db.Create(&af).Error
+func (ar *articleRepo) UpdateArticle(ctx context.Context, article *biz.Article) (*biz.Article, error) {
+	var dbArticle Article
+	err := ar.data.Transaction(ctx, func(ctx context.Context) error {
+		// 查到数据库中的文章内容
+		err := ar.data.DB(ctx).Model(&Article{}).Where("slug = ?", article.Slug).First(&dbArticle).Error
 		if err != nil {
-			return err
+			return translateNotFound(err, biz.ErrArticleNotFound)
 		}
-	} else {
-		// 收藏过则取消收藏
-		// 采用物理删除
-		ar.log.Infof("unfavorite article by article_id: %d", aid)
-		err := ar.data.db.Unscoped().Where(&af).Delete(&ArticleFavorite{}).Error
-		if err != nil {
+
+		// 更新文章内容
+		if article.Title != "" {
+			dbArticle.Title = article.Title
+			dbArticle.Slug = utils.Slugify(article.Title)
+		}
+		if article.Description != "" {
+			dbArticle.Description = article.Description
+		}
+		if article.Body != "" {
+			dbArticle.Body = article.Body
+		}
+		if article.CoverImageUpdate != nil {
+			dbArticle.CoverImage = *article.CoverImageUpdate
+		}
+		if err := ar.data.DB(ctx).Save(&dbArticle).Error; err != nil {
+			if isUniqueViolation(err, "slug") {
+				return biz.TakenError("title")
+			}
 			return err
 		}
-	}
 
-	// todo: article里的收藏数量可以删除这个字段, 直接通过article_favorites表来计算
-	fc, err := countFavorites(ar.data.db, aid)
+		// 更新tag - 需要更新关联
+		if len(article.TagList) > 0 {
+			tags, err := ensureTags(ar.data.DB(ctx), article.TagList)
+			if err != nil {
+				return err
+			}
+			return ar.data.DB(ctx).Model(&dbArticle).Association("Tags").Replace(tags)
+		}
+		return nil
+	})
 	if err != nil {
-		return err
+		return nil, err
 	}
-	a.FavoritesCount = fc
-	ar.log.Infof("收藏数量: %d", a.FavoritesCount)
+	ar.data.cache.invalidate(ctx, articleCacheKey(dbArticle.ID), articleSlugCacheKey(article.Slug), tagsCacheKey)
 
-	// 更新文章
-	err = ar.data.db.Model(&Article{}).Where("id = ?", aid).UpdateColumn("favorites_count", a.FavoritesCount).Error
-	return err
+	return convertArticle(dbArticle), nil
+}
+
+// 收藏 - 已经收藏过时不报错, 收藏数只在新增时加一
+func (ar *articleRepo) FavoriteArticle(ctx context.Context, aid uint, uid uint) (bool, error) {
+	added := false
+	// 收藏数变化, 提交后失效文章的缓存
+	err := ar.data.Transaction(ctx, func(ctx context.Context) error {
+		ar.data.cache.invalidate(ctx, articleCacheKey(aid))
+		// 唯一索引冲突时不插入, 用影响的行数判断是否新增
+		result := ar.data.DB(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&ArticleFavorite{
+			UserID:    uid,
+			ArticleID: aid,
+		})
+		if isForeignKeyViolation(result.Error) {
+			return biz.ErrArticleNotFound
+		}
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+		added = true
+		return ar.data.DB(ctx).Model(&Article{}).Where("id = ?", aid).UpdateColumn("favorites_count", incrExpr("favorites_count")).Error
+	})
+	return added && err == nil, err
 }
 
-// 只做取消收藏
+// 取消收藏 - 没有收藏过时不报错, 收藏数只在删除时减一
 func (ar *articleRepo) UnfavoriteArticle(ctx context.Context, aid uint, uid uint) error {
-	af := ArticleFavorite{
-		UserID:    uid,
-		ArticleID: aid,
-	}
+	return ar.data.Transaction(ctx, func(ctx context.Context) error {
+		ar.data.cache.invalidate(ctx, articleCacheKey(aid))
+		// 采用物理删除
+		result := ar.data.DB(ctx).Unscoped().Where("user_id = ? AND article_id = ?", uid, aid).Delete(&ArticleFavorite{})
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+		return ar.data.DB(ctx).Model(&Article{}).Where("id = ?", aid).UpdateColumn("favorites_count", decrExpr("favorites_count")).Error
+	})
+}
 
-	a := Article{}
-	err := ar.data.db.Model(&Article{}).Where("id = ?", aid).First(&a).Error
-	if err != nil {
-		return nil
+// 按id顺序检查afterID之后的limit篇文章, 收藏数和收藏表不一致的按收藏表重新计算
+func (ar *articleRepo) ReconcileFavoritesCounts(ctx context.Context, afterID uint, limit int) (int, uint, error) {
+	var articles []Article
+	err := ar.data.DB(ctx).Select("id, favorites_count").Where("id > ?", afterID).Order("id").Limit(limit).Find(&articles).Error
+	if err != nil || len(articles) == 0 {
+		return 0, 0, err
+	}
+	aids := make([]uint, len(articles))
+	for i, a := range articles {
+		aids[i] = a.ID
 	}
 
-	result := ar.data.db.Unscoped().Where(&af).Delete(&ArticleFavorite{})
-	if result.Error != nil {
-		return result.Error
+	type favoriteCount struct {
+		ArticleID uint
+		Count     uint32
 	}
-	if result.RowsAffected == 0 {
-		return errors.NotFound("FAVORITE_NOT_FOUND", "you do notfavorite this article")
+	var counts []favoriteCount
+	err = ar.data.DB(ctx).Model(&ArticleFavorite{}).Select("article_id, COUNT(*) AS count").
+		Where("article_id IN ?", aids).Group("article_id").Scan(&counts).Error
+	if err != nil {
+		return 0, 0, err
+	}
+	countMap := make(map[uint]uint32, len(counts))
+	for _, c := range counts {
+		countMap[c.ArticleID] = c.Count
 	}
 
-	fc, err := countFavorites(ar.data.db, aid)
-	if err != nil {
-		return err
+	fixed := 0
+	for _, a := range articles {
+		if a.FavoritesCount == countMap[a.ID] {
+			continue
+		}
+		// 检查之后可能又有新的收藏, 在同一条语句中重新计数
+		count := ar.data.DB(ctx).Model(&ArticleFavorite{}).Select("COUNT(*)").Where("article_id = ?", a.ID)
+		err := ar.data.DB(ctx).Model(&Article{}).Where("id = ?", a.ID).UpdateColumn("favorites_count", gorm.Expr("(?)", count)).Error
+		if err != nil {
+			return fixed, 0, err
+		}
+		ar.data.cache.invalidate(ctx, articleCacheKey(a.ID))
+		fixed++
 	}
-	a.FavoritesCount = fc
-	err = ar.data.db.Model(&Article{}).Where("id = ?", aid).UpdateColumn("favorites_count", a.FavoritesCount).Error
-	return err
+	return fixed, articles[len(articles)-1].ID, nil
 }
 
-// 一个uid与多个aid之间的收藏关系
-func (ar *articleRepo) GetIsFavorited(ctx context.Context, aids []uint, uid uint) (map[uint]bool, error) {
-	var favorites []ArticleFavorite
-	err := ar.data.db.Model(&ArticleFavorite{}).Where("user_id = ? AND article_id IN ?", uid, aids).Find(&favorites).Error
+// 一个uid与多个aid之间的收藏, 书签关系和与作者之间的关注关系, 一条查询完成
+func (ar *articleRepo) GetArticleViewerStates(ctx context.Context, aids []uint, uid uint) (map[uint]biz.ArticleViewerState, error) {
+	result := make(map[uint]biz.ArticleViewerState, len(aids))
+	if len(aids) == 0 {
+		return result, nil
+	}
+	var rows []struct {
+		ID           uint
+		IsFavorited  bool
+		IsBookmarked bool
+		IsFollowing  bool
+	}
+	err := ar.data.DB(ctx).Model(&Article{}).
+		Select("articles.id, "+
+			"EXISTS (SELECT 1 FROM article_favorites WHERE article_favorites.article_id = articles.id AND article_favorites.user_id = ? AND article_favorites.deleted_at IS NULL) AS is_favorited, "+
+			"EXISTS (SELECT 1 FROM bookmarks WHERE bookmarks.article_id = articles.id AND bookmarks.user_id = ? AND bookmarks.deleted_at IS NULL) AS is_bookmarked, "+
+			"EXISTS (SELECT 1 FROM follows WHERE follows.following_id = articles.author_id AND follows.follower_id = ? AND follows.deleted_at IS NULL) AS is_following",
+			uid, uid, uid).
+		Where("articles.id IN ?", aids).
+		Scan(&rows).Error
 	if err != nil {
 		return nil, err
 	}
-	result := make(map[uint]bool)
-	for _, aid := range aids {
-		result[aid] = false
-	}
-
-	for _, favorite := range favorites {
-		result[favorite.ArticleID] = true
+	for _, row := range rows {
+		result[row.ID] = biz.ArticleViewerState{Favorited: row.IsFavorited, Bookmarked: row.IsBookmarked, Following: row.IsFollowing}
 	}
-
 	return result, nil
 }
 
 // 查询文章
 func (ar *articleRepo) ListArticlesByOptions(ctx context.Context, options *biz.ListOptions) ([]*biz.Article, error) {
-	db := ar.data.db.Model(&Article{}).Preload("Author").Preload("Tags").Preload("Favorites")
+	db := ar.data.DB(ctx).Model(&Article{})
+
+	// 和当前用户互相拉黑的作者的文章不可见
+	if options.CurrentUid > 0 {
+		db = excludeBlocked(db, "articles.author_id", options.CurrentUid)
+	}
+	// 私密账号的文章只对粉丝可见
+	db = excludePrivate(db, "articles.author_id", options.CurrentUid)
 
-	// 返回当前用户关注的用户文章
+	// 返回当前用户关注的用户文章, 静音的用户不出现在feed中
 	if options.CurrentUid > 0 && options.Tag == "" && options.Author == "" && options.FavoritedBy == "" {
 		ar.log.Infof("查询用户关注的用户文章: %v", options.CurrentUid)
 		db = db.Joins("JOIN follows ON follows.following_id = articles.author_id").
-			Where("follows.follower_id = ?", options.CurrentUid)
+			Where("follows.follower_id = ? AND follows.deleted_at IS NULL", options.CurrentUid).
+			Where("articles.author_id NOT IN (?)", mutedSubQuery(ar.data.DB(ctx), options.CurrentUid))
 	} else {
 		// 按标签过滤
 		if options.Tag != "" {
@@ -374,9 +481,8 @@ func (ar *articleRepo) ListArticlesByOptions(ctx context.Context, options *biz.L
 	}
 
 	// 执行查询
-	db = db.Order("articles.created_at DESC")
-	var articles []Article
-	if err := db.Find(&articles).Error; err != nil {
+	articles, err := findArticles(ctx, ar.data, db.Order("articles.created_at DESC"))
+	if err != nil {
 		return nil, err
 	}
 
@@ -388,33 +494,42 @@ func (ar *articleRepo) ListArticlesByOptions(ctx context.Context, options *biz.L
 	return articleList, nil
 }
 
-// 两个用户之间的follow关系, uid_1是否关注uids
-func (ar *articleRepo) GetOneIsFollowingAnother(ctx context.Context, uid_1 uint, uids []uint) (map[uint]bool, error) {
-	if len(uids) == 0 {
-		return make(map[uint]bool), nil
+// feed - 关注的作者的文章和关注的标签下的文章, 一条查询完成去重
+// 自己的文章和静音的用户的文章不出现, 按id倒序近似发布时间
+func (ar *articleRepo) ListFeedArticles(ctx context.Context, uid uint, cursor uint, offset int, limit int) ([]*biz.Article, uint, error) {
+	newDB := ar.data.DB(ctx).Session(&gorm.Session{NewDB: true})
+	followedAuthors := newDB.Model(&Follow{}).Select("following_id").Where("follower_id = ?", uid)
+	followedTags := newDB.Table("article_tags").Select("article_tags.article_id").
+		Joins("JOIN tag_follows ON tag_follows.tag_id = article_tags.tag_id AND tag_follows.deleted_at IS NULL").
+		Where("tag_follows.user_id = ?", uid)
+
+	db := ar.data.DB(ctx).Model(&Article{}).
+		Where("(articles.author_id IN (?) OR articles.id IN (?))", followedAuthors, followedTags).
+		Where("articles.author_id <> ?", uid).
+		Where("articles.author_id NOT IN (?)", mutedSubQuery(ar.data.DB(ctx), uid))
+	db = excludeBlocked(db, "articles.author_id", uid)
+	db = excludePrivate(db, "articles.author_id", uid)
+	if cursor > 0 {
+		db = db.Where("articles.id < ?", cursor)
+	}
+	if offset > 0 {
+		db = db.Offset(offset)
+	}
+
+	articles, err := findArticles(ctx, ar.data, db.Order("articles.id DESC").Limit(limit+1))
+	if err != nil {
+		return nil, 0, err
 	}
-
-	var follows []Follow
-	if err := ar.data.db.Model(&Follow{}).
-		Where("follower_id = ? AND following_id IN ?", uid_1, uids).
-		Find(&follows).Error; err != nil {
-		return nil, err
+	var next uint
+	if len(articles) > limit {
+		articles = articles[:limit]
+		next = articles[limit-1].ID
 	}
-
-	// Create a map of following status
-	followingMap := make(map[uint]bool)
-	for _, follow := range follows {
-		followingMap[follow.FollowingID] = true
+	list := make([]*biz.Article, len(articles))
+	for i, a := range articles {
+		list[i] = convertArticle(a)
 	}
-
-	// Initialize all requested uids to false
-	for _, uid := range uids {
-		if _, exists := followingMap[uid]; !exists {
-			followingMap[uid] = false
-		}
-	}
-
-	return followingMap, nil
+	return list, next, nil
 }
 
 type commentRepo struct {
@@ -423,6 +538,9 @@ type commentRepo struct {
 }
 
 func NewCommentRepo(data *Data, logger log.Logger) biz.CommentRepo {
+	if data.mem != nil {
+		return &memoryCommentRepo{mem: data.mem}
+	}
 	return &commentRepo{
 		data: data,
 		log:  log.NewHelper(logger),
@@ -436,14 +554,18 @@ func (cr *commentRepo) AddComment(ctx context.Context, c *biz.Comment) (*biz.Com
 		Body:      c.Body,
 	}
 
-	result := cr.data.db.Create(&comment)
+	result := cr.data.DB(ctx).Create(&comment)
 	if result.Error != nil {
+		// 启用外键时, 文章已经被删除
+		if isForeignKeyViolation(result.Error) {
+			return nil, biz.ErrArticleNotFound
+		}
 		return nil, result.Error
 	}
 
 	// 评论人的用户信息
 	var user User
-	if err := cr.data.db.Model(&User{}).Where("id = ?", c.AuthorID).First(&user).Error; err != nil {
+	if err := cr.data.DB(ctx).Model(&User{}).Where("id = ?", c.AuthorID).First(&user).Error; err != nil {
 		return nil, err
 	}
 	profile := &biz.ProfileResp{
@@ -462,19 +584,45 @@ func (cr *commentRepo) AddComment(ctx context.Context, c *biz.Comment) (*biz.Com
 }
 
 func (cr *commentRepo) DeleteCommentByID(ctx context.Context, id uint) error {
-	result := cr.data.db.Delete(&Comment{}, "id = ?", id)
+	result := cr.data.DB(ctx).Delete(&Comment{}, "id = ?", id)
 	if result.Error != nil {
 		return result.Error
 	}
 	if result.RowsAffected == 0 {
-		return errors.NotFound("COMMENT_NOT_FOUND", "comment not found")
+		return biz.ErrCommentNotFound
 	}
 	return nil
 }
 
-func (cr *commentRepo) GetCommentsByID(ctx context.Context, cid uint) ([]*biz.Comment, error) {
+func (cr *commentRepo) GetCommentByID(ctx context.Context, id uint) (*biz.Comment, error) {
+	var comment Comment
+	if err := cr.data.DB(ctx).Where("id = ?", id).Preload("Author").First(&comment).Error; err != nil {
+		return nil, translateNotFound(err, biz.ErrCommentNotFound)
+	}
+	return &biz.Comment{
+		ID:        comment.ID,
+		Body:      comment.Body,
+		CreatedAt: comment.CreatedAt,
+		UpdatedAt: comment.UpdatedAt,
+		Author: &biz.ProfileResp{
+			Username: comment.Author.Username,
+			Bio:      comment.Author.Bio,
+			Image:    comment.Author.Image,
+		},
+		AuthorID:  comment.AuthorID,
+		ArticleID: comment.ArticleID,
+	}, nil
+}
+
+func (cr *commentRepo) GetCommentsByID(ctx context.Context, cid uint, viewerUid uint) ([]*biz.Comment, error) {
 	var comments []Comment
-	result := cr.data.db.Model(&Comment{}).Where("article_id = ?", cid).Preload("Author").Find(&comments)
+	db := cr.data.DB(ctx).Model(&Comment{}).Where("article_id = ?", cid)
+	// 拉黑和静音的用户的评论不可见
+	if viewerUid > 0 {
+		db = excludeBlocked(db, "comments.author_id", viewerUid).
+			Where("comments.author_id NOT IN (?)", mutedSubQuery(cr.data.DB(ctx), viewerUid))
+	}
+	result := db.Preload("Author").Find(&comments)
 	if result.Error != nil {
 		return nil, result.Error
 	}
@@ -503,22 +651,62 @@ type tagRepo struct {
 }
 
 func NewTagRepo(data *Data, logger log.Logger) biz.TagRepo {
+	if data.mem != nil {
+		return &memoryTagRepo{mem: data.mem}
+	}
 	return &tagRepo{
 		data: data,
 		log:  log.NewHelper(logger),
 	}
 }
 
-func (tr *tagRepo) GetTags(ctx context.Context) ([]biz.Tag, error) {
-	var tags []Tag
-	err := tr.data.db.Find(&tags).Error
-	if err != nil {
+// 只统计未删除的文章, 没有文章使用的标签不会出现
+func tagsWithCount(db *gorm.DB) *gorm.DB {
+	return db.Model(&Tag{}).Select("tags.id, tags.name, COUNT(articles.id) AS articles_count").
+		Joins("JOIN article_tags ON article_tags.tag_id = tags.id").
+		Joins("JOIN articles ON articles.id = article_tags.article_id AND articles.deleted_at IS NULL").
+		Group("tags.id, tags.name")
+}
+
+type tagCount struct {
+	ID            uint
+	Name          string
+	ArticlesCount uint32
+}
+
+func (tr *tagRepo) GetTags(ctx context.Context, prefix string, limit int) ([]*biz.TagInfo, error) {
+	// 只缓存不带前缀的完整列表, 按limit截取
+	if prefix == "" && tr.data.cache.usable(ctx) {
+		tags, err := cached(ctx, tr.data.cache, tagsCacheKey, func(ctx context.Context) ([]*biz.TagInfo, error) {
+			return tr.getTags(ctx, "", 0)
+		})
+		if err != nil {
+			return nil, err
+		}
+		if limit > 0 && len(tags) > limit {
+			tags = tags[:limit]
+		}
+		return tags, nil
+	}
+	return tr.getTags(ctx, prefix, limit)
+}
+
+func (tr *tagRepo) getTags(ctx context.Context, prefix string, limit int) ([]*biz.TagInfo, error) {
+	db := tagsWithCount(tr.data.DB(ctx))
+	if prefix != "" {
+		db = db.Where("LOWER(tags.name) LIKE ? ESCAPE '!'", escapeLike(strings.ToLower(prefix))+"%")
+	}
+	if limit > 0 {
+		db = db.Limit(limit)
+	}
+	var tags []tagCount
+	if err := db.Order("articles_count DESC").Order("tags.name").Scan(&tags).Error; err != nil {
 		return nil, err
 	}
 	// 转换
-	tagList := make([]biz.Tag, len(tags))
+	tagList := make([]*biz.TagInfo, len(tags))
 	for i, tag := range tags {
-		tagList[i] = biz.Tag(tag.Name)
+		tagList[i] = &biz.TagInfo{ID: tag.ID, Name: biz.Tag(tag.Name), ArticlesCount: tag.ArticlesCount}
 	}
 	return tagList, nil
 }
@@ -0,0 +1,206 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"kratos-realworld/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-playground/assert/v2"
+)
+
+func createTestUsers(t *testing.T, d *Data, n int) []uint {
+	ur := NewUserRepo(d, log.DefaultLogger)
+	prefix := fmt.Sprintf("u%d", time.Now().UnixNano())
+	uids := make([]uint, n)
+	for i := range uids {
+		u := &biz.User{Username: fmt.Sprintf("%s-%d", prefix, i), Email: fmt.Sprintf("%s-%d@example.com", prefix, i)}
+		if err := ur.CreateUser(context.Background(), u); err != nil {
+			t.Fatal(err)
+		}
+		uids[i] = u.ID
+	}
+	return uids
+}
+
+// 内存中的sqlite只有一个连接, 请求会依次执行, 只在mysql和postgres上能测到并发
+func skipUnlessConcurrent(t *testing.T) {
+	switch os.Getenv("REALWORLD_TEST_DRIVER") {
+	case "mysql", "postgres":
+	default:
+		t.Skip("set REALWORLD_TEST_DRIVER to mysql or postgres to run concurrently")
+	}
+}
+
+func TestFavoriteArticleConcurrent(t *testing.T) {
+	skipUnlessConcurrent(t)
+	d := newTestData(t)
+	ctx := context.Background()
+	ar := NewArticleRepo(d, log.DefaultLogger)
+	uids := createTestUsers(t, d, 10)
+	a, err := ar.CreateArticle(ctx, &biz.Article{Slug: fmt.Sprintf("favorite-%d", time.Now().UnixNano()), Title: "favorite", AuthorID: uids[0]})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 每个用户同时重复收藏, 收藏数只增加一次, 也只有一次返回新增
+	var wg sync.WaitGroup
+	var added atomic.Int32
+	errs := make(chan error, len(uids)*3)
+	for _, uid := range uids {
+		for i := 0; i < 3; i++ {
+			wg.Add(1)
+			go func(uid uint) {
+				defer wg.Done()
+				ok, err := ar.FavoriteArticle(ctx, a.ID, uid)
+				if ok {
+					added.Add(1)
+				}
+				errs <- err
+			}(uid)
+		}
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		assert.Equal(t, nil, err)
+	}
+	assert.Equal(t, int32(len(uids)), added.Load())
+	a, err = ar.GetArticleByAid(ctx, a.ID)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, uint32(len(uids)), a.FavoritesCount)
+
+	// 重复取消收藏不报错, 收藏数只减少一次
+	assert.Equal(t, nil, ar.UnfavoriteArticle(ctx, a.ID, uids[0]))
+	assert.Equal(t, nil, ar.UnfavoriteArticle(ctx, a.ID, uids[0]))
+	a, err = ar.GetArticleByAid(ctx, a.ID)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, uint32(len(uids)-1), a.FavoritesCount)
+}
+
+// 同一分组同时收到多个操作者, 合并为一条通知
+func TestAddNotificationConcurrent(t *testing.T) {
+	skipUnlessConcurrent(t)
+	d := newTestData(t)
+	ctx := context.Background()
+	nr := NewNotificationRepo(d, log.DefaultLogger)
+	uids := createTestUsers(t, d, 10)
+	groupKey := fmt.Sprintf("follow-%d", time.Now().UnixNano())
+
+	var wg sync.WaitGroup
+	errs := make(chan error, len(uids)-1)
+	for _, uid := range uids[1:] {
+		wg.Add(1)
+		go func(uid uint) {
+			defer wg.Done()
+			errs <- nr.AddNotification(ctx, &biz.Notification{UserID: uids[0], Type: biz.NotificationFollow, GroupKey: groupKey, ActorID: uid})
+		}(uid)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		assert.Equal(t, nil, err)
+	}
+	var rows []Notification
+	assert.Equal(t, nil, d.db.Where("user_id = ? AND group_key = ?", uids[0], groupKey).Find(&rows).Error)
+	assert.Equal(t, 1, len(rows))
+	assert.Equal(t, uint32(len(uids)-1), rows[0].ActorsCount)
+}
+
+func TestReconcileFavoritesCounts(t *testing.T) {
+	d := newTestData(t)
+	ctx := context.Background()
+	ar := NewArticleRepo(d, log.DefaultLogger)
+	uids := createTestUsers(t, d, 2)
+	a, err := ar.CreateArticle(ctx, &biz.Article{Slug: fmt.Sprintf("reconcile-%d", time.Now().UnixNano()), Title: "reconcile", AuthorID: uids[0]})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = ar.FavoriteArticle(ctx, a.ID, uids[1])
+	assert.Equal(t, nil, err)
+	// 模拟计数漂移
+	d.db.Model(&Article{}).Where("id = ?", a.ID).UpdateColumn("favorites_count", 7)
+
+	fixed, last, err := ar.ReconcileFavoritesCounts(ctx, a.ID-1, 1)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 1, fixed)
+	assert.Equal(t, a.ID, last)
+	a, err = ar.GetArticleByAid(ctx, a.ID)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, uint32(1), a.FavoritesCount)
+}
+
+// 列表的查询数量固定, 不随文章数量增加
+func TestArticleListingQueryCount(t *testing.T) {
+	d := newTestData(t)
+	ctx := context.Background()
+	uids := createTestUsers(t, d, 3)
+	reader := uids[0]
+	ar := NewArticleRepo(d, log.DefaultLogger)
+	pr := NewProfileRepo(d, log.DefaultLogger)
+	br := NewBookmarkRepo(d, log.DefaultLogger)
+	for _, author := range uids[1:] {
+		if err := pr.FollowUserByUsername(ctx, reader, author); err != nil {
+			t.Fatal(err)
+		}
+	}
+	aids := make([]uint, 6)
+	for i := range aids {
+		slug := fmt.Sprintf("query-count-%d", i)
+		a, err := ar.CreateArticle(ctx, &biz.Article{Slug: slug, Title: slug, AuthorID: uids[1+i%2], TagList: []string{"go", fmt.Sprintf("tag-%d", i)}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		aids[i] = a.ID
+		if _, err := ar.FavoriteArticle(ctx, a.ID, reader); err != nil {
+			t.Fatal(err)
+		}
+		if err := br.CreateBookmark(ctx, reader, a.ID, 0); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, limit := range []int{1, len(aids)} {
+		var list []*biz.Article
+		n := countQueries(t, d, func() {
+			list, _ = ar.ListArticlesByOptions(ctx, &biz.ListOptions{Tag: "go", Limit: int64(limit)})
+		})
+		assert.Equal(t, limit, len(list))
+		assert.Equal(t, 2, n)
+		assert.Equal(t, 2, len(list[0].TagList))
+		assert.NotEqual(t, "", list[0].Author.Username)
+		assert.Equal(t, uint32(1), list[0].FavoritesCount)
+
+		n = countQueries(t, d, func() {
+			list, _, _ = ar.ListFeedArticles(ctx, reader, 0, 0, limit)
+		})
+		assert.Equal(t, limit, len(list))
+		assert.Equal(t, 2, n)
+
+		n = countQueries(t, d, func() {
+			list, _, _ = br.ListBookmarks(ctx, reader, 0, 0, limit)
+		})
+		assert.Equal(t, limit, len(list))
+		assert.Equal(t, 3, n)
+	}
+
+	var states map[uint]biz.ArticleViewerState
+	n := countQueries(t, d, func() {
+		states, _ = ar.GetArticleViewerStates(ctx, aids, reader)
+	})
+	assert.Equal(t, 1, n)
+	assert.Equal(t, biz.ArticleViewerState{Favorited: true, Bookmarked: true, Following: true}, states[aids[0]])
+
+	var a *biz.Article
+	n = countQueries(t, d, func() {
+		a, _ = ar.GetArticleBySlug(ctx, "query-count-0")
+	})
+	assert.Equal(t, 2, n)
+	assert.Equal(t, []string{"go", "tag-0"}, a.TagList)
+}
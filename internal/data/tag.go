@@ -0,0 +1,278 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"kratos-realworld/internal/biz"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 用户关注的标签
+type TagFollow struct {
+	gorm.Model
+	UserID uint `gorm:"index:idx_tag_follow_user_tag,unique"`
+	TagID  uint `gorm:"index:idx_tag_follow_user_tag,unique;index"`
+}
+
+// 标签的别名 - 写入和查询时替换为规范的标签
+type TagAlias struct {
+	gorm.Model
+	Alias string `gorm:"size:500;uniqueIndex"`
+	TagID uint   `gorm:"index"`
+}
+
+func (tr *tagRepo) GetTag(ctx context.Context, name string) (*biz.TagInfo, error) {
+	var tag Tag
+	if err := tr.data.DB(ctx).Where("name = ?", name).First(&tag).Error; err != nil {
+		return nil, translateNotFound(err, biz.ErrTagNotFound)
+	}
+	var count tagCount
+	if err := tagsWithCount(tr.data.DB(ctx)).Where("tags.id = ?", tag.ID).Scan(&count).Error; err != nil {
+		return nil, err
+	}
+	return &biz.TagInfo{ID: tag.ID, Name: biz.Tag(tag.Name), ArticlesCount: count.ArticlesCount}, nil
+}
+
+func (tr *tagRepo) ListTagUses(ctx context.Context, since time.Time) ([]*biz.TagUse, error) {
+	type tagUse struct {
+		Name      string
+		CreatedAt time.Time
+	}
+	var uses []tagUse
+	err := tr.data.DB(ctx).Model(&Tag{}).Select("tags.name, articles.created_at").
+		Joins("JOIN article_tags ON article_tags.tag_id = tags.id").
+		Joins("JOIN articles ON articles.id = article_tags.article_id AND articles.deleted_at IS NULL").
+		Where("articles.created_at >= ?", since).Scan(&uses).Error
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*biz.TagUse, len(uses))
+	for i, use := range uses {
+		list[i] = &biz.TagUse{Name: biz.Tag(use.Name), CreatedAt: use.CreatedAt}
+	}
+	return list, nil
+}
+
+func (tr *tagRepo) FollowTag(ctx context.Context, uid uint, tagID uint) error {
+	return tr.data.DB(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&TagFollow{UserID: uid, TagID: tagID}).Error
+}
+
+func (tr *tagRepo) UnfollowTag(ctx context.Context, uid uint, tagID uint) error {
+	return tr.data.DB(ctx).Unscoped().Where("user_id = ? AND tag_id = ?", uid, tagID).Delete(&TagFollow{}).Error
+}
+
+func (tr *tagRepo) GetFollowedTags(ctx context.Context, uid uint) ([]biz.Tag, error) {
+	var names []string
+	err := tr.data.DB(ctx).Model(&Tag{}).
+		Joins("JOIN tag_follows ON tag_follows.tag_id = tags.id").
+		Where("tag_follows.user_id = ?", uid).
+		Order("tags.name").Pluck("tags.name", &names).Error
+	if err != nil {
+		return nil, err
+	}
+	tags := make([]biz.Tag, len(names))
+	for i, name := range names {
+		tags[i] = biz.Tag(name)
+	}
+	return tags, nil
+}
+
+func (tr *tagRepo) ResolveAliases(ctx context.Context, names []string) (map[string]string, error) {
+	result := make(map[string]string)
+	if len(names) == 0 {
+		return result, nil
+	}
+	type alias struct {
+		Alias string
+		Name  string
+	}
+	var aliases []alias
+	err := tr.data.DB(ctx).Model(&TagAlias{}).Select("tag_aliases.alias, tags.name").
+		Joins("JOIN tags ON tags.id = tag_aliases.tag_id AND tags.deleted_at IS NULL").
+		Where("tag_aliases.alias IN ?", names).Scan(&aliases).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range aliases {
+		result[a.Alias] = a.Name
+	}
+	return result, nil
+}
+
+func (tr *tagRepo) ListAliases(ctx context.Context, tagID uint) ([]string, error) {
+	aliases := make([]string, 0)
+	err := tr.data.DB(ctx).Model(&TagAlias{}).Where("tag_id = ?", tagID).Order("alias").Pluck("alias", &aliases).Error
+	return aliases, err
+}
+
+// 已经是这个标签的别名时不报错, 是其他标签的别名时返回TakenError
+func (tr *tagRepo) AddAlias(ctx context.Context, tagID uint, alias string) error {
+	result := tr.data.DB(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&TagAlias{Alias: alias, TagID: tagID})
+	if result.Error != nil || result.RowsAffected > 0 {
+		return result.Error
+	}
+	var existing TagAlias
+	if err := tr.data.DB(ctx).Where("alias = ?", alias).First(&existing).Error; err != nil {
+		return err
+	}
+	if existing.TagID != tagID {
+		return biz.TakenError("alias")
+	}
+	return nil
+}
+
+func (tr *tagRepo) RemoveAlias(ctx context.Context, tagID uint, alias string) error {
+	result := tr.data.DB(ctx).Unscoped().Where("tag_id = ? AND alias = ?", tagID, alias).Delete(&TagAlias{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return biz.ErrAliasNotFound
+	}
+	return nil
+}
+
+// 标签列表和使用这个标签的文章的缓存key
+func taggedCacheKeys(tx *gorm.DB, tagID uint) ([]string, error) {
+	var aids []uint
+	if err := tx.Table("article_tags").Where("tag_id = ?", tagID).Pluck("article_id", &aids).Error; err != nil {
+		return nil, err
+	}
+	keys := []string{tagsCacheKey}
+	for _, aid := range aids {
+		keys = append(keys, articleCacheKey(aid))
+	}
+	return keys, nil
+}
+
+func (tr *tagRepo) RenameTag(ctx context.Context, tagID uint, name string) error {
+	var stale []string
+	err := tr.data.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		var tag Tag
+		if err := tx.Where("id = ?", tagID).First(&tag).Error; err != nil {
+			return translateNotFound(err, biz.ErrTagNotFound)
+		}
+		// 新名称原来是这个标签的别名
+		if err := tx.Unscoped().Where("alias = ?", name).Delete(&TagAlias{}).Error; err != nil {
+			return err
+		}
+		oldName := tag.Name
+		if err := tx.Model(&tag).Update("name", name).Error; err != nil {
+			if isUniqueViolation(err, "name") {
+				return biz.TakenError("name")
+			}
+			return err
+		}
+		var err error
+		if stale, err = taggedCacheKeys(tx, tagID); err != nil {
+			return err
+		}
+		return tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&TagAlias{Alias: oldName, TagID: tagID}).Error
+	})
+	if err != nil {
+		return err
+	}
+	tr.data.cache.invalidate(ctx, stale...)
+	return nil
+}
+
+func (tr *tagRepo) MergeTags(ctx context.Context, sourceID uint, targetID uint) error {
+	var stale []string
+	err := tr.data.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		var source Tag
+		if err := tx.Where("id = ?", sourceID).First(&source).Error; err != nil {
+			return translateNotFound(err, biz.ErrTagNotFound)
+		}
+
+		// 文章 - 已经同时使用两个标签的文章只保留target
+		var sourceArticles, targetArticles []uint
+		if err := tx.Table("article_tags").Where("tag_id = ?", sourceID).Pluck("article_id", &sourceArticles).Error; err != nil {
+			return err
+		}
+		if err := tx.Table("article_tags").Where("tag_id = ?", targetID).Pluck("article_id", &targetArticles).Error; err != nil {
+			return err
+		}
+		stale = append(stale, tagsCacheKey)
+		for _, aid := range sourceArticles {
+			stale = append(stale, articleCacheKey(aid))
+		}
+		tagged := make(map[uint]bool, len(targetArticles))
+		for _, aid := range targetArticles {
+			tagged[aid] = true
+		}
+		rows := make([]map[string]interface{}, 0, len(sourceArticles))
+		for _, aid := range sourceArticles {
+			if !tagged[aid] {
+				rows = append(rows, map[string]interface{}{"article_id": aid, "tag_id": targetID})
+			}
+		}
+		if len(rows) > 0 {
+			if err := tx.Table("article_tags").Create(rows).Error; err != nil {
+				return err
+			}
+		}
+
+		// 关注 - 已经关注target的用户删除对source的关注
+		var followers []uint
+		if err := tx.Model(&TagFollow{}).Where("tag_id = ?", targetID).Pluck("user_id", &followers).Error; err != nil {
+			return err
+		}
+		move := tx.Model(&TagFollow{}).Where("tag_id = ?", sourceID)
+		if len(followers) > 0 {
+			move = move.Where("user_id NOT IN ?", followers)
+		}
+		if err := move.Update("tag_id", targetID).Error; err != nil {
+			return err
+		}
+
+		// 别名 - source的别名和名称都指向target
+		if err := tx.Model(&TagAlias{}).Where("tag_id = ?", sourceID).Update("tag_id", targetID).Error; err != nil {
+			return err
+		}
+		if err := tx.Create(&TagAlias{Alias: source.Name, TagID: targetID}).Error; err != nil {
+			if isUniqueViolation(err, "alias") {
+				return biz.TakenError("alias")
+			}
+			return err
+		}
+		return deleteTag(tx, sourceID)
+	})
+	if err != nil {
+		return err
+	}
+	tr.data.cache.invalidate(ctx, stale...)
+	return nil
+}
+
+func (tr *tagRepo) DeleteTag(ctx context.Context, tagID uint) error {
+	var stale []string
+	err := tr.data.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		if stale, err = taggedCacheKeys(tx, tagID); err != nil {
+			return err
+		}
+		return deleteTag(tx, tagID)
+	})
+	if err != nil {
+		return err
+	}
+	tr.data.cache.invalidate(ctx, stale...)
+	return nil
+}
+
+// 删除标签和它的文章关联, 关注和别名; 标签名唯一, 需要真正删除
+func deleteTag(tx *gorm.DB, tagID uint) error {
+	if err := tx.Exec("DELETE FROM article_tags WHERE tag_id = ?", tagID).Error; err != nil {
+		return err
+	}
+	if err := tx.Unscoped().Where("tag_id = ?", tagID).Delete(&TagFollow{}).Error; err != nil {
+		return err
+	}
+	if err := tx.Unscoped().Where("tag_id = ?", tagID).Delete(&TagAlias{}).Error; err != nil {
+		return err
+	}
+	return tx.Unscoped().Delete(&Tag{}, tagID).Error
+}
@@ -2,15 +2,17 @@ package data
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"strings"
+	"time"
 
 	"kratos-realworld/internal/biz"
-	e "kratos-realworld/internal/errors"
 	"kratos-realworld/internal/pkg/middleware/auth"
 
-	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // data层定义数据库中的数据结构
@@ -22,14 +24,152 @@ type User struct {
 	Bio          string `gorm:"size:1000"`
 	Image        string `gorm:"size:1000"`
 	PasswordHash string `gorm:"size:500"`
+	// 注销后匿名化的时间, 匿名用户不能再通过token访问
+	AnonymizedAt *time.Time
+	// 私密账号
+	Private bool `gorm:"not null;default:false"`
+
+	// 冗余计数 - 关注/取关/发文/删文时原子更新
+	FollowersCount uint32 `gorm:"not null;default:0"`
+	FollowingCount uint32 `gorm:"not null;default:0"`
+	ArticlesCount  uint32 `gorm:"not null;default:0"`
+	// 附件占用的空间, 上传和删除附件时原子更新
+	AttachmentsBytes int64 `gorm:"not null;default:0"`
 }
 
 // follow表 - 关注id和被关注id
 type Follow struct {
 	gorm.Model
-	// 唯一性约束 避免重复关注 fix
-	FollowerID  uint `gorm:"index"` // 关注者的id - 粉丝
-	FollowingID uint `gorm:"index"` // 被关注者的id - 博主
+	// 唯一性约束 避免重复关注, 取关时物理删除
+	FollowerID  uint `gorm:"index;index:idx_follower_following,unique"` // 关注者的id - 粉丝
+	FollowingID uint `gorm:"index;index:idx_follower_following,unique"` // 被关注者的id - 博主
+}
+
+// 关注申请表 - 关注私密账号时创建, 同意/拒绝/撤回后物理删除
+type FollowRequest struct {
+	gorm.Model
+	RequesterID uint `gorm:"index:idx_requester_target,unique"`
+	TargetID    uint `gorm:"index:idx_requester_target,unique;index"`
+}
+
+// 拉黑表 - blocker拉黑blocked, 取消拉黑时物理删除
+type Block struct {
+	gorm.Model
+	BlockerID uint `gorm:"index:idx_blocker_blocked,unique"`
+	BlockedID uint `gorm:"index:idx_blocker_blocked,unique;index"`
+}
+
+// 静音表 - muter静音muted, 只影响muter自己能看到的内容
+type Mute struct {
+	gorm.Model
+	MuterID uint `gorm:"index:idx_muter_muted,unique"`
+	MutedID uint `gorm:"index:idx_muter_muted,unique"`
+}
+
+// uid拉黑的人和拉黑uid的人 - 返回两个子查询, 用于NOT IN过滤
+func blockedSubQueries(db *gorm.DB, uid uint) (*gorm.DB, *gorm.DB) {
+	db = db.Session(&gorm.Session{NewDB: true})
+	return db.Model(&Block{}).Select("blocked_id").Where("blocker_id = ?", uid),
+		db.Model(&Block{}).Select("blocker_id").Where("blocked_id = ?", uid)
+}
+
+// uid静音的人
+func mutedSubQuery(db *gorm.DB, uid uint) *gorm.DB {
+	return db.Session(&gorm.Session{NewDB: true}).Model(&Mute{}).Select("muted_id").Where("muter_id = ?", uid)
+}
+
+// 过滤掉和uid之间存在拉黑关系的用户, column为用户id所在的列
+func excludeBlocked(db *gorm.DB, column string, uid uint) *gorm.DB {
+	blocking, blockedBy := blockedSubQueries(db, uid)
+	return db.Where(column+" NOT IN (?)", blocking).Where(column+" NOT IN (?)", blockedBy)
+}
+
+// 过滤掉uid看不到的私密账号 - 自己和已关注的私密账号除外, uid为0表示未登录
+func excludePrivate(db *gorm.DB, column string, uid uint) *gorm.DB {
+	newDB := db.Session(&gorm.Session{NewDB: true})
+	hidden := newDB.Model(&User{}).Select("id").Where("private = ?", true)
+	if uid > 0 {
+		following := newDB.Model(&Follow{}).Select("following_id").Where("follower_id = ?", uid)
+		hidden = hidden.Where("id <> ?", uid).Where("id NOT IN (?)", following)
+	}
+	return db.Where(column+" NOT IN (?)", hidden)
+}
+
+// 不能登录的用户 - 已注销的用户和文章转移用的占位用户
+func inactiveUsers(db *gorm.DB) *gorm.DB {
+	return db.Session(&gorm.Session{NewDB: true}).Model(&User{}).Select("id").
+		Where("anonymized_at IS NOT NULL OR password_hash = ?", "")
+}
+
+// 推荐关注时需要排除的用户 - 自己, 已关注, 已申请关注, 互相拉黑以及不能登录的用户
+func excludeForSuggestion(db *gorm.DB, column string, uid uint) *gorm.DB {
+	newDB := db.Session(&gorm.Session{NewDB: true})
+	following := newDB.Model(&Follow{}).Select("following_id").Where("follower_id = ?", uid)
+	requested := newDB.Model(&FollowRequest{}).Select("target_id").Where("requester_id = ?", uid)
+	return excludeBlocked(db, column, uid).
+		Where(column+" <> ?", uid).
+		Where(column+" NOT IN (?)", following).
+		Where(column+" NOT IN (?)", requested).
+		Where(column+" NOT IN (?)", inactiveUsers(db))
+}
+
+// LIKE的通配符转义 - 各数据库默认的转义字符不一致, 统一用!并在语句中声明ESCAPE
+func escapeLike(s string) string {
+	return strings.NewReplacer("!", "!!", "%", "!%", "_", "!_").Replace(s)
+}
+
+// 转换data.User为biz.ProfileResp, following由调用方决定
+func convertProfile(u User) *biz.ProfileResp {
+	return &biz.ProfileResp{
+		ID:             u.ID,
+		Username:       u.Username,
+		Bio:            u.Bio,
+		Image:          u.Image,
+		FollowersCount: u.FollowersCount,
+		FollowingCount: u.FollowingCount,
+		ArticlesCount:  u.ArticlesCount,
+		Private:        u.Private,
+	}
+}
+
+// 计数器原子加一
+func incrExpr(column string) clause.Expr {
+	return gorm.Expr(column + " + 1")
+}
+
+// 计数器原子减一, 不会小于0
+func decrExpr(column string) clause.Expr {
+	return gorm.Expr("CASE WHEN " + column + " > 0 THEN " + column + " - 1 ELSE 0 END")
+}
+
+// 关注/取关时同时更新双方的计数
+func adjustFollowCounts(tx *gorm.DB, followerID uint, followingID uint, expr func(string) clause.Expr) error {
+	if err := tx.Model(&User{}).Where("id = ?", followerID).UpdateColumn("following_count", expr("following_count")).Error; err != nil {
+		return err
+	}
+	return tx.Model(&User{}).Where("id = ?", followingID).UpdateColumn("followers_count", expr("followers_count")).Error
+}
+
+// uid是否关注uids中的每个用户
+func followingMap(db *gorm.DB, uid uint, uids []uint) (map[uint]bool, error) {
+	result := make(map[uint]bool, len(uids))
+	if len(uids) == 0 {
+		return result, nil
+	}
+
+	var follows []Follow
+	if err := db.Model(&Follow{}).
+		Where("follower_id = ? AND following_id IN ?", uid, uids).
+		Find(&follows).Error; err != nil {
+		return nil, err
+	}
+	for _, uid := range uids {
+		result[uid] = false
+	}
+	for _, follow := range follows {
+		result[follow.FollowingID] = true
+	}
+	return result, nil
 }
 
 // 具体实现 biz层的interface
@@ -39,6 +179,9 @@ type userRepo struct {
 }
 
 func NewUserRepo(data *Data, logger log.Logger) biz.UserRepo {
+	if data.mem != nil {
+		return &memoryUserRepo{mem: data.mem}
+	}
 	return &userRepo{
 		data: data,
 		log:  log.NewHelper(logger),
@@ -53,15 +196,15 @@ func (r *userRepo) CreateUser(ctx context.Context, user *biz.User) error {
 		Image:        user.Image,
 		PasswordHash: user.PasswordHash,
 	}
-	if err := r.data.db.Create(&u).Error; err != nil {
+	if err := r.data.DB(ctx).Create(&u).Error; err != nil {
 		// 检查错误是否为重复的key
-		if strings.Contains(err.Error(), "Duplicate entry") {
-			if strings.Contains(err.Error(), "username") {
-				return e.NewHTTPError(400, "username", "username already exists")
-			}
-			return e.NewHTTPError(400, "email", "email already exists")
+		if isUniqueViolation(err, "username") {
+			return biz.TakenError("username")
 		}
-		return e.NewHTTPError(500, "database", "database error")
+		if isUniqueViolation(err, "email") {
+			return biz.TakenError("email")
+		}
+		return err
 	}
 	// uid需要返回到biz层 - token需要
 	user.ID = u.ID
@@ -70,9 +213,8 @@ func (r *userRepo) CreateUser(ctx context.Context, user *biz.User) error {
 
 func (r *userRepo) GetUserByEmail(ctx context.Context, email string) (*biz.User, error) {
 	u := new(User)
-	result := r.data.db.Where("email = ?", email).First(u)
-	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
-		return nil, errors.NotFound("user", "not found by email")
+	if err := r.data.DB(ctx).Where("email = ?", email).First(u).Error; err != nil {
+		return nil, translateNotFound(err, biz.ErrUserNotFound)
 	}
 
 	return &biz.User{
@@ -82,19 +224,30 @@ func (r *userRepo) GetUserByEmail(ctx context.Context, email string) (*biz.User,
 		Bio:          u.Bio,
 		Image:        u.Image,
 		PasswordHash: u.PasswordHash,
+		Private:      u.Private,
 	}, nil
 
 }
 
 func (r *userRepo) GetUserByUsername(ctx context.Context, username string) (*biz.User, error) {
-	return nil, nil
+	u := new(User)
+	if err := r.data.DB(ctx).Where("username = ? AND anonymized_at IS NULL", username).First(u).Error; err != nil {
+		return nil, translateNotFound(err, biz.ErrUserNotFound)
+	}
+	return &biz.User{
+		ID:       u.ID,
+		Email:    u.Email,
+		Username: u.Username,
+		Bio:      u.Bio,
+		Image:    u.Image,
+		Private:  u.Private,
+	}, nil
 }
 
 func (r *userRepo) GetUserByID(ctx context.Context, uid uint) (*biz.User, error) {
 	u := new(User)
-	result := r.data.db.Where("id = ?", uid).First(u)
-	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
-		return nil, errors.NotFound("user", "not found by id")
+	if err := r.data.DB(ctx).Where("id = ? AND anonymized_at IS NULL", uid).First(u).Error; err != nil {
+		return nil, translateNotFound(err, biz.ErrUserNotFound)
 	}
 	return &biz.User{
 		// uid返回是为了做修改的时候 能够确保知道是哪个uid
@@ -103,6 +256,7 @@ func (r *userRepo) GetUserByID(ctx context.Context, uid uint) (*biz.User, error)
 		Username: u.Username,
 		Bio:      u.Bio,
 		Image:    u.Image,
+		Private:  u.Private,
 	}, nil
 }
 
@@ -110,11 +264,11 @@ func (r *userRepo) UpdateUser(ctx context.Context, user *biz.User) (*biz.User, e
 	// uid是唯一的
 	u := new(User)
 	// 1. 先找到要修改的用户
-	if err := r.data.db.Where("id = ?", user.ID).First(u).Error; err != nil {
-		return nil, err
+	if err := r.data.DB(ctx).Where("id = ?", user.ID).First(u).Error; err != nil {
+		return nil, translateNotFound(err, biz.ErrUserNotFound)
 	}
 	// 2. 更新用户信息
-	err := r.data.db.Model(&u).Updates(User{
+	err := r.data.DB(ctx).Model(&u).Updates(User{
 		Email:        user.Email,
 		Username:     user.Username,
 		Bio:          user.Bio,
@@ -122,14 +276,19 @@ func (r *userRepo) UpdateUser(ctx context.Context, user *biz.User) (*biz.User, e
 		PasswordHash: user.PasswordHash,
 	}).Error
 	if err != nil {
-		if strings.Contains(err.Error(), "Duplicate entry") {
-			if strings.Contains(err.Error(), "username") {
-				return nil, errors.BadRequest("username", "username already exists")
-			}
-			return nil, errors.BadRequest("email", "email already exists")
+		if isUniqueViolation(err, "username") {
+			return nil, biz.TakenError("username")
 		}
+		if isUniqueViolation(err, "email") {
+			return nil, biz.TakenError("email")
+		}
+		return nil, err
+	}
+	// Updates会忽略零值, private单独更新
+	if err := r.data.DB(ctx).Model(u).UpdateColumn("private", user.Private).Error; err != nil {
 		return nil, err
 	}
+	r.data.cache.invalidate(ctx, profileCacheKey(u.ID))
 
 	// 返回更新后内容
 	return &biz.User{
@@ -139,25 +298,411 @@ func (r *userRepo) UpdateUser(ctx context.Context, user *biz.User) (*biz.User, e
 		Bio:          u.Bio,
 		Image:        u.Image,
 		PasswordHash: u.PasswordHash,
+		Private:      u.Private,
 	}, nil
 }
 
+// 只更新密码hash - 登录时rehash使用
+func (r *userRepo) UpdatePasswordHash(ctx context.Context, uid uint, hash string) error {
+	return r.data.DB(ctx).Model(&User{}).Where("id = ?", uid).UpdateColumn("password_hash", hash).Error
+}
+
+// 删除用户的关注/拉黑/静音关系和收藏, 并重新计算受影响文章的收藏数
+// 返回计数变化的用户和文章的缓存key
+func deleteUserRelations(tx *gorm.DB, uid uint) ([]string, error) {
+	// 对方的计数也要同步减掉
+	var followingIDs, followerIDs []uint
+	if err := tx.Model(&Follow{}).Where("follower_id = ?", uid).Pluck("following_id", &followingIDs).Error; err != nil {
+		return nil, err
+	}
+	if err := tx.Model(&Follow{}).Where("following_id = ?", uid).Pluck("follower_id", &followerIDs).Error; err != nil {
+		return nil, err
+	}
+	if len(followingIDs) > 0 {
+		if err := tx.Model(&User{}).Where("id IN ?", followingIDs).UpdateColumn("followers_count", decrExpr("followers_count")).Error; err != nil {
+			return nil, err
+		}
+	}
+	if len(followerIDs) > 0 {
+		if err := tx.Model(&User{}).Where("id IN ?", followerIDs).UpdateColumn("following_count", decrExpr("following_count")).Error; err != nil {
+			return nil, err
+		}
+	}
+	if err := tx.Unscoped().Where("follower_id = ? OR following_id = ?", uid, uid).Delete(&Follow{}).Error; err != nil {
+		return nil, err
+	}
+	if err := tx.Unscoped().Where("blocker_id = ? OR blocked_id = ?", uid, uid).Delete(&Block{}).Error; err != nil {
+		return nil, err
+	}
+	if err := tx.Unscoped().Where("muter_id = ? OR muted_id = ?", uid, uid).Delete(&Mute{}).Error; err != nil {
+		return nil, err
+	}
+	if err := tx.Unscoped().Where("requester_id = ? OR target_id = ?", uid, uid).Delete(&FollowRequest{}).Error; err != nil {
+		return nil, err
+	}
+	if err := tx.Unscoped().Where("user_id = ?", uid).Delete(&Bookmark{}).Error; err != nil {
+		return nil, err
+	}
+	if err := tx.Unscoped().Where("user_id = ?", uid).Delete(&BookmarkCollection{}).Error; err != nil {
+		return nil, err
+	}
+	if err := deleteUserReactions(tx, uid); err != nil {
+		return nil, err
+	}
+	if err := tx.Unscoped().Where("user_id = ?", uid).Delete(&TagFollow{}).Error; err != nil {
+		return nil, err
+	}
+	if err := deleteUserNotifications(tx, uid); err != nil {
+		return nil, err
+	}
+
+	var aids []uint
+	if err := tx.Model(&ArticleFavorite{}).Where("user_id = ?", uid).Pluck("article_id", &aids).Error; err != nil {
+		return nil, err
+	}
+	if err := tx.Unscoped().Where("user_id = ?", uid).Delete(&ArticleFavorite{}).Error; err != nil {
+		return nil, err
+	}
+	for _, aid := range aids {
+		if err := tx.Model(&Article{}).Where("id = ?", aid).UpdateColumn("favorites_count", decrExpr("favorites_count")).Error; err != nil {
+			return nil, err
+		}
+	}
+	stale := make([]string, 0, len(followingIDs)+len(followerIDs)+len(aids))
+	for _, id := range append(followingIDs, followerIDs...) {
+		stale = append(stale, profileCacheKey(id))
+	}
+	for _, aid := range aids {
+		stale = append(stale, articleCacheKey(aid))
+	}
+	return stale, nil
+}
+
+// 匿名化 - 用户行保留, 文章和评论仍然挂在这个用户下
+func (r *userRepo) AnonymizeUser(ctx context.Context, uid uint) error {
+	var stale []string
+	err := r.data.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		if stale, err = deleteUserRelations(tx, uid); err != nil {
+			return err
+		}
+		result := tx.Model(&User{}).Where("id = ? AND anonymized_at IS NULL", uid).Updates(map[string]interface{}{
+			"email":           fmt.Sprintf("deleted-%d@deleted.invalid", uid),
+			"username":        fmt.Sprintf("deleted-user-%d", uid),
+			"bio":             "",
+			"image":           "",
+			"password_hash":   "",
+			"anonymized_at":   time.Now(),
+			"private":         false,
+			"followers_count": 0,
+			"following_count": 0,
+		})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return biz.ErrUserNotFound
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	r.data.cache.invalidate(ctx, append(stale, profileCacheKey(uid))...)
+	return nil
+}
+
+// 删除 - 文章和评论转移到占位用户, 再物理删除用户行
+func (r *userRepo) DeleteUser(ctx context.Context, uid uint, placeholderUsername string) error {
+	var stale []string
+	err := r.data.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		if stale, err = deleteUserRelations(tx, uid); err != nil {
+			return err
+		}
+
+		// 占位用户不存在则创建, 没有密码所以无法登录
+		placeholder := User{}
+		err = tx.Where("username = ?", placeholderUsername).Take(&placeholder).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			placeholder = User{Username: placeholderUsername, Email: placeholderUsername + "@deleted.invalid"}
+			err = tx.Create(&placeholder).Error
+		}
+		if err != nil {
+			return err
+		}
+		if placeholder.ID == uid {
+			return biz.ErrPlaceholderUser
+		}
+		// 同名的是可以登录的普通用户时不能把内容转移给他
+		if placeholder.PasswordHash != "" || placeholder.AnonymizedAt != nil {
+			return biz.ErrPlaceholderTaken
+		}
+
+		// 转移的文章缓存了作者id
+		var moving []uint
+		if err := tx.Model(&Article{}).Where("author_id = ?", uid).Pluck("id", &moving).Error; err != nil {
+			return err
+		}
+		for _, aid := range moving {
+			stale = append(stale, articleCacheKey(aid))
+		}
+		stale = append(stale, profileCacheKey(placeholder.ID))
+		moved := tx.Model(&Article{}).Where("author_id = ?", uid).UpdateColumn("author_id", placeholder.ID)
+		if moved.Error != nil {
+			return moved.Error
+		}
+		if moved.RowsAffected > 0 {
+			err := tx.Model(&User{}).Where("id = ?", placeholder.ID).
+				UpdateColumn("articles_count", gorm.Expr("articles_count + ?", moved.RowsAffected)).Error
+			if err != nil {
+				return err
+			}
+		}
+		if err := tx.Model(&Comment{}).Where("author_id = ?", uid).UpdateColumn("author_id", placeholder.ID).Error; err != nil {
+			return err
+		}
+
+		result := tx.Unscoped().Delete(&User{}, "id = ?", uid)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return biz.ErrUserNotFound
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	r.data.cache.invalidate(ctx, append(stale, profileCacheKey(uid))...)
+	return nil
+}
+
+// 导出用户数据 - 个人信息, 发布的内容和删除账号时会删除的所有关系
+func (r *userRepo) ExportUser(ctx context.Context, uid uint) (*biz.UserExport, error) {
+	u := new(User)
+	if err := r.data.DB(ctx).Where("id = ? AND anonymized_at IS NULL", uid).First(u).Error; err != nil {
+		return nil, translateNotFound(err, biz.ErrUserNotFound)
+	}
+
+	var articles []Article
+	if err := r.data.DB(ctx).Where("author_id = ?", uid).Preload("Author").Preload("Tags").Order("created_at").Find(&articles).Error; err != nil {
+		return nil, err
+	}
+	articleList := make([]*biz.Article, len(articles))
+	for i, a := range articles {
+		articleList[i] = convertArticle(a)
+	}
+
+	var comments []Comment
+	if err := r.data.DB(ctx).Where("author_id = ?", uid).Preload("Article").Order("created_at").Find(&comments).Error; err != nil {
+		return nil, err
+	}
+	commentList := make([]*biz.Comment, len(comments))
+	for i, c := range comments {
+		commentList[i] = &biz.Comment{
+			ID:          c.ID,
+			Body:        c.Body,
+			CreatedAt:   c.CreatedAt,
+			UpdatedAt:   c.UpdatedAt,
+			AuthorID:    c.AuthorID,
+			ArticleID:   c.ArticleID,
+			ArticleSlug: c.Article.Slug,
+		}
+	}
+
+	var favorites []*biz.ExportFavorite
+	err := r.data.DB(ctx).Model(&ArticleFavorite{}).
+		Select("articles.slug AS slug, article_favorites.created_at AS created_at").
+		Joins("JOIN articles ON articles.id = article_favorites.article_id").
+		Where("article_favorites.user_id = ?", uid).
+		Order("article_favorites.created_at").
+		Scan(&favorites).Error
+	if err != nil {
+		return nil, err
+	}
+
+	export := &biz.UserExport{
+		User: &biz.User{
+			ID:       u.ID,
+			Email:    u.Email,
+			Username: u.Username,
+			Bio:      u.Bio,
+			Image:    u.Image,
+		},
+		CreatedAt: u.CreatedAt,
+		Articles:  articleList,
+		Comments:  commentList,
+		Favorites: favorites,
+	}
+	db := r.data.DB(ctx)
+	relations := []struct {
+		list   *[]*biz.ExportFollow
+		model  interface{}
+		table  string
+		column string
+		other  string
+	}{
+		{&export.Following, &Follow{}, "follows", "follower_id", "following_id"},
+		{&export.Followers, &Follow{}, "follows", "following_id", "follower_id"},
+		{&export.FollowRequestsSent, &FollowRequest{}, "follow_requests", "requester_id", "target_id"},
+		{&export.FollowRequestsReceived, &FollowRequest{}, "follow_requests", "target_id", "requester_id"},
+		{&export.Blocked, &Block{}, "blocks", "blocker_id", "blocked_id"},
+		{&export.Muted, &Mute{}, "mutes", "muter_id", "muted_id"},
+	}
+	for _, rel := range relations {
+		err := db.Model(rel.model).
+			Select("users.username AS username, "+rel.table+".created_at AS created_at").
+			Joins("JOIN users ON users.id = "+rel.table+"."+rel.other).
+			Where(rel.table+"."+rel.column+" = ?", uid).
+			Order(rel.table + ".created_at").
+			Scan(rel.list).Error
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var attachments []Attachment
+	if err := db.Where("user_id = ?", uid).Order("created_at").Find(&attachments).Error; err != nil {
+		return nil, err
+	}
+	export.Attachments = convertAttachments(attachments)
+
+	var collections []BookmarkCollection
+	if err := db.Where("user_id = ?", uid).Order("created_at").Find(&collections).Error; err != nil {
+		return nil, err
+	}
+	type bookmarkRow struct {
+		Slug         string
+		CollectionID uint
+		CreatedAt    time.Time
+	}
+	var bookmarks []bookmarkRow
+	err = db.Model(&Bookmark{}).
+		Select("articles.slug AS slug, bookmarks.collection_id AS collection_id, bookmarks.created_at AS created_at").
+		Joins("JOIN articles ON articles.id = bookmarks.article_id").
+		Where("bookmarks.user_id = ?", uid).
+		Order("bookmarks.created_at").
+		Scan(&bookmarks).Error
+	if err != nil {
+		return nil, err
+	}
+	names := make(map[uint]string, len(collections))
+	counts := make(map[uint]uint32, len(collections))
+	for _, c := range collections {
+		names[c.ID] = c.Name
+	}
+	for _, b := range bookmarks {
+		counts[b.CollectionID]++
+		export.Bookmarks = append(export.Bookmarks, &biz.ExportBookmark{Slug: b.Slug, Collection: names[b.CollectionID], CreatedAt: b.CreatedAt})
+	}
+	for _, c := range collections {
+		export.BookmarkCollections = append(export.BookmarkCollections, convertCollection(c, counts[c.ID]))
+	}
+
+	var reactions []Reaction
+	if err := db.Where("user_id = ?", uid).Order("created_at").Find(&reactions).Error; err != nil {
+		return nil, err
+	}
+	for _, reaction := range reactions {
+		export.Reactions = append(export.Reactions, &biz.ExportReaction{
+			TargetType: reaction.TargetType,
+			TargetID:   reaction.TargetID,
+			Reaction:   reaction.Reaction,
+			CreatedAt:  reaction.CreatedAt,
+		})
+	}
+
+	err = db.Model(&TagFollow{}).
+		Select("tags.name AS name, tag_follows.created_at AS created_at").
+		Joins("JOIN tags ON tags.id = tag_follows.tag_id").
+		Where("tag_follows.user_id = ?", uid).
+		Order("tag_follows.created_at").
+		Scan(&export.FollowedTags).Error
+	if err != nil {
+		return nil, err
+	}
+
+	if export.Notifications, err = exportNotifications(db, uid); err != nil {
+		return nil, err
+	}
+	var preferences []NotificationPreference
+	if err := db.Where("user_id = ?", uid).Find(&preferences).Error; err != nil {
+		return nil, err
+	}
+	export.NotificationPreferences = make(map[string]bool, len(preferences))
+	for _, p := range preferences {
+		export.NotificationPreferences[p.Type] = p.Enabled
+	}
+	return export, nil
+}
+
 type profileRepo struct {
 	data *Data
 	log  *log.Helper
 }
 
 func NewProfileRepo(data *Data, logger log.Logger) biz.ProfileRepo {
+	if data.mem != nil {
+		return &memoryProfileRepo{mem: data.mem}
+	}
 	return &profileRepo{
 		data: data,
 		log:  log.NewHelper(logger),
 	}
 }
 
-func (p *profileRepo) GetProfileByUsername(ctx context.Context, username string) (*biz.ProfileResp, error) {
+// 用户资料, 不包含和当前用户相关的字段
+func loadProfile(ctx context.Context, data *Data, query string, arg interface{}) (*biz.ProfileResp, error) {
 	u := new(User)
+	if err := data.DB(ctx).Where(query, arg).First(u).Error; err != nil {
+		return nil, translateNotFound(err, biz.ErrUserNotFound)
+	}
+	return convertProfile(*u), nil
+}
+
+func cachedProfile(ctx context.Context, data *Data, uid uint) (*biz.ProfileResp, error) {
+	return cached(ctx, data.cache, profileCacheKey(uid), func(ctx context.Context) (*biz.ProfileResp, error) {
+		return loadProfile(ctx, data, "id = ?", uid)
+	})
+}
+
+// 和GetUserByUsername一样, 已经匿名化的用户不能按username查到
+// 按id读取资料不过滤, 匿名化用户的文章和评论仍然需要作者资料
+func loadProfileByUsername(ctx context.Context, data *Data, username string) (*biz.ProfileResp, error) {
+	return loadProfile(ctx, data, "username = ? AND anonymized_at IS NULL", username)
+}
+
+// username先映射到id, 再读取id对应的资料
+func (p *profileRepo) profileByUsername(ctx context.Context, username string) (*biz.ProfileResp, error) {
+	if !p.data.cache.usable(ctx) {
+		return loadProfileByUsername(ctx, p.data, username)
+	}
+	uid, err := cached(ctx, p.data.cache, profileNameCacheKey(username), func(ctx context.Context) (uint, error) {
+		profile, err := loadProfileByUsername(ctx, p.data, username)
+		if err != nil {
+			return 0, err
+		}
+		return profile.ID, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	profile, err := cachedProfile(ctx, p.data, uid)
+	// 改名或删除后username可能已经属于其他用户, 映射不主动失效, 在这里校验
+	// 匿名化会改掉username, 同样在这里发现
+	if errors.Is(err, biz.ErrUserNotFound) || (err == nil && profile.Username != username) {
+		p.data.cache.invalidate(ctx, profileNameCacheKey(username))
+		return loadProfileByUsername(ctx, p.data, username)
+	}
+	return profile, err
+}
+
+func (p *profileRepo) GetProfileByUsername(ctx context.Context, username string) (*biz.ProfileResp, error) {
 	// 1. 获取username对应的数据
-	if err := p.data.db.Where("username = ?", username).First(u).Error; err != nil {
+	profile, err := p.profileByUsername(ctx, username)
+	if err != nil {
 		return nil, err
 	}
 	// 2. 查看当前用户是否关注该博主username
@@ -165,53 +710,478 @@ func (p *profileRepo) GetProfileByUsername(ctx context.Context, username string)
 	currentUser, ok := auth.FromContext(ctx)
 	if ok {
 		var count int64
-		err := p.data.db.Model(&Follow{}).
-			Where("follower_id = ? AND following_id = ?", currentUser.UserID, u.ID).
+		err := p.data.DB(ctx).Model(&Follow{}).
+			Where("follower_id = ? AND following_id = ?", currentUser.UserID, profile.ID).
 			Count(&count).Error
 		if err != nil {
 			return nil, err
 		}
 		following = count > 0
 	}
+	// 私密账号 - 是否有待处理的关注申请
+	var requested bool
+	if ok && profile.Private && !following {
+		var count int64
+		err := p.data.DB(ctx).Model(&FollowRequest{}).
+			Where("requester_id = ? AND target_id = ?", currentUser.UserID, profile.ID).
+			Count(&count).Error
+		if err != nil {
+			return nil, err
+		}
+		requested = count > 0
+	}
 
-	return &biz.ProfileResp{
-		ID:        u.ID,
-		Username:  u.Username,
-		Bio:       u.Bio,
-		Image:     u.Image,
-		Following: following,
-	}, nil
+	profile.Following = following
+	profile.FollowRequested = requested
+	return profile, nil
 }
 
 func (p *profileRepo) FollowUserByUsername(ctx context.Context, currentUserID uint, followingUserID uint) error {
-	var count int64
-	if err := p.data.db.Model(&Follow{}).Where("follower_id = ? AND following_id = ?", currentUserID, followingUserID).Count(&count).Error; err != nil {
+	// 创建新的关注关系, 同时更新双方计数
+	follow := Follow{
+		FollowerID:  currentUserID,
+		FollowingID: followingUserID,
+	}
+
+	err := p.data.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		// 唯一索引冲突时不插入, 没有新增说明已经关注
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&follow)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return biz.ErrFollowExists
+		}
+		return adjustFollowCounts(tx, currentUserID, followingUserID, incrExpr)
+	})
+	if err != nil {
 		return err
 	}
+	p.data.cache.invalidate(ctx, profileCacheKey(currentUserID), profileCacheKey(followingUserID))
+	return nil
+}
 
-	// 如果已经关注，返回错误
-	if count > 0 {
-		return errors.BadRequest("FOLLOW_EXISTS", "already followed")
+func (p *profileRepo) UnfollowUserByUsername(ctx context.Context, currentUserID uint, followingUserID uint) error {
+	err := p.data.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		// 采用物理删除, 否则唯一索引会挡住再次关注
+		result := tx.Unscoped().Where("follower_id = ? AND following_id = ?", currentUserID, followingUserID).Delete(&Follow{})
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return biz.ErrFollowNotFound
+		}
+
+		return adjustFollowCounts(tx, currentUserID, followingUserID, decrExpr)
+	})
+	if err != nil {
+		return err
 	}
+	p.data.cache.invalidate(ctx, profileCacheKey(currentUserID), profileCacheKey(followingUserID))
+	return nil
+}
 
-	// 创建新的关注关系
-	follow := Follow{
-		FollowerID:  currentUserID,
-		FollowingID: followingUserID,
+// 粉丝列表 - uid被谁关注
+func (p *profileRepo) ListFollowers(ctx context.Context, uid uint, cursor uint, limit int) ([]*biz.ProfileResp, uint, error) {
+	return p.listRelations(ctx, &Follow{}, "following_id", "follower_id", uid, cursor, limit)
+}
+
+// 关注列表 - uid关注了谁
+func (p *profileRepo) ListFollowing(ctx context.Context, uid uint, cursor uint, limit int) ([]*biz.ProfileResp, uint, error) {
+	return p.listRelations(ctx, &Follow{}, "follower_id", "following_id", uid, cursor, limit)
+}
+
+// 关注/拉黑/静音等关系表的通用分页
+// 按关系记录id倒序, 多查一条来判断是否有下一页; column为uid所在列, target为要列出的用户所在列
+func (p *profileRepo) listRelations(ctx context.Context, model interface{}, column string, target string, uid uint, cursor uint, limit int) ([]*biz.ProfileResp, uint, error) {
+	type relation struct {
+		ID       uint
+		TargetID uint
+	}
+
+	db := p.data.DB(ctx).Model(model).Select("id, "+target+" AS target_id").Where(column+" = ?", uid)
+	if cursor > 0 {
+		db = db.Where("id < ?", cursor)
+	}
+	var relations []relation
+	if err := db.Order("id DESC").Limit(limit + 1).Scan(&relations).Error; err != nil {
+		return nil, 0, err
 	}
 
-	return p.data.db.Create(&follow).Error
+	var next uint
+	if len(relations) > limit {
+		relations = relations[:limit]
+		next = relations[limit-1].ID
+	}
+	if len(relations) == 0 {
+		return []*biz.ProfileResp{}, 0, nil
+	}
+
+	uids := make([]uint, len(relations))
+	for i, r := range relations {
+		uids[i] = r.TargetID
+	}
+	// 保持关系记录的顺序
+	profiles, err := profilesByIDs(p.data.DB(ctx), uids)
+	if err != nil {
+		return nil, 0, err
+	}
+	return profiles, next, nil
 }
 
-func (p *profileRepo) UnfollowUserByUsername(ctx context.Context, currentUserID uint, followingUserID uint) error {
-	result := p.data.db.Where("follower_id = ? AND following_id = ?", currentUserID, followingUserID).Delete(&Follow{})
+// 批量查询用户, 按uids的顺序返回, 不存在的用户跳过
+func profilesByIDs(db *gorm.DB, uids []uint) ([]*biz.ProfileResp, error) {
+	profiles := make([]*biz.ProfileResp, 0, len(uids))
+	if len(uids) == 0 {
+		return profiles, nil
+	}
+	var users []User
+	if err := db.Where("id IN ?", uids).Find(&users).Error; err != nil {
+		return nil, err
+	}
+	userMap := make(map[uint]User, len(users))
+	for _, u := range users {
+		userMap[u.ID] = u
+	}
+	for _, id := range uids {
+		if u, ok := userMap[id]; ok {
+			profiles = append(profiles, convertProfile(u))
+		}
+	}
+	return profiles, nil
+}
+
+func (p *profileRepo) GetFollowingMap(ctx context.Context, uid uint, uids []uint) (map[uint]bool, error) {
+	return followingMap(p.data.DB(ctx), uid, uids)
+}
+
+// 拉黑 - 同时删除双向的关注关系并更新计数
+func (p *profileRepo) BlockUser(ctx context.Context, uid uint, targetID uint) error {
+	err := p.data.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		var count int64
+		if err := tx.Model(&Block{}).Where("blocker_id = ? AND blocked_id = ?", uid, targetID).Count(&count).Error; err != nil {
+			return err
+		}
+		if count > 0 {
+			return biz.ErrBlockExists
+		}
+		if err := tx.Create(&Block{BlockerID: uid, BlockedID: targetID}).Error; err != nil {
+			if isUniqueViolation(err, "") {
+				return biz.ErrBlockExists
+			}
+			return err
+		}
+		err := tx.Unscoped().
+			Where("(requester_id = ? AND target_id = ?) OR (requester_id = ? AND target_id = ?)", uid, targetID, targetID, uid).
+			Delete(&FollowRequest{}).Error
+		if err != nil {
+			return err
+		}
+
+		for _, pair := range [][2]uint{{uid, targetID}, {targetID, uid}} {
+			result := tx.Unscoped().Where("follower_id = ? AND following_id = ?", pair[0], pair[1]).Delete(&Follow{})
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected > 0 {
+				if err := adjustFollowCounts(tx, pair[0], pair[1], decrExpr); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	p.data.cache.invalidate(ctx, profileCacheKey(uid), profileCacheKey(targetID))
+	return nil
+}
+
+func (p *profileRepo) UnblockUser(ctx context.Context, uid uint, targetID uint) error {
+	result := p.data.DB(ctx).Unscoped().Where("blocker_id = ? AND blocked_id = ?", uid, targetID).Delete(&Block{})
 	if result.Error != nil {
 		return result.Error
 	}
+	if result.RowsAffected == 0 {
+		return biz.ErrBlockNotFound
+	}
+	return nil
+}
+
+func (p *profileRepo) MuteUser(ctx context.Context, uid uint, targetID uint) error {
+	var count int64
+	if err := p.data.DB(ctx).Model(&Mute{}).Where("muter_id = ? AND muted_id = ?", uid, targetID).Count(&count).Error; err != nil {
+		return err
+	}
+	if count > 0 {
+		return biz.ErrMuteExists
+	}
+	if err := p.data.DB(ctx).Create(&Mute{MuterID: uid, MutedID: targetID}).Error; err != nil {
+		// 并发请求时检查之后才写入
+		if isUniqueViolation(err, "") {
+			return biz.ErrMuteExists
+		}
+		return err
+	}
+	return nil
+}
 
+func (p *profileRepo) UnmuteUser(ctx context.Context, uid uint, targetID uint) error {
+	result := p.data.DB(ctx).Unscoped().Where("muter_id = ? AND muted_id = ?", uid, targetID).Delete(&Mute{})
+	if result.Error != nil {
+		return result.Error
+	}
 	if result.RowsAffected == 0 {
-		return errors.BadRequest("FOLLOW_NOT_FOUND", "not followed")
+		return biz.ErrMuteNotFound
 	}
+	return nil
+}
 
+func (p *profileRepo) ListBlockedUsers(ctx context.Context, uid uint, cursor uint, limit int) ([]*biz.ProfileResp, uint, error) {
+	return p.listRelations(ctx, &Block{}, "blocker_id", "blocked_id", uid, cursor, limit)
+}
+
+func (p *profileRepo) ListMutedUsers(ctx context.Context, uid uint, cursor uint, limit int) ([]*biz.ProfileResp, uint, error) {
+	return p.listRelations(ctx, &Mute{}, "muter_id", "muted_id", uid, cursor, limit)
+}
+
+func (p *profileRepo) IsBlocked(ctx context.Context, uid uint, otherID uint) (bool, error) {
+	if uid == 0 || otherID == 0 || uid == otherID {
+		return false, nil
+	}
+	var count int64
+	err := p.data.DB(ctx).Model(&Block{}).
+		Where("(blocker_id = ? AND blocked_id = ?) OR (blocker_id = ? AND blocked_id = ?)", uid, otherID, otherID, uid).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (p *profileRepo) IsMuted(ctx context.Context, uid uint, targetID uint) (bool, error) {
+	var count int64
+	if err := p.data.DB(ctx).Model(&Mute{}).Where("muter_id = ? AND muted_id = ?", uid, targetID).Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (p *profileRepo) CreateFollowRequest(ctx context.Context, uid uint, targetID uint) error {
+	var count int64
+	if err := p.data.DB(ctx).Model(&Follow{}).Where("follower_id = ? AND following_id = ?", uid, targetID).Count(&count).Error; err != nil {
+		return err
+	}
+	if count > 0 {
+		return biz.ErrFollowExists
+	}
+	if err := p.data.DB(ctx).Model(&FollowRequest{}).Where("requester_id = ? AND target_id = ?", uid, targetID).Count(&count).Error; err != nil {
+		return err
+	}
+	if count > 0 {
+		return biz.ErrFollowRequestExists
+	}
+	if err := p.data.DB(ctx).Create(&FollowRequest{RequesterID: uid, TargetID: targetID}).Error; err != nil {
+		if isUniqueViolation(err, "") {
+			return biz.ErrFollowRequestExists
+		}
+		return err
+	}
+	return nil
+}
+
+// 同意 - 删除申请并创建关注关系, 同时更新双方计数
+func (p *profileRepo) ApproveFollowRequest(ctx context.Context, requesterID uint, targetID uint) error {
+	err := p.data.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Unscoped().Where("requester_id = ? AND target_id = ?", requesterID, targetID).Delete(&FollowRequest{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return biz.ErrFollowRequestNotFound
+		}
+		_, err := approveFollow(tx, requesterID, targetID)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	p.data.cache.invalidate(ctx, profileCacheKey(requesterID), profileCacheKey(targetID))
+	return nil
+}
+
+func (p *profileRepo) DeleteFollowRequest(ctx context.Context, requesterID uint, targetID uint) error {
+	result := p.data.DB(ctx).Unscoped().Where("requester_id = ? AND target_id = ?", requesterID, targetID).Delete(&FollowRequest{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return biz.ErrFollowRequestNotFound
+	}
 	return nil
 }
+
+func (p *profileRepo) ApproveAllFollowRequests(ctx context.Context, targetID uint) ([]uint, error) {
+	var requesterIDs, approved []uint
+	err := p.data.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&FollowRequest{}).Where("target_id = ?", targetID).Pluck("requester_id", &requesterIDs).Error; err != nil {
+			return err
+		}
+		if len(requesterIDs) == 0 {
+			return nil
+		}
+		if err := tx.Unscoped().Where("target_id = ?", targetID).Delete(&FollowRequest{}).Error; err != nil {
+			return err
+		}
+		for _, requesterID := range requesterIDs {
+			created, err := approveFollow(tx, requesterID, targetID)
+			if err != nil {
+				return err
+			}
+			if created {
+				approved = append(approved, requesterID)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	stale := []string{profileCacheKey(targetID)}
+	for _, requesterID := range requesterIDs {
+		stale = append(stale, profileCacheKey(requesterID))
+	}
+	p.data.cache.invalidate(ctx, stale...)
+	return approved, nil
+}
+
+// 申请通过后建立关注, 已经关注的情况直接跳过 - 返回是否新建了关注
+func approveFollow(tx *gorm.DB, followerID uint, followingID uint) (bool, error) {
+	result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&Follow{FollowerID: followerID, FollowingID: followingID})
+	if result.Error != nil || result.RowsAffected == 0 {
+		return false, result.Error
+	}
+	return true, adjustFollowCounts(tx, followerID, followingID, incrExpr)
+}
+
+// 收到的申请 - 列出申请人
+func (p *profileRepo) ListIncomingFollowRequests(ctx context.Context, uid uint, cursor uint, limit int) ([]*biz.ProfileResp, uint, error) {
+	return p.listRelations(ctx, &FollowRequest{}, "target_id", "requester_id", uid, cursor, limit)
+}
+
+// 发出的申请 - 列出申请关注的账号
+func (p *profileRepo) ListOutgoingFollowRequests(ctx context.Context, uid uint, cursor uint, limit int) ([]*biz.ProfileResp, uint, error) {
+	return p.listRelations(ctx, &FollowRequest{}, "requester_id", "target_id", uid, cursor, limit)
+}
+
+func (p *profileRepo) SearchProfiles(ctx context.Context, viewerUid uint, query string, offset uint, limit int) ([]*biz.ProfileResp, uint, error) {
+	q := strings.ToLower(query)
+	prefix := escapeLike(q) + "%"
+	contains := "%" + escapeLike(q) + "%"
+
+	db := p.data.DB(ctx).Model(&User{}).
+		Where("(LOWER(username) LIKE ? ESCAPE '!' OR LOWER(bio) LIKE ? ESCAPE '!')", contains, contains).
+		Where("anonymized_at IS NULL AND password_hash <> ?", "")
+	if viewerUid > 0 {
+		db = excludeBlocked(db, "users.id", viewerUid)
+	}
+	// 完全匹配 > username前缀 > username包含 > 只有bio包含, 同一档按粉丝数排序
+	// 排序写在同一个表达式里, 之后再调用Order会丢掉Expression
+	db = db.Order(clause.OrderBy{Expression: clause.Expr{
+		SQL:                "CASE WHEN LOWER(username) = ? THEN 0 WHEN LOWER(username) LIKE ? ESCAPE '!' THEN 1 WHEN LOWER(username) LIKE ? ESCAPE '!' THEN 2 ELSE 3 END, followers_count DESC, id",
+		Vars:               []interface{}{q, prefix, contains},
+		WithoutParentheses: true,
+	}})
+
+	var users []User
+	if err := db.Offset(int(offset)).Limit(limit + 1).Find(&users).Error; err != nil {
+		return nil, 0, err
+	}
+	var next uint
+	if len(users) > limit {
+		users = users[:limit]
+		next = offset + uint(limit)
+	}
+	profiles := make([]*biz.ProfileResp, len(users))
+	for i, u := range users {
+		profiles[i] = convertProfile(u)
+	}
+	return profiles, next, nil
+}
+
+// 三项信号分别查询, 每项按分数取前limit个候选人后合并
+func (p *profileRepo) GetSuggestionSignals(ctx context.Context, uid uint, since time.Time, limit int) ([]*biz.SuggestionSignal, error) {
+	type row struct {
+		UserID uint
+		Score  int
+	}
+	signals := make(map[uint]*biz.SuggestionSignal)
+	signal := func(id uint) *biz.SuggestionSignal {
+		s, ok := signals[id]
+		if !ok {
+			s = &biz.SuggestionSignal{UserID: id}
+			signals[id] = s
+		}
+		return s
+	}
+
+	// 关注的人的关注
+	var rows []row
+	err := excludeForSuggestion(p.data.DB(ctx).Table("follows AS f1"), "f2.following_id", uid).
+		Select("f2.following_id AS user_id, COUNT(*) AS score").
+		Joins("JOIN follows AS f2 ON f2.follower_id = f1.following_id AND f2.deleted_at IS NULL").
+		Where("f1.follower_id = ? AND f1.deleted_at IS NULL", uid).
+		Group("f2.following_id").Order("score DESC").Limit(limit).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range rows {
+		signal(r.UserID).MutualFollows = r.Score
+	}
+
+	// 文章的tag和自己收藏过的文章的tag相同
+	favoriteTags := p.data.DB(ctx).Session(&gorm.Session{NewDB: true}).Table("article_favorites").
+		Select("article_tags.tag_id").
+		Joins("JOIN article_tags ON article_tags.article_id = article_favorites.article_id").
+		Where("article_favorites.user_id = ? AND article_favorites.deleted_at IS NULL", uid)
+	rows = nil
+	err = excludeForSuggestion(p.data.DB(ctx).Table("articles"), "articles.author_id", uid).
+		Select("articles.author_id AS user_id, COUNT(DISTINCT article_tags.tag_id) AS score").
+		Joins("JOIN article_tags ON article_tags.article_id = articles.id").
+		Where("articles.deleted_at IS NULL AND article_tags.tag_id IN (?)", favoriteTags).
+		Group("articles.author_id").Order("score DESC").Limit(limit).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range rows {
+		signal(r.UserID).SharedTags = r.Score
+	}
+
+	// 最近活跃 - since之后发布的文章数
+	rows = nil
+	err = excludeForSuggestion(p.data.DB(ctx).Table("articles"), "articles.author_id", uid).
+		Select("articles.author_id AS user_id, COUNT(*) AS score").
+		Where("articles.deleted_at IS NULL AND articles.created_at >= ?", since).
+		Group("articles.author_id").Order("score DESC").Limit(limit).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range rows {
+		signal(r.UserID).RecentArticles = r.Score
+	}
+
+	result := make([]*biz.SuggestionSignal, 0, len(signals))
+	for _, s := range signals {
+		result = append(result, s)
+	}
+	return result, nil
+}
+
+func (p *profileRepo) GetProfilesByIDs(ctx context.Context, uids []uint) ([]*biz.ProfileResp, error) {
+	return profilesByIDs(p.data.DB(ctx), uids)
+}
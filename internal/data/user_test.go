@@ -0,0 +1,60 @@
+package data
+
+import (
+	"context"
+	"testing"
+
+	"gorm.io/gorm"
+)
+
+// 删除账号时删除数据的每张表都要出现在导出中
+func TestExportCoversDeletedTables(t *testing.T) {
+	d := newTestData(t)
+	r := newConformanceRepos(d)
+	me := seedUserData(t, r)
+
+	const name = "test:record_tables"
+	exported := make(map[string]bool)
+	deleted := make(map[string]bool)
+	record := func(tables map[string]bool) func(db *gorm.DB) {
+		return func(db *gorm.DB) {
+			if !db.DryRun && db.Statement.Table != "" {
+				tables[db.Statement.Table] = true
+			}
+		}
+	}
+	cb := d.db.Callback()
+	for _, err := range []error{
+		cb.Query().After("gorm:query").Register(name, record(exported)),
+		cb.Row().After("gorm:row").Register(name, record(exported)),
+		cb.Delete().After("gorm:delete").Register(name, record(deleted)),
+	} {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	defer func() {
+		cb.Query().Remove(name)
+		cb.Row().Remove(name)
+		cb.Delete().Remove(name)
+	}()
+
+	if _, err := r.users.ExportUser(context.Background(), me); err != nil {
+		t.Fatal(err)
+	}
+	err := d.db.Transaction(func(tx *gorm.DB) error {
+		_, err := deleteUserRelations(tx, me)
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deleted) == 0 {
+		t.Fatal("no tables recorded for deleteUserRelations")
+	}
+	for table := range deleted {
+		if !exported[table] {
+			t.Errorf("table %s is deleted with the account but missing from the export", table)
+		}
+	}
+}
@@ -1,9 +1,15 @@
 package errors
 
 import (
+	"context"
 	"encoding/json"
 
+	v1 "kratos-realworld/api/realworld/v1"
+
 	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/go-kratos/kratos/v2/transport/http"
 )
 
 func NewHTTPError(code int, filed string, detail string) *HTTPError {
@@ -27,22 +33,66 @@ func (e *HTTPError) Error() string {
 }
 
 // 把error类型转换成自定义的错误类型HTTPError
+// key为metadata中的field, 没有field时为reason
 func FromError(err error) *HTTPError {
 	if err == nil {
 		return nil
 	}
+	// 自定义的错误类型直接返回
+	var he *HTTPError
+	if errors.As(err, &he) {
+		return he
+	}
 
-	// 自定义的错误类型进行转换
-	var se *HTTPError
+	se := Normalize(err)
+	key := se.Metadata["field"]
+	if key == "" {
+		key = se.Reason
+	}
+	return NewHTTPError(int(se.Code), key, se.Message)
+}
+
+// 把任意错误转换成kratos的错误, http和grpc使用同一套转换
+// biz和data返回的领域错误原样返回, 其他错误不把内部信息返回给客户端
+func Normalize(err error) *errors.Error {
+	if err == nil {
+		return nil
+	}
+	var se *errors.Error
 	if errors.As(err, &se) {
 		return se
 	}
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return v1.ErrorTimeout("request timeout")
+	case errors.Is(err, context.Canceled):
+		return v1.ErrorCanceled("request canceled")
+	}
+	return v1.ErrorInternal("internal server error")
+}
 
-	// kratos的错误进行转换
-	// transport层做grpc和http的错误转换
-	if se := new(errors.Error); errors.As(err, &se) {
-		return NewHTTPError(int(se.Code), se.Reason, se.Message)
+// Normalize是否隐藏了原始错误, 这些错误返回给客户端之前需要记录下来
+func Masked(err error) bool {
+	var he *HTTPError
+	var se *errors.Error
+	if err == nil || errors.As(err, &he) || errors.As(err, &se) {
+		return false
 	}
+	return !errors.Is(err, context.DeadlineExceeded) && !errors.Is(err, context.Canceled)
+}
 
-	return &HTTPError{}
+// 记录被隐藏的原始错误和对应的请求, 客户端只能看到internal server error
+func LogMasked(ctx context.Context, logger log.Logger, err error) {
+	if !Masked(err) {
+		return
+	}
+	operation := ""
+	if tr, ok := transport.FromServerContext(ctx); ok {
+		operation = tr.Operation()
+		// 手动注册的路由没有operation, 使用请求的路径
+		if ht, ok := tr.(http.Transporter); ok && operation == "" {
+			operation = ht.Request().Method + " " + ht.Request().URL.Path
+		}
+	}
+	log.NewHelper(logger).WithContext(ctx).Errorf("internal error in %s: %+v", operation, err)
 }
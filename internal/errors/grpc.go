@@ -0,0 +1,46 @@
+package errors
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport/http/status"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+)
+
+// 和http相同的转换规则得到grpc状态, reason和metadata放在ErrorInfo中
+func GRPCStatus(err error) *grpcstatus.Status {
+	se := Normalize(err)
+	code := status.ToGRPCCode(int(se.Code))
+	// kratos没有422的对应关系
+	if se.Code == http.StatusUnprocessableEntity {
+		code = codes.InvalidArgument
+	}
+	s, err := grpcstatus.New(code, se.Message).WithDetails(&errdetails.ErrorInfo{
+		Reason:   se.Reason,
+		Metadata: se.Metadata,
+	})
+	if err != nil {
+		return grpcstatus.New(code, se.Message)
+	}
+	return s
+}
+
+// grpc服务端中间件 - handler返回的错误直接作为grpc状态返回, 需要在这里转换
+// 转换前记录会被隐藏的内部错误
+func Server(logger log.Logger) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			reply, err := handler(ctx, req)
+			if err != nil {
+				LogMasked(ctx, logger, err)
+				return nil, GRPCStatus(err).Err()
+			}
+			return reply, nil
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package imaging
+
+import "encoding/binary"
+
+const (
+	exifOrientationTag = 0x0112
+	tiffTypeShort      = 3
+)
+
+// 从jpeg的APP1段读取EXIF方向, 没有或解析失败时返回1(正常方向)
+func exifOrientation(data []byte) int {
+	if len(data) < 4 || data[0] != 0xFF || data[1] != 0xD8 {
+		return 1
+	}
+	i := 2
+	for i+4 <= len(data) {
+		if data[i] != 0xFF {
+			return 1
+		}
+		marker := data[i+1]
+		// 填充字节
+		if marker == 0xFF {
+			i++
+			continue
+		}
+		// SOS之后是图像数据, EXIF一定在它之前
+		if marker == 0xDA || marker == 0xD9 {
+			return 1
+		}
+		length := int(binary.BigEndian.Uint16(data[i+2:]))
+		if length < 2 || i+2+length > len(data) {
+			return 1
+		}
+		segment := data[i+4 : i+2+length]
+		if marker == 0xE1 && len(segment) >= 6 && string(segment[:6]) == "Exif\x00\x00" {
+			return tiffOrientation(segment[6:])
+		}
+		i += 2 + length
+	}
+	return 1
+}
+
+// 在TIFF结构的第一个IFD中查找方向tag
+func tiffOrientation(t []byte) int {
+	if len(t) < 8 {
+		return 1
+	}
+	var order binary.ByteOrder
+	switch string(t[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return 1
+	}
+	if order.Uint16(t[2:]) != 42 {
+		return 1
+	}
+	ifd := int(order.Uint32(t[4:]))
+	if ifd < 8 || ifd+2 > len(t) {
+		return 1
+	}
+	n := int(order.Uint16(t[ifd:]))
+	for k := 0; k < n; k++ {
+		entry := ifd + 2 + k*12
+		if entry+12 > len(t) {
+			return 1
+		}
+		if order.Uint16(t[entry:]) != exifOrientationTag {
+			continue
+		}
+		// SHORT类型的值直接存放在value字段的前两个字节
+		if order.Uint16(t[entry+2:]) != tiffTypeShort {
+			return 1
+		}
+		if o := int(order.Uint16(t[entry+8:])); o >= 1 && o <= 8 {
+			return o
+		}
+		return 1
+	}
+	return 1
+}
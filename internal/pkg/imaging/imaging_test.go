@@ -0,0 +1,127 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"testing"
+)
+
+// 构造一个只包含方向tag的EXIF APP1段, 插入到jpeg的SOI之后
+func withOrientation(t *testing.T, data []byte, orientation uint16) []byte {
+	t.Helper()
+	var tiff bytes.Buffer
+	tiff.WriteString("MM")
+	binary.Write(&tiff, binary.BigEndian, uint16(42))
+	binary.Write(&tiff, binary.BigEndian, uint32(8))
+	binary.Write(&tiff, binary.BigEndian, uint16(1))
+	binary.Write(&tiff, binary.BigEndian, []uint16{exifOrientationTag, tiffTypeShort})
+	binary.Write(&tiff, binary.BigEndian, uint32(1))
+	binary.Write(&tiff, binary.BigEndian, []uint16{orientation, 0})
+	binary.Write(&tiff, binary.BigEndian, uint32(0))
+
+	segment := append([]byte("Exif\x00\x00"), tiff.Bytes()...)
+	app1 := []byte{0xFF, 0xE1, 0, 0}
+	binary.BigEndian.PutUint16(app1[2:], uint16(len(segment)+2))
+	app1 = append(app1, segment...)
+
+	out := append([]byte{}, data[:2]...)
+	out = append(out, app1...)
+	return append(out, data[2:]...)
+}
+
+func encodeJPEG(t *testing.T, w, h int) []byte {
+	t.Helper()
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for x := 0; x < w; x++ {
+		for y := 0; y < h; y++ {
+			img.Set(x, y, color.RGBA{R: uint8(x * 10), G: uint8(y * 10), B: 100, A: 255})
+		}
+	}
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, nil); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestExifOrientation(t *testing.T) {
+	data := encodeJPEG(t, 8, 4)
+	if o := exifOrientation(data); o != 1 {
+		t.Fatalf("orientation without exif = %d, want 1", o)
+	}
+	for _, o := range []uint16{3, 6, 8} {
+		if got := exifOrientation(withOrientation(t, data, o)); got != int(o) {
+			t.Fatalf("orientation = %d, want %d", got, o)
+		}
+	}
+}
+
+func TestDecodeAppliesOrientationAndStripsExif(t *testing.T) {
+	data := withOrientation(t, encodeJPEG(t, 8, 4), 6)
+	img, format, err := Decode(data, 1000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if format != "jpeg" {
+		t.Fatalf("format = %s, want jpeg", format)
+	}
+	// 顺时针旋转90度后宽高互换
+	if b := img.Bounds(); b.Dx() != 4 || b.Dy() != 8 {
+		t.Fatalf("bounds = %v, want 4x8", b)
+	}
+
+	out, contentType, err := Encode(img, format)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if contentType != "image/jpeg" {
+		t.Fatalf("content type = %s", contentType)
+	}
+	if bytes.Contains(out, []byte("Exif")) {
+		t.Fatal("re-encoded image still contains exif")
+	}
+}
+
+func TestDecodeLimits(t *testing.T) {
+	if _, _, err := Decode([]byte("not an image"), 1000); err != ErrUnsupported {
+		t.Fatalf("err = %v, want ErrUnsupported", err)
+	}
+	if _, _, err := Decode(encodeJPEG(t, 40, 40), 1000); err != ErrTooLarge {
+		t.Fatalf("err = %v, want ErrTooLarge", err)
+	}
+}
+
+func TestThumbnail(t *testing.T) {
+	img, _, err := Decode(encodeJPEG(t, 30, 20), 1000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	thumb := Thumbnail(img, 8)
+	if b := thumb.Bounds(); b.Dx() != 8 || b.Dy() != 8 {
+		t.Fatalf("bounds = %v, want 8x8", b)
+	}
+}
+
+func TestFit(t *testing.T) {
+	tests := []struct {
+		w, h    int
+		wantW   int
+		wantH   int
+		maxSide int
+	}{
+		{w: 30, h: 20, maxSide: 40, wantW: 30, wantH: 20},
+		{w: 30, h: 20, maxSide: 15, wantW: 15, wantH: 10},
+		{w: 20, h: 30, maxSide: 15, wantW: 10, wantH: 15},
+		{w: 300, h: 1, maxSide: 10, wantW: 10, wantH: 1},
+	}
+	for _, tt := range tests {
+		img := image.NewRGBA(image.Rect(0, 0, tt.w, tt.h))
+		b := Fit(img, tt.maxSide).Bounds()
+		if b.Dx() != tt.wantW || b.Dy() != tt.wantH {
+			t.Errorf("Fit(%dx%d, %d) = %dx%d, want %dx%d", tt.w, tt.h, tt.maxSide, b.Dx(), b.Dy(), tt.wantW, tt.wantH)
+		}
+	}
+}
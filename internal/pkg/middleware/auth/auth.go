@@ -17,9 +17,14 @@ const (
 
 // 可选鉴权接口
 var optionalAuthRouters = map[string]struct{}{
-	"/realworld.v1.RealWorld/GetProfile":   {},
-	"/realworld.v1.RealWorld/GetComments":  {},
-	"/realworld.v1.RealWorld/ListArticles": {},
+	"/realworld.v1.RealWorld/GetProfile":     {},
+	"/realworld.v1.RealWorld/GetArticle":     {},
+	"/realworld.v1.RealWorld/GetComments":    {},
+	"/realworld.v1.RealWorld/ListArticles":   {},
+	"/realworld.v1.RealWorld/ListFollowers":  {},
+	"/realworld.v1.RealWorld/ListFollowing":  {},
+	"/realworld.v1.RealWorld/SearchProfiles": {},
+	"/realworld.v1.RealWorld/GetTags":        {},
 }
 
 // 在context里面存储用户信息-uid
@@ -4,12 +4,20 @@ import (
 	"kratos-realworld/internal/errors"
 	nethttp "net/http"
 
+	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/transport/http"
 )
 
 // 错误编码器 - 写数据到输出
-// 统一的错误处理机制 - 处理框架返回的错误error
-func errorEncoder(w nethttp.ResponseWriter, r *nethttp.Request, err error) {
+// 统一的错误处理机制 - 处理框架返回的错误error, 会被隐藏的内部错误先记录日志
+func newErrorEncoder(logger log.Logger) http.EncodeErrorFunc {
+	return func(w nethttp.ResponseWriter, r *nethttp.Request, err error) {
+		errors.LogMasked(r.Context(), logger, err)
+		encodeError(w, r, err)
+	}
+}
+
+func encodeError(w nethttp.ResponseWriter, r *nethttp.Request, err error) {
 	se := errors.FromError(err)
 	codec, _ := http.CodecForRequest(r, "Accept")
 	body, err := codec.Marshal(se)
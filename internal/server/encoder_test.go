@@ -1,12 +1,20 @@
 package server
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
+	"kratos-realworld/internal/biz"
 	"kratos-realworld/internal/errors"
+	nethttp "net/http"
+	"net/http/httptest"
 	"testing"
 
+	"github.com/go-kratos/kratos/v2/log"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
 )
 
 func TestHTTPError(t *testing.T) {
@@ -19,3 +27,65 @@ func TestHTTPError(t *testing.T) {
 	assert.NoError(t, err)
 	fmt.Println(string(b))
 }
+
+func TestFromError(t *testing.T) {
+	// 校验错误的key为字段名
+	he := errors.FromError(biz.ValidationError("email", "can not be empty"))
+	assert.Equal(t, 422, he.Code)
+	assert.Equal(t, map[string][]string{"email": {"can not be empty"}}, he.Errors)
+
+	// 其他领域错误的key为reason
+	he = errors.FromError(fmt.Errorf("get article: %w", biz.ErrArticleNotFound))
+	assert.Equal(t, 404, he.Code)
+	assert.Equal(t, map[string][]string{"ARTICLE_NOT_FOUND": {"article not found"}}, he.Errors)
+
+	// 内部错误不返回具体信息
+	he = errors.FromError(fmt.Errorf("dial tcp 10.0.0.1:3306: connection refused"))
+	assert.Equal(t, 500, he.Code)
+	assert.Equal(t, map[string][]string{"INTERNAL": {"internal server error"}}, he.Errors)
+
+	he = errors.FromError(fmt.Errorf("query: %w", context.DeadlineExceeded))
+	assert.Equal(t, 504, he.Code)
+
+	// 占位用户名被占用和删除占位用户是不同的错误
+	he = errors.FromError(biz.ErrPlaceholderTaken)
+	assert.Equal(t, 409, he.Code)
+	assert.Contains(t, he.Errors, "PLACEHOLDER_TAKEN")
+}
+
+func TestGRPCStatus(t *testing.T) {
+	s := errors.GRPCStatus(biz.ValidationError("email", "can not be empty"))
+	assert.Equal(t, codes.InvalidArgument, s.Code())
+	info := s.Details()[0].(*errdetails.ErrorInfo)
+	assert.Equal(t, "VALIDATION_FAILED", info.Reason)
+	assert.Equal(t, "email", info.Metadata["field"])
+
+	assert.Equal(t, codes.NotFound, errors.GRPCStatus(biz.ErrUserNotFound).Code())
+	assert.Equal(t, codes.Internal, errors.GRPCStatus(fmt.Errorf("boom")).Code())
+}
+
+// 内部错误返回500之前记录原始错误, 领域错误不记录
+func TestErrorEncoderLogsMaskedErrors(t *testing.T) {
+	var buf bytes.Buffer
+	encode := newErrorEncoder(log.NewStdLogger(&buf))
+	r := httptest.NewRequest(nethttp.MethodGet, "/api/user", nil)
+
+	w := httptest.NewRecorder()
+	encode(w, r, fmt.Errorf("query users: %w", fmt.Errorf("dial tcp 10.0.0.1:3306: connection refused")))
+	assert.Equal(t, 500, w.Code)
+	assert.Contains(t, buf.String(), "dial tcp 10.0.0.1:3306: connection refused")
+	assert.NotContains(t, w.Body.String(), "10.0.0.1")
+
+	buf.Reset()
+	w = httptest.NewRecorder()
+	encode(w, r, biz.ErrUserNotFound)
+	assert.Equal(t, 404, w.Code)
+	assert.Equal(t, "", buf.String())
+
+	buf.Reset()
+	_, err := errors.Server(log.NewStdLogger(&buf))(func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, fmt.Errorf("redis: connection pool timeout")
+	})(context.Background(), nil)
+	assert.Error(t, err)
+	assert.Contains(t, buf.String(), "redis: connection pool timeout")
+}
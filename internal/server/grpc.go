@@ -3,6 +3,7 @@ package server
 import (
 	v1 "kratos-realworld/api/realworld/v1"
 	"kratos-realworld/internal/conf"
+	"kratos-realworld/internal/errors"
 	"kratos-realworld/internal/service"
 
 	"github.com/go-kratos/kratos/v2/log"
@@ -14,6 +15,8 @@ import (
 func NewGRPCServer(c *conf.Server, greeter *service.RealWorldService, logger log.Logger) *grpc.Server {
 	var opts = []grpc.ServerOption{
 		grpc.Middleware(
+			// 错误转换在最外层, recovery返回的错误也要转换
+			errors.Server(logger),
 			recovery.Recovery(),
 		),
 	}
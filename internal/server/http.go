@@ -20,8 +20,9 @@ func NewSkipRoutersMatcher() selector.MatchFunc {
 	skipRouters := make(map[string]struct{})
 	skipRouters["/realworld.v1.RealWorld/Login"] = struct{}{}
 	skipRouters["/realworld.v1.RealWorld/Register"] = struct{}{}
-	skipRouters["/realworld.v1.RealWorld/GetArticle"] = struct{}{}
-	skipRouters["/realworld.v1.RealWorld/GetTags"] = struct{}{}
+	skipRouters["/realworld.v1.RealWorld/GetReactions"] = struct{}{}
+	skipRouters["/realworld.v1.RealWorld/GetTrendingTags"] = struct{}{}
+	skipRouters[service.OperationGetMedia] = struct{}{}
 	return func(ctx context.Context, operation string) bool {
 		if _, ok := skipRouters[operation]; ok {
 			return false
@@ -33,7 +34,7 @@ func NewSkipRoutersMatcher() selector.MatchFunc {
 // NewHTTPServer new an HTTP server.
 func NewHTTPServer(c *conf.Server, jwt *conf.JWT, greeter *service.RealWorldService, logger log.Logger) *http.Server {
 	var opts = []http.ServerOption{
-		http.ErrorEncoder(errorEncoder),
+		http.ErrorEncoder(newErrorEncoder(logger)),
 		http.Middleware(
 			recovery.Recovery(),
 			selector.Server(auth.JWTAuth(jwt.Secret)).Match(NewSkipRoutersMatcher()).Build(),
@@ -61,5 +62,11 @@ func NewHTTPServer(c *conf.Server, jwt *conf.JWT, greeter *service.RealWorldServ
 	// 注册http服务到server上
 	// pb做网络监听、请求分发
 	v1.RegisterRealWorldHTTPServer(srv, greeter)
+	// multipart上传和本地文件访问不走pb生成的路由
+	r := srv.Route("/")
+	r.POST("/api/user/avatar", greeter.UploadAvatar)
+	r.POST("/api/attachments", greeter.UploadAttachment)
+	r.POST("/api/articles/{slug}/attachments", greeter.UploadAttachment)
+	r.GET("/media/{key:.+}", greeter.GetMedia)
 	return srv
 }
@@ -0,0 +1,109 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"kratos-realworld/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 后台定时任务 - 实现transport.Server, 随应用一起启动和停止
+type JobServer struct {
+	jobs     []*job
+	log      *log.Helper
+	stop     chan struct{}
+	stopOnce sync.Once
+	done     chan struct{}
+}
+
+type job struct {
+	name     string
+	interval time.Duration
+	run      func(ctx context.Context) error
+	// 收到通知时立即执行, 不等下一次间隔
+	wake <-chan struct{}
+}
+
+func NewJobServer(mu *biz.MediaUsecase, su *biz.SocialUsecase, eb *biz.EventBus, logger log.Logger) *JobServer {
+	s := &JobServer{
+		log:  log.NewHelper(logger),
+		stop: make(chan struct{}),
+		done: make(chan struct{}),
+	}
+	s.register("cleanup-attachments", mu.CleanupInterval(), func(ctx context.Context) error {
+		n, err := mu.CleanupAttachments(ctx)
+		if n > 0 {
+			s.log.Infof("cleaned up %d orphaned attachments", n)
+		}
+		return err
+	})
+	s.register("reconcile-favorites", su.ReconcileInterval(), func(ctx context.Context) error {
+		n, err := su.ReconcileFavoritesCounts(ctx)
+		if n > 0 {
+			s.log.Warnf("fixed favorites count of %d articles", n)
+		}
+		return err
+	})
+	// 事件提交后立即投递, 定时检查到期的重试和其他实例没有投递完的事件
+	s.register("dispatch-events", eb.PollInterval(), func(ctx context.Context) error {
+		_, err := eb.Dispatch(ctx)
+		return err
+	}).wake = eb.Notify()
+	return s
+}
+
+func (s *JobServer) register(name string, interval time.Duration, run func(ctx context.Context) error) *job {
+	j := &job{name: name, interval: interval, run: run}
+	s.jobs = append(s.jobs, j)
+	return j
+}
+
+// 启动时先执行一次, 之后按间隔执行; 阻塞到Stop
+func (s *JobServer) Start(ctx context.Context) error {
+	defer close(s.done)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	for _, j := range s.jobs {
+		wg.Add(1)
+		go func(j *job) {
+			defer wg.Done()
+			ticker := time.NewTicker(j.interval)
+			defer ticker.Stop()
+			for {
+				if err := j.run(ctx); err != nil && ctx.Err() == nil {
+					s.log.Errorf("job %s error: %v", j.name, err)
+				}
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+				case <-j.wake:
+				}
+			}
+		}(j)
+	}
+
+	select {
+	case <-s.stop:
+	case <-ctx.Done():
+	}
+	// 取消正在执行的任务并等待退出
+	cancel()
+	wg.Wait()
+	return nil
+}
+
+func (s *JobServer) Stop(ctx context.Context) error {
+	s.stopOnce.Do(func() { close(s.stop) })
+	select {
+	case <-s.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,86 @@
+package service
+
+import (
+	"context"
+
+	v1 "kratos-realworld/api/realworld/v1"
+	"kratos-realworld/internal/biz"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func convertCollection(c *biz.BookmarkCollection) *v1.BookmarkCollection {
+	return &v1.BookmarkCollection{
+		Id:             uint32(c.ID),
+		Name:           c.Name,
+		BookmarksCount: c.BookmarksCount,
+		CreatedAt:      timestamppb.New(c.CreatedAt),
+	}
+}
+
+func (s *RealWorldService) BookmarkArticle(ctx context.Context, req *v1.BookmarkArticleRequest) (*v1.SingleArticleResponse, error) {
+	article, err := s.uc.BookmarkArticle(ctx, req.Slug, uint(req.CollectionId))
+	if err != nil {
+		return nil, err
+	}
+	return convertArticle(article), nil
+}
+
+func (s *RealWorldService) UnbookmarkArticle(ctx context.Context, req *v1.UnbookmarkArticleRequest) (*v1.SingleArticleResponse, error) {
+	article, err := s.uc.UnbookmarkArticle(ctx, req.Slug)
+	if err != nil {
+		return nil, err
+	}
+	return convertArticle(article), nil
+}
+
+func (s *RealWorldService) ListBookmarks(ctx context.Context, req *v1.ListBookmarksRequest) (*v1.MultipleArticleResponse, error) {
+	page, err := s.uc.ListBookmarks(ctx, uint(req.CollectionId), req.Cursor, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+	articles := make([]*v1.Article, 0, len(page.Articles))
+	for _, article := range page.Articles {
+		articles = append(articles, convertArticle(article).Article)
+	}
+	return &v1.MultipleArticleResponse{
+		Articles:      articles,
+		ArticlesCount: uint32(len(articles)),
+		NextCursor:    page.NextCursor,
+	}, nil
+}
+
+func (s *RealWorldService) ListBookmarkCollections(ctx context.Context, req *v1.ListBookmarkCollectionsRequest) (*v1.MultipleBookmarkCollectionResponse, error) {
+	list, err := s.uc.ListBookmarkCollections(ctx)
+	if err != nil {
+		return nil, err
+	}
+	collections := make([]*v1.BookmarkCollection, len(list))
+	for i, c := range list {
+		collections[i] = convertCollection(c)
+	}
+	return &v1.MultipleBookmarkCollectionResponse{Collections: collections}, nil
+}
+
+func (s *RealWorldService) CreateBookmarkCollection(ctx context.Context, req *v1.CreateBookmarkCollectionRequest) (*v1.SingleBookmarkCollectionResponse, error) {
+	c, err := s.uc.CreateBookmarkCollection(ctx, req.GetCollection().GetName())
+	if err != nil {
+		return nil, err
+	}
+	return &v1.SingleBookmarkCollectionResponse{Collection: convertCollection(c)}, nil
+}
+
+func (s *RealWorldService) UpdateBookmarkCollection(ctx context.Context, req *v1.UpdateBookmarkCollectionRequest) (*v1.SingleBookmarkCollectionResponse, error) {
+	c, err := s.uc.RenameBookmarkCollection(ctx, uint(req.Id), req.GetCollection().GetName())
+	if err != nil {
+		return nil, err
+	}
+	return &v1.SingleBookmarkCollectionResponse{Collection: convertCollection(c)}, nil
+}
+
+func (s *RealWorldService) DeleteBookmarkCollection(ctx context.Context, req *v1.DeleteBookmarkCollectionRequest) (*v1.DeleteBookmarkCollectionResponse, error) {
+	if err := s.uc.DeleteBookmarkCollection(ctx, uint(req.Id)); err != nil {
+		return nil, err
+	}
+	return &v1.DeleteBookmarkCollectionResponse{}, nil
+}
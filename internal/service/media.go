@@ -0,0 +1,175 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"io"
+	nethttp "net/http"
+	"time"
+
+	v1 "kratos-realworld/api/realworld/v1"
+	"kratos-realworld/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/transport/http"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// 不是pb生成的路由, 手动设置operation, 鉴权中间件按它判断白名单
+const (
+	OperationUploadAvatar     = "/realworld.v1.RealWorld/UploadAvatar"
+	OperationUploadAttachment = "/realworld.v1.RealWorld/UploadAttachment"
+	OperationGetMedia         = "/realworld.v1.RealWorld/GetMedia"
+)
+
+// multipart边界和其它字段的开销
+const multipartOverhead = 64 << 10
+
+// 上传头像 - multipart/form-data, 文件字段为image
+func (s *RealWorldService) UploadAvatar(ctx http.Context) error {
+	http.SetOperation(ctx, OperationUploadAvatar)
+	w := ctx.Response()
+	h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+		contentType, data, err := readUpload(w, req.(*nethttp.Request), s.mu.MaxAvatarBytes())
+		if err != nil {
+			return nil, err
+		}
+		return s.mu.UploadAvatar(ctx, contentType, data)
+	})
+	out, err := h(ctx, ctx.Request())
+	if err != nil {
+		return err
+	}
+	return ctx.Result(200, convertAvatar(out.(*biz.Avatar)))
+}
+
+// 读取multipart请求中的image字段
+func readUpload(w nethttp.ResponseWriter, r *nethttp.Request, maxBytes int64) (string, []byte, error) {
+	r.Body = nethttp.MaxBytesReader(w, r.Body, maxBytes+multipartOverhead)
+	file, header, err := r.FormFile("image")
+	if err != nil {
+		var tooLarge *nethttp.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			return "", nil, biz.ValidationError("image", "is too large")
+		}
+		return "", nil, biz.ValidationError("image", "can not be empty")
+	}
+	defer file.Close()
+	// 多读一个字节, biz层据此判断是否超过大小限制
+	data, err := io.ReadAll(io.LimitReader(file, maxBytes+1))
+	if err != nil {
+		return "", nil, err
+	}
+	return header.Header.Get("Content-Type"), data, nil
+}
+
+// 上传文章附件 - /api/attachments或/api/articles/{slug}/attachments, 文件字段为image
+func (s *RealWorldService) UploadAttachment(ctx http.Context) error {
+	http.SetOperation(ctx, OperationUploadAttachment)
+	w := ctx.Response()
+	slug := ctx.Vars().Get("slug")
+	h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+		contentType, data, err := readUpload(w, req.(*nethttp.Request), s.mu.MaxAttachmentBytes())
+		if err != nil {
+			return nil, err
+		}
+		return s.mu.UploadAttachment(ctx, slug, contentType, data)
+	})
+	out, err := h(ctx, ctx.Request())
+	if err != nil {
+		return err
+	}
+	return ctx.Result(200, &v1.SingleAttachmentResponse{Attachment: convertAttachment(out.(*biz.Attachment))})
+}
+
+func convertAttachment(a *biz.Attachment) *v1.Attachment {
+	return &v1.Attachment{
+		Id:          uint32(a.ID),
+		Url:         a.URL,
+		PreviewUrl:  a.PreviewURL,
+		ContentType: a.ContentType,
+		Size:        a.Size,
+		Width:       int32(a.Width),
+		Height:      int32(a.Height),
+		CreatedAt:   timestamppb.New(a.CreatedAt),
+	}
+}
+
+func convertAttachments(attachments []*biz.Attachment, usage *biz.AttachmentUsage) *v1.MultipleAttachmentResponse {
+	list := make([]*v1.Attachment, len(attachments))
+	for i, a := range attachments {
+		list[i] = convertAttachment(a)
+	}
+	return &v1.MultipleAttachmentResponse{
+		Attachments: list,
+		UsedBytes:   usage.UsedBytes,
+		QuotaBytes:  usage.QuotaBytes,
+	}
+}
+
+func (s *RealWorldService) ListAttachments(ctx context.Context, req *v1.ListAttachmentsRequest) (*v1.MultipleAttachmentResponse, error) {
+	attachments, usage, err := s.mu.ListAttachments(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return convertAttachments(attachments, usage), nil
+}
+
+func (s *RealWorldService) ListArticleAttachments(ctx context.Context, req *v1.ListArticleAttachmentsRequest) (*v1.MultipleAttachmentResponse, error) {
+	attachments, usage, err := s.mu.ListArticleAttachments(ctx, req.Slug)
+	if err != nil {
+		return nil, err
+	}
+	return convertAttachments(attachments, usage), nil
+}
+
+func (s *RealWorldService) DeleteAttachment(ctx context.Context, req *v1.DeleteAttachmentRequest) (*v1.DeleteAttachmentResponse, error) {
+	if err := s.mu.DeleteAttachment(ctx, uint(req.Id)); err != nil {
+		return nil, err
+	}
+	return &v1.DeleteAttachmentResponse{}, nil
+}
+
+func convertAvatar(a *biz.Avatar) *v1.UploadAvatarResponse {
+	thumbnails := make([]*v1.UploadAvatarResponse_Thumbnail, len(a.Thumbnails))
+	for i, t := range a.Thumbnails {
+		thumbnails[i] = &v1.UploadAvatarResponse_Thumbnail{
+			Size: int32(t.Size),
+			Url:  t.URL,
+		}
+	}
+	return &v1.UploadAvatarResponse{
+		Image: &v1.UploadAvatarResponse_Image{
+			Url:        a.URL,
+			Thumbnails: thumbnails,
+		},
+	}
+}
+
+// 本地存储的文件 - key每次上传都是随机生成的, 内容不会变化, 可以长期缓存
+// 签名url访问的文件只允许浏览器在签名过期前缓存
+func (s *RealWorldService) GetMedia(ctx http.Context) error {
+	http.SetOperation(ctx, OperationGetMedia)
+	query := ctx.Query()
+	h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+		return s.mu.GetMedia(ctx, req.(string), query.Get("expires"), query.Get("signature"))
+	})
+	out, err := h(ctx, ctx.Vars().Get("key"))
+	if err != nil {
+		return err
+	}
+	blob := out.(*biz.Blob)
+	if blob.RedirectURL != "" {
+		ctx.Response().Header().Set("Cache-Control", "private, no-store")
+		nethttp.Redirect(ctx.Response(), ctx.Request(), blob.RedirectURL, nethttp.StatusFound)
+		return nil
+	}
+	if blob.Expires.IsZero() {
+		ctx.Response().Header().Set("Cache-Control", "public, max-age=31536000, immutable")
+	} else {
+		maxAge := int(time.Until(blob.Expires).Seconds())
+		ctx.Response().Header().Set("Cache-Control", fmt.Sprintf("private, max-age=%d", maxAge))
+	}
+	ctx.Response().Header().Set("X-Content-Type-Options", "nosniff")
+	return ctx.Blob(200, blob.ContentType, blob.Data)
+}
@@ -0,0 +1,107 @@
+package service
+
+import (
+	"context"
+
+	v1 "kratos-realworld/api/realworld/v1"
+	"kratos-realworld/internal/biz"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func convertNotification(n *biz.Notification) *v1.Notification {
+	notification := &v1.Notification{
+		Id:           uint32(n.ID),
+		Type:         n.Type,
+		Message:      n.Message,
+		ActorsCount:  n.ActorsCount,
+		ArticleSlug:  n.ArticleSlug,
+		ArticleTitle: n.ArticleTitle,
+		CommentId:    uint32(n.CommentID),
+		Read:         n.Read,
+		CreatedAt:    timestamppb.New(n.CreatedAt),
+	}
+	if n.Actor != nil {
+		notification.Actor = (*v1.Profile)(convertProfile(n.Actor))
+	}
+	return notification
+}
+
+func convertNotificationPreferences(preferences map[string]bool) *v1.NotificationPreferencesResponse {
+	enabled := func(t string) *bool {
+		v := preferences[t]
+		return &v
+	}
+	return &v1.NotificationPreferencesResponse{
+		Preferences: &v1.NotificationPreferences{
+			Follow:   enabled(biz.NotificationFollow),
+			Favorite: enabled(biz.NotificationFavorite),
+			Comment:  enabled(biz.NotificationComment),
+			Mention:  enabled(biz.NotificationMention),
+		},
+	}
+}
+
+func (s *RealWorldService) ListNotifications(ctx context.Context, req *v1.ListNotificationsRequest) (*v1.MultipleNotificationResponse, error) {
+	page, err := s.nu.ListNotifications(ctx, req.Cursor, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+	notifications := make([]*v1.Notification, len(page.Notifications))
+	for i, n := range page.Notifications {
+		notifications[i] = convertNotification(n)
+	}
+	return &v1.MultipleNotificationResponse{
+		Notifications: notifications,
+		UnreadCount:   uint32(page.UnreadCount),
+		NextCursor:    page.NextCursor,
+	}, nil
+}
+
+func (s *RealWorldService) MarkNotificationRead(ctx context.Context, req *v1.MarkNotificationReadRequest) (*v1.MarkNotificationsReadResponse, error) {
+	unread, err := s.nu.MarkNotificationRead(ctx, uint(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	return &v1.MarkNotificationsReadResponse{UnreadCount: uint32(unread)}, nil
+}
+
+func (s *RealWorldService) MarkAllNotificationsRead(ctx context.Context, req *v1.MarkAllNotificationsReadRequest) (*v1.MarkNotificationsReadResponse, error) {
+	unread, err := s.nu.MarkAllNotificationsRead(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.MarkNotificationsReadResponse{UnreadCount: uint32(unread)}, nil
+}
+
+func (s *RealWorldService) GetNotificationPreferences(ctx context.Context, req *v1.GetNotificationPreferencesRequest) (*v1.NotificationPreferencesResponse, error) {
+	preferences, err := s.nu.GetNotificationPreferences(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return convertNotificationPreferences(preferences), nil
+}
+
+func (s *RealWorldService) UpdateNotificationPreferences(ctx context.Context, req *v1.UpdateNotificationPreferencesRequest) (*v1.NotificationPreferencesResponse, error) {
+	// 只修改传入的类型
+	update := make(map[string]bool)
+	if p := req.GetPreferences(); p != nil {
+		if p.Follow != nil {
+			update[biz.NotificationFollow] = p.GetFollow()
+		}
+		if p.Favorite != nil {
+			update[biz.NotificationFavorite] = p.GetFavorite()
+		}
+		if p.Comment != nil {
+			update[biz.NotificationComment] = p.GetComment()
+		}
+		if p.Mention != nil {
+			update[biz.NotificationMention] = p.GetMention()
+		}
+	}
+	preferences, err := s.nu.UpdateNotificationPreferences(ctx, update)
+	if err != nil {
+		return nil, err
+	}
+	return convertNotificationPreferences(preferences), nil
+}
@@ -0,0 +1,52 @@
+package service
+
+import (
+	"context"
+
+	v1 "kratos-realworld/api/realworld/v1"
+	"kratos-realworld/internal/biz"
+)
+
+func convertReactions(reactions []biz.ReactionCount) []*v1.Reaction {
+	list := make([]*v1.Reaction, len(reactions))
+	for i, r := range reactions {
+		list[i] = &v1.Reaction{Reaction: r.Reaction, Count: r.Count}
+	}
+	return list
+}
+
+func (s *RealWorldService) AddArticleReaction(ctx context.Context, req *v1.AddArticleReactionRequest) (*v1.SingleArticleResponse, error) {
+	article, err := s.uc.AddArticleReaction(ctx, req.Slug, req.Reaction)
+	if err != nil {
+		return nil, err
+	}
+	return convertArticle(article), nil
+}
+
+func (s *RealWorldService) RemoveArticleReaction(ctx context.Context, req *v1.RemoveArticleReactionRequest) (*v1.SingleArticleResponse, error) {
+	article, err := s.uc.RemoveArticleReaction(ctx, req.Slug, req.Reaction)
+	if err != nil {
+		return nil, err
+	}
+	return convertArticle(article), nil
+}
+
+func (s *RealWorldService) AddCommentReaction(ctx context.Context, req *v1.AddCommentReactionRequest) (*v1.SingleCommentResponse, error) {
+	comment, err := s.uc.AddCommentReaction(ctx, req.Slug, uint(req.Id), req.Reaction)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.SingleCommentResponse{Comment: convertComment(comment)}, nil
+}
+
+func (s *RealWorldService) RemoveCommentReaction(ctx context.Context, req *v1.RemoveCommentReactionRequest) (*v1.SingleCommentResponse, error) {
+	comment, err := s.uc.RemoveCommentReaction(ctx, req.Slug, uint(req.Id), req.Reaction)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.SingleCommentResponse{Comment: convertComment(comment)}, nil
+}
+
+func (s *RealWorldService) GetReactions(ctx context.Context, req *v1.GetReactionsRequest) (*v1.ReactionsListResponse, error) {
+	return &v1.ReactionsListResponse{Reactions: s.uc.GetReactions(ctx)}, nil
+}
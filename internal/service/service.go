@@ -16,11 +16,13 @@ type RealWorldService struct {
 
 	ur *biz.UserUsecase
 	uc *biz.SocialUsecase
+	mu *biz.MediaUsecase
+	nu *biz.NotificationUsecase
 }
 
 // [1] 通过new的方式进行实例初始化
-func NewRealWorldService(ur *biz.UserUsecase, uc *biz.SocialUsecase) *RealWorldService {
-	return &RealWorldService{uc: uc, ur: ur}
+func NewRealWorldService(ur *biz.UserUsecase, uc *biz.SocialUsecase, mu *biz.MediaUsecase, nu *biz.NotificationUsecase) *RealWorldService {
+	return &RealWorldService{uc: uc, ur: ur, mu: mu, nu: nu}
 }
 
 // [1] service层实现所有api的方法
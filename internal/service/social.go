@@ -22,17 +22,23 @@ func convertArticle(a *biz.Article) *v1.SingleArticleResponse {
 			Favorited:      a.Favorited,
 			FavoritesCount: a.FavoritesCount,
 			Author:         (*v1.Profile)(convertProfile(a.Author)),
+			CoverImage:     a.CoverImage,
+			Bookmarked:     a.Bookmarked,
+			Reactions:      convertReactions(a.Reactions),
+			MyReactions:    a.MyReactions,
 		},
 	}
 }
 
 func convertComment(c *biz.Comment) *v1.Comment {
 	return &v1.Comment{
-		Id:        uint32(c.ID),
-		Body:      c.Body,
-		CreatedAt: timestamppb.New(c.CreatedAt),
-		UpdatedAt: timestamppb.New(c.UpdatedAt),
-		Author:    (*v1.Profile)(convertProfile(c.Author)),
+		Id:          uint32(c.ID),
+		Body:        c.Body,
+		CreatedAt:   timestamppb.New(c.CreatedAt),
+		UpdatedAt:   timestamppb.New(c.UpdatedAt),
+		Author:      (*v1.Profile)(convertProfile(c.Author)),
+		Reactions:   convertReactions(c.Reactions),
+		MyReactions: c.MyReactions,
 	}
 }
 
@@ -78,18 +84,19 @@ func (s *RealWorldService) FeedArticles(ctx context.Context, req *v1.FeedArticle
 	if req.Offset > 0 {
 		opts = append(opts, biz.WithOffset(int(req.Offset)))
 	}
-	a, err := s.uc.FeedArticles(ctx, opts...)
+	page, err := s.uc.FeedArticles(ctx, req.Cursor, opts...)
 	if err != nil {
 		return nil, err
 	}
 
 	articles := make([]*v1.Article, 0)
-	for _, article := range a {
+	for _, article := range page.Articles {
 		articles = append(articles, convertArticle(article).Article)
 	}
 	return &v1.MultipleArticleResponse{
 		Articles:      articles,
 		ArticlesCount: uint32(len(articles)),
+		NextCursor:    page.NextCursor,
 	}, nil
 }
 
@@ -107,6 +114,7 @@ func (s *RealWorldService) CreateArticle(ctx context.Context, req *v1.CreateArti
 		Description: req.Article.Description,
 		Body:        req.Article.Body,
 		TagList:     req.Article.TagList,
+		CoverImage:  req.Article.CoverImage,
 	})
 	if err != nil {
 		return nil, err
@@ -116,11 +124,12 @@ func (s *RealWorldService) CreateArticle(ctx context.Context, req *v1.CreateArti
 
 func (s *RealWorldService) UpdateArticle(ctx context.Context, req *v1.UpdateArticleRequest) (*v1.SingleArticleResponse, error) {
 	article, err := s.uc.UpdateArticle(ctx, &biz.Article{
-		Slug:        req.Slug,
-		Title:       req.Article.Title,
-		Description: req.Article.Description,
-		Body:        req.Article.Body,
-		TagList:     req.Article.TagList,
+		Slug:             req.Slug,
+		Title:            req.Article.Title,
+		Description:      req.Article.Description,
+		Body:             req.Article.Body,
+		TagList:          req.Article.TagList,
+		CoverImageUpdate: req.Article.CoverImage,
 	})
 	if err != nil {
 		return nil, err
@@ -189,16 +198,99 @@ func (s *RealWorldService) UnfavoriteArticle(ctx context.Context, req *v1.Unfavo
 	return convertArticle(article), nil
 }
 
+func convertTag(t *biz.TagInfo) *v1.Tag {
+	return &v1.Tag{
+		Name:          string(t.Name),
+		Following:     t.Following,
+		ArticlesCount: t.ArticlesCount,
+		Aliases:       t.Aliases,
+	}
+}
+
 func (s *RealWorldService) GetTags(ctx context.Context, in *v1.GetTagsRequest) (*v1.TagsListResponse, error) {
-	tags, err := s.uc.GetTags(ctx)
+	tags, err := s.uc.GetTags(ctx, in.Prefix, in.Limit)
 	if err != nil {
 		return nil, err
 	}
 	tagList := make([]string, len(tags))
+	details := make([]*v1.Tag, len(tags))
 	for i, tag := range tags {
-		tagList[i] = string(tag)
+		tagList[i] = string(tag.Name)
+		details[i] = convertTag(tag)
 	}
 	return &v1.TagsListResponse{
-		Tags: tagList,
+		Tags:    tagList,
+		Details: details,
 	}, nil
 }
+
+func (s *RealWorldService) GetTrendingTags(ctx context.Context, req *v1.GetTrendingTagsRequest) (*v1.TrendingTagsResponse, error) {
+	tags, err := s.uc.GetTrendingTags(ctx, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*v1.TrendingTag, len(tags))
+	for i, tag := range tags {
+		list[i] = &v1.TrendingTag{
+			Name:                string(tag.Name),
+			Score:               tag.Score,
+			RecentArticlesCount: tag.RecentCount,
+		}
+	}
+	return &v1.TrendingTagsResponse{Tags: list}, nil
+}
+
+func (s *RealWorldService) FollowTag(ctx context.Context, req *v1.FollowTagRequest) (*v1.TagResponse, error) {
+	tag, err := s.uc.FollowTag(ctx, req.Tag)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.TagResponse{Tag: convertTag(tag)}, nil
+}
+
+func (s *RealWorldService) UnfollowTag(ctx context.Context, req *v1.UnfollowTagRequest) (*v1.TagResponse, error) {
+	tag, err := s.uc.UnfollowTag(ctx, req.Tag)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.TagResponse{Tag: convertTag(tag)}, nil
+}
+
+func (s *RealWorldService) RenameTag(ctx context.Context, req *v1.RenameTagRequest) (*v1.TagResponse, error) {
+	tag, err := s.uc.RenameTag(ctx, req.Tag, req.Name)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.TagResponse{Tag: convertTag(tag)}, nil
+}
+
+func (s *RealWorldService) MergeTag(ctx context.Context, req *v1.MergeTagRequest) (*v1.TagResponse, error) {
+	tag, err := s.uc.MergeTag(ctx, req.Tag, req.Target)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.TagResponse{Tag: convertTag(tag)}, nil
+}
+
+func (s *RealWorldService) DeleteTag(ctx context.Context, req *v1.DeleteTagRequest) (*v1.DeleteTagResponse, error) {
+	if err := s.uc.DeleteTag(ctx, req.Tag); err != nil {
+		return nil, err
+	}
+	return &v1.DeleteTagResponse{}, nil
+}
+
+func (s *RealWorldService) AddTagAlias(ctx context.Context, req *v1.AddTagAliasRequest) (*v1.TagResponse, error) {
+	tag, err := s.uc.AddTagAlias(ctx, req.Tag, req.Alias)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.TagResponse{Tag: convertTag(tag)}, nil
+}
+
+func (s *RealWorldService) RemoveTagAlias(ctx context.Context, req *v1.RemoveTagAliasRequest) (*v1.TagResponse, error) {
+	tag, err := s.uc.RemoveTagAlias(ctx, req.Tag, req.Alias)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.TagResponse{Tag: convertTag(tag)}, nil
+}
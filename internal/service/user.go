@@ -2,9 +2,12 @@ package service
 
 import (
 	"context"
+	"time"
 
 	v1 "kratos-realworld/api/realworld/v1"
 	"kratos-realworld/internal/biz"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // 转换结构体
@@ -15,6 +18,13 @@ func convertProfile(profile *biz.ProfileResp) *v1.ProfileResponse_Profile {
 		Bio:       profile.Bio,
 		Image:     profile.Image,
 		Following: profile.Following,
+
+		Private:         profile.Private,
+		FollowRequested: profile.FollowRequested,
+
+		FollowersCount: profile.FollowersCount,
+		FollowingCount: profile.FollowingCount,
+		ArticlesCount:  profile.ArticlesCount,
 	}
 }
 
@@ -30,6 +40,7 @@ func (s *RealWorldService) Login(ctx context.Context, req *v1.LoginRequest) (*v1
 			Username: user.Username,
 			Email:    user.Email,
 			Token:    user.Token,
+			Private:  user.Private,
 		},
 	}, nil
 }
@@ -61,6 +72,7 @@ func (s *RealWorldService) GetCurrentUser(ctx context.Context, req *v1.GetCurren
 			Email:    user.Email,
 			Image:    user.Image,
 			Bio:      user.Bio,
+			Private:  user.Private,
 		},
 	}, nil
 }
@@ -73,6 +85,7 @@ func (s *RealWorldService) UpdateUser(ctx context.Context, req *v1.UpdateUserReq
 		Username: req.User.Username,
 		Bio:      req.User.Bio,
 		Image:    req.User.Image,
+		Private:  req.User.Private,
 	})
 	if err != nil {
 		return nil, err
@@ -84,7 +97,129 @@ func (s *RealWorldService) UpdateUser(ctx context.Context, req *v1.UpdateUserReq
 			Token:    user.Token,
 			Image:    user.Image,
 			Bio:      user.Bio,
+			Private:  user.Private,
+		},
+	}, nil
+}
+
+// 注销账号
+func (s *RealWorldService) DeleteCurrentUser(ctx context.Context, req *v1.DeleteCurrentUserRequest) (*v1.DeleteCurrentUserResponse, error) {
+	if err := s.ur.DeleteCurrentUser(ctx); err != nil {
+		return nil, err
+	}
+	return &v1.DeleteCurrentUserResponse{
+		Message: "delete user success",
+	}, nil
+}
+
+// 导出个人数据
+func (s *RealWorldService) ExportCurrentUser(ctx context.Context, req *v1.ExportCurrentUserRequest) (*v1.UserExportResponse, error) {
+	export, err := s.ur.ExportCurrentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	articles := make([]*v1.Article, len(export.Articles))
+	for i, a := range export.Articles {
+		articles[i] = convertArticle(a).Article
+	}
+	comments := make([]*v1.UserExportResponse_Comment, len(export.Comments))
+	for i, c := range export.Comments {
+		comments[i] = &v1.UserExportResponse_Comment{
+			Id:          uint32(c.ID),
+			ArticleSlug: c.ArticleSlug,
+			Body:        c.Body,
+			CreatedAt:   timestamppb.New(c.CreatedAt),
+			UpdatedAt:   timestamppb.New(c.UpdatedAt),
+		}
+	}
+	favorites := make([]*v1.UserExportResponse_Favorite, len(export.Favorites))
+	for i, f := range export.Favorites {
+		favorites[i] = &v1.UserExportResponse_Favorite{
+			Slug:      f.Slug,
+			CreatedAt: timestamppb.New(f.CreatedAt),
+		}
+	}
+	convertFollows := func(follows []*biz.ExportFollow) []*v1.UserExportResponse_Follow {
+		list := make([]*v1.UserExportResponse_Follow, len(follows))
+		for i, f := range follows {
+			list[i] = &v1.UserExportResponse_Follow{
+				Username:  f.Username,
+				CreatedAt: timestamppb.New(f.CreatedAt),
+			}
+		}
+		return list
+	}
+
+	attachments := make([]*v1.Attachment, len(export.Attachments))
+	for i, a := range export.Attachments {
+		attachments[i] = convertAttachment(a)
+	}
+	collections := make([]*v1.BookmarkCollection, len(export.BookmarkCollections))
+	for i, c := range export.BookmarkCollections {
+		collections[i] = convertCollection(c)
+	}
+	bookmarks := make([]*v1.UserExportResponse_Bookmark, len(export.Bookmarks))
+	for i, b := range export.Bookmarks {
+		bookmarks[i] = &v1.UserExportResponse_Bookmark{
+			Slug:       b.Slug,
+			Collection: b.Collection,
+			CreatedAt:  timestamppb.New(b.CreatedAt),
+		}
+	}
+	reactions := make([]*v1.UserExportResponse_Reaction, len(export.Reactions))
+	for i, r := range export.Reactions {
+		reactions[i] = &v1.UserExportResponse_Reaction{
+			TargetType: r.TargetType,
+			TargetId:   uint32(r.TargetID),
+			Reaction:   r.Reaction,
+			CreatedAt:  timestamppb.New(r.CreatedAt),
+		}
+	}
+	tags := make([]*v1.UserExportResponse_Tag, len(export.FollowedTags))
+	for i, t := range export.FollowedTags {
+		tags[i] = &v1.UserExportResponse_Tag{
+			Name:      t.Name,
+			CreatedAt: timestamppb.New(t.CreatedAt),
+		}
+	}
+	notifications := make([]*v1.UserExportResponse_Notification, len(export.Notifications))
+	for i, n := range export.Notifications {
+		notifications[i] = &v1.UserExportResponse_Notification{
+			Type:        n.Type,
+			ArticleSlug: n.ArticleSlug,
+			CommentId:   uint32(n.CommentID),
+			Actors:      n.Actors,
+			Read:        n.Read,
+			CreatedAt:   timestamppb.New(n.CreatedAt),
+		}
+	}
+
+	return &v1.UserExportResponse{
+		User: &v1.UserExportResponse_User{
+			Email:     export.User.Email,
+			Username:  export.User.Username,
+			Bio:       export.User.Bio,
+			Image:     export.User.Image,
+			CreatedAt: timestamppb.New(export.CreatedAt),
 		},
+		Articles:                articles,
+		Comments:                comments,
+		Favorites:               favorites,
+		Following:               convertFollows(export.Following),
+		Followers:               convertFollows(export.Followers),
+		ExportedAt:              timestamppb.New(time.Now()),
+		FollowRequestsSent:      convertFollows(export.FollowRequestsSent),
+		FollowRequestsReceived:  convertFollows(export.FollowRequestsReceived),
+		Blocked:                 convertFollows(export.Blocked),
+		Muted:                   convertFollows(export.Muted),
+		Attachments:             attachments,
+		BookmarkCollections:     collections,
+		Bookmarks:               bookmarks,
+		Reactions:               reactions,
+		FollowedTags:            tags,
+		Notifications:           notifications,
+		NotificationPreferences: export.NotificationPreferences,
 	}, nil
 }
 
@@ -108,6 +243,33 @@ func (s *RealWorldService) FollowUser(ctx context.Context, req *v1.FollowUserReq
 	}, nil
 }
 
+func convertProfilePage(page *biz.ProfilePage) *v1.MultipleProfileResponse {
+	profiles := make([]*v1.Profile, len(page.Profiles))
+	for i, p := range page.Profiles {
+		profiles[i] = (*v1.Profile)(convertProfile(p))
+	}
+	return &v1.MultipleProfileResponse{
+		Profiles:   profiles,
+		NextCursor: page.NextCursor,
+	}
+}
+
+func (s *RealWorldService) ListFollowers(ctx context.Context, req *v1.ListFollowsRequest) (*v1.MultipleProfileResponse, error) {
+	page, err := s.ur.ListFollowers(ctx, req.Username, req.Cursor, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+	return convertProfilePage(page), nil
+}
+
+func (s *RealWorldService) ListFollowing(ctx context.Context, req *v1.ListFollowsRequest) (*v1.MultipleProfileResponse, error) {
+	page, err := s.ur.ListFollowing(ctx, req.Username, req.Cursor, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+	return convertProfilePage(page), nil
+}
+
 func (s *RealWorldService) UnfollowUser(ctx context.Context, req *v1.UnfollowUserRequest) (*v1.ProfileResponse, error) {
 	profile, err := s.ur.UnfollowUser(ctx, req.Username)
 	if err != nil {
@@ -117,3 +279,121 @@ func (s *RealWorldService) UnfollowUser(ctx context.Context, req *v1.UnfollowUse
 		Profile: convertProfile(profile),
 	}, nil
 }
+
+func (s *RealWorldService) BlockUser(ctx context.Context, req *v1.BlockUserRequest) (*v1.ProfileResponse, error) {
+	profile, err := s.ur.BlockUser(ctx, req.Username)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.ProfileResponse{
+		Profile: convertProfile(profile),
+	}, nil
+}
+
+func (s *RealWorldService) UnblockUser(ctx context.Context, req *v1.UnblockUserRequest) (*v1.ProfileResponse, error) {
+	profile, err := s.ur.UnblockUser(ctx, req.Username)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.ProfileResponse{
+		Profile: convertProfile(profile),
+	}, nil
+}
+
+func (s *RealWorldService) MuteUser(ctx context.Context, req *v1.MuteUserRequest) (*v1.ProfileResponse, error) {
+	profile, err := s.ur.MuteUser(ctx, req.Username)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.ProfileResponse{
+		Profile: convertProfile(profile),
+	}, nil
+}
+
+func (s *RealWorldService) UnmuteUser(ctx context.Context, req *v1.UnmuteUserRequest) (*v1.ProfileResponse, error) {
+	profile, err := s.ur.UnmuteUser(ctx, req.Username)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.ProfileResponse{
+		Profile: convertProfile(profile),
+	}, nil
+}
+
+func (s *RealWorldService) ListBlockedUsers(ctx context.Context, req *v1.ListBlockedUsersRequest) (*v1.MultipleProfileResponse, error) {
+	page, err := s.ur.ListBlockedUsers(ctx, req.Cursor, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+	return convertProfilePage(page), nil
+}
+
+func (s *RealWorldService) ListMutedUsers(ctx context.Context, req *v1.ListMutedUsersRequest) (*v1.MultipleProfileResponse, error) {
+	page, err := s.ur.ListMutedUsers(ctx, req.Cursor, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+	return convertProfilePage(page), nil
+}
+
+func (s *RealWorldService) ListFollowRequests(ctx context.Context, req *v1.ListFollowRequestsRequest) (*v1.MultipleProfileResponse, error) {
+	page, err := s.ur.ListFollowRequests(ctx, req.Cursor, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+	return convertProfilePage(page), nil
+}
+
+func (s *RealWorldService) ListOutgoingFollowRequests(ctx context.Context, req *v1.ListFollowRequestsRequest) (*v1.MultipleProfileResponse, error) {
+	page, err := s.ur.ListOutgoingFollowRequests(ctx, req.Cursor, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+	return convertProfilePage(page), nil
+}
+
+func (s *RealWorldService) ApproveFollowRequest(ctx context.Context, req *v1.ApproveFollowRequestRequest) (*v1.ProfileResponse, error) {
+	profile, err := s.ur.ApproveFollowRequest(ctx, req.Username)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.ProfileResponse{
+		Profile: convertProfile(profile),
+	}, nil
+}
+
+func (s *RealWorldService) RejectFollowRequest(ctx context.Context, req *v1.RejectFollowRequestRequest) (*v1.ProfileResponse, error) {
+	profile, err := s.ur.RejectFollowRequest(ctx, req.Username)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.ProfileResponse{
+		Profile: convertProfile(profile),
+	}, nil
+}
+
+func (s *RealWorldService) CancelFollowRequest(ctx context.Context, req *v1.CancelFollowRequestRequest) (*v1.ProfileResponse, error) {
+	profile, err := s.ur.CancelFollowRequest(ctx, req.Username)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.ProfileResponse{
+		Profile: convertProfile(profile),
+	}, nil
+}
+
+func (s *RealWorldService) SearchProfiles(ctx context.Context, req *v1.SearchProfilesRequest) (*v1.MultipleProfileResponse, error) {
+	page, err := s.ur.SearchProfiles(ctx, req.Q, req.Cursor, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+	return convertProfilePage(page), nil
+}
+
+func (s *RealWorldService) SuggestProfiles(ctx context.Context, req *v1.SuggestProfilesRequest) (*v1.MultipleProfileResponse, error) {
+	page, err := s.ur.SuggestProfiles(ctx, req.Cursor, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+	return convertProfilePage(page), nil
+}